@@ -37,6 +37,7 @@ def cli(specs, output):
     env.filters["format_value"] = formatter.format_value
     env.filters["is_reference"] = formatter.is_reference
     env.filters["is_primitive"] = formatter.is_primitive
+    env.filters["is_validated"] = formatter.is_validated
     env.filters["parameter_schema"] = openapi.parameter_schema
     env.filters["parameters"] = openapi.parameters
     env.filters["form_parameter"] = openapi.form_parameter
@@ -47,6 +48,7 @@ def cli(specs, output):
     env.filters["snake_case"] = formatter.snake_case
     env.filters["untitle_case"] = formatter.untitle_case
     env.filters["upperfirst"] = utils.upperfirst
+    env.filters["validation_constraints"] = formatter.validation_constraints
     env.filters["variable_name"] = formatter.variable_name
 
     env.globals["enumerate"] = enumerate
@@ -57,7 +59,12 @@ def cli(specs, output):
     env.globals["get_default"] = openapi.get_default
     env.globals["get_container"] = openapi.get_container
     env.globals["get_container_type"] = openapi.get_container_type
+    env.globals["get_containers"] = openapi.get_containers
     env.globals["get_type_at_path"] = openapi.get_type_at_path
+    env.globals["is_idempotent"] = openapi.is_idempotent
+    env.globals["auth_schemes"] = openapi.auth_schemes
+    env.globals["stream_field"] = openapi.stream_field
+    env.globals["implicit_pagination"] = openapi.implicit_pagination
     env.globals["common_package_name"] = COMMON_PACKAGE_NAME
     env.globals["module"] = MODULE
 
@@ -73,6 +80,34 @@ def cli(specs, output):
         "no_zstd.go": env.get_template("no_zstd.j2"),
         "encoding_json.go": env.get_template("encoding_json.j2"),
         "goccy_gojson.go": env.get_template("goccy_gojson.j2"),
+        "interceptor.go": env.get_template("interceptor.j2"),
+        "rate_limiter.go": env.get_template("rate_limiter.j2"),
+        "retry_policy.go": env.get_template("retry_policy.j2"),
+        "circuit_breaker.go": env.get_template("circuit_breaker.j2"),
+        "errors.go": env.get_template("errors.j2"),
+        "stream.go": env.get_template("stream.j2"),
+        "paginator.go": env.get_template("paginator.j2"),
+        "credentials.go": env.get_template("credentials.j2"),
+        "orgs.go": env.get_template("orgs.j2"),
+        "config_file.go": env.get_template("config_file.j2"),
+        "logger.go": env.get_template("logger.j2"),
+        "log_redaction.go": env.get_template("log_redaction.j2"),
+        "datadogtest/cassette.go": env.get_template("datadogtest/cassette.j2"),
+        "datadogtest/recorder.go": env.get_template("datadogtest/recorder.j2"),
+        "datadogtest/server.go": env.get_template("datadogtest/server.j2"),
+        "datadogtest/server_v1.go": env.get_template("datadogtest/server_v1.j2"),
+        "datadogtest/server_v2.go": env.get_template("datadogtest/server_v2.j2"),
+        "validation.go": env.get_template("validation.j2"),
+        "unparsed.go": env.get_template("unparsed.j2"),
+        "response_meta.go": env.get_template("response_meta.j2"),
+        "batch.go": env.get_template("batch.j2"),
+        "intake/intake.go": env.get_template("intake/intake.j2"),
+        "intake/metrics_submitter.go": env.get_template("intake/metrics_submitter.j2"),
+        "intake/sketch.go": env.get_template("intake/sketch.j2"),
+        "intake/distribution_submitter.go": env.get_template("intake/distribution_submitter.j2"),
+        "intake/log_shipper.go": env.get_template("intake/log_shipper.j2"),
+        "intake/log_handler.go": env.get_template("intake/log_handler.j2"),
+        "intake/spool.go": env.get_template("intake/spool.j2"),
     }
 
     test_scenarios_files = {
@@ -124,6 +159,7 @@ def cli(specs, output):
     common_package_output.mkdir(parents=True, exist_ok=True)
     for name, template in extra_files.items():
         filename = common_package_output / name
+        filename.parent.mkdir(parents=True, exist_ok=True)
         with filename.open("w") as fp:
             fp.write(template.render(apis=all_apis, all_specs=all_specs))
 
@@ -189,6 +189,60 @@ def is_reference(schema, attribute):
     return True
 
 
+
+def validation_constraints(schema):
+    """Return the Go literal of the constraints of a schema checked by datadog.ValidateValue, or "nil"."""
+    if "enum" in schema or "oneOf" in schema:
+        return "nil"
+
+    def number(value):
+        return str(int(value)) if isinstance(value, float) and value.is_integer() else str(value)
+
+    constraints = []
+    _type = schema.get("type", "object")
+    if _type == "string" and schema.get("format") not in ("date-time", "date", "uuid", "binary"):
+        if "minLength" in schema:
+            constraints.append(f"MinLength: datadog.PtrInt64({schema['minLength']})")
+        if "maxLength" in schema:
+            constraints.append(f"MaxLength: datadog.PtrInt64({schema['maxLength']})")
+        if "pattern" in schema:
+            constraints.append(f"Pattern: `{schema['pattern']}`")
+    elif _type in ("integer", "number"):
+        if "minimum" in schema:
+            constraints.append(f"Minimum: datadog.PtrFloat64({number(schema['minimum'])})")
+            if schema.get("exclusiveMinimum"):
+                constraints.append("ExclusiveMinimum: true")
+        if "maximum" in schema:
+            constraints.append(f"Maximum: datadog.PtrFloat64({number(schema['maximum'])})")
+            if schema.get("exclusiveMaximum"):
+                constraints.append("ExclusiveMaximum: true")
+    elif _type == "array":
+        if "minItems" in schema:
+            constraints.append(f"MinItems: datadog.PtrInt64({schema['minItems']})")
+        if "maxItems" in schema:
+            constraints.append(f"MaxItems: datadog.PtrInt64({schema['maxItems']})")
+        items = validation_constraints(schema.get("items", {}))
+        if items != "nil":
+            constraints.append(f"Items: {items}")
+    if not constraints:
+        return "nil"
+    return "&datadog.Constraints{" + ", ".join(constraints) + "}"
+
+
+def is_validated(schema):
+    """Check if the values of a schema are checked by datadog.ValidateValue."""
+    if validation_constraints(schema) != "nil" or "enum" in schema or "oneOf" in schema:
+        return True
+    _type = schema.get("type", "object")
+    if _type == "array":
+        return is_validated(schema.get("items", {}))
+    if _type == "object":
+        if schema.get("properties"):
+            return True
+        additional_properties = schema.get("additionalProperties")
+        return isinstance(additional_properties, dict) and is_validated(additional_properties)
+    return False
+
 def attribute_path(attribute):
     return ".".join(attribute_name(a) for a in attribute.split("."))
 
@@ -248,6 +248,115 @@ def apis(spec):
     return operations
 
 
+IDEMPOTENT_METHODS = {"get", "head", "options", "put", "delete"}
+READ_ONLY_OPERATION_PREFIXES = ("Aggregate", "Get", "List", "Query", "Search", "Validate")
+
+
+def is_idempotent(method, operation):
+    """Return whether the operation can be sent more than once without duplicating its side effects."""
+    if "x-idempotent" in operation:
+        return operation["x-idempotent"]
+    return method.lower() in IDEMPOTENT_METHODS or operation["operationId"].startswith(READ_ONLY_OPERATION_PREFIXES)
+
+
+def auth_schemes(spec, operation):
+    """Return the names of the API key security schemes of the operation, in the order of the spec."""
+    names = []
+    for auth_method in operation.get("security", spec.get("security", [])):
+        for name in auth_method:
+            schema = spec["components"]["securitySchemes"][name]
+            if schema["type"] == "apiKey" and schema["in"] != "cookie" and name not in names:
+                names.append(name)
+    return names
+
+
+STREAMING_OPERATIONS = {
+    "GetHourlyUsage",
+    "ListAuditLogs",
+    "ListLogs",
+    "ListLogsGet",
+    "ListSpans",
+    "ListSpansGet",
+    "SearchAuditLogs",
+}
+
+
+def stream_field(operation):
+    """Return the top-level array field streamed by the Stream variant of the operation, if it has one."""
+    if not operation.get("x-streaming", operation["operationId"] in STREAMING_OPERATIONS):
+        return None
+    for code, response in operation.get("responses", {}).items():
+        if int(code) >= 300:
+            continue
+        for content in response.get("content", {}).values():
+            data = content.get("schema", {}).get("properties", {}).get("data", {})
+            if data.get("type") == "array":
+                return "data"
+    return None
+
+
+PAGE_SIZE_PARAMETERS = ("page[size]", "page[limit]", "page_size", "per_page", "limit")
+PAGE_NUMBER_PARAMETERS = ("page[number]", "page_number", "page")
+PAGE_OFFSET_PARAMETERS = ("page[offset]",)
+PAGE_CURSOR_PARAMETERS = ("page[cursor]",)
+NEXT_RECORD_ID_PARAMETERS = ("page[next_record_id]", "next_record_id")
+
+
+def implicit_pagination(operation):
+    """Return the pagination of an operation paging through query parameters without x-pagination, if any."""
+    if "x-pagination" in operation:
+        return None
+    names = {name for name, parameter in parameters(operation) if parameter.get("in") == "query"}
+
+    def first(candidates):
+        return next((name for name in candidates if name in names), None)
+
+    schema = None
+    for code, response in operation.get("responses", {}).items():
+        if int(code) >= 300:
+            continue
+        for content in response.get("content", {}).values():
+            schema = content.get("schema")
+    if schema is None:
+        return None
+    properties = schema.get("properties", {})
+    arrays = [name for name, prop in properties.items() if prop.get("type") == "array" and name != "included"]
+    if not arrays:
+        return None
+
+    pagination = {
+        "limitParam": first(PAGE_SIZE_PARAMETERS),
+        "resultsPath": "data" if "data" in arrays else arrays[0],
+    }
+    for name, parameter in parameters(operation):
+        if name == pagination["limitParam"]:
+            pagination["limitDefault"] = parameter["schema"].get("default")
+    if first(NEXT_RECORD_ID_PARAMETERS):
+        pagination["strategy"] = "NextRecordIDPagination"
+        pagination["cursorParam"] = first(NEXT_RECORD_ID_PARAMETERS)
+    elif first(PAGE_CURSOR_PARAMETERS):
+        pagination["strategy"] = "CursorPagination"
+        pagination["cursorParam"] = first(PAGE_CURSOR_PARAMETERS)
+    elif first(PAGE_OFFSET_PARAMETERS):
+        pagination["strategy"] = "OffsetPagination"
+        pagination["pageOffsetParam"] = first(PAGE_OFFSET_PARAMETERS)
+    elif first(PAGE_NUMBER_PARAMETERS):
+        pagination["strategy"] = "PageNumberPagination"
+        pagination["pageParam"] = first(PAGE_NUMBER_PARAMETERS)
+    else:
+        return None
+
+    if "cursorParam" in pagination:
+        for meta in ("meta", "metadata"):
+            cursor = properties.get(meta, {}).get("properties", {}).get("pagination", {}).get("properties", {})
+            for name in ("next_record_id", "next_cursor"):
+                if name in cursor:
+                    pagination["cursorPath"] = f"{meta}.pagination.{name}"
+        if "cursorPath" not in pagination:
+            return None
+    return pagination
+
+
 def operation(spec, operation_id):
     for path in spec["paths"]:
         for method in spec["paths"][path]:
@@ -420,6 +529,16 @@ def get_container(operation, attribute_path, container_name="o[0]"):
     return f'{container_name}.{formatter.attribute_path(attribute_path)}'
 
 
+def get_containers(operation, attribute_path, container_name="o[0]"):
+    """Return the containers of the attributes along a path which may be nil, with their types, outermost first."""
+    parts = attribute_path.split(".")
+    required = any(name == parts[0] and parameter["required"] for name, parameter in parameters(operation))
+    return [
+        (get_container(operation, ".".join(parts[:n]), container_name), get_container_type(operation, ".".join(parts[:n])))
+        for n in range(2 if required else 1, len(parts))
+    ]
+
+
 def get_container_type(operation, attribute_path, stop=None):
     attrs = attribute_path.split(".")[:stop]
     for name, parameter in parameters(operation):
@@ -0,0 +1,230 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBatchConcurrency is the number of operations run at the same time when BatchOptions.Concurrency is not set.
+	DefaultBatchConcurrency = 8
+	// DefaultBatchRateLimitRetries is the number of times a rate limited operation is run again when
+	// BatchOptions.RateLimitRetries is not set.
+	DefaultBatchRateLimitRetries = 3
+)
+
+// ErrBatchStopped is the error of the items skipped because an operation failed with BatchOptions.StopOnError.
+var ErrBatchStopped = errors.New("batch stopped after a failure")
+
+// BatchOptions configures Batch.
+type BatchOptions struct {
+	// Concurrency is the maximum number of operations running at the same time. Zero means DefaultBatchConcurrency.
+	Concurrency int
+	// StopOnError skips the operations not started yet once one fails.
+	StopOnError bool
+	// RateLimitRetries is the number of times an operation failing with a 429 response is run again, once the
+	// rate limit is reset. Zero means DefaultBatchRateLimitRetries, and a negative value disables it.
+	RateLimitRetries int
+}
+
+// BatchItem is the outcome of the operation of an input of Batch.
+type BatchItem[Out any] struct {
+	// Index is the index of the input.
+	Index int
+	// Value and Response are the value and response returned by the last attempt of the operation.
+	Value    Out
+	Response *http.Response
+	// Err is the error returned by the last attempt of the operation, or the cause of the cancellation of the
+	// batch if the operation was skipped.
+	Err error
+	// Attempts is the number of times the operation was run, zero if it was skipped.
+	Attempts int
+}
+
+// Skipped returns true if the operation was not run, because the batch was canceled or stopped.
+func (i BatchItem[Out]) Skipped() bool {
+	return i.Attempts == 0
+}
+
+// BatchResult holds the outcome of the operations of Batch, in the order of the inputs, and a summary.
+type BatchResult[Out any] struct {
+	Items     []BatchItem[Out]
+	Succeeded int
+	Failed    int
+	Skipped   int
+	// Duration is the time it took to run the batch.
+	Duration time.Duration
+}
+
+// BatchError is the error of an operation of Batch.
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// Err returns the errors of the failed and skipped operations as BatchErrors joined with errors.Join,
+// or nil if all of them succeeded.
+func (r *BatchResult[Out]) Err() error {
+	var errs []error
+	for _, item := range r.Items {
+		if item.Err != nil {
+			errs = append(errs, &BatchError{Index: item.Index, Err: item.Err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// String returns the summary of the batch, e.g. "3000 items: 2990 succeeded, 8 failed, 2 skipped in 1m2s".
+func (r *BatchResult[Out]) String() string {
+	return fmt.Sprintf("%d items: %d succeeded, %d failed, %d skipped in %s", len(r.Items), r.Succeeded, r.Failed, r.Skipped, r.Duration)
+}
+
+// Batch runs an operation for each input, at most BatchOptions.Concurrency at the same time, and returns
+// the outcome of each of them, e.g. to update monitors:
+//
+//	result := datadog.Batch(ctx, monitors, func(ctx context.Context, monitor datadogV1.Monitor) (datadogV1.Monitor, *http.Response, error) {
+//		return api.UpdateMonitor(ctx, monitor.GetId(), update)
+//	}, datadog.BatchOptions{Concurrency: 16})
+//
+// The batch respects the rate limits of the API: the Configuration.RateLimiter of the client, if any, holds
+// the operations before the buckets are exhausted, and when an operation gets a 429 response, no operation
+// is started until the rate limit is reset, after which the rate limited operation is run again.
+// Canceling ctx skips the operations not started yet.
+func Batch[In, Out any](ctx context.Context, inputs []In, operation func(ctx context.Context, input In) (Out, *http.Response, error), options BatchOptions) *BatchResult[Out] {
+	start := time.Now()
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+	retries := options.RateLimitRetries
+	if retries == 0 {
+		retries = DefaultBatchRateLimitRetries
+	}
+
+	// Stopping the batch skips the operations not started yet, without canceling the running ones.
+	scheduling, stop := context.WithCancelCause(ctx)
+	defer stop(nil)
+	result := &BatchResult[Out]{Items: make([]BatchItem[Out], len(inputs))}
+	pause := &batchPause{}
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < concurrency && worker < len(inputs); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				item := runBatchItem(ctx, scheduling, i, inputs[i], operation, pause, retries)
+				if item.Err != nil && !item.Skipped() && options.StopOnError {
+					stop(ErrBatchStopped)
+				}
+				result.Items[i] = item
+			}
+		}()
+	}
+	for i := range inputs {
+		if scheduling.Err() != nil {
+			result.Items[i] = BatchItem[Out]{Index: i, Err: context.Cause(scheduling)}
+			continue
+		}
+		select {
+		case indexes <- i:
+		case <-scheduling.Done():
+			result.Items[i] = BatchItem[Out]{Index: i, Err: context.Cause(scheduling)}
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, item := range result.Items {
+		switch {
+		case item.Skipped():
+			result.Skipped++
+		case item.Err != nil:
+			result.Failed++
+		default:
+			result.Succeeded++
+		}
+	}
+	result.Duration = time.Since(start)
+	return result
+}
+
+// runBatchItem runs the operation of an input with ctx, again after a pause when it is rate limited. It is
+// skipped if scheduling is canceled before it starts.
+func runBatchItem[In, Out any](ctx, scheduling context.Context, index int, input In, operation func(ctx context.Context, input In) (Out, *http.Response, error), pause *batchPause, retries int) BatchItem[Out] {
+	item := BatchItem[Out]{Index: index}
+	for {
+		if err := pause.wait(scheduling); err != nil {
+			if item.Attempts == 0 {
+				item.Err = err
+			}
+			return item
+		}
+		item.Value, item.Response, item.Err = operation(ctx, input)
+		item.Attempts++
+		if item.Err == nil || !errors.Is(item.Err, ErrRateLimited) || item.Attempts > retries {
+			return item
+		}
+		pause.until(rateLimitReset(item.Err, item.Attempts))
+	}
+}
+
+// rateLimitReset returns the time at which the rate limit of a 429 response is reset, or a backoff if the
+// response does not tell.
+func rateLimitReset(err error, attempts int) time.Time {
+	if apiErr, ok := AsAPIError(err); ok && apiErr.RateLimit != nil && apiErr.RateLimit.ResetAt.After(time.Now()) {
+		return apiErr.RateLimit.ResetAt
+	}
+	return time.Now().Add(time.Duration(attempts) * time.Second)
+}
+
+// batchPause holds the operations of a batch until a rate limit is reset.
+type batchPause struct {
+	mu       sync.Mutex
+	resumeAt time.Time
+}
+
+func (p *batchPause) until(t time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t.After(p.resumeAt) {
+		p.resumeAt = t
+	}
+}
+
+// wait blocks until the pause ends, and returns the cause of the cancellation of ctx if it is canceled first.
+func (p *batchPause) wait(ctx context.Context) error {
+	for {
+		if ctx.Err() != nil {
+			return context.Cause(ctx)
+		}
+		p.mu.Lock()
+		wait := time.Until(p.resumeAt)
+		p.mu.Unlock()
+		if wait <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
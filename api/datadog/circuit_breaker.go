@@ -0,0 +1,220 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a circuit of the CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through to decide whether to close the circuit.
+	CircuitHalfOpen
+)
+
+// String returns the name of the state.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitKey identifies a circuit: requests are tracked per server URL and operation.
+type CircuitKey struct {
+	// ServerURL is the scheme and host the request is sent to, e.g. "https://api.datadoghq.com".
+	ServerURL string
+	// OperationID is the fully qualified operation ID, e.g. "v2.LogsApi.SubmitLog".
+	OperationID string
+}
+
+// ErrCircuitOpen is returned by the API client instead of sending a request when its circuit is open.
+type ErrCircuitOpen struct {
+	Key CircuitKey
+	// RetryAt is the earliest time at which a probe request will be let through.
+	RetryAt time.Time
+}
+
+// Error returns a description of the open circuit.
+func (e ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("circuit open for %s on %s until %s", e.Key.OperationID, e.Key.ServerURL, e.RetryAt.Format(time.RFC3339))
+}
+
+// CircuitBreaker stops sending requests to an operation of a server after consecutive failures,
+// and probes it again once OpenTimeout has elapsed. It is safe for concurrent use.
+type CircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failures that opens a circuit.
+	FailureThreshold int
+	// OpenTimeout is how long a circuit stays open before probe requests are let through.
+	OpenTimeout time.Duration
+	// HalfOpenMaxRequests is the number of concurrent probe requests allowed while half-open.
+	HalfOpenMaxRequests int
+	// IsFailure classifies the outcome of an attempt. By default, transport errors and 5xx responses are failures.
+	IsFailure func(resp *http.Response, err error) bool
+	// OnStateChange, if set, is called on every state transition, outside of the breaker lock.
+	OnStateChange func(key CircuitKey, from CircuitState, to CircuitState)
+
+	mu       sync.Mutex
+	circuits map[CircuitKey]*circuit
+}
+
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probes   int
+}
+
+// NewCircuitBreaker returns a CircuitBreaker opening after 5 consecutive failures for 30 seconds.
+func NewCircuitBreaker() *CircuitBreaker {
+	return &CircuitBreaker{
+		FailureThreshold:    5,
+		OpenTimeout:         30 * time.Second,
+		HalfOpenMaxRequests: 1,
+	}
+}
+
+// Allow returns ErrCircuitOpen if a request for the given key must not be sent.
+// Otherwise, the returned function must be called with the outcome of the request.
+func (b *CircuitBreaker) Allow(key CircuitKey) (func(*http.Response, error), error) {
+	b.mu.Lock()
+	c := b.circuit(key)
+	from := c.state
+	if c.state == CircuitOpen {
+		retryAt := c.openedAt.Add(b.OpenTimeout)
+		if time.Now().Before(retryAt) {
+			b.mu.Unlock()
+			return nil, ErrCircuitOpen{Key: key, RetryAt: retryAt}
+		}
+		c.state = CircuitHalfOpen
+		c.probes = 0
+	}
+	if c.state == CircuitHalfOpen {
+		if c.probes >= b.maxProbes() {
+			b.mu.Unlock()
+			return nil, ErrCircuitOpen{Key: key, RetryAt: time.Now().Add(b.OpenTimeout)}
+		}
+		c.probes++
+	}
+	to := c.state
+	b.mu.Unlock()
+	b.notify(key, from, to)
+
+	var once sync.Once
+	return func(resp *http.Response, err error) {
+		once.Do(func() { b.record(key, resp, err) })
+	}, nil
+}
+
+// State returns the current state of the circuit for the given key.
+func (b *CircuitBreaker) State(key CircuitKey) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c, ok := b.circuits[key]; ok {
+		return c.state
+	}
+	return CircuitClosed
+}
+
+// Reset closes all the circuits.
+func (b *CircuitBreaker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.circuits = nil
+}
+
+func (b *CircuitBreaker) record(key CircuitKey, resp *http.Response, err error) {
+	if errors.Is(err, context.Canceled) {
+		// Requests canceled by the caller say nothing about the health of the server.
+		b.mu.Lock()
+		if c := b.circuit(key); c.state == CircuitHalfOpen && c.probes > 0 {
+			c.probes--
+		}
+		b.mu.Unlock()
+		return
+	}
+	isFailure := b.IsFailure
+	if isFailure == nil {
+		isFailure = defaultIsFailure
+	}
+	failed := isFailure(resp, err)
+
+	b.mu.Lock()
+	c := b.circuit(key)
+	from := c.state
+	switch {
+	case !failed:
+		c.state = CircuitClosed
+		c.failures = 0
+	case c.state == CircuitHalfOpen:
+		c.state = CircuitOpen
+		c.openedAt = time.Now()
+	default:
+		c.failures++
+		if b.FailureThreshold > 0 && c.failures >= b.FailureThreshold {
+			c.state = CircuitOpen
+			c.openedAt = time.Now()
+		}
+	}
+	if c.state != CircuitHalfOpen {
+		c.probes = 0
+	}
+	to := c.state
+	b.mu.Unlock()
+	b.notify(key, from, to)
+}
+
+func (b *CircuitBreaker) circuit(key CircuitKey) *circuit {
+	if b.circuits == nil {
+		b.circuits = make(map[CircuitKey]*circuit)
+	}
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{}
+		b.circuits[key] = c
+	}
+	return c
+}
+
+func (b *CircuitBreaker) maxProbes() int {
+	if b.HalfOpenMaxRequests < 1 {
+		return 1
+	}
+	return b.HalfOpenMaxRequests
+}
+
+func (b *CircuitBreaker) notify(key CircuitKey, from CircuitState, to CircuitState) {
+	if from != to && b.OnStateChange != nil {
+		b.OnStateChange(key, from, to)
+	}
+}
+
+func defaultIsFailure(resp *http.Response, err error) bool {
+	return err != nil || resp == nil || resp.StatusCode >= 500
+}
+
+// circuitKey returns the circuit of the request, using the server URL it is sent to.
+func circuitKey(request *http.Request, operationID string) CircuitKey {
+	return CircuitKey{
+		ServerURL:   request.URL.Scheme + "://" + request.URL.Host,
+		OperationID: operationID,
+	}
+}
@@ -13,11 +13,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"math"
 	"mime/multipart"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -31,9 +29,14 @@ import (
 )
 
 var (
-	jsonCheck            = regexp.MustCompile(`(?i:(?:application|text)/(?:vnd\.[^;]+\+)?json)`)
-	xmlCheck             = regexp.MustCompile(`(?i:(?:application|text)/xml)`)
-	rateLimitResetHeader = "X-Ratelimit-Reset"
+	jsonCheck                = regexp.MustCompile(`(?i:(?:application|text)/(?:vnd\.[^;]+\+)?json)`)
+	xmlCheck                 = regexp.MustCompile(`(?i:(?:application|text)/xml)`)
+	rateLimitResetHeader     = "X-Ratelimit-Reset"
+	rateLimitLimitHeader     = "X-Ratelimit-Limit"
+	rateLimitPeriodHeader    = "X-Ratelimit-Period"
+	rateLimitRemainingHeader = "X-Ratelimit-Remaining"
+	rateLimitNameHeader      = "X-Ratelimit-Name"
+	retryAfterHeader         = "Retry-After"
 )
 
 // APIClient manages communication with the Datadog API V2 Collection API v1.0.
@@ -69,6 +72,13 @@ func SetAuthKeys(ctx context.Context, headerParams *map[string]string, keys ...[
 
 // ReadBody returns the byte content of the response and make it available again on the response object.
 func ReadBody(response *http.Response) ([]byte, error) {
+	if stream := itemStreamFromResponse(response); stream != nil {
+		// Only the rest of the object is kept in memory, the items are handed to the stream.
+		body, err := stream.streamBody(response.Body)
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewBuffer(body))
+		return body, err
+	}
 	body, err := io.ReadAll(response.Body)
 	response.Body.Close()
 	response.Body = io.NopCloser(bytes.NewBuffer(body))
@@ -84,7 +94,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 
 	if cfg.RetryConfiguration.BackOffBase < 2 {
 		cfg.RetryConfiguration.BackOffBase = 2
-		log.Printf("WARNING: BackOffBase value is smaller than 2. Setting it to 2.")
+		cfg.Log(context.Background(), LevelWarn, "BackOffBase value is smaller than 2. Setting it to 2.")
 	}
 
 	c := &APIClient{}
@@ -121,58 +131,96 @@ func ParameterToString(obj interface{}, collectionFormat string) string {
 }
 
 // CallAPI do the request.
-func (c *APIClient) CallAPI(request *http.Request) (*http.Response, error) {
+func (c *APIClient) CallAPI(request *http.Request) (resp *http.Response, err error) {
 	var rawBody []byte
 	if request.Body != nil && request.Body != http.NoBody {
 		rawBody, _ = io.ReadAll(request.Body)
 		request.Body.Close()
 	}
+	retryCount := 0
+	info := newInterceptorInfo(request.Context())
+	info.Idempotent = c.Cfg.IsIdempotentOperation(info.OperationID, request.Method)
+	started := time.Now()
+	defer func() {
+		setResponseMeta(request.Context(), resp, newResponseMeta(info.OperationID, resp, retryCount, time.Since(started)))
+	}()
 	ctx, ccancel := context.WithTimeout(request.Context(), c.Cfg.RetryConfiguration.HTTPRetryTimeout)
 	defer ccancel()
-	retryCount := 0
 	for {
-		newRequest := copyRequest(request, &rawBody)
-		if c.Cfg.Debug {
-			dump, err := httputil.DumpRequestOut(newRequest, true)
+		newRequest, keys, err := c.Cfg.applyCredentials(copyRequest(request, &rawBody), info.OperationID)
+		if err != nil {
+			return nil, err
+		}
+		info.APIKeys = keys
+		info.Attempt = retryCount + 1
+		releaseRateLimit := func(http.Header) {}
+		if c.Cfg.RateLimiter != nil {
+			release, err := c.Cfg.RateLimiter.Wait(newRequest.Context(), info.OperationID)
 			if err != nil {
 				return nil, err
 			}
-			// Strip any api keys from the response being logged
-			keys, ok := newRequest.Context().Value(ContextAPIKeys).(map[string]APIKey)
-			if keys != nil && ok {
-				for _, apiKey := range keys {
-					valueRegex := regexp.MustCompile(fmt.Sprintf("(?m)%s", apiKey.Key))
-					dump = valueRegex.ReplaceAll(dump, []byte("REDACTED"))
-				}
+			releaseRateLimit = release
+		}
+		intercepted, err := c.interceptRequest(newRequest, info)
+		if err != nil {
+			releaseRateLimit(nil)
+			if responseErr := c.interceptResponse(newRequest, nil, err, info, intercepted); responseErr != nil {
+				err = errors.Join(err, responseErr)
 			}
-			log.Printf("\n%s\n", string(dump))
+			return nil, err
 		}
-		resp, requestErr := c.Cfg.HTTPClient.Do(newRequest)
-
-		if requestErr != nil {
+		debug := c.Cfg.logger().Enabled(newRequest.Context(), LevelDebug)
+		if debug {
+			c.logRequest(newRequest, rawBody, info)
+		}
+		start := time.Now()
+		resp, requestErr := c.send(newRequest, info)
+		if debug {
+			c.logResponse(newRequest, resp, requestErr, info, time.Since(start))
+		}
+		if resp != nil {
+			releaseRateLimit(resp.Header)
+		} else {
+			releaseRateLimit(nil)
+		}
+		if err := c.interceptResponse(newRequest, resp, requestErr, info, intercepted); err != nil {
+			if resp != nil {
+				// The call is aborted, so the body is released here, which ends the response of the
+				// interceptors waiting for it to be closed.
+				resp.Body.Close()
+			}
+			return resp, err
+		}
+		var circuitErr ErrCircuitOpen
+		if errors.As(requestErr, &circuitErr) {
 			return resp, requestErr
 		}
 
-		if c.Cfg.Debug {
-			dump, _ := httputil.DumpResponse(resp, true)
-			if c.Cfg.RetryConfiguration.EnableRetry {
-				log.Println("Max retries:", c.Cfg.RetryConfiguration.MaxRetries, " Current retry:", retryCount)
-				if retryCount == c.Cfg.RetryConfiguration.MaxRetries {
-					log.Println("Max retries reached")
-				}
-			}
-			log.Printf("\n%s\n", string(dump))
+		if requestErr != nil && c.Cfg.RetryPolicy == nil {
+			return resp, requestErr
 		}
 
-		retryDuration, shouldRetry := c.shouldRetryRequest(resp, retryCount)
+		retryDuration, shouldRetry := c.shouldRetry(newRequest, resp, requestErr, retryCount, info)
 		if !shouldRetry {
+			if retryCount > 0 && retryCount == c.Cfg.RetryConfiguration.MaxRetries {
+				c.Cfg.Log(newRequest.Context(), LevelDebug, "Max retries reached", "attempt", info.Attempt)
+			}
 			return resp, requestErr
 		}
+		c.Cfg.Log(newRequest.Context(), LevelDebug, "Retrying request",
+			"attempt", info.Attempt,
+			"max_retries", c.Cfg.RetryConfiguration.MaxRetries,
+			"wait", *retryDuration,
+		)
 
 		select {
 		case <-ctx.Done():
 			return resp, requestErr
 		case <-time.After(*retryDuration):
+			if resp != nil {
+				// Release the connection of the discarded response before retrying
+				resp.Body.Close()
+			}
 			retryCount++
 			continue
 		}
@@ -180,8 +228,35 @@ func (c *APIClient) CallAPI(request *http.Request) (*http.Response, error) {
 	}
 }
 
-// Determine if a request should be retried
-func (c *APIClient) shouldRetryRequest(response *http.Response, retryCount int) (*time.Duration, bool) {
+// Send a single attempt, going through the circuit breaker when one is configured
+func (c *APIClient) send(request *http.Request, info InterceptorInfo) (*http.Response, error) {
+	if c.Cfg.CircuitBreaker == nil {
+		return c.Cfg.HTTPClient.Do(request)
+	}
+	done, err := c.Cfg.CircuitBreaker.Allow(circuitKey(request, info.OperationID))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Cfg.HTTPClient.Do(request)
+	done(resp, err)
+	return resp, err
+}
+
+// Determine if a request should be retried, using the retry policy when one is configured
+func (c *APIClient) shouldRetry(request *http.Request, response *http.Response, requestErr error, retryCount int, info InterceptorInfo) (*time.Duration, bool) {
+	if c.Cfg.RetryPolicy == nil {
+		return c.shouldRetryRequest(response, retryCount, info)
+	}
+	if !c.Cfg.RetryConfiguration.EnableRetry {
+		return nil, false
+	}
+	retryDuration, shouldRetry := c.Cfg.RetryPolicy.ShouldRetry(request, response, requestErr, info)
+	return &retryDuration, shouldRetry
+}
+
+// Determine if a request should be retried. Server errors are only retried for idempotent operations, so that
+// calls like CreateMonitor are not duplicated.
+func (c *APIClient) shouldRetryRequest(response *http.Response, retryCount int, info InterceptorInfo) (*time.Duration, bool) {
 	enableRetry := c.Cfg.RetryConfiguration.EnableRetry
 	maxRetries := c.Cfg.RetryConfiguration.MaxRetries
 	if !enableRetry || retryCount == maxRetries {
@@ -198,7 +273,7 @@ func (c *APIClient) shouldRetryRequest(response *http.Response, retryCount int)
 
 	// Calculate retry for 5xx errors or if unable to parse value of rateLimitResetHeader
 	// or if the `rateLimitResetHeader` header is missing or if status code >= 500.
-	if err != nil || response.StatusCode == 429 || response.StatusCode >= 500 {
+	if err != nil || response.StatusCode == 429 || (response.StatusCode >= 500 && info.Idempotent) {
 		// Calculate the retry val (base * multiplier^retryCount)
 		retryVal := c.Cfg.RetryConfiguration.BackOffBase * math.Pow(c.Cfg.RetryConfiguration.BackOffMultiplier, float64(retryCount))
 		// retry duration shouldn't exceed default timeout period
@@ -229,6 +304,12 @@ func (c *APIClient) PrepareRequest(
 
 	// Detect postBody type and post.
 	if postBody != nil {
+		if c.Cfg.ValidateRequests {
+			if err := ValidateValue("", postBody, nil); err != nil {
+				return nil, fmt.Errorf("invalid request body: %w", err)
+			}
+		}
+
 		contentType := headerParams["Content-Type"]
 		if contentType == "" {
 			contentType = detectContentType(postBody)
@@ -409,6 +490,20 @@ func (c *APIClient) PrepareRequest(
 
 // Decode unmarshal bytes into an interface
 func (c *APIClient) Decode(v interface{}, b []byte, contentType string) (err error) {
+	if err = c.DecodeErrorModel(v, b, contentType); err != nil {
+		return err
+	}
+	if c.Cfg.StrictDecoding {
+		if unparsed := FindUnparsed(v); len(unparsed) > 0 {
+			return &UnparsedError{Unparsed: unparsed}
+		}
+	}
+	return nil
+}
+
+// DecodeErrorModel unmarshal the body of an error response into an interface. Unlike Decode, it ignores
+// StrictDecoding, so that the error model of a response is available whatever its fields.
+func (c *APIClient) DecodeErrorModel(v interface{}, b []byte, contentType string) (err error) {
 	if len(b) == 0 {
 		return nil
 	}
@@ -520,6 +615,13 @@ type GenericOpenAPIError struct {
 	ErrorBody    []byte
 	ErrorMessage string
 	ErrorModel   interface{}
+	StatusCode   int
+	OperationID  string
+	RequestID    string
+	RateLimit    *RateLimitBucket
+	Header       http.Header
+	// Err is the error the response body could not be decoded with, if any.
+	Err error
 }
 
 // Error returns non-empty string if there was an error.
@@ -527,6 +629,11 @@ func (e GenericOpenAPIError) Error() string {
 	return e.ErrorMessage
 }
 
+// Unwrap returns the error the response body could not be decoded with, if any.
+func (e GenericOpenAPIError) Unwrap() error {
+	return e.Err
+}
+
 // Body returns the raw bytes of the response.
 func (e GenericOpenAPIError) Body() []byte {
 	return e.ErrorBody
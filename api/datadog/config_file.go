@@ -0,0 +1,320 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	tomlLineCheck = regexp.MustCompile(`(?m)^\s*(\[[^\]]+\]|[\w."'-]+\s*=)`)
+)
+
+// ConfigFile is the content of a configuration file read by LoadConfiguration.
+type ConfigFile struct {
+	// DefaultProfile is the profile used when none is requested. It defaults to "default".
+	DefaultProfile string                   `json:"default_profile,omitempty"`
+	Profiles       map[string]ConfigProfile `json:"profiles"`
+}
+
+// ConfigProfile holds the settings of a named profile of a configuration file.
+type ConfigProfile struct {
+	// Site is the Datadog site, e.g. "datadoghq.eu".
+	Site   string `json:"site,omitempty"`
+	APIKey string `json:"api_key,omitempty"`
+	AppKey string `json:"app_key,omitempty"`
+	// APIKeyFile and AppKeyFile are paths of files holding the keys. They are read again when their
+	// modification time changes, so that rotated keys are picked up.
+	APIKeyFile string `json:"api_key_file,omitempty"`
+	AppKeyFile string `json:"app_key_file,omitempty"`
+	// Proxy is the URL of the proxy the requests are sent through.
+	Proxy string `json:"proxy,omitempty"`
+	// Timeout is the timeout of every HTTP request, e.g. "30s".
+	Timeout  string       `json:"timeout,omitempty"`
+	Compress *bool        `json:"compress,omitempty"`
+	Debug    bool         `json:"debug,omitempty"`
+	Retry    *ConfigRetry `json:"retry,omitempty"`
+	// UnstableOperations lists the unstable operations to enable, e.g. "v2.ListIncidents".
+	UnstableOperations []string          `json:"unstable_operations,omitempty"`
+	Headers            map[string]string `json:"headers,omitempty"`
+}
+
+// ConfigRetry holds the retry settings of a profile. Unset values keep the settings of the configuration.
+type ConfigRetry struct {
+	Enabled           *bool    `json:"enabled,omitempty"`
+	MaxRetries        *int     `json:"max_retries,omitempty"`
+	BackOffBase       *float64 `json:"backoff_base,omitempty"`
+	BackOffMultiplier *float64 `json:"backoff_multiplier,omitempty"`
+	// Timeout is the total time spent retrying a request, e.g. "2m".
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// DefaultConfigPath returns the path of the configuration file read by LoadConfiguration when none
+// is given: the DD_CONFIG_FILE environment variable if set, or ~/.datadog/config.
+func DefaultConfigPath() (string, error) {
+	if path, ok := os.LookupEnv("DD_CONFIG_FILE"); ok {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".datadog", "config"), nil
+}
+
+// ReadConfigFile reads a configuration file in YAML, JSON or TOML. The format is chosen from the file
+// extension, or guessed from the content when there is none.
+func ReadConfigFile(path string) (*ConfigFile, error) {
+	content, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return nil, err
+	}
+	var values interface{}
+	switch format := configFormat(path, content); format {
+	case "json":
+		err = json.Unmarshal(content, &values)
+	case "toml":
+		var table map[string]interface{}
+		err = toml.Unmarshal(content, &table)
+		values = table
+	default:
+		err = yaml.Unmarshal(content, &values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading configuration file %s: %w", path, err)
+	}
+
+	// All the formats are decoded through JSON, so that only the JSON tags are needed and unknown
+	// settings are reported.
+	normalized, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("reading configuration file %s: %w", path, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(normalized))
+	decoder.DisallowUnknownFields()
+	var file ConfigFile
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("reading configuration file %s: %w", path, err)
+	}
+	return &file, nil
+}
+
+// Profile returns the profile with the given name. An empty name selects the profile of the
+// DD_PROFILE environment variable, then the default profile of the file.
+func (f *ConfigFile) Profile(name string) (ConfigProfile, error) {
+	if name == "" {
+		name = os.Getenv("DD_PROFILE")
+	}
+	if name == "" {
+		name = f.DefaultProfile
+	}
+	if name == "" {
+		name = "default"
+	}
+	profile, ok := f.Profiles[name]
+	if !ok {
+		return ConfigProfile{}, fmt.Errorf("profile %q not found in configuration file", name)
+	}
+	return profile, nil
+}
+
+// Apply sets up the configuration with the settings of the profile, and returns a copy of ctx
+// with its site.
+func (p ConfigProfile) Apply(ctx context.Context, cfg *Configuration) (context.Context, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if p.Site != "" {
+		ctx = context.WithValue(ctx, ContextServerVariables, map[string]string{"site": p.Site})
+	}
+	if credentials := p.credentials(); credentials != nil {
+		cfg.Credentials = credentials
+	}
+
+	if p.Proxy != "" || p.Timeout != "" {
+		client := &http.Client{}
+		if cfg.HTTPClient != nil {
+			*client = *cfg.HTTPClient
+		}
+		if p.Proxy != "" {
+			proxy, err := url.Parse(p.Proxy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxy %q: %w", p.Proxy, err)
+			}
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.Proxy = http.ProxyURL(proxy)
+			client.Transport = transport
+		}
+		if p.Timeout != "" {
+			timeout, err := time.ParseDuration(p.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timeout %q: %w", p.Timeout, err)
+			}
+			client.Timeout = timeout
+		}
+		cfg.HTTPClient = client
+	}
+
+	if p.Compress != nil {
+		cfg.Compress = *p.Compress
+	}
+	cfg.Debug = cfg.Debug || p.Debug
+	if p.Retry != nil {
+		retry := &cfg.RetryConfiguration
+		if p.Retry.Enabled != nil {
+			retry.EnableRetry = *p.Retry.Enabled
+		}
+		if p.Retry.MaxRetries != nil {
+			retry.MaxRetries = *p.Retry.MaxRetries
+		}
+		if p.Retry.BackOffBase != nil {
+			retry.BackOffBase = *p.Retry.BackOffBase
+		}
+		if p.Retry.BackOffMultiplier != nil {
+			retry.BackOffMultiplier = *p.Retry.BackOffMultiplier
+		}
+		if p.Retry.Timeout != "" {
+			timeout, err := time.ParseDuration(p.Retry.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retry timeout %q: %w", p.Retry.Timeout, err)
+			}
+			retry.HTTPRetryTimeout = timeout
+		}
+	}
+	for _, operation := range p.UnstableOperations {
+		if !cfg.IsUnstableOperation(operation) {
+			return nil, fmt.Errorf("%s is not an unstable operation", operation)
+		}
+		cfg.SetUnstableOperationEnabled(operation, true)
+	}
+	for key, value := range p.Headers {
+		cfg.AddDefaultHeader(key, value)
+	}
+	return ctx, nil
+}
+
+// LoadConfiguration returns a configuration and a context set up with a profile of a configuration file.
+// An empty path reads the file returned by DefaultConfigPath, and an empty profile selects the
+// profile as described in ConfigFile.Profile.
+//
+// A configuration file in YAML looks like:
+//
+//	default_profile: prod
+//	profiles:
+//	  prod:
+//	    site: datadoghq.eu
+//	    api_key_file: /var/run/secrets/datadog/api-key
+//	    app_key_file: /var/run/secrets/datadog/app-key
+//	    retry:
+//	      enabled: true
+//	      max_retries: 5
+//	    unstable_operations: [v2.ListIncidents]
+func LoadConfiguration(ctx context.Context, path, profile string) (*Configuration, context.Context, error) {
+	if path == "" {
+		var err error
+		if path, err = DefaultConfigPath(); err != nil {
+			return nil, nil, err
+		}
+	}
+	file, err := ReadConfigFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	settings, err := file.Profile(profile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	cfg := NewConfiguration()
+	if ctx, err = settings.Apply(ctx, cfg); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, ctx, nil
+}
+
+// credentials returns the provider of the keys of the profile, if it has any.
+func (p ConfigProfile) credentials() CredentialProvider {
+	if p.APIKeyFile == "" && p.AppKeyFile == "" {
+		if p.APIKey == "" && p.AppKey == "" {
+			return nil
+		}
+		return StaticCredentials(p.APIKey, p.AppKey)
+	}
+	var keyFiles []CredentialProvider
+	if p.APIKeyFile != "" {
+		keyFiles = append(keyFiles, keyFileCredentials(p.APIKeyFile, func(key string) Credentials { return Credentials{APIKey: key} }))
+	}
+	if p.AppKeyFile != "" {
+		keyFiles = append(keyFiles, keyFileCredentials(p.AppKeyFile, func(key string) Credentials { return Credentials{AppKey: key} }))
+	}
+	return CredentialProviderFunc(func(ctx context.Context) (Credentials, error) {
+		credentials := Credentials{APIKey: p.APIKey, AppKey: p.AppKey}
+		for _, keyFile := range keyFiles {
+			keys, err := keyFile.Credentials(ctx)
+			if err != nil {
+				return Credentials{}, err
+			}
+			if keys.APIKey != "" {
+				credentials.APIKey = keys.APIKey
+			}
+			if keys.AppKey != "" {
+				credentials.AppKey = keys.AppKey
+			}
+		}
+		return credentials, nil
+	})
+}
+
+// keyFileCredentials returns a provider reading a key from a file holding only the key, cached like
+// the ones of FileCredentials.
+func keyFileCredentials(path string, credentials func(key string) Credentials) CredentialProvider {
+	return &fileCredentials{path: expandHome(path), parse: func(content []byte) (Credentials, error) {
+		return credentials(strings.TrimSpace(string(content))), nil
+	}}
+}
+
+// configFormat returns the format of a configuration file.
+func configFormat(path string, content []byte) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return "json"
+	case ".toml":
+		return "toml"
+	case ".yaml", ".yml":
+		return "yaml"
+	}
+	trimmed := bytes.TrimSpace(content)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		return "json"
+	}
+	if tomlLineCheck.Match(trimmed) {
+		return "toml"
+	}
+	return "yaml"
+}
+
+// expandHome replaces a leading ~ in path with the home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
@@ -7,7 +7,6 @@ package datadog
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"os"
 	"runtime"
@@ -54,6 +53,21 @@ var (
 
 	// ContextOperationServerVariables overrides a server configuration variables using operation specific values.
 	ContextOperationServerVariables = contextKey("serverOperationVariables")
+
+	// ContextOperationID holds the fully qualified ID of the operation being called, e.g. "v2.LogsApi.SubmitLog".
+	ContextOperationID = contextKey("operationID")
+
+	// ContextItemStream holds the item stream of a request, set by WithItemStream.
+	ContextItemStream = contextKey("itemStream")
+
+	// ContextOrg holds the name of the organization of a request, set by WithOrg.
+	ContextOrg = contextKey("org")
+
+	// ContextLogFields holds the fields logged with the entries of a request, set by WithLogFields.
+	ContextLogFields = contextKey("logFields")
+
+	// ContextResponseMeta holds the ResponseMeta filled at the end of a call, set by WithResponseMeta.
+	ContextResponseMeta = contextKey("responseMeta")
 )
 
 // BasicAuth provides basic http authentication to a request passed via context using ContextBasicAuth.
@@ -87,17 +101,33 @@ type ServerConfigurations []ServerConfiguration
 
 // Configuration stores the configuration of the API client
 type Configuration struct {
-	Host               string            `json:"host,omitempty"`
-	Scheme             string            `json:"scheme,omitempty"`
-	DefaultHeader      map[string]string `json:"defaultHeader,omitempty"`
-	UserAgent          string            `json:"userAgent,omitempty"`
-	Debug              bool              `json:"debug,omitempty"`
-	Compress           bool              `json:"compress,omitempty"`
-	Servers            ServerConfigurations
-	OperationServers   map[string]ServerConfigurations
-	HTTPClient         *http.Client
-	unstableOperations map[string]bool
-	RetryConfiguration RetryConfiguration
+	Host                 string            `json:"host,omitempty"`
+	Scheme               string            `json:"scheme,omitempty"`
+	DefaultHeader        map[string]string `json:"defaultHeader,omitempty"`
+	UserAgent            string            `json:"userAgent,omitempty"`
+	Debug                bool              `json:"debug,omitempty"`
+	Compress             bool              `json:"compress,omitempty"`
+	Servers              ServerConfigurations
+	OperationServers     map[string]ServerConfigurations
+	HTTPClient           *http.Client
+	unstableOperations   map[string]bool
+	idempotentOperations map[string]bool
+	RetryConfiguration   RetryConfiguration
+	Interceptors         []Interceptor
+	RateLimiter          *RateLimiter
+	RetryPolicy          RetryPolicy
+	CircuitBreaker       *CircuitBreaker
+	Credentials          CredentialProvider
+	Orgs                 *OrgRegistry
+	Logger               Logger
+	LogRedaction         LogRedaction
+	// ValidateRequests checks the request bodies against the constraints of the spec before sending them,
+	// returning the ValidationErrors instead of a 400 response.
+	ValidateRequests bool
+	// StrictDecoding makes APIClient.Decode return an UnparsedError when parts of a successful response do
+	// not fit the models, instead of keeping them in UnparsedObject and AdditionalProperties fields silently.
+	// The error models of failed responses are always decoded leniently.
+	StrictDecoding bool
 }
 
 // RetryConfiguration stores the configuration of the retry behavior of the api client
@@ -381,6 +411,27 @@ func NewConfiguration() *Configuration {
 			"v2.ListIncidentTeams":            false,
 			"v2.UpdateIncidentTeam":           false,
 		},
+		idempotentOperations: map[string]bool{
+			"v1.LogsApi.ListLogs":                                      true,
+			"v1.MonitorsApi.ValidateExistingMonitor":                   true,
+			"v1.MonitorsApi.ValidateMonitor":                           true,
+			"v2.AuditApi.SearchAuditLogs":                              true,
+			"v2.CIVisibilityPipelinesApi.AggregateCIAppPipelineEvents": true,
+			"v2.CIVisibilityPipelinesApi.SearchCIAppPipelineEvents":    true,
+			"v2.CIVisibilityTestsApi.AggregateCIAppTestEvents":         true,
+			"v2.CIVisibilityTestsApi.SearchCIAppTestEvents":            true,
+			"v2.EventsApi.SearchEvents":                                true,
+			"v2.LogsApi.AggregateLogs":                                 true,
+			"v2.LogsApi.ListLogs":                                      true,
+			"v2.MetricsApi.QueryScalarData":                            true,
+			"v2.MetricsApi.QueryTimeseriesData":                        true,
+			"v2.SecurityMonitoringApi.SearchSecurityMonitoringSignals": true,
+			"v2.SecurityMonitoringApi.ValidateSecurityMonitoringRule":  true,
+			"v2.RUMApi.AggregateRUMEvents":                             true,
+			"v2.RUMApi.SearchRUMEvents":                                true,
+			"v2.SpansApi.AggregateSpans":                               true,
+			"v2.SpansApi.ListSpans":                                    true,
+		},
 		RetryConfiguration: RetryConfiguration{
 			EnableRetry:       false,
 			BackOffMultiplier: 2,
@@ -397,6 +448,11 @@ func (c *Configuration) AddDefaultHeader(key string, value string) {
 	c.DefaultHeader[key] = value
 }
 
+// AddInterceptor appends an interceptor to the chain run by the API client on every request.
+func (c *Configuration) AddInterceptor(interceptor Interceptor) {
+	c.Interceptors = append(c.Interceptors, interceptor)
+}
+
 // URL formats template on a index using given variables.
 func (sc ServerConfigurations) URL(index int, variables map[string]string) (string, error) {
 	if index < 0 || len(sc) <= index {
@@ -503,6 +559,22 @@ func (c *Configuration) ServerURLWithContext(ctx context.Context, endpoint strin
 		return "", err
 	}
 
+	if name, ok := OrgFromContext(ctx); ok {
+		org, err := c.org(name)
+		if err != nil {
+			return "", err
+		}
+		if org.Site != "" {
+			orgVariables := map[string]string{"site": org.Site}
+			for key, value := range variables {
+				if key != "site" {
+					orgVariables[key] = value
+				}
+			}
+			variables = orgVariables
+		}
+	}
+
 	return sc.URL(index, variables)
 }
 
@@ -523,7 +595,7 @@ func (c *Configuration) SetUnstableOperationEnabled(operation string, enabled bo
 		c.unstableOperations[operation] = enabled
 		return true
 	}
-	log.Printf("WARNING: '%s' is not an unstable operation, can't enable/disable", operation)
+	c.Log(context.Background(), LevelWarn, "Not an unstable operation, can't enable/disable", "operation_id", operation)
 	return false
 }
 
@@ -541,10 +613,59 @@ func (c *Configuration) IsUnstableOperationEnabled(operation string) bool {
 	if enabled, present := c.unstableOperations[operation]; present {
 		return enabled
 	}
-	log.Printf("WARNING: '%s' is not an unstable operation, is always enabled", operation)
+	c.Log(context.Background(), LevelWarn, "Not an unstable operation, is always enabled", "operation_id", operation)
 	return false
 }
 
+// IsIdempotentOperation determines whether an operation can be sent more than once without duplicating its side effects.
+// This function accepts the fully qualified operation ID, e.g. "v2.LogsApi.ListLogs", and the HTTP method of the request.
+// Operations using GET, HEAD, OPTIONS, PUT or DELETE are idempotent unless overridden with SetIdempotentOperation.
+func (c *Configuration) IsIdempotentOperation(operation string, method string) bool {
+	if idempotent, present := c.idempotentOperations[operation]; present {
+		return idempotent
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// SetIdempotentOperation overrides whether an operation is considered idempotent by the retry policy.
+// This function accepts the fully qualified operation ID, e.g. "v2.LogsApi.ListLogs".
+func (c *Configuration) SetIdempotentOperation(operation string, idempotent bool) {
+	if c.idempotentOperations == nil {
+		c.idempotentOperations = make(map[string]bool)
+	}
+	c.idempotentOperations[operation] = idempotent
+}
+
+// operationAuthSchemes lists the API key security schemes of the operations not authenticated with both
+// the API and application keys.
+var operationAuthSchemes = map[string][]string{
+	"v1.AuthenticationApi.Validate":                        {"apiKeyAuth"},
+	"v1.EventsApi.CreateEvent":                             {"apiKeyAuth"},
+	"v1.IPRangesApi.GetIPRanges":                           {},
+	"v1.LogsApi.SubmitLog":                                 {"apiKeyAuth"},
+	"v1.MetricsApi.SubmitDistributionPoints":               {"apiKeyAuth"},
+	"v1.MetricsApi.SubmitMetrics":                          {"apiKeyAuth"},
+	"v1.ServiceChecksApi.SubmitServiceCheck":               {"apiKeyAuth"},
+	"v2.CIVisibilityPipelinesApi.CreateCIAppPipelineEvent": {"apiKeyAuth"},
+	"v2.DORAMetricsApi.CreateDORADeployment":               {"apiKeyAuth"},
+	"v2.DORAMetricsApi.CreateDORAIncident":                 {"apiKeyAuth"},
+	"v2.LogsApi.SubmitLog":                                 {"apiKeyAuth"},
+	"v2.MetricsApi.SubmitMetrics":                          {"apiKeyAuth"},
+}
+
+// authSchemes returns the API key security schemes of an operation.
+// This function accepts the fully qualified operation ID, e.g. "v2.LogsApi.ListLogs".
+func authSchemes(operation string) []string {
+	if schemes, present := operationAuthSchemes[operation]; present {
+		return schemes
+	}
+	return []string{"apiKeyAuth", "appKeyAuth"}
+}
+
 func getUserAgent() string {
 	return fmt.Sprintf(
 		"datadog-api-client-go/%s (go %s; os %s; arch %s)",
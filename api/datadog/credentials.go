@@ -0,0 +1,183 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNoCredentials is returned by a credential provider which has no API key to provide.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Credentials holds the keys used to authenticate against an organization.
+type Credentials struct {
+	APIKey string `json:"api_key"`
+	AppKey string `json:"app_key,omitempty"`
+}
+
+// CredentialProvider provides the credentials of a request.
+// It is called for every attempt of every request, and must be safe for concurrent use.
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialProviderFunc is a function implementing CredentialProvider.
+type CredentialProviderFunc func(ctx context.Context) (Credentials, error)
+
+// Credentials calls f.
+func (f CredentialProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials returns a provider always returning the given keys.
+func StaticCredentials(apiKey, appKey string) CredentialProvider {
+	return CredentialProviderFunc(func(context.Context) (Credentials, error) {
+		return Credentials{APIKey: apiKey, AppKey: appKey}, nil
+	})
+}
+
+// EnvCredentials returns a provider reading the keys from the DD_API_KEY and DD_APP_KEY environment variables
+// on every call. It returns ErrNoCredentials if DD_API_KEY is not set.
+func EnvCredentials() CredentialProvider {
+	return CredentialProviderFunc(func(context.Context) (Credentials, error) {
+		apiKey, ok := os.LookupEnv("DD_API_KEY")
+		if !ok {
+			return Credentials{}, fmt.Errorf("%w: DD_API_KEY is not set", ErrNoCredentials)
+		}
+		return Credentials{APIKey: apiKey, AppKey: os.Getenv("DD_APP_KEY")}, nil
+	})
+}
+
+// FileCredentials returns a provider reading the keys from a JSON file such as
+//
+//	{"api_key": "<API key>", "app_key": "<application key>"}
+//
+// The file is read again when its modification time changes, so that keys rotated by another
+// process are picked up without restarting.
+func FileCredentials(path string) CredentialProvider {
+	return &fileCredentials{path: path, parse: func(content []byte) (Credentials, error) {
+		var credentials Credentials
+		if err := json.Unmarshal(content, &credentials); err != nil {
+			return Credentials{}, fmt.Errorf("reading credentials from %s: %w", path, err)
+		}
+		if credentials.APIKey == "" {
+			return Credentials{}, fmt.Errorf("%w: no api_key in %s", ErrNoCredentials, path)
+		}
+		return credentials, nil
+	}}
+}
+
+type fileCredentials struct {
+	path    string
+	parse   func(content []byte) (Credentials, error)
+	mu      sync.Mutex
+	modTime time.Time
+	cached  Credentials
+}
+
+func (f *fileCredentials) Credentials(context.Context) (Credentials, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return Credentials{}, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.modTime.IsZero() && info.ModTime().Equal(f.modTime) {
+		return f.cached, nil
+	}
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		return Credentials{}, err
+	}
+	credentials, err := f.parse(content)
+	if err != nil {
+		return Credentials{}, err
+	}
+	f.cached, f.modTime = credentials, info.ModTime()
+	return credentials, nil
+}
+
+// RefreshingCredentials returns a provider caching the credentials returned by fetch until they expire.
+// They are fetched again refreshBefore their expiration time, and are kept as long as they are valid
+// if fetching new ones fails. A zero expiration time means the credentials never expire.
+func RefreshingCredentials(fetch func(ctx context.Context) (Credentials, time.Time, error), refreshBefore time.Duration) CredentialProvider {
+	return &refreshingCredentials{fetch: fetch, refreshBefore: refreshBefore}
+}
+
+type refreshingCredentials struct {
+	fetch         func(ctx context.Context) (Credentials, time.Time, error)
+	refreshBefore time.Duration
+	mu            sync.Mutex
+	cached        *Credentials
+	expiration    time.Time
+}
+
+func (r *refreshingCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	if r.cached != nil && (r.expiration.IsZero() || now.Before(r.expiration.Add(-r.refreshBefore))) {
+		return *r.cached, nil
+	}
+	credentials, expiration, err := r.fetch(ctx)
+	if err != nil {
+		if r.cached != nil && now.Before(r.expiration) {
+			return *r.cached, nil
+		}
+		return Credentials{}, err
+	}
+	r.cached, r.expiration = &credentials, expiration
+	return credentials, nil
+}
+
+// applyCredentials sets the authentication of the request from the credentials of the organization
+// set with WithOrg, or from the configured credential provider when the request has no API key.
+// Only the keys of the security schemes of the operation are sent, as done by SetAuthKeys.
+// It returns the request and the keys it is sent with.
+func (c *Configuration) applyCredentials(req *http.Request, operationID string) (*http.Request, map[string]APIKey, error) {
+	ctx := req.Context()
+	keys, _ := ctx.Value(ContextAPIKeys).(map[string]APIKey)
+	var provider CredentialProvider
+	if name, ok := OrgFromContext(ctx); ok {
+		org, err := c.org(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		provider = org.Credentials
+	} else if _, ok := keys["apiKeyAuth"]; !ok {
+		provider = c.Credentials
+	}
+	if provider == nil {
+		return req, keys, nil
+	}
+
+	credentials, err := provider.Credentials(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	headers := map[string]string{"apiKeyAuth": "DD-API-KEY", "appKeyAuth": "DD-APPLICATION-KEY"}
+	values := map[string]string{"apiKeyAuth": credentials.APIKey, "appKeyAuth": credentials.AppKey}
+	keys = map[string]APIKey{}
+	for _, scheme := range authSchemes(operationID) {
+		if values[scheme] != "" {
+			keys[scheme] = APIKey{Key: values[scheme]}
+		}
+	}
+	for scheme, header := range headers {
+		if key, ok := keys[scheme]; ok {
+			req.Header.Set(header, key.Key)
+		} else {
+			req.Header.Del(header)
+		}
+	}
+	return req.WithContext(context.WithValue(ctx, ContextAPIKeys, keys)), keys, nil
+}
@@ -0,0 +1,214 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadogtest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInteractionNotFound is returned by the Recorder when replaying a request that is not in the cassette.
+var ErrInteractionNotFound = errors.New("datadogtest: no interaction found in cassette")
+
+const (
+	cassetteVersion = 2
+	scrubbedValue   = "REDACTED"
+)
+
+var (
+	secretHeaders         = []string{"DD-API-KEY", "DD-APPLICATION-KEY", "Authorization", "Proxy-Authorization", "Cookie"}
+	secretQueryParams     = []string{"api_key", "application_key"}
+	secretResponseHeaders = []string{"Set-Cookie"}
+)
+
+// Cassette holds the interactions recorded by a Recorder. It is stored in YAML, in the format of
+// go-vcr cassettes with the operation ID of the requests, so that the cassettes of tests/scenarios can be replayed.
+type Cassette struct {
+	Version      int            `yaml:"version"`
+	Interactions []*Interaction `yaml:"interactions"`
+}
+
+// Interaction is a request and the response it got.
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+// Request is a recorded request. Its body is stored decompressed.
+type Request struct {
+	// OperationID is the fully qualified ID of the operation, e.g. "v2.TeamsApi.GetTeam". It is empty for
+	// requests not sent by the API client.
+	OperationID string      `yaml:"operation_id,omitempty"`
+	Method      string      `yaml:"method"`
+	URL         string      `yaml:"url"`
+	Headers     http.Header `yaml:"headers,omitempty"`
+	Body        string      `yaml:"body"`
+}
+
+// Response is a recorded response. Its body is stored decompressed.
+type Response struct {
+	Code    int         `yaml:"code"`
+	Status  string      `yaml:"status"`
+	Headers http.Header `yaml:"headers,omitempty"`
+	Body    string      `yaml:"body"`
+}
+
+// LoadCassette reads a cassette file.
+func LoadCassette(path string) (*Cassette, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := yaml.Unmarshal(content, &cassette); err != nil {
+		return nil, fmt.Errorf("datadogtest: reading cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to a file, creating its directory if needed.
+func (c *Cassette) Save(path string) error {
+	if c.Version == 0 {
+		c.Version = cassetteVersion
+	}
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o644)
+}
+
+// DefaultMatcher reports whether a request matches a recorded one: they must have the same method, URL
+// and query parameters, ignoring the api_key and application_key parameters, and the same operation ID when
+// both have one. Bodies must be equal, or equivalent JSON documents.
+func DefaultMatcher(request Request, recorded Request) bool {
+	if request.Method != recorded.Method {
+		return false
+	}
+	if request.OperationID != "" && recorded.OperationID != "" && request.OperationID != recorded.OperationID {
+		return false
+	}
+	if !sameURL(request.URL, recorded.URL) {
+		return false
+	}
+	return sameBody(request.Body, recorded.Body)
+}
+
+func sameURL(a, b string) bool {
+	u, err := url.Parse(a)
+	if err != nil {
+		return a == b
+	}
+	v, err := url.Parse(b)
+	if err != nil {
+		return a == b
+	}
+	if u.Host != v.Host || u.Path != v.Path {
+		return false
+	}
+	q, r := u.Query(), v.Query()
+	for _, name := range secretQueryParams {
+		q.Del(name)
+		r.Del(name)
+	}
+	return reflect.DeepEqual(q, r)
+}
+
+func sameBody(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var x, y interface{}
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// scrub removes the credentials from an interaction: the secret headers and query parameters are deleted,
+// and their values are replaced wherever else they appear.
+func scrub(interaction *Interaction) {
+	var secrets []string
+	for _, name := range secretHeaders {
+		for _, value := range interaction.Request.Headers.Values(name) {
+			secrets = append(secrets, strings.TrimSpace(strings.TrimPrefix(value, "Bearer ")))
+		}
+		interaction.Request.Headers.Del(name)
+	}
+	for _, name := range secretResponseHeaders {
+		interaction.Response.Headers.Del(name)
+	}
+	if u, err := url.Parse(interaction.Request.URL); err == nil {
+		query := u.Query()
+		for _, name := range secretQueryParams {
+			if query.Has(name) {
+				secrets = append(secrets, query[name]...)
+				query.Del(name)
+			}
+		}
+		u.RawQuery = query.Encode()
+		interaction.Request.URL = u.String()
+	}
+
+	replacer := make([]string, 0, 2*len(secrets))
+	for _, secret := range secrets {
+		if secret != "" {
+			replacer = append(replacer, secret, scrubbedValue)
+		}
+	}
+	if len(replacer) == 0 {
+		return
+	}
+	r := strings.NewReplacer(replacer...)
+	interaction.Request.URL = r.Replace(interaction.Request.URL)
+	interaction.Request.Body = r.Replace(interaction.Request.Body)
+	interaction.Response.Body = r.Replace(interaction.Response.Body)
+	for _, headers := range []http.Header{interaction.Request.Headers, interaction.Response.Headers} {
+		for name, values := range headers {
+			for i, value := range values {
+				headers[name][i] = r.Replace(value)
+			}
+		}
+	}
+}
+
+// decodeBody returns a body without its content encoding, when it is gzip or deflate.
+func decodeBody(body []byte, encoding string) ([]byte, bool) {
+	var reader io.ReadCloser
+	var err error
+	switch encoding {
+	case "gzip":
+		reader, err = gzip.NewReader(bytes.NewReader(body))
+	case "deflate":
+		reader, err = zlib.NewReader(bytes.NewReader(body))
+	default:
+		return body, false
+	}
+	if err != nil {
+		return body, false
+	}
+	defer reader.Close()
+	var decoded bytes.Buffer
+	if _, err := decoded.ReadFrom(reader); err != nil {
+		return body, false
+	}
+	return decoded.Bytes(), true
+}
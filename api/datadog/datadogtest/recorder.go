@@ -0,0 +1,272 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+// Package datadogtest provides helpers to test code using the Datadog API client without a Datadog account.
+//
+// A Recorder records the requests sent by the API client and their responses in a cassette file, with the
+// API and application keys scrubbed, and replays them in later runs:
+//
+//	recorder, err := datadogtest.NewRecorder("testdata/list_monitors.yaml", datadogtest.ModeAuto)
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	defer recorder.Close()
+//	configuration := datadog.NewConfiguration()
+//	configuration.HTTPClient = recorder.HTTPClient()
+//
+// A Server is an in-memory fake of the API, implementing the main operations on monitors, dashboards,
+// SLOs, synthetics tests, downtimes, users, teams and incidents.
+package datadogtest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+)
+
+// Mode is the behavior of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of the cassette, and fails the requests not found in it.
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests and records them in a new cassette.
+	ModeRecord
+	// ModeAuto replays the cassette if its file exists, and records it otherwise.
+	ModeAuto
+	// ModePassthrough sends the requests without recording nor replaying them.
+	ModePassthrough
+)
+
+// String returns the name of the mode.
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	case ModeAuto:
+		return "auto"
+	case ModePassthrough:
+		return "passthrough"
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// Recorder is an http.RoundTripper recording requests and their responses in a cassette, or replaying them.
+// Each recorded interaction is replayed at most once, in order, so that the same request can get different
+// responses. It is safe for concurrent use.
+type Recorder struct {
+	// Transport sends the requests when recording. It defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+	// Matcher reports whether a request matches a recorded one. It defaults to DefaultMatcher.
+	Matcher func(request Request, recorded Request) bool
+	// Scrub, if set, is called on every recorded interaction after the credentials have been removed, to
+	// hide other sensitive data before it is saved.
+	Scrub func(interaction *Interaction)
+
+	path     string
+	mode     Mode
+	mu       sync.Mutex
+	cassette *Cassette
+	replayed []bool
+}
+
+// NewRecorder returns a Recorder using the cassette at the given path. In replay mode, the cassette is
+// read immediately. In record mode, it is written by Close.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	if mode == ModeAuto {
+		mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			mode = ModeReplay
+		}
+	}
+	r := &Recorder{
+		path:     path,
+		mode:     mode,
+		cassette: &Cassette{Version: cassetteVersion},
+	}
+	if mode == ModeReplay {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.replayed = make([]bool, len(cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns the mode of the recorder. It is never ModeAuto.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// HTTPClient returns an HTTP client sending its requests through the recorder, to be set as
+// Configuration.HTTPClient.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Close saves the cassette when recording.
+func (r *Recorder) Close() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+// RoundTrip records or replays a request.
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	switch r.mode {
+	case ModePassthrough:
+		return r.transport().RoundTrip(request)
+	case ModeRecord:
+		return r.record(request)
+	default:
+		return r.replay(request)
+	}
+}
+
+func (r *Recorder) transport() http.RoundTripper {
+	if r.Transport != nil {
+		return r.Transport
+	}
+	return http.DefaultTransport
+}
+
+func (r *Recorder) record(request *http.Request) (*http.Response, error) {
+	recorded, clone, err := readRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.transport().RoundTrip(clone)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if decoded, ok := decodeBody(body, resp.Header.Get("Content-Encoding")); ok {
+		body = decoded
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = int64(len(body))
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := &Interaction{
+		Request: recorded,
+		Response: Response{
+			Code:    resp.StatusCode,
+			Status:  resp.Status,
+			Headers: resp.Header.Clone(),
+			Body:    string(body),
+		},
+	}
+	scrub(interaction)
+	if r.Scrub != nil {
+		r.Scrub(interaction)
+	}
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func (r *Recorder) replay(request *http.Request) (*http.Response, error) {
+	recorded, clone, err := readRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	if clone.Body != nil {
+		clone.Body.Close()
+	}
+	match := r.Matcher
+	if match == nil {
+		match = DefaultMatcher
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !match(recorded, interaction.Request) {
+			continue
+		}
+		r.replayed[i] = true
+		status := interaction.Response.Status
+		if status == "" {
+			status = strconv.Itoa(interaction.Response.Code) + " " + http.StatusText(interaction.Response.Code)
+		}
+		header := interaction.Response.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        status,
+			StatusCode:    interaction.Response.Code,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       request,
+		}, nil
+	}
+	name := recorded.OperationID
+	if name == "" {
+		name = recorded.Method
+	}
+	return nil, fmt.Errorf("%w: %s %s in %s", ErrInteractionNotFound, name, recorded.URL, r.path)
+}
+
+// readRequest returns the request to record, with its body decompressed, and a clone of the request to
+// send. The body is read from GetBody when it is set, or else replaced on the clone, so that the request of
+// the caller is not modified.
+func readRequest(request *http.Request) (Request, *http.Request, error) {
+	clone := request.Clone(request.Context())
+	recorded := Request{
+		OperationID: datadog.OperationIDFromContext(request.Context()),
+		Method:      request.Method,
+		URL:         request.URL.String(),
+		Headers:     request.Header.Clone(),
+	}
+	if request.Body == nil || request.Body == http.NoBody {
+		return recorded, clone, nil
+	}
+	var body []byte
+	var err error
+	if request.GetBody != nil {
+		var reader io.ReadCloser
+		if reader, err = request.GetBody(); err != nil {
+			return recorded, nil, err
+		}
+		body, err = io.ReadAll(reader)
+		reader.Close()
+	} else {
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		clone.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if err != nil {
+		return recorded, nil, err
+	}
+	if decoded, ok := decodeBody(body, request.Header.Get("Content-Encoding")); ok {
+		body = decoded
+	}
+	recorded.Body = string(body)
+	return recorded, clone, nil
+}
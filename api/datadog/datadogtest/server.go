@@ -0,0 +1,284 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadogtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	publicIDAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	fakeUserEmail    = "frog@datadoghq.com"
+)
+
+// Server is an in-memory fake of the Datadog API, for unit tests of code using the API client.
+//
+// It implements the creation, retrieval, update, deletion and listing of monitors, dashboards, SLOs and
+// synthetics API and browser tests of v1, and of downtimes, users, teams and incidents of v2. Request bodies
+// are validated with the generated models, identifiers and server-side fields are generated, and errors are
+// returned with the status codes and bodies of the API. Requests without API and application keys are
+// rejected with 403 Forbidden. It is safe for concurrent use.
+//
+//	server := datadogtest.NewServer()
+//	defer server.Close()
+//	configuration := datadog.NewConfiguration()
+//	configuration.HTTPClient = server.HTTPClient()
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	resources map[string]*resourceStore
+	lastID    int64
+}
+
+// resourceStore holds the JSON objects of a kind of resource, in creation order.
+type resourceStore struct {
+	objects map[string]map[string]interface{}
+	ids     []string
+}
+
+// NewServer starts a fake server. It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{resources: make(map[string]*resourceStore)}
+	mux := http.NewServeMux()
+	s.registerV1(mux)
+	s.registerV2(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeErrors(w, http.StatusNotFound, "Not found")
+	})
+	s.Server = httptest.NewServer(authenticate(mux))
+	return s
+}
+
+// HTTPClient returns an HTTP client sending all its requests to the fake server, whatever their host,
+// to be set as Configuration.HTTPClient.
+func (s *Server) HTTPClient() *http.Client {
+	serverURL, _ := url.Parse(s.URL)
+	return &http.Client{Transport: &serverTransport{url: serverURL, transport: s.Client().Transport}}
+}
+
+// Reset deletes all the resources.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources = make(map[string]*resourceStore)
+}
+
+// serverTransport redirects the requests to the fake server.
+type serverTransport struct {
+	url       *url.URL
+	transport http.RoundTripper
+}
+
+func (t *serverTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	request.URL.Scheme = t.url.Scheme
+	request.URL.Host = t.url.Host
+	request.Host = ""
+	return t.transport.RoundTrip(request)
+}
+
+// authenticate rejects the requests without API and application keys, like the API.
+func authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("DD-API-KEY") == "" || r.Header.Get("DD-APPLICATION-KEY") == "" {
+			writeErrors(w, http.StatusForbidden, "Forbidden")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// store returns the store of a kind of resource. The lock must be held.
+func (s *Server) store(kind string) *resourceStore {
+	store, ok := s.resources[kind]
+	if !ok {
+		store = &resourceStore{objects: make(map[string]map[string]interface{})}
+		s.resources[kind] = store
+	}
+	return store
+}
+
+// nextID returns a new numeric identifier. The lock must be held.
+func (s *Server) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+func (r *resourceStore) get(id string) (map[string]interface{}, bool) {
+	object, ok := r.objects[id]
+	return object, ok
+}
+
+func (r *resourceStore) put(id string, object map[string]interface{}) {
+	if _, ok := r.objects[id]; !ok {
+		r.ids = append(r.ids, id)
+	}
+	r.objects[id] = object
+}
+
+func (r *resourceStore) remove(id string) bool {
+	if _, ok := r.objects[id]; !ok {
+		return false
+	}
+	delete(r.objects, id)
+	for i, existing := range r.ids {
+		if existing == id {
+			r.ids = append(r.ids[:i], r.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (r *resourceStore) list() []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0, len(r.ids))
+	for _, id := range r.ids {
+		objects = append(objects, r.objects[id])
+	}
+	return objects
+}
+
+// readBody decodes a request body into the generated model, to validate it like the API, and returns
+// it as a JSON object.
+func readBody(r *http.Request, model interface{}) (map[string]interface{}, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, model); err != nil {
+		return nil, err
+	}
+	if unparsed := reflect.ValueOf(model).Elem().FieldByName("UnparsedObject"); unparsed.IsValid() && !unparsed.IsNil() {
+		return nil, fmt.Errorf("invalid value in %s", reflect.TypeOf(model).Elem().Name())
+	}
+	var object map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// clone returns a deep copy of a JSON object, so that it can be encoded outside of the lock.
+func clone(object map[string]interface{}) map[string]interface{} {
+	content, _ := json.Marshal(object)
+	var copied map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	decoder.Decode(&copied)
+	return copied
+}
+
+// merge sets the fields of patch on object.
+func merge(object, patch map[string]interface{}) {
+	for key, value := range patch {
+		object[key] = value
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// writeErrors writes an error response in the format of the API.
+func writeErrors(w http.ResponseWriter, status int, errors ...string) {
+	writeJSON(w, status, map[string]interface{}{"errors": errors})
+}
+
+// window returns the objects of a page, given its offset and size. A negative size returns all the objects.
+func window(objects []map[string]interface{}, offset, size int) []map[string]interface{} {
+	if offset > len(objects) {
+		offset = len(objects)
+	}
+	objects = objects[offset:]
+	if size >= 0 && size < len(objects) {
+		objects = objects[:size]
+	}
+	return objects
+}
+
+// queryInt returns an integer query parameter, or a default value if it is missing.
+func queryInt(r *http.Request, name string, value int) (int, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return value, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid value %q for %s", raw, name)
+	}
+	return value, nil
+}
+
+// pageByNumber returns the offset and size of a page given by number and size parameters. defaultSize is
+// the size when no page is requested, negative to return all the objects, in which case a page requested
+// without a size has 100 objects.
+func pageByNumber(r *http.Request, sizeParam, numberParam string, defaultSize int) (int, int, error) {
+	if r.URL.Query().Get(sizeParam) == "" && r.URL.Query().Get(numberParam) == "" {
+		return 0, defaultSize, nil
+	}
+	size, err := queryInt(r, sizeParam, defaultSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	if size < 0 {
+		size = 100
+	}
+	number, err := queryInt(r, numberParam, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	return number * size, size, nil
+}
+
+// pageByOffset returns the offset and size of a page given by offset and size parameters.
+func pageByOffset(r *http.Request, sizeParam, offsetParam string, defaultSize int) (int, int, error) {
+	size, err := queryInt(r, sizeParam, defaultSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	offset, err := queryInt(r, offsetParam, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	return offset, size, nil
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func creator() map[string]interface{} {
+	return map[string]interface{}{"email": fakeUserEmail, "handle": fakeUserEmail, "name": "Frog"}
+}
+
+// publicID returns an identifier like the ones of dashboards and synthetics tests, e.g. "abc-123-xyz".
+func publicID() string {
+	random := uuid.New()
+	id := make([]byte, 0, 11)
+	for i := 0; i < 9; i++ {
+		if i == 3 || i == 6 {
+			id = append(id, '-')
+		}
+		id = append(id, publicIDAlphabet[int(random[i])%len(publicIDAlphabet)])
+	}
+	return string(id)
+}
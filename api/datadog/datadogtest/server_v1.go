@@ -0,0 +1,477 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadogtest
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+)
+
+var (
+	slugCheck = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+func (s *Server) registerV1(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/monitor", s.createMonitor)
+	mux.HandleFunc("GET /api/v1/monitor", s.listMonitors)
+	mux.HandleFunc("GET /api/v1/monitor/{id}", s.getMonitor)
+	mux.HandleFunc("PUT /api/v1/monitor/{id}", s.updateMonitor)
+	mux.HandleFunc("DELETE /api/v1/monitor/{id}", s.deleteMonitor)
+
+	mux.HandleFunc("POST /api/v1/dashboard", s.createDashboard)
+	mux.HandleFunc("GET /api/v1/dashboard", s.listDashboards)
+	mux.HandleFunc("GET /api/v1/dashboard/{id}", s.getDashboard)
+	mux.HandleFunc("PUT /api/v1/dashboard/{id}", s.updateDashboard)
+	mux.HandleFunc("DELETE /api/v1/dashboard/{id}", s.deleteDashboard)
+
+	mux.HandleFunc("POST /api/v1/slo", s.createSLO)
+	mux.HandleFunc("GET /api/v1/slo", s.listSLOs)
+	mux.HandleFunc("GET /api/v1/slo/{id}", s.getSLO)
+	mux.HandleFunc("PUT /api/v1/slo/{id}", s.updateSLO)
+	mux.HandleFunc("DELETE /api/v1/slo/{id}", s.deleteSLO)
+
+	mux.HandleFunc("POST /api/v1/synthetics/tests/api", s.createSyntheticsTest("api"))
+	mux.HandleFunc("POST /api/v1/synthetics/tests/browser", s.createSyntheticsTest("browser"))
+	mux.HandleFunc("GET /api/v1/synthetics/tests", s.listSyntheticsTests)
+	mux.HandleFunc("GET /api/v1/synthetics/tests/{id}", s.getSyntheticsTest(""))
+	mux.HandleFunc("GET /api/v1/synthetics/tests/api/{id}", s.getSyntheticsTest("api"))
+	mux.HandleFunc("GET /api/v1/synthetics/tests/browser/{id}", s.getSyntheticsTest("browser"))
+	mux.HandleFunc("PUT /api/v1/synthetics/tests/api/{id}", s.updateSyntheticsTest("api"))
+	mux.HandleFunc("PUT /api/v1/synthetics/tests/browser/{id}", s.updateSyntheticsTest("browser"))
+	mux.HandleFunc("POST /api/v1/synthetics/tests/delete", s.deleteSyntheticsTests)
+}
+
+func (s *Server) createMonitor(w http.ResponseWriter, r *http.Request) {
+	monitor, err := readBody(r, &datadogV1.Monitor{})
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	now := timestamp(time.Now())
+	s.mu.Lock()
+	id := s.nextID()
+	monitor["id"] = id
+	monitor["created"] = now
+	monitor["modified"] = now
+	monitor["creator"] = creator()
+	monitor["deleted"] = nil
+	monitor["overall_state"] = "No Data"
+	if _, ok := monitor["name"]; !ok {
+		monitor["name"] = monitor["query"]
+	}
+	if _, ok := monitor["options"]; !ok {
+		monitor["options"] = map[string]interface{}{}
+	}
+	s.store("monitor").put(strconv.FormatInt(id, 10), monitor)
+	monitor = clone(monitor)
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+func (s *Server) listMonitors(w http.ResponseWriter, r *http.Request) {
+	offset, size, err := pageByNumber(r, "page_size", "page", -1)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.mu.Lock()
+	monitors := window(s.store("monitor").list(), offset, size)
+	for i, monitor := range monitors {
+		monitors[i] = clone(monitor)
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, monitors)
+}
+
+func (s *Server) getMonitor(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	monitor, ok := s.store("monitor").get(r.PathValue("id"))
+	if ok {
+		monitor = clone(monitor)
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+func (s *Server) updateMonitor(w http.ResponseWriter, r *http.Request) {
+	update, err := readBody(r, &datadogV1.MonitorUpdateRequest{})
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	for _, field := range []string{"id", "created", "creator", "deleted", "overall_state"} {
+		delete(update, field)
+	}
+	s.mu.Lock()
+	monitor, ok := s.store("monitor").get(r.PathValue("id"))
+	if ok {
+		merge(monitor, update)
+		monitor["modified"] = timestamp(time.Now())
+		monitor = clone(monitor)
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+func (s *Server) deleteMonitor(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	s.mu.Lock()
+	ok := s.store("monitor").remove(id)
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+	deleted, _ := strconv.ParseInt(id, 10, 64)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"deleted_monitor_id": deleted})
+}
+
+func (s *Server) createDashboard(w http.ResponseWriter, r *http.Request) {
+	dashboard, err := readBody(r, &datadogV1.Dashboard{})
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	now := timestamp(time.Now())
+	id := publicID()
+	s.mu.Lock()
+	dashboard["id"] = id
+	dashboard["url"] = "/dashboard/" + id + "/" + strings.Trim(slugCheck.ReplaceAllString(strings.ToLower(dashboard["title"].(string)), "-"), "-")
+	dashboard["created_at"] = now
+	dashboard["modified_at"] = now
+	dashboard["author_handle"] = fakeUserEmail
+	dashboard["author_name"] = "Frog"
+	s.setWidgetIDs(dashboard["widgets"])
+	s.store("dashboard").put(id, dashboard)
+	dashboard = clone(dashboard)
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, dashboard)
+}
+
+// setWidgetIDs sets the identifiers of the widgets created with a dashboard, including the ones of groups.
+// The lock must be held.
+func (s *Server) setWidgetIDs(widgets interface{}) {
+	list, _ := widgets.([]interface{})
+	for _, widget := range list {
+		widget, ok := widget.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := widget["id"]; !ok {
+			widget["id"] = s.nextID()
+		}
+		if definition, ok := widget["definition"].(map[string]interface{}); ok {
+			s.setWidgetIDs(definition["widgets"])
+		}
+	}
+}
+
+func (s *Server) listDashboards(w http.ResponseWriter, r *http.Request) {
+	offset, size, err := pageByOffset(r, "count", "start", -1)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.mu.Lock()
+	summaries := make([]interface{}, 0)
+	for _, dashboard := range window(s.store("dashboard").list(), offset, size) {
+		summary := map[string]interface{}{"is_read_only": false}
+		for _, field := range []string{"id", "title", "description", "layout_type", "url", "created_at", "modified_at", "author_handle"} {
+			summary[field] = dashboard[field]
+		}
+		summaries = append(summaries, summary)
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"dashboards": summaries})
+}
+
+func (s *Server) getDashboard(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	s.mu.Lock()
+	dashboard, ok := s.store("dashboard").get(id)
+	if ok {
+		dashboard = clone(dashboard)
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Dashboard with ID "+id+" not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, dashboard)
+}
+
+func (s *Server) updateDashboard(w http.ResponseWriter, r *http.Request) {
+	update, err := readBody(r, &datadogV1.Dashboard{})
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	id := r.PathValue("id")
+	s.mu.Lock()
+	dashboard, ok := s.store("dashboard").get(id)
+	if ok {
+		for _, field := range []string{"id", "url", "created_at", "author_handle", "author_name"} {
+			update[field] = dashboard[field]
+		}
+		update["modified_at"] = timestamp(time.Now())
+		s.setWidgetIDs(update["widgets"])
+		s.store("dashboard").put(id, update)
+		dashboard = clone(update)
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Dashboard with ID "+id+" not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, dashboard)
+}
+
+func (s *Server) deleteDashboard(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	s.mu.Lock()
+	ok := s.store("dashboard").remove(id)
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Dashboard with ID "+id+" not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"deleted_dashboard_id": id})
+}
+
+func (s *Server) createSLO(w http.ResponseWriter, r *http.Request) {
+	slo, err := readBody(r, &datadogV1.ServiceLevelObjectiveRequest{})
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	now := time.Now().Unix()
+	id := strings.ReplaceAll(uuid.NewString(), "-", "")
+	s.mu.Lock()
+	slo["id"] = id
+	slo["created_at"] = now
+	slo["modified_at"] = now
+	slo["creator"] = creator()
+	s.store("slo").put(id, slo)
+	slo = clone(slo)
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": []interface{}{slo}})
+}
+
+func (s *Server) listSLOs(w http.ResponseWriter, r *http.Request) {
+	offset, size, err := pageByOffset(r, "limit", "offset", 1000)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var ids map[string]bool
+	if raw := r.URL.Query().Get("ids"); raw != "" {
+		ids = make(map[string]bool)
+		for _, id := range strings.Split(raw, ",") {
+			ids[id] = true
+		}
+	}
+	s.mu.Lock()
+	var slos []map[string]interface{}
+	for _, slo := range s.store("slo").list() {
+		if ids == nil || ids[slo["id"].(string)] {
+			slos = append(slos, clone(slo))
+		}
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": window(slos, offset, size)})
+}
+
+func (s *Server) getSLO(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	s.mu.Lock()
+	slo, ok := s.store("slo").get(id)
+	if ok {
+		slo = clone(slo)
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "SLO not found: "+id)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": slo})
+}
+
+func (s *Server) updateSLO(w http.ResponseWriter, r *http.Request) {
+	update, err := readBody(r, &datadogV1.ServiceLevelObjective{})
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	id := r.PathValue("id")
+	s.mu.Lock()
+	slo, ok := s.store("slo").get(id)
+	if ok {
+		for _, field := range []string{"id", "created_at", "creator"} {
+			update[field] = slo[field]
+		}
+		update["modified_at"] = time.Now().Unix()
+		s.store("slo").put(id, update)
+		slo = clone(update)
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "SLO not found: "+id)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": []interface{}{slo}})
+}
+
+func (s *Server) deleteSLO(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	s.mu.Lock()
+	ok := s.store("slo").remove(id)
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "SLO not found: "+id)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": []string{id}, "errors": map[string]string{}})
+}
+
+func (s *Server) createSyntheticsTest(testType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var model interface{} = &datadogV1.SyntheticsAPITest{}
+		if testType == "browser" {
+			model = &datadogV1.SyntheticsBrowserTest{}
+		}
+		test, err := readBody(r, model)
+		if err != nil {
+			writeErrors(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		id := publicID()
+		s.mu.Lock()
+		test["public_id"] = id
+		test["monitor_id"] = s.nextID()
+		if _, ok := test["status"]; !ok {
+			test["status"] = "live"
+		}
+		s.store("synthetics").put(id, test)
+		test = clone(test)
+		s.mu.Unlock()
+		writeJSON(w, http.StatusOK, test)
+	}
+}
+
+// syntheticsTest returns a synthetics test of the given type, or of any type if it is empty.
+// The lock must be held.
+func (s *Server) syntheticsTest(id, testType string) (map[string]interface{}, bool) {
+	test, ok := s.store("synthetics").get(id)
+	if !ok || testType == "" {
+		return test, ok
+	}
+	if test["type"] == testType {
+		return test, true
+	}
+	return nil, false
+}
+
+func (s *Server) listSyntheticsTests(w http.ResponseWriter, r *http.Request) {
+	offset, size, err := pageByNumber(r, "page_size", "page_number", -1)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.mu.Lock()
+	tests := window(s.store("synthetics").list(), offset, size)
+	for i, test := range tests {
+		tests[i] = clone(test)
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"tests": tests})
+}
+
+func (s *Server) getSyntheticsTest(testType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		test, ok := s.syntheticsTest(r.PathValue("id"), testType)
+		if ok {
+			test = clone(test)
+		}
+		s.mu.Unlock()
+		if !ok {
+			writeErrors(w, http.StatusNotFound, "Synthetics test not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, test)
+	}
+}
+
+func (s *Server) updateSyntheticsTest(testType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var model interface{} = &datadogV1.SyntheticsAPITest{}
+		if testType == "browser" {
+			model = &datadogV1.SyntheticsBrowserTest{}
+		}
+		update, err := readBody(r, model)
+		if err != nil {
+			writeErrors(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		id := r.PathValue("id")
+		s.mu.Lock()
+		test, ok := s.syntheticsTest(id, testType)
+		if ok {
+			update["public_id"] = test["public_id"]
+			update["monitor_id"] = test["monitor_id"]
+			if _, ok := update["status"]; !ok {
+				update["status"] = test["status"]
+			}
+			s.store("synthetics").put(id, update)
+			test = clone(update)
+		}
+		s.mu.Unlock()
+		if !ok {
+			writeErrors(w, http.StatusNotFound, "Synthetics test not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, test)
+	}
+}
+
+func (s *Server) deleteSyntheticsTests(w http.ResponseWriter, r *http.Request) {
+	payload := datadogV1.SyntheticsDeleteTestsPayload{}
+	if _, err := readBody(r, &payload); err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	now := timestamp(time.Now())
+	s.mu.Lock()
+	store := s.store("synthetics")
+	for _, id := range payload.PublicIds {
+		if _, ok := store.get(id); !ok {
+			s.mu.Unlock()
+			writeErrors(w, http.StatusNotFound, "Synthetics test "+id+" not found")
+			return
+		}
+	}
+	deleted := make([]interface{}, 0, len(payload.PublicIds))
+	for _, id := range payload.PublicIds {
+		store.remove(id)
+		deleted = append(deleted, map[string]interface{}{"public_id": id, "deleted_at": now})
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"deleted_tests": deleted})
+}
@@ -0,0 +1,312 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadogtest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+)
+
+// jsonAPIResource describes a resource of v2, following JSON:API: objects have an id, a type,
+// attributes and relationships, and are wrapped in a data field.
+type jsonAPIResource struct {
+	// path is the path of the collection, e.g. "/api/v2/team".
+	path string
+	// kind is the JSON:API type of the objects, e.g. "team".
+	kind string
+	// name is used in the error messages, e.g. "Team".
+	name string
+	// createStatus is the status code of the creation responses.
+	createStatus int
+	// createModel and updateModel return the models request bodies are validated with.
+	createModel func() interface{}
+	updateModel func() interface{}
+	// created and modified are the names of the timestamp attributes.
+	created, modified string
+	// page returns the offset and size of the requested page.
+	page func(r *http.Request) (int, int, error)
+	// create sets the server-side attributes of a new object, or returns a conflict. The lock is held.
+	create func(s *Server, attributes map[string]interface{}) error
+	// remove is called on deletion instead of removing the object, when it is kept with a new status.
+	remove func(attributes map[string]interface{}, now string)
+	// meta returns the metadata of a list response.
+	meta func(offset, size, total int) map[string]interface{}
+}
+
+// conflictError is returned by jsonAPIResource.create when the object conflicts with an existing one.
+type conflictError string
+
+func (e conflictError) Error() string {
+	return string(e)
+}
+
+func (s *Server) registerV2(mux *http.ServeMux) {
+	resources := []*jsonAPIResource{
+		{
+			path:         "/api/v2/downtime",
+			kind:         "downtime",
+			name:         "Downtime",
+			createStatus: http.StatusOK,
+			createModel:  func() interface{} { return &datadogV2.DowntimeCreateRequest{} },
+			updateModel:  func() interface{} { return &datadogV2.DowntimeUpdateRequest{} },
+			created:      "created",
+			modified:     "modified",
+			page: func(r *http.Request) (int, int, error) {
+				return pageByOffset(r, "page[limit]", "page[offset]", 30)
+			},
+			create: func(s *Server, attributes map[string]interface{}) error {
+				attributes["status"] = "active"
+				attributes["canceled"] = nil
+				if _, ok := attributes["display_timezone"]; !ok {
+					attributes["display_timezone"] = "UTC"
+				}
+				if _, ok := attributes["schedule"]; !ok {
+					attributes["schedule"] = map[string]interface{}{"start": attributes["created"], "end": nil}
+				}
+				return nil
+			},
+			remove: func(attributes map[string]interface{}, now string) {
+				attributes["status"] = "canceled"
+				attributes["canceled"] = now
+			},
+			meta: func(offset, size, total int) map[string]interface{} {
+				return map[string]interface{}{"page": map[string]interface{}{"total_filtered_count": total}}
+			},
+		},
+		{
+			path:         "/api/v2/users",
+			kind:         "users",
+			name:         "User",
+			createStatus: http.StatusCreated,
+			createModel:  func() interface{} { return &datadogV2.UserCreateRequest{} },
+			updateModel:  func() interface{} { return &datadogV2.UserUpdateRequest{} },
+			created:      "created_at",
+			modified:     "modified_at",
+			page: func(r *http.Request) (int, int, error) {
+				return pageByNumber(r, "page[size]", "page[number]", 10)
+			},
+			create: func(s *Server, attributes map[string]interface{}) error {
+				email := attributes["email"]
+				for _, user := range s.store("users").list() {
+					if user["attributes"].(map[string]interface{})["email"] == email {
+						return conflictError(fmt.Sprintf("User with email %s already exists", email))
+					}
+				}
+				attributes["handle"] = email
+				attributes["status"] = "Pending"
+				attributes["disabled"] = false
+				attributes["verified"] = false
+				attributes["service_account"] = false
+				attributes["mfa_enabled"] = false
+				return nil
+			},
+			remove: func(attributes map[string]interface{}, now string) {
+				attributes["status"] = "Disabled"
+				attributes["disabled"] = true
+			},
+			meta: func(offset, size, total int) map[string]interface{} {
+				return map[string]interface{}{"page": map[string]interface{}{"total_count": total, "total_filtered_count": total}}
+			},
+		},
+		{
+			path:         "/api/v2/team",
+			kind:         "team",
+			name:         "Team",
+			createStatus: http.StatusCreated,
+			createModel:  func() interface{} { return &datadogV2.TeamCreateRequest{} },
+			updateModel:  func() interface{} { return &datadogV2.TeamUpdateRequest{} },
+			created:      "created_at",
+			modified:     "modified_at",
+			page: func(r *http.Request) (int, int, error) {
+				return pageByNumber(r, "page[size]", "page[number]", 10)
+			},
+			create: func(s *Server, attributes map[string]interface{}) error {
+				handle := attributes["handle"]
+				for _, team := range s.store("team").list() {
+					if team["attributes"].(map[string]interface{})["handle"] == handle {
+						return conflictError(fmt.Sprintf("Team with handle %s already exists", handle))
+					}
+				}
+				attributes["user_count"] = 0
+				attributes["link_count"] = 0
+				return nil
+			},
+		},
+		{
+			path:         "/api/v2/incidents",
+			kind:         "incidents",
+			name:         "Incident",
+			createStatus: http.StatusCreated,
+			createModel:  func() interface{} { return &datadogV2.IncidentCreateRequest{} },
+			updateModel:  func() interface{} { return &datadogV2.IncidentUpdateRequest{} },
+			created:      "created",
+			modified:     "modified",
+			page: func(r *http.Request) (int, int, error) {
+				return pageByOffset(r, "page[size]", "page[offset]", 10)
+			},
+			create: func(s *Server, attributes map[string]interface{}) error {
+				attributes["public_id"] = s.nextID()
+				attributes["state"] = "active"
+				attributes["resolved"] = nil
+				return nil
+			},
+			meta: func(offset, size, total int) map[string]interface{} {
+				pagination := map[string]interface{}{"offset": offset, "size": size}
+				if offset+size < total {
+					pagination["next_offset"] = offset + size
+				}
+				return map[string]interface{}{"pagination": pagination}
+			},
+		},
+	}
+	for _, resource := range resources {
+		mux.HandleFunc("POST "+resource.path, s.createJSONAPI(resource))
+		mux.HandleFunc("GET "+resource.path, s.listJSONAPI(resource))
+		mux.HandleFunc("GET "+resource.path+"/{id}", s.getJSONAPI(resource))
+		mux.HandleFunc("PATCH "+resource.path+"/{id}", s.updateJSONAPI(resource))
+		mux.HandleFunc("DELETE "+resource.path+"/{id}", s.deleteJSONAPI(resource))
+	}
+}
+
+func (s *Server) createJSONAPI(resource *jsonAPIResource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request, err := readBody(r, resource.createModel())
+		if err != nil {
+			writeErrors(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		data, _ := request["data"].(map[string]interface{})
+		attributes, _ := data["attributes"].(map[string]interface{})
+		if attributes == nil {
+			attributes = map[string]interface{}{}
+		}
+		now := timestamp(time.Now())
+		attributes[resource.created] = now
+		attributes[resource.modified] = now
+		object := map[string]interface{}{
+			"id":         uuid.NewString(),
+			"type":       resource.kind,
+			"attributes": attributes,
+		}
+		if relationships, ok := data["relationships"]; ok {
+			object["relationships"] = relationships
+		}
+
+		s.mu.Lock()
+		if err := resource.create(s, attributes); err != nil {
+			s.mu.Unlock()
+			writeErrors(w, http.StatusConflict, err.Error())
+			return
+		}
+		s.store(resource.kind).put(object["id"].(string), object)
+		object = clone(object)
+		s.mu.Unlock()
+		writeJSON(w, resource.createStatus, map[string]interface{}{"data": object})
+	}
+}
+
+func (s *Server) listJSONAPI(resource *jsonAPIResource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		offset, size, err := resource.page(r)
+		if err != nil {
+			writeErrors(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.mu.Lock()
+		objects := s.store(resource.kind).list()
+		total := len(objects)
+		objects = window(objects, offset, size)
+		for i, object := range objects {
+			objects[i] = clone(object)
+		}
+		s.mu.Unlock()
+		response := map[string]interface{}{"data": objects}
+		if resource.meta != nil {
+			response["meta"] = resource.meta(offset, size, total)
+		}
+		writeJSON(w, http.StatusOK, response)
+	}
+}
+
+func (s *Server) getJSONAPI(resource *jsonAPIResource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		object, ok := s.store(resource.kind).get(r.PathValue("id"))
+		if ok {
+			object = clone(object)
+		}
+		s.mu.Unlock()
+		if !ok {
+			writeErrors(w, http.StatusNotFound, resource.name+" not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": object})
+	}
+}
+
+func (s *Server) updateJSONAPI(resource *jsonAPIResource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request, err := readBody(r, resource.updateModel())
+		if err != nil {
+			writeErrors(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		data, _ := request["data"].(map[string]interface{})
+		if id, ok := data["id"].(string); ok && !strings.EqualFold(id, r.PathValue("id")) {
+			writeErrors(w, http.StatusBadRequest, "The id in the body does not match the id in the path")
+			return
+		}
+		update, _ := data["attributes"].(map[string]interface{})
+
+		s.mu.Lock()
+		object, ok := s.store(resource.kind).get(r.PathValue("id"))
+		if ok {
+			attributes := object["attributes"].(map[string]interface{})
+			merge(attributes, update)
+			attributes[resource.modified] = timestamp(time.Now())
+			if relationships, ok := data["relationships"]; ok {
+				object["relationships"] = relationships
+			}
+			object = clone(object)
+		}
+		s.mu.Unlock()
+		if !ok {
+			writeErrors(w, http.StatusNotFound, resource.name+" not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": object})
+	}
+}
+
+func (s *Server) deleteJSONAPI(resource *jsonAPIResource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		s.mu.Lock()
+		store := s.store(resource.kind)
+		object, ok := store.get(id)
+		if ok {
+			if resource.remove != nil {
+				now := timestamp(time.Now())
+				attributes := object["attributes"].(map[string]interface{})
+				resource.remove(attributes, now)
+				attributes[resource.modified] = now
+			} else {
+				store.remove(id)
+			}
+		}
+		s.mu.Unlock()
+		if !ok {
+			writeErrors(w, http.StatusNotFound, resource.name+" not found")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -11,6 +11,14 @@ import (
 	"io"
 )
 
+// jsonDecoder, jsonDelim and jsonRawMessage are the types of the JSON package of the build, for the code
+// reading tokens from NewDecoder.
+type (
+	jsonDecoder    = json.Decoder
+	jsonDelim      = json.Delim
+	jsonRawMessage = json.RawMessage
+)
+
 func Marshal(v interface{}) ([]byte, error) {
 	return json.Marshal(v)
 }
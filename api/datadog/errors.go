@@ -0,0 +1,145 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Sentinel errors matched by GenericOpenAPIError with errors.Is, depending on the response status code.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+var (
+	requestIDHeaders = []string{"X-Datadog-Request-Id", "X-Request-Id"}
+)
+
+// NewGenericOpenAPIError returns the error for an unsuccessful response, with the status code,
+// operation ID, request ID and rate limit of the response. The error model is left to the caller.
+func NewGenericOpenAPIError(resp *http.Response, body []byte) GenericOpenAPIError {
+	e := GenericOpenAPIError{
+		ErrorBody:    body,
+		ErrorMessage: resp.Status,
+		StatusCode:   resp.StatusCode,
+		Header:       resp.Header,
+		RequestID:    requestIDFromHeader(resp.Header),
+	}
+	if resp.Request != nil {
+		e.OperationID = OperationIDFromContext(resp.Request.Context())
+	}
+	if bucket, ok := rateLimitFromHeader(resp.Header, e.OperationID); ok {
+		e.RateLimit = &bucket
+	}
+	return e
+}
+
+// Is reports whether the status code of the error matches one of the sentinel errors.
+func (e GenericOpenAPIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= 500 && e.StatusCode < 600
+	}
+	return false
+}
+
+// AsAPIError returns the GenericOpenAPIError wrapped in err, if any.
+func AsAPIError(err error) (GenericOpenAPIError, bool) {
+	var apiErr GenericOpenAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	var apiErrPtr *GenericOpenAPIError
+	if errors.As(err, &apiErrPtr) && apiErrPtr != nil {
+		return *apiErrPtr, true
+	}
+	return GenericOpenAPIError{}, false
+}
+
+// ErrorModelAs returns the decoded error model of the API error wrapped in err, if it is a T.
+//
+//	if model, ok := datadog.ErrorModelAs[datadogV2.APIErrorResponse](err); ok {
+//		fmt.Println(model.Errors)
+//	}
+func ErrorModelAs[T any](err error) (T, bool) {
+	var zero T
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return zero, false
+	}
+	switch model := apiErr.ErrorModel.(type) {
+	case T:
+		return model, true
+	case *T:
+		if model != nil {
+			return *model, true
+		}
+	}
+	return zero, false
+}
+
+// IsBadRequest returns true if err is an API error with a 400 status code.
+func IsBadRequest(err error) bool {
+	return errors.Is(err, ErrBadRequest)
+}
+
+// IsUnauthorized returns true if err is an API error with a 401 status code.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden returns true if err is an API error with a 403 status code.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsNotFound returns true if err is an API error with a 404 status code.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict returns true if err is an API error with a 409 status code.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsRateLimited returns true if err is an API error with a 429 status code.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsServerError returns true if err is an API error with a 5xx status code.
+func IsServerError(err error) bool {
+	return errors.Is(err, ErrServer)
+}
+
+// requestIDFromHeader returns the ID Datadog assigned to the request, if the response carries one.
+func requestIDFromHeader(header http.Header) string {
+	for _, name := range requestIDHeaders {
+		if v := header.Get(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -12,6 +12,14 @@ import (
 	"github.com/goccy/go-json"
 )
 
+// jsonDecoder, jsonDelim and jsonRawMessage are the types of the JSON package of the build, for the code
+// reading tokens from NewDecoder.
+type (
+	jsonDecoder    = json.Decoder
+	jsonDelim      = json.Delim
+	jsonRawMessage = json.RawMessage
+)
+
 func Marshal(v interface{}) ([]byte, error) {
 	return json.Marshal(v)
 }
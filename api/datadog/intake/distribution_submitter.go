@@ -0,0 +1,306 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package intake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+)
+
+// DefaultDistributionFlushInterval is the interval at which distributions are sent when
+// DistributionSubmitterOptions.FlushInterval is not set.
+const DefaultDistributionFlushInterval = 10 * time.Second
+
+// ErrTooManySeries is the error of the values dropped because DistributionSubmitterOptions.MaxSeries was reached.
+var ErrTooManySeries = errors.New("too many series")
+
+const (
+	// submitDistributionPointsOperationID is the operation ID of MetricsApi.SubmitDistributionPoints.
+	submitDistributionPointsOperationID = "v1.MetricsApi.SubmitDistributionPoints"
+	// maxDistributionPointValues is the largest number of values of a point, so that a series with many
+	// values is split into points which fit in the payloads.
+	maxDistributionPointValues = 40000
+)
+
+// DistributionSubmitterOptions configures a DistributionSubmitter.
+type DistributionSubmitterOptions struct {
+	// FlushInterval is the interval at which the distributions are sent. Zero means
+	// DefaultDistributionFlushInterval.
+	FlushInterval time.Duration
+	// RelativeAccuracy is the relative accuracy of the values sent. Zero means DefaultSketchRelativeAccuracy.
+	RelativeAccuracy float64
+	// MaxBins bounds the memory used by each series, see Sketch. Zero means DefaultSketchMaxBins.
+	MaxBins int
+	// MaxValues is the largest number of values sent for each series in a flush, if not zero. The values of a
+	// series with more values are scaled down to it, see DistributionSubmitter. Zero sends every value.
+	MaxValues int
+	// MaxSeries is the largest number of series aggregated in a flush interval, if not zero. The values of
+	// other series are dropped until the next flush, which reports them with ErrTooManySeries.
+	MaxSeries int
+	// Tags are added to the tags of every distribution.
+	Tags []string
+	// Host is the host the distributions are reported for, if any.
+	Host string
+	// DisableCompression sends the payloads uncompressed instead of compressed with deflate.
+	DisableCompression bool
+	// MaxPayloadSize is the largest size of a payload, after compression. Payloads are split to stay under it.
+	// Zero means MaxMetricsPayloadSize.
+	MaxPayloadSize int
+	// Retry configures how the payloads the intake failed to accept are sent again.
+	Retry RetryOptions
+	// OnError is called with the error of a background flush, when values are dropped.
+	OnError func(err error)
+}
+
+// DistributionSubmitter aggregates the values of distributions client-side in a Sketch for each combination
+// of metric name and tags, and sends them with MetricsApi.SubmitDistributionPoints every flush interval. It
+// is safe for concurrent use, and must be closed to send the last values.
+//
+// The endpoint takes the values themselves, so each bin of a sketch is sent as its representative value,
+// repeated for each value of the bin, with as many digits as the relative accuracy needs. The smallest and
+// largest values are sent exactly. These repeated values make small payloads once compressed, while the
+// memory used between two flushes is bounded by the number of bins instead of the number of values.
+//
+// Every value added is sent, the values of a series being split into points which fit in the payloads. The
+// payloads can be bounded by setting MaxValues: a series with more than MaxValues values in a flush is then
+// sent as MaxValues values, each bin being repeated in proportion to its count. Its quantiles then have an
+// error of at most 1/MaxValues in rank on top of the relative accuracy, but its count and sum are the ones
+// of the values sent, not of the values added, so MaxValues should only be set for series whose count and
+// sum are submitted as count metrics too.
+type DistributionSubmitter struct {
+	ctx     context.Context
+	api     *datadogV1.MetricsApi
+	options DistributionSubmitterOptions
+	digits  int
+
+	mu          sync.Mutex
+	sketches    map[distributionKey]*distributionSketch
+	windowStart time.Time
+	dropped     int
+	closed      bool
+
+	// flushMu makes the flushes send the windows in order.
+	flushMu sync.Mutex
+	flusher *flusher
+}
+
+type distributionKey struct {
+	name string
+	tags string
+}
+
+type distributionSketch struct {
+	tags   []string
+	sketch *Sketch
+}
+
+// NewDistributionSubmitter returns a DistributionSubmitter sending distributions with api. The distributions
+// are sent with ctx, which holds the API keys, until the submitter is closed or ctx is canceled. It returns
+// an error if the relative accuracy or the number of bins is invalid.
+func NewDistributionSubmitter(ctx context.Context, api *datadogV1.MetricsApi, options DistributionSubmitterOptions) (*DistributionSubmitter, error) {
+	if options.FlushInterval <= 0 {
+		options.FlushInterval = DefaultDistributionFlushInterval
+	}
+	if options.MaxPayloadSize <= 0 {
+		options.MaxPayloadSize = MaxMetricsPayloadSize
+	}
+	sketch, err := NewSketch(options.RelativeAccuracy, options.MaxBins)
+	if err != nil {
+		return nil, err
+	}
+	s := &DistributionSubmitter{
+		ctx:     ctx,
+		api:     api,
+		options: options,
+		// Rounding the values to these significant digits moves them by less than a hundredth of the
+		// relative accuracy.
+		digits:      int(math.Ceil(-math.Log10(sketch.RelativeAccuracy()))) + 3,
+		sketches:    make(map[distributionKey]*distributionSketch),
+		windowStart: time.Now(),
+	}
+	s.flusher = startFlusher(ctx, options.FlushInterval, s.Flush, options.OnError)
+	return s, nil
+}
+
+// Distribution adds a value to a distribution.
+func (s *DistributionSubmitter) Distribution(name string, value float64, tags ...string) {
+	tags = normalizeTags(tags)
+	key := distributionKey{name: name, tags: strings.Join(tags, ",")}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	sketch, ok := s.sketches[key]
+	if !ok {
+		if s.options.MaxSeries > 0 && len(s.sketches) >= s.options.MaxSeries {
+			s.dropped++
+			return
+		}
+		// The options were checked by NewDistributionSubmitter.
+		newSketch, _ := NewSketch(s.options.RelativeAccuracy, s.options.MaxBins)
+		sketch = &distributionSketch{tags: tags, sketch: newSketch}
+		s.sketches[key] = sketch
+	}
+	sketch.sketch.Add(value)
+}
+
+// Flush sends the distributions aggregated since the last flush with ctx, and returns the *SubmitError of
+// each payload the intake did not accept and the ErrTooManySeries error of the dropped values, joined with
+// errors.Join.
+func (s *DistributionSubmitter) Flush(ctx context.Context) error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+	s.mu.Lock()
+	sketches, start, dropped := s.sketches, s.windowStart, s.dropped
+	s.sketches = make(map[distributionKey]*distributionSketch)
+	s.windowStart, s.dropped = time.Now(), 0
+	s.mu.Unlock()
+
+	var errs []error
+	if dropped > 0 {
+		errs = append(errs, fmt.Errorf("%w: %d values dropped", ErrTooManySeries, dropped))
+	}
+	if len(sketches) == 0 {
+		return errors.Join(errs...)
+	}
+	for _, payload := range s.payloads(s.series(sketches, start)) {
+		attempts, err := sendWithRetry(ctx, s.options.Retry, func(ctx context.Context) error {
+			_, _, err := s.api.SubmitDistributionPoints(ctx, payload, s.submitOptions()...)
+			return err
+		})
+		if err != nil {
+			errs = append(errs, &SubmitError{OperationID: submitDistributionPointsOperationID, Items: len(payload.Series), Attempts: attempts, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close stops the background flushes, sends the last distributions and returns the error of this last flush.
+// Values added after Close are ignored.
+func (s *DistributionSubmitter) Close() error {
+	return s.flusher.close(func() error {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+		return s.Flush(s.ctx)
+	})
+}
+
+func (s *DistributionSubmitter) submitOptions() []datadogV1.SubmitDistributionPointsOptionalParameters {
+	if s.options.DisableCompression {
+		return nil
+	}
+	return []datadogV1.SubmitDistributionPointsOptionalParameters{*datadogV1.NewSubmitDistributionPointsOptionalParameters().WithContentEncoding(datadogV1.DISTRIBUTIONPOINTSCONTENTENCODING_DEFLATE)}
+}
+
+// series returns the series of the sketches of the window started at start, sorted by name and tags. The
+// values of a sketch are split into series of at most maxDistributionPointValues values.
+func (s *DistributionSubmitter) series(sketches map[distributionKey]*distributionSketch, start time.Time) []datadogV1.DistributionPointsSeries {
+	keys := make([]distributionKey, 0, len(sketches))
+	for key := range sketches {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].tags < keys[j].tags
+	})
+
+	timestamp := float64(start.Unix())
+	var series []datadogV1.DistributionPointsSeries
+	for _, key := range keys {
+		sketch := sketches[key]
+		values := s.values(sketch.sketch)
+		for from := 0; from < len(values); from += maxDistributionPointValues {
+			to := from + maxDistributionPointValues
+			if to > len(values) {
+				to = len(values)
+			}
+			chunk := values[from:to]
+			point := []datadogV1.DistributionPointItem{
+				datadogV1.DistributionPointTimestampAsDistributionPointItem(&timestamp),
+				datadogV1.DistributionPointDataAsDistributionPointItem(&chunk),
+			}
+			serie := datadogV1.NewDistributionPointsSeries(key.name, [][]datadogV1.DistributionPointItem{point})
+			serie.SetType(datadogV1.DISTRIBUTIONPOINTSTYPE_DISTRIBUTION)
+			if tags := append(append([]string{}, sketch.tags...), s.options.Tags...); len(tags) > 0 {
+				serie.SetTags(tags)
+			}
+			if s.options.Host != "" {
+				serie.SetHost(s.options.Host)
+			}
+			series = append(series, *serie)
+		}
+	}
+	return series
+}
+
+// values returns the values representing a sketch, at most MaxValues if set, in increasing order, with its exact smallest
+// and largest values.
+func (s *DistributionSubmitter) values(sketch *Sketch) []float64 {
+	total := sketch.Count()
+	size := total
+	if maxValues := uint64(s.options.MaxValues); maxValues > 0 && size > maxValues {
+		size = maxValues
+	}
+	values := make([]float64, 0, size)
+	var count uint64
+	sketch.ForEach(func(value float64, binCount uint64) bool {
+		// The cumulated counts are scaled down rather than the counts of the bins, so that the rounding
+		// errors do not add up and the ranks of the values are kept.
+		count += binCount
+		end := size
+		if count < total {
+			end = uint64(math.Round(float64(count) / float64(total) * float64(size)))
+		}
+		value = s.round(value)
+		for uint64(len(values)) < end {
+			values = append(values, value)
+		}
+		return true
+	})
+	if len(values) > 0 {
+		values[0], values[len(values)-1] = sketch.Min(), sketch.Max()
+	}
+	return values
+}
+
+// round rounds a value to the significant digits needed by the relative accuracy, to shorten its encoding.
+func (s *DistributionSubmitter) round(value float64) float64 {
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(value, 'g', s.digits, 64), 64)
+	if err != nil {
+		return value
+	}
+	return rounded
+}
+
+// payloads splits series into payloads under the size limits of the intake: the decompressed size of the
+// compressed payloads, and the size of the payloads once compressed.
+func (s *DistributionSubmitter) payloads(series []datadogV1.DistributionPointsSeries) []datadogV1.DistributionPointsPayload {
+	limits := payloadLimits{prefix: `{"series":[`, suffix: `]}`, maxSize: s.options.MaxPayloadSize}
+	if !s.options.DisableCompression {
+		limits.encoding = string(datadogV1.DISTRIBUTIONPOINTSCONTENTENCODING_DEFLATE)
+		limits.maxCompressedSize = s.options.MaxPayloadSize
+		if limits.maxSize < MaxMetricsDecompressedPayloadSize {
+			limits.maxSize = MaxMetricsDecompressedPayloadSize
+		}
+	}
+	var payloads []datadogV1.DistributionPointsPayload
+	for _, r := range limits.split(encodeItems(series)) {
+		payloads = append(payloads, *datadogV1.NewDistributionPointsPayload(series[r[0]:r[1]]))
+	}
+	return payloads
+}
@@ -0,0 +1,265 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+// Package intake provides long-lived submitters built on the intake endpoints of the API, which buffer
+// what they are given, send it in batches in the background and retry what the intake fails to accept.
+//
+// A MetricsSubmitter aggregates gauges, counts and rates and sends them with MetricsApi.SubmitMetrics:
+//
+//	submitter := intake.NewMetricsSubmitter(ctx, datadogV2.NewMetricsApi(apiClient), intake.MetricsSubmitterOptions{
+//		Tags: []string{"env:prod"},
+//	})
+//	defer submitter.Close()
+//	submitter.Count("jobs.processed", 1, "queue:default")
+//
+// A DistributionSubmitter aggregates the values of distributions in a Sketch for each series, and sends them
+// with MetricsApi.SubmitDistributionPoints.
+//
+// A LogShipper queues logs and sends them in batches with LogsApi.SubmitLog, and a LogHandler is a
+// slog.Handler shipping the records of a slog.Logger with it.
+//
+// A Spool writes the payloads of any of these endpoints, and of EventsApi.CreateEvent and
+// ServiceChecksApi.SubmitServiceCheck, to disk before they are sent, so that they survive network outages
+// and restarts.
+package intake
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a payload is sent again when RetryOptions.MaxRetries is not set.
+	DefaultMaxRetries = 3
+	// DefaultBackoff is the wait before the first retry when RetryOptions.Backoff is not set.
+	DefaultBackoff = time.Second
+	// DefaultMaxBackoff is the longest wait between two attempts when RetryOptions.MaxBackoff is not set.
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// RetryOptions configures how a payload the intake failed to accept is sent again. Payloads are sent again
+// when the request timed out (408), was rate limited (429), failed with a server error (5xx) or got no
+// response. This comes on top of the retries of the client configured with Configuration.RetryConfiguration.
+type RetryOptions struct {
+	// MaxRetries is the number of times a payload is sent again. Zero means DefaultMaxRetries, and a negative
+	// value disables the retries.
+	MaxRetries int
+	// Backoff is the wait before the first retry, doubled at each retry up to MaxBackoff. A rate limited
+	// payload is sent again once the rate limit is reset if it is later. Zero means DefaultBackoff.
+	Backoff time.Duration
+	// MaxBackoff is the longest wait between two attempts. Zero means DefaultMaxBackoff.
+	MaxBackoff time.Duration
+}
+
+func (o RetryOptions) withDefaults() RetryOptions {
+	if o.MaxRetries == 0 {
+		o.MaxRetries = DefaultMaxRetries
+	}
+	if o.Backoff <= 0 {
+		o.Backoff = DefaultBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = DefaultMaxBackoff
+	}
+	return o
+}
+
+// SubmitError is the error of a payload the intake did not accept, after the retries. Its items are dropped.
+type SubmitError struct {
+	// OperationID is the operation the payload was sent with, e.g. "v2.MetricsApi.SubmitMetrics".
+	OperationID string
+	// Items is the number of items of the payload, e.g. series or logs.
+	Items int
+	// Attempts is the number of times the payload was sent.
+	Attempts int
+	Err      error
+}
+
+func (e *SubmitError) Error() string {
+	return fmt.Sprintf("%s: %d items dropped after %d attempts: %v", e.OperationID, e.Items, e.Attempts, e.Err)
+}
+
+func (e *SubmitError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable reports whether a payload failing with err may be accepted if sent again: the request timed out,
+// was rate limited, failed with a server error or got no response.
+func Retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if apiErr, ok := datadog.AsAPIError(err); ok {
+		return apiErr.StatusCode == http.StatusRequestTimeout || apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && !errors.Is(err, context.Canceled)
+}
+
+// sendWithRetry sends a payload until it is accepted, it fails with an error which is not Retryable or the
+// retries are exhausted, and returns the number of attempts with the last error.
+func sendWithRetry(ctx context.Context, options RetryOptions, send func(ctx context.Context) error) (int, error) {
+	options = options.withDefaults()
+	backoff := options.Backoff
+	for attempts := 1; ; attempts++ {
+		err := send(ctx)
+		if err == nil || !Retryable(err) || attempts > options.MaxRetries {
+			return attempts, err
+		}
+		wait := backoff
+		if apiErr, ok := datadog.AsAPIError(err); ok && apiErr.RateLimit != nil {
+			if reset := time.Until(apiErr.RateLimit.ResetAt); reset > wait {
+				wait = reset
+			}
+		}
+		if wait > options.MaxBackoff {
+			wait = options.MaxBackoff
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, errors.Join(err, context.Cause(ctx))
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// flusher runs the flushes of a submitter every interval in the background, until it is closed.
+type flusher struct {
+	done      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// startFlusher calls flush with ctx every interval, and onError, if any, with the errors it returns.
+func startFlusher(ctx context.Context, interval time.Duration, flush func(ctx context.Context) error, onError func(err error)) *flusher {
+	f := &flusher{done: make(chan struct{}), stopped: make(chan struct{})}
+	go func() {
+		defer close(f.stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-f.done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := flush(ctx); err != nil && onError != nil {
+					onError(err)
+				}
+			}
+		}
+	}()
+	return f
+}
+
+// close stops the background flushes, waiting for the running one, and returns the error of last, which is
+// called once.
+func (f *flusher) close(last func() error) error {
+	f.closeOnce.Do(func() {
+		close(f.done)
+		<-f.stopped
+		f.closeErr = last()
+	})
+	return f.closeErr
+}
+
+// payloadLimits are the limits of the payloads of an intake, made of encoded items separated by commas.
+type payloadLimits struct {
+	// prefix and suffix surround the items of a payload, e.g. `{"series":[` and `]}`.
+	prefix, suffix string
+	// maxSize is the largest size of a payload before compression.
+	maxSize int
+	// maxItems is the largest number of items of a payload, if not zero.
+	maxItems int
+	// encoding is the compression of the payloads, "gzip" or "deflate", whose compressed size is kept under
+	// maxCompressedSize. Payloads are not measured once compressed if it is empty.
+	encoding          string
+	maxCompressedSize int
+}
+
+// split returns the ranges [from, to) of the items of each payload. An item over the limits is sent alone.
+func (l payloadLimits) split(items [][]byte) [][2]int {
+	var ranges [][2]int
+	var measure func(from, to int)
+	measure = func(from, to int) {
+		if to-from > 1 && l.encoding != "" && l.compressedSize(items[from:to]) > l.maxCompressedSize {
+			middle := (from + to) / 2
+			measure(from, middle)
+			measure(middle, to)
+			return
+		}
+		ranges = append(ranges, [2]int{from, to})
+	}
+	overhead := len(l.prefix) + len(l.suffix)
+	from, size := 0, overhead
+	for i := range items {
+		if i > from && (size+len(items[i])+1 > l.maxSize || (l.maxItems > 0 && i-from >= l.maxItems)) {
+			measure(from, i)
+			from, size = i, overhead
+		}
+		size += len(items[i]) + 1
+	}
+	if from < len(items) {
+		measure(from, len(items))
+	}
+	return ranges
+}
+
+// compressedSize returns the size of the payload of the given items once compressed.
+func (l payloadLimits) compressedSize(items [][]byte) int {
+	var buf bytes.Buffer
+	var compressor io.WriteCloser
+	if l.encoding == "deflate" {
+		compressor = zlib.NewWriter(&buf)
+	} else {
+		compressor = gzip.NewWriter(&buf)
+	}
+	compressor.Write([]byte(l.prefix))
+	compressor.Write(bytes.Join(items, []byte(",")))
+	compressor.Write([]byte(l.suffix))
+	compressor.Close()
+	return buf.Len()
+}
+
+// encodeItems encodes each item in JSON. An item which can't be encoded is left to the operation to report.
+func encodeItems[T any](items []T) [][]byte {
+	encoded := make([][]byte, len(items))
+	for i := range items {
+		encoded[i], _ = datadog.Marshal(items[i])
+	}
+	return encoded
+}
+
+// normalizeTags returns a sorted copy of tags without duplicates.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	normalized := append([]string{}, tags...)
+	sort.Strings(normalized)
+	unique := normalized[:1]
+	for _, tag := range normalized[1:] {
+		if tag != unique[len(unique)-1] {
+			unique = append(unique, tag)
+		}
+	}
+	return unique
+}
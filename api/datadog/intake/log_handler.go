@@ -0,0 +1,221 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package intake
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+)
+
+// DefaultLogSource is the source of the logs of a LogHandler when LogHandlerOptions.Source is not set.
+const DefaultLogSource = "go"
+
+// LogHandlerOptions configures a LogHandler.
+type LogHandlerOptions struct {
+	// Service, Source and Hostname are the service, source and hostname of the logs, unless a record has a
+	// top-level "service", "ddsource" or "hostname" attribute. Empty Source means DefaultLogSource.
+	Service  string
+	Source   string
+	Hostname string
+	// Tags are the tags of the logs, with the tags of the top-level "ddtags" attribute of a record, if any.
+	Tags []string
+	// Level is the minimum level of the records handled. Nil means slog.LevelInfo.
+	Level slog.Leveler
+	// AddSource adds the function, file and line of the call to the "logger" attribute of the logs.
+	AddSource bool
+	// ReplaceAttr, if not nil, is called with each attribute which is not a group, and the groups it is in,
+	// to replace or drop it, like slog.HandlerOptions.ReplaceAttr.
+	ReplaceAttr func(groups []string, attr slog.Attr) slog.Attr
+}
+
+// LogHandler is a slog.Handler sending the records as logs with a LogShipper. The message of a record is the
+// message of the log, its level the "status" and its time the "timestamp" in milliseconds. The attributes of
+// the record are the attributes of the log, groups being nested objects, so that they can be faceted in
+// Datadog, e.g. "http.status_code". Errors are objects with the message and the type of the error, and
+// durations are in nanoseconds, like the standard attributes of Datadog.
+//
+//	logger := slog.New(intake.NewLogHandler(shipper, intake.LogHandlerOptions{Service: "payments"}))
+//	logger.Info("payment processed", slog.Group("http", slog.Int("status_code", 200)))
+//
+// Records are shipped with the context given to slog, and the LogShipperOptions.Overflow policy applies when
+// the queue of the shipper is full.
+type LogHandler struct {
+	shipper *LogShipper
+	options LogHandlerOptions
+	// attrs are the attributes added with WithAttrs, which are not modified once the handler is returned.
+	attrs  map[string]interface{}
+	groups []string
+}
+
+// NewLogHandler returns a LogHandler sending logs with shipper.
+func NewLogHandler(shipper *LogShipper, options LogHandlerOptions) *LogHandler {
+	if options.Source == "" {
+		options.Source = DefaultLogSource
+	}
+	if options.Level == nil {
+		options.Level = slog.LevelInfo
+	}
+	return &LogHandler{shipper: shipper, options: options, attrs: map[string]interface{}{}}
+}
+
+// Enabled reports whether the level is at least LogHandlerOptions.Level.
+func (h *LogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.options.Level.Level()
+}
+
+// Handle ships the record as a log.
+func (h *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	properties := copyProperties(h.attrs)
+	if _, ok := properties["status"]; !ok {
+		properties["status"] = logStatus(record.Level)
+	}
+	if _, ok := properties["timestamp"]; !ok && !record.Time.IsZero() {
+		properties["timestamp"] = record.Time.UnixMilli()
+	}
+	if h.options.AddSource && record.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{record.PC}).Next()
+		properties["logger"] = map[string]interface{}{"method_name": frame.Function, "file_name": frame.File, "line": frame.Line}
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		h.addAttr(properties, h.groups, attr)
+		return true
+	})
+
+	item := datadogV2.NewHTTPLogItem(record.Message)
+	item.SetDdsource(h.options.Source)
+	if h.options.Service != "" {
+		item.SetService(h.options.Service)
+	}
+	if h.options.Hostname != "" {
+		item.SetHostname(h.options.Hostname)
+	}
+	for key, set := range map[string]func(string){"service": item.SetService, "ddsource": item.SetDdsource, "hostname": item.SetHostname} {
+		if value, ok := properties[key].(string); ok {
+			set(value)
+			delete(properties, key)
+		}
+	}
+	tags := h.options.Tags
+	if value, ok := properties["ddtags"].(string); ok {
+		tags = append(append([]string{}, tags...), value)
+		delete(properties, "ddtags")
+	}
+	if len(tags) > 0 {
+		item.SetDdtags(strings.Join(tags, ","))
+	}
+	// The message of the record is the message of the log.
+	delete(properties, "message")
+	item.AdditionalProperties = properties
+	return h.shipper.Ship(ctx, *item)
+}
+
+// WithAttrs returns a handler adding attrs to the logs, in the groups of h.
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	handler := *h
+	handler.attrs = copyProperties(h.attrs)
+	for _, attr := range attrs {
+		handler.addAttr(handler.attrs, h.groups, attr)
+	}
+	return &handler
+}
+
+// WithGroup returns a handler adding the attributes of the records in a group.
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	handler := *h
+	handler.groups = append(h.groups[:len(h.groups):len(h.groups)], name)
+	return &handler
+}
+
+// addAttr adds an attribute to properties, in the given groups. Groups are created only when they have
+// attributes.
+func (h *LogHandler) addAttr(properties map[string]interface{}, groups []string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if h.options.ReplaceAttr != nil && attr.Value.Kind() != slog.KindGroup {
+		attr = h.options.ReplaceAttr(groups, attr)
+		attr.Value = attr.Value.Resolve()
+	}
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			groups = append(groups[:len(groups):len(groups)], attr.Key)
+		}
+		for _, member := range attr.Value.Group() {
+			h.addAttr(properties, groups, member)
+		}
+		return
+	}
+	for _, group := range groups {
+		nested, ok := properties[group].(map[string]interface{})
+		if !ok {
+			nested = map[string]interface{}{}
+			properties[group] = nested
+		}
+		properties = nested
+	}
+	properties[attr.Key] = logValue(attr.Value)
+}
+
+// logValue returns the value of an attribute as it is sent.
+func logValue(value slog.Value) interface{} {
+	switch value.Kind() {
+	case slog.KindDuration:
+		return value.Duration().Nanoseconds()
+	case slog.KindTime:
+		return value.Time().Format(time.RFC3339Nano)
+	case slog.KindAny:
+		switch v := value.Any().(type) {
+		case error:
+			return map[string]interface{}{"message": v.Error(), "kind": fmt.Sprintf("%T", v)}
+		default:
+			if _, err := datadog.Marshal(v); err != nil {
+				return fmt.Sprintf("%+v", v)
+			}
+			return v
+		}
+	default:
+		return value.Any()
+	}
+}
+
+// logStatus returns the status of a level, as recognized by Datadog.
+func logStatus(level slog.Level) string {
+	switch {
+	case level < slog.LevelInfo:
+		return "debug"
+	case level < slog.LevelWarn:
+		return "info"
+	case level < slog.LevelError:
+		return "warn"
+	default:
+		return "error"
+	}
+}
+
+// copyProperties returns a deep copy of the properties of a log, whose nested objects are groups.
+func copyProperties(properties map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(properties))
+	for key, value := range properties {
+		if group, ok := value.(map[string]interface{}); ok {
+			value = copyProperties(group)
+		}
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,349 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package intake
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+)
+
+const (
+	// DefaultLogQueueSize is the number of logs queued when LogShipperOptions.QueueSize is not set.
+	DefaultLogQueueSize = 10000
+	// DefaultLogBatchAge is the longest time a log waits to be sent when LogShipperOptions.MaxBatchAge is not set.
+	DefaultLogBatchAge = 5 * time.Second
+	// MaxLogsPerPayload is the largest number of logs accepted by LogsApi.SubmitLog in a payload.
+	MaxLogsPerPayload = 1000
+	// MaxLogsPayloadSize is the largest uncompressed payload accepted by LogsApi.SubmitLog.
+	MaxLogsPayloadSize = 5242880
+)
+
+// submitLogOperationID is the operation ID of LogsApi.SubmitLog.
+const submitLogOperationID = "v2.LogsApi.SubmitLog"
+
+var (
+	// ErrQueueFull is returned by LogShipper.Ship when the queue is full and the log is dropped.
+	ErrQueueFull = errors.New("queue full")
+	// ErrShipperClosed is returned by LogShipper.Ship when the shipper is closed.
+	ErrShipperClosed = errors.New("shipper closed")
+)
+
+// OverflowPolicy is what LogShipper.Ship does when the queue is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock blocks until there is room in the queue, which applies backpressure to the callers.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest drops the log being shipped and returns ErrQueueFull.
+	OverflowDropNewest
+	// OverflowDropOldest drops the oldest log of the queue to make room for the log being shipped.
+	OverflowDropOldest
+)
+
+// LogShipperOptions configures a LogShipper.
+type LogShipperOptions struct {
+	// QueueSize is the largest number of logs waiting to be sent. Zero means DefaultLogQueueSize.
+	QueueSize int
+	// Overflow is what Ship does when the queue is full. The zero value is OverflowBlock.
+	Overflow OverflowPolicy
+	// MaxBatchSize is the largest number of logs of a payload. Zero means MaxLogsPerPayload.
+	MaxBatchSize int
+	// MaxBatchBytes is the largest size of a payload before compression. Zero means MaxLogsPayloadSize.
+	MaxBatchBytes int
+	// MaxBatchAge is the longest time a log waits to be sent once shipped. Zero means DefaultLogBatchAge.
+	MaxBatchAge time.Duration
+	// ContentEncoding is the compression of the payloads. Empty means gzip, and identity sends them uncompressed.
+	ContentEncoding datadogV2.ContentEncoding
+	// Tags are added to the tags of every log, with the ddtags parameter.
+	Tags []string
+	// Retry configures how the payloads the intake failed to accept are sent again.
+	Retry RetryOptions
+	// OnError is called with a *SubmitError when the logs of a payload sent in the background are dropped.
+	OnError func(err error)
+	// Spool, if not nil, is the spool the payloads are enqueued to instead of being sent, to be sent by the
+	// SpoolSender of "v2.LogsApi.SubmitLog" and its parameters. ContentEncoding and Retry are then ignored,
+	// and Tags are added to the ddtags attribute of every log instead of the ddtags parameter.
+	Spool *Spool
+}
+
+// LogShipperStats are the counters of a LogShipper.
+type LogShipperStats struct {
+	// Queued is the number of logs waiting in the queue.
+	Queued int
+	// Sent is the number of logs accepted by the intake, or enqueued to LogShipperOptions.Spool.
+	Sent uint64
+	// Dropped is the number of logs dropped because the queue was full.
+	Dropped uint64
+	// Failed is the number of logs dropped because the intake did not accept them.
+	Failed uint64
+}
+
+// LogShipper sends logs with LogsApi.SubmitLog in the background. Shipped logs wait in a bounded queue, and
+// are sent in compressed batches when a batch reaches the number of logs or the size limit of the intake, or
+// when its oldest log reaches MaxBatchAge. Payloads are sent in order, one at a time, and again when the
+// intake times out, rate limits them or fails with a server error. It is safe for concurrent use, and must be
+// closed to send the last logs.
+type LogShipper struct {
+	ctx     context.Context
+	api     *datadogV2.LogsApi
+	options LogShipperOptions
+
+	// mu guards the queue against being closed while logs are shipped.
+	mu     sync.RWMutex
+	closed bool
+	// closing is set before the queue is closed, for the errors of the last logs to be returned by Close.
+	closing atomic.Bool
+	queue   chan queuedLog
+	flushes chan logFlush
+	stopped chan struct{}
+
+	sent     atomic.Uint64
+	dropped  atomic.Uint64
+	failed   atomic.Uint64
+	closeErr error
+}
+
+type queuedLog struct {
+	item     datadogV2.HTTPLogItem
+	encoded  []byte
+	queuedAt time.Time
+}
+
+type logFlush struct {
+	ctx  context.Context
+	done chan error
+}
+
+// NewLogShipper returns a LogShipper sending logs with api. The logs are sent with ctx, which holds the API
+// keys.
+func NewLogShipper(ctx context.Context, api *datadogV2.LogsApi, options LogShipperOptions) *LogShipper {
+	if options.QueueSize <= 0 {
+		options.QueueSize = DefaultLogQueueSize
+	}
+	if options.MaxBatchSize <= 0 || options.MaxBatchSize > MaxLogsPerPayload {
+		options.MaxBatchSize = MaxLogsPerPayload
+	}
+	if options.MaxBatchBytes <= 0 || options.MaxBatchBytes > MaxLogsPayloadSize {
+		options.MaxBatchBytes = MaxLogsPayloadSize
+	}
+	if options.MaxBatchAge <= 0 {
+		options.MaxBatchAge = DefaultLogBatchAge
+	}
+	if options.ContentEncoding == "" {
+		options.ContentEncoding = datadogV2.CONTENTENCODING_GZIP
+	}
+	s := &LogShipper{
+		ctx:     ctx,
+		api:     api,
+		options: options,
+		queue:   make(chan queuedLog, options.QueueSize),
+		flushes: make(chan logFlush),
+		stopped: make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Ship queues a log to be sent. When the queue is full, it blocks until there is room or ctx is canceled,
+// or drops a log, depending on LogShipperOptions.Overflow. It returns ErrShipperClosed once the shipper is
+// closed.
+func (s *LogShipper) Ship(ctx context.Context, item datadogV2.HTTPLogItem) error {
+	encoded, err := datadog.Marshal(item)
+	if err != nil {
+		return err
+	}
+	log := queuedLog{item: item, encoded: encoded, queuedAt: time.Now()}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrShipperClosed
+	}
+	switch s.options.Overflow {
+	case OverflowDropNewest:
+		select {
+		case s.queue <- log:
+			return nil
+		default:
+			s.dropped.Add(1)
+			return ErrQueueFull
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.queue <- log:
+				return nil
+			default:
+			}
+			select {
+			case <-s.queue:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.queue <- log:
+			return nil
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
+	}
+}
+
+// Flush sends the logs queued so far with ctx, and returns the *SubmitError of each payload the intake did
+// not accept, joined with errors.Join.
+func (s *LogShipper) Flush(ctx context.Context) error {
+	flush := logFlush{ctx: ctx, done: make(chan error, 1)}
+	select {
+	case s.flushes <- flush:
+		return <-flush.done
+	case <-s.stopped:
+		return nil
+	}
+}
+
+// Close stops accepting logs, sends the queued ones and returns the *SubmitError of each payload the intake
+// did not accept, joined with errors.Join.
+func (s *LogShipper) Close() error {
+	s.closing.Store(true)
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.stopped
+	return s.closeErr
+}
+
+// Stats returns the counters of the shipper.
+func (s *LogShipper) Stats() LogShipperStats {
+	return LogShipperStats{
+		Queued:  len(s.queue),
+		Sent:    s.sent.Load(),
+		Dropped: s.dropped.Load(),
+		Failed:  s.failed.Load(),
+	}
+}
+
+// run batches the queued logs and sends them, until the queue is closed and drained.
+func (s *LogShipper) run() {
+	defer close(s.stopped)
+	// size is the size of the payload of the batch: the logs, the commas between them and the brackets.
+	var batch []queuedLog
+	size := 1
+	age := time.NewTimer(time.Hour)
+	age.Stop()
+	var expired <-chan time.Time
+
+	send := func(ctx context.Context) error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := s.send(ctx, batch)
+		batch, size, expired = nil, 1, nil
+		age.Stop()
+		return err
+	}
+	add := func(ctx context.Context, log queuedLog) error {
+		var err error
+		if len(batch) > 0 && size+len(log.encoded)+1 > s.options.MaxBatchBytes {
+			err = send(ctx)
+		}
+		if len(batch) == 0 {
+			age.Reset(s.options.MaxBatchAge - time.Since(log.queuedAt))
+			expired = age.C
+		}
+		batch = append(batch, log)
+		size += len(log.encoded) + 1
+		if len(batch) >= s.options.MaxBatchSize {
+			err = errors.Join(err, send(ctx))
+		}
+		return err
+	}
+	report := func(err error) {
+		if err != nil && s.options.OnError != nil {
+			s.options.OnError(err)
+		}
+	}
+
+	var closeErrs []error
+	for {
+		select {
+		case log, ok := <-s.queue:
+			if !ok {
+				s.closeErr = errors.Join(append(closeErrs, send(s.ctx))...)
+				return
+			}
+			if err := add(s.ctx, log); err != nil {
+				// The errors of the logs queued before Close are returned by Close.
+				if s.closing.Load() {
+					closeErrs = append(closeErrs, err)
+				} else {
+					report(err)
+				}
+			}
+		case <-expired:
+			report(send(s.ctx))
+		case flush := <-s.flushes:
+			var errs []error
+			for queued := len(s.queue); queued > 0; queued-- {
+				if log, ok := <-s.queue; ok {
+					errs = append(errs, add(flush.ctx, log))
+				}
+			}
+			errs = append(errs, send(flush.ctx))
+			flush.done <- errors.Join(errs...)
+		}
+	}
+}
+
+// send sends a batch of logs, again while the intake may accept it.
+func (s *LogShipper) send(ctx context.Context, batch []queuedLog) error {
+	items := make([]datadogV2.HTTPLogItem, len(batch))
+	for i, log := range batch {
+		items[i] = log.item
+	}
+	if s.options.Spool != nil {
+		if len(s.options.Tags) > 0 {
+			// The parameters of the sender of the spool are shared by every payload, so the tags go with the logs.
+			tags := strings.Join(s.options.Tags, ",")
+			for i := range items {
+				if ddtags := items[i].GetDdtags(); ddtags != "" {
+					items[i].SetDdtags(ddtags + "," + tags)
+				} else {
+					items[i].SetDdtags(tags)
+				}
+			}
+		}
+		if err := s.options.Spool.Enqueue(submitLogOperationID, items); err != nil {
+			s.failed.Add(uint64(len(batch)))
+			return &SubmitError{OperationID: submitLogOperationID, Items: len(batch), Err: err}
+		}
+		s.sent.Add(uint64(len(batch)))
+		return nil
+	}
+	options := datadogV2.NewSubmitLogOptionalParameters().WithContentEncoding(s.options.ContentEncoding)
+	if len(s.options.Tags) > 0 {
+		options.WithDdtags(strings.Join(s.options.Tags, ","))
+	}
+	attempts, err := sendWithRetry(ctx, s.options.Retry, func(ctx context.Context) error {
+		_, _, err := s.api.SubmitLog(ctx, items, *options)
+		return err
+	})
+	if err != nil {
+		s.failed.Add(uint64(len(batch)))
+		return &SubmitError{OperationID: submitLogOperationID, Items: len(batch), Attempts: attempts, Err: err}
+	}
+	s.sent.Add(uint64(len(batch)))
+	return nil
+}
@@ -0,0 +1,268 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package intake
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+)
+
+const (
+	// DefaultMetricsFlushInterval is the interval at which metrics are sent when
+	// MetricsSubmitterOptions.FlushInterval is not set.
+	DefaultMetricsFlushInterval = 10 * time.Second
+	// MaxMetricsPayloadSize is the largest payload accepted by MetricsApi.SubmitMetrics, compressed or not.
+	MaxMetricsPayloadSize = 512000
+	// MaxMetricsDecompressedPayloadSize is the largest decompressed size of a compressed payload accepted by
+	// MetricsApi.SubmitMetrics.
+	MaxMetricsDecompressedPayloadSize = 5242880
+)
+
+// submitMetricsOperationID is the operation ID of MetricsApi.SubmitMetrics.
+const submitMetricsOperationID = "v2.MetricsApi.SubmitMetrics"
+
+// MetricsSubmitterOptions configures a MetricsSubmitter.
+type MetricsSubmitterOptions struct {
+	// FlushInterval is the interval at which the metrics are aggregated and sent. Zero means
+	// DefaultMetricsFlushInterval.
+	FlushInterval time.Duration
+	// Tags are added to the tags of every metric.
+	Tags []string
+	// Host is the host the metrics are reported for, if any.
+	Host string
+	// ContentEncoding is the compression of the payloads. Empty means gzip.
+	ContentEncoding datadogV2.MetricContentEncoding
+	// DisableCompression sends the payloads uncompressed.
+	DisableCompression bool
+	// MaxPayloadSize is the largest size of a payload, after compression. Payloads are split to stay under it.
+	// Zero means MaxMetricsPayloadSize.
+	MaxPayloadSize int
+	// Retry configures how the payloads the intake failed to accept are sent again.
+	Retry RetryOptions
+	// OnError is called with a *SubmitError when the metrics of a background flush are dropped.
+	OnError func(err error)
+	// Spool, if not nil, is the spool the payloads are enqueued to instead of being sent, to be sent by the
+	// SpoolSender of "v2.MetricsApi.SubmitMetrics" and its parameters. ContentEncoding, DisableCompression
+	// and Retry are then ignored.
+	Spool *Spool
+}
+
+// MetricsSubmitter aggregates gauges, counts and rates client-side and sends them with
+// MetricsApi.SubmitMetrics every flush interval, in compressed payloads split to stay under the size limit
+// of the intake. It is safe for concurrent use, and must be closed to send the last metrics.
+//
+// Within a flush interval, a gauge keeps its last value, and counts and rates are summed, for each
+// combination of metric name and tags.
+type MetricsSubmitter struct {
+	ctx     context.Context
+	api     *datadogV2.MetricsApi
+	options MetricsSubmitterOptions
+
+	mu          sync.Mutex
+	metrics     map[metricKey]*metricAggregate
+	windowStart time.Time
+	closed      bool
+
+	// flushMu makes the flushes send the windows in order.
+	flushMu sync.Mutex
+	flusher *flusher
+}
+
+type metricKey struct {
+	name       string
+	metricType datadogV2.MetricIntakeType
+	tags       string
+}
+
+type metricAggregate struct {
+	tags      []string
+	value     float64
+	timestamp time.Time
+}
+
+// NewMetricsSubmitter returns a MetricsSubmitter sending metrics with api. The metrics are sent with ctx,
+// which holds the API keys, until the submitter is closed or ctx is canceled.
+func NewMetricsSubmitter(ctx context.Context, api *datadogV2.MetricsApi, options MetricsSubmitterOptions) *MetricsSubmitter {
+	if options.FlushInterval <= 0 {
+		options.FlushInterval = DefaultMetricsFlushInterval
+	}
+	if options.ContentEncoding == "" {
+		options.ContentEncoding = datadogV2.METRICCONTENTENCODING_GZIP
+	}
+	if options.MaxPayloadSize <= 0 {
+		options.MaxPayloadSize = MaxMetricsPayloadSize
+	}
+	s := &MetricsSubmitter{
+		ctx:         ctx,
+		api:         api,
+		options:     options,
+		metrics:     make(map[metricKey]*metricAggregate),
+		windowStart: time.Now(),
+	}
+	s.flusher = startFlusher(ctx, options.FlushInterval, s.Flush, options.OnError)
+	return s
+}
+
+// Gauge records the value of a gauge. The last value recorded in a flush interval is sent.
+func (s *MetricsSubmitter) Gauge(name string, value float64, tags ...string) {
+	s.record(name, datadogV2.METRICINTAKETYPE_GAUGE, value, tags, false)
+}
+
+// Count adds a value to a count. The sum of the values added in a flush interval is sent.
+func (s *MetricsSubmitter) Count(name string, value float64, tags ...string) {
+	s.record(name, datadogV2.METRICINTAKETYPE_COUNT, value, tags, true)
+}
+
+// Rate adds a value to a rate. The sum of the values added in a flush interval, divided by the length of
+// the interval in seconds, is sent as a rate per second.
+func (s *MetricsSubmitter) Rate(name string, value float64, tags ...string) {
+	s.record(name, datadogV2.METRICINTAKETYPE_RATE, value, tags, true)
+}
+
+func (s *MetricsSubmitter) record(name string, metricType datadogV2.MetricIntakeType, value float64, tags []string, sum bool) {
+	tags = normalizeTags(tags)
+	key := metricKey{name: name, metricType: metricType, tags: strings.Join(tags, ",")}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	aggregate, ok := s.metrics[key]
+	if !ok {
+		aggregate = &metricAggregate{tags: tags}
+		s.metrics[key] = aggregate
+	}
+	if sum {
+		aggregate.value += value
+	} else {
+		aggregate.value = value
+	}
+	aggregate.timestamp = time.Now()
+}
+
+// Flush sends the metrics recorded since the last flush with ctx, and returns the *SubmitError of each
+// payload the intake did not accept, joined with errors.Join.
+func (s *MetricsSubmitter) Flush(ctx context.Context) error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+	s.mu.Lock()
+	metrics, start, end := s.metrics, s.windowStart, time.Now()
+	s.metrics = make(map[metricKey]*metricAggregate)
+	s.windowStart = end
+	s.mu.Unlock()
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, payload := range s.payloads(s.series(metrics, start, end)) {
+		if s.options.Spool != nil {
+			if err := s.options.Spool.Enqueue(submitMetricsOperationID, payload); err != nil {
+				errs = append(errs, &SubmitError{OperationID: submitMetricsOperationID, Items: len(payload.Series), Err: err})
+			}
+			continue
+		}
+		attempts, err := sendWithRetry(ctx, s.options.Retry, func(ctx context.Context) error {
+			_, _, err := s.api.SubmitMetrics(ctx, payload, s.submitOptions()...)
+			return err
+		})
+		if err != nil {
+			errs = append(errs, &SubmitError{OperationID: submitMetricsOperationID, Items: len(payload.Series), Attempts: attempts, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close stops the background flushes, sends the last metrics and returns the error of this last flush.
+// Metrics recorded after Close are ignored.
+func (s *MetricsSubmitter) Close() error {
+	return s.flusher.close(func() error {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+		return s.Flush(s.ctx)
+	})
+}
+
+func (s *MetricsSubmitter) submitOptions() []datadogV2.SubmitMetricsOptionalParameters {
+	if s.options.DisableCompression {
+		return nil
+	}
+	return []datadogV2.SubmitMetricsOptionalParameters{*datadogV2.NewSubmitMetricsOptionalParameters().WithContentEncoding(s.options.ContentEncoding)}
+}
+
+// series returns the series of the metrics aggregated between start and end, sorted by name, type and tags.
+func (s *MetricsSubmitter) series(metrics map[metricKey]*metricAggregate, start, end time.Time) []datadogV2.MetricSeries {
+	keys := make([]metricKey, 0, len(metrics))
+	for key := range metrics {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		if keys[i].metricType != keys[j].metricType {
+			return keys[i].metricType < keys[j].metricType
+		}
+		return keys[i].tags < keys[j].tags
+	})
+
+	interval := int64(end.Sub(start).Round(time.Second) / time.Second)
+	if interval < 1 {
+		interval = 1
+	}
+	series := make([]datadogV2.MetricSeries, 0, len(keys))
+	for _, key := range keys {
+		aggregate := metrics[key]
+		point := datadogV2.MetricPoint{Timestamp: datadog.PtrInt64(aggregate.timestamp.Unix()), Value: datadog.PtrFloat64(aggregate.value)}
+		serie := datadogV2.NewMetricSeries(key.name, nil)
+		serie.SetType(key.metricType)
+		if key.metricType != datadogV2.METRICINTAKETYPE_GAUGE {
+			point.Timestamp = datadog.PtrInt64(start.Unix())
+			serie.SetInterval(interval)
+		}
+		if key.metricType == datadogV2.METRICINTAKETYPE_RATE {
+			point.Value = datadog.PtrFloat64(aggregate.value / float64(interval))
+		}
+		serie.Points = []datadogV2.MetricPoint{point}
+		if tags := append(append([]string{}, aggregate.tags...), s.options.Tags...); len(tags) > 0 {
+			serie.SetTags(tags)
+		}
+		if s.options.Host != "" {
+			resource := datadogV2.NewMetricResource()
+			resource.SetName(s.options.Host)
+			resource.SetType("host")
+			serie.SetResources([]datadogV2.MetricResource{*resource})
+		}
+		series = append(series, *serie)
+	}
+	return series
+}
+
+// payloads splits series into payloads under the size limits of the intake: the decompressed size of the
+// compressed payloads, and the size of the payloads once compressed.
+func (s *MetricsSubmitter) payloads(series []datadogV2.MetricSeries) []datadogV2.MetricPayload {
+	limits := payloadLimits{prefix: `{"series":[`, suffix: `]}`, maxSize: s.options.MaxPayloadSize}
+	// Payloads compressed with zstd1 are not measured, and are kept under the size limit before compression.
+	if !s.options.DisableCompression && (s.options.ContentEncoding == datadogV2.METRICCONTENTENCODING_GZIP || s.options.ContentEncoding == datadogV2.METRICCONTENTENCODING_DEFLATE) {
+		limits.encoding = string(s.options.ContentEncoding)
+		limits.maxCompressedSize = s.options.MaxPayloadSize
+		if limits.maxSize < MaxMetricsDecompressedPayloadSize {
+			limits.maxSize = MaxMetricsDecompressedPayloadSize
+		}
+	}
+	var payloads []datadogV2.MetricPayload
+	for _, r := range limits.split(encodeItems(series)) {
+		payloads = append(payloads, *datadogV2.NewMetricPayload(series[r[0]:r[1]]))
+	}
+	return payloads
+}
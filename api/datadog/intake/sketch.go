@@ -0,0 +1,222 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package intake
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultSketchRelativeAccuracy is the relative accuracy of a Sketch when it is not set.
+	DefaultSketchRelativeAccuracy = 0.01
+	// DefaultSketchMaxBins is the number of bins of a Sketch for each sign when it is not set. With the default
+	// relative accuracy, it covers values from 1 to more than 10^17 without collapsing bins.
+	DefaultSketchMaxBins = 2048
+)
+
+// Sketch is a DDSketch: it summarizes values in logarithmic bins, so that the quantiles it returns are within
+// a relative accuracy of the actual ones, in a bounded memory. The values of a bin are represented by a value
+// within the relative accuracy of all of them. When there are more than maxBins bins for a sign, the bins of
+// the values closest to zero are collapsed, which loses the accuracy for these values only.
+//
+// A Sketch is not safe for concurrent use.
+type Sketch struct {
+	relativeAccuracy float64
+	gamma            float64
+	logGamma         float64
+	minIndexable     float64
+	maxBins          int
+
+	positive sketchStore
+	negative sketchStore
+	zeros    uint64
+	count    uint64
+	sum      float64
+	min      float64
+	max      float64
+}
+
+// NewSketch returns an empty Sketch with the given relative accuracy, between 0 and 1 excluded, and number of
+// bins for each sign. Zero values mean DefaultSketchRelativeAccuracy and DefaultSketchMaxBins.
+func NewSketch(relativeAccuracy float64, maxBins int) (*Sketch, error) {
+	if relativeAccuracy == 0 {
+		relativeAccuracy = DefaultSketchRelativeAccuracy
+	}
+	if relativeAccuracy <= 0 || relativeAccuracy >= 1 {
+		return nil, fmt.Errorf("relative accuracy must be between 0 and 1, got %v", relativeAccuracy)
+	}
+	if maxBins == 0 {
+		maxBins = DefaultSketchMaxBins
+	}
+	if maxBins < 1 {
+		return nil, fmt.Errorf("max bins must be positive, got %d", maxBins)
+	}
+	gamma := (1 + relativeAccuracy) / (1 - relativeAccuracy)
+	return &Sketch{
+		relativeAccuracy: relativeAccuracy,
+		gamma:            gamma,
+		logGamma:         math.Log(gamma),
+		// Smaller values, below the smallest normal float64, are counted as zeros.
+		minIndexable: 0x1p-1022 * gamma,
+		maxBins:      maxBins,
+		positive:     sketchStore{bins: make(map[int]uint64)},
+		negative:     sketchStore{bins: make(map[int]uint64)},
+		min:          math.Inf(1),
+		max:          math.Inf(-1),
+	}, nil
+}
+
+// RelativeAccuracy returns the relative accuracy of the sketch.
+func (s *Sketch) RelativeAccuracy() float64 {
+	return s.relativeAccuracy
+}
+
+// Add adds a value to the sketch. NaN and infinite values are ignored.
+func (s *Sketch) Add(value float64) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+	switch {
+	case value >= s.minIndexable:
+		s.positive.add(s.index(value), s.maxBins)
+	case value <= -s.minIndexable:
+		s.negative.add(s.index(-value), s.maxBins)
+	default:
+		s.zeros++
+	}
+	s.count++
+	s.sum += value
+	s.min = math.Min(s.min, value)
+	s.max = math.Max(s.max, value)
+}
+
+// Count returns the number of values added to the sketch.
+func (s *Sketch) Count() uint64 {
+	return s.count
+}
+
+// Sum returns the sum of the values added to the sketch.
+func (s *Sketch) Sum() float64 {
+	return s.sum
+}
+
+// Min returns the smallest value added to the sketch, or NaN if it is empty.
+func (s *Sketch) Min() float64 {
+	if s.count == 0 {
+		return math.NaN()
+	}
+	return s.min
+}
+
+// Max returns the largest value added to the sketch, or NaN if it is empty.
+func (s *Sketch) Max() float64 {
+	if s.count == 0 {
+		return math.NaN()
+	}
+	return s.max
+}
+
+// Bins returns the number of bins of the sketch, which bounds its memory.
+func (s *Sketch) Bins() int {
+	return len(s.positive.bins) + len(s.negative.bins)
+}
+
+// Quantile returns the value at quantile q, between 0 and 1, within the relative accuracy of the sketch, or
+// NaN if it is empty.
+func (s *Sketch) Quantile(q float64) float64 {
+	if s.count == 0 || q < 0 || q > 1 {
+		return math.NaN()
+	}
+	rank := uint64(q * float64(s.count-1))
+	// The extremes are known exactly.
+	if rank == 0 {
+		return s.min
+	}
+	if rank == s.count-1 {
+		return s.max
+	}
+	quantile := math.NaN()
+	var seen uint64
+	s.ForEach(func(value float64, count uint64) bool {
+		seen += count
+		if seen > rank {
+			quantile = value
+			return false
+		}
+		return true
+	})
+	return math.Max(s.min, math.Min(s.max, quantile))
+}
+
+// ForEach calls f with the value representing each bin of the sketch and its number of values, in increasing
+// order of values, until f returns false.
+func (s *Sketch) ForEach(f func(value float64, count uint64) bool) {
+	negative := s.negative.indexes()
+	for i := len(negative) - 1; i >= 0; i-- {
+		if !f(-s.value(negative[i]), s.negative.bins[negative[i]]) {
+			return
+		}
+	}
+	if s.zeros > 0 && !f(0, s.zeros) {
+		return
+	}
+	for _, index := range s.positive.indexes() {
+		if !f(s.value(index), s.positive.bins[index]) {
+			return
+		}
+	}
+}
+
+// index returns the index of the bin of a positive value: the bin i holds the values in (gamma^(i-1), gamma^i].
+func (s *Sketch) index(value float64) int {
+	return int(math.Ceil(math.Log(value) / s.logGamma))
+}
+
+// value returns the value representing the bin of an index, within the relative accuracy of its values.
+func (s *Sketch) value(index int) float64 {
+	return 2 * math.Exp(float64(index)*s.logGamma) / (1 + s.gamma)
+}
+
+// sketchStore counts the values of a sign in bins, by index.
+type sketchStore struct {
+	bins map[int]uint64
+	// collapsed is true when the bins below minIndex were collapsed into it.
+	collapsed bool
+	minIndex  int
+}
+
+func (s *sketchStore) add(index int, maxBins int) {
+	if s.collapsed && index < s.minIndex {
+		index = s.minIndex
+	}
+	s.bins[index]++
+	if len(s.bins) <= maxBins {
+		return
+	}
+	// Collapse the lowest bin into the next one.
+	lowest, next := math.MaxInt, math.MaxInt
+	for index := range s.bins {
+		if index < lowest {
+			lowest, next = index, lowest
+		} else if index < next {
+			next = index
+		}
+	}
+	s.bins[next] += s.bins[lowest]
+	delete(s.bins, lowest)
+	s.collapsed, s.minIndex = true, next
+}
+
+// indexes returns the indexes of the bins in increasing order.
+func (s *sketchStore) indexes() []int {
+	indexes := make([]int, 0, len(s.bins))
+	for index := range s.bins {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
@@ -0,0 +1,545 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package intake
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+)
+
+// DefaultSpoolMaxBytes is the largest size of the payloads of a Spool when SpoolOptions.MaxBytes is not set.
+const DefaultSpoolMaxBytes = 64 << 20
+
+const (
+	spoolExtension     = ".spool"
+	spoolTempExtension = ".tmp"
+)
+
+var (
+	// ErrSpoolFull is returned by Spool.Enqueue when the payload doesn't fit in SpoolOptions.MaxBytes.
+	ErrSpoolFull = errors.New("spool full")
+	// ErrNoSpoolSender is returned by Spool.Enqueue when no SpoolSender is configured for the operation.
+	ErrNoSpoolSender = errors.New("no spool sender for the operation")
+)
+
+// EvictionPolicy is what Spool.Enqueue does when a payload doesn't fit in SpoolOptions.MaxBytes.
+type EvictionPolicy int
+
+const (
+	// EvictOldest drops the oldest payloads to make room for the new one.
+	EvictOldest EvictionPolicy = iota
+	// RejectNewest drops the new payload and returns ErrSpoolFull.
+	RejectNewest
+)
+
+// SpoolDropReason is the reason a payload was dropped from a Spool.
+type SpoolDropReason string
+
+const (
+	// SpoolDropEvicted is the reason of the payloads dropped to stay under SpoolOptions.MaxBytes.
+	SpoolDropEvicted SpoolDropReason = "evicted"
+	// SpoolDropExpired is the reason of the payloads older than SpoolOptions.MaxAge.
+	SpoolDropExpired SpoolDropReason = "expired"
+	// SpoolDropRejected is the reason of the payloads the intake rejected with an error which is not Retryable.
+	SpoolDropRejected SpoolDropReason = "rejected"
+	// SpoolDropFull is the reason of the payloads which don't fit in the spool, with RejectNewest or when
+	// they are larger than SpoolOptions.MaxBytes.
+	SpoolDropFull SpoolDropReason = "full"
+	// SpoolDropCorrupted is the reason of the files of the spool which could not be read.
+	SpoolDropCorrupted SpoolDropReason = "corrupted"
+)
+
+// SpoolDrop describes a payload dropped from a Spool.
+type SpoolDrop struct {
+	// OperationID is the operation of the payload, if known.
+	OperationID string
+	// Size is the size of the payload in bytes.
+	Size       int
+	EnqueuedAt time.Time
+	Reason     SpoolDropReason
+	// Err is the error of the intake for SpoolDropRejected, or of the file for SpoolDropCorrupted.
+	Err error
+}
+
+func (d SpoolDrop) String() string {
+	if d.Err != nil {
+		return fmt.Sprintf("%s payload of %d bytes %s: %v", d.OperationID, d.Size, d.Reason, d.Err)
+	}
+	return fmt.Sprintf("%s payload of %d bytes %s", d.OperationID, d.Size, d.Reason)
+}
+
+// SpoolSender sends a payload of a Spool, encoded in JSON.
+type SpoolSender func(ctx context.Context, payload []byte) error
+
+// NewSpoolSender returns a SpoolSender decoding the payloads into the body of an operation and sending them
+// with send.
+func NewSpoolSender[T any](send func(ctx context.Context, body T) error) SpoolSender {
+	return func(ctx context.Context, payload []byte) error {
+		var body T
+		if err := datadog.Unmarshal(payload, &body); err != nil {
+			return err
+		}
+		return send(ctx, body)
+	}
+}
+
+// SubmitMetricsSender returns the SpoolSender of "v2.MetricsApi.SubmitMetrics".
+func SubmitMetricsSender(api *datadogV2.MetricsApi, o ...datadogV2.SubmitMetricsOptionalParameters) SpoolSender {
+	return NewSpoolSender(func(ctx context.Context, body datadogV2.MetricPayload) error {
+		_, _, err := api.SubmitMetrics(ctx, body, o...)
+		return err
+	})
+}
+
+// SubmitLogSender returns the SpoolSender of "v2.LogsApi.SubmitLog".
+func SubmitLogSender(api *datadogV2.LogsApi, o ...datadogV2.SubmitLogOptionalParameters) SpoolSender {
+	return NewSpoolSender(func(ctx context.Context, body []datadogV2.HTTPLogItem) error {
+		_, _, err := api.SubmitLog(ctx, body, o...)
+		return err
+	})
+}
+
+// CreateEventSender returns the SpoolSender of "v1.EventsApi.CreateEvent".
+func CreateEventSender(api *datadogV1.EventsApi) SpoolSender {
+	return NewSpoolSender(func(ctx context.Context, body datadogV1.EventCreateRequest) error {
+		_, _, err := api.CreateEvent(ctx, body)
+		return err
+	})
+}
+
+// SubmitServiceCheckSender returns the SpoolSender of "v1.ServiceChecksApi.SubmitServiceCheck".
+func SubmitServiceCheckSender(api *datadogV1.ServiceChecksApi) SpoolSender {
+	return NewSpoolSender(func(ctx context.Context, body []datadogV1.ServiceCheck) error {
+		_, _, err := api.SubmitServiceCheck(ctx, body)
+		return err
+	})
+}
+
+// SpoolOptions configures a Spool.
+type SpoolOptions struct {
+	// Senders are the senders of the payloads, by operation ID, e.g. "v2.MetricsApi.SubmitMetrics".
+	Senders map[string]SpoolSender
+	// MaxBytes is the largest size of the payloads kept on disk. Zero means DefaultSpoolMaxBytes.
+	MaxBytes int64
+	// Eviction is what Enqueue does when a payload doesn't fit in MaxBytes. The zero value is EvictOldest.
+	Eviction EvictionPolicy
+	// MaxAge is the longest time a payload is kept, if not zero, e.g. the oldest timestamp accepted by the
+	// intake. Older payloads are dropped instead of being sent.
+	MaxAge time.Duration
+	// Retry configures the waits between the attempts to send a payload failing with a Retryable error. Such a
+	// payload is sent again until it is accepted, expired or evicted, and RetryOptions.MaxRetries is ignored.
+	Retry RetryOptions
+	// OnDrop, if not nil, is called with each dropped payload. It must not call the methods of the Spool.
+	OnDrop func(drop SpoolDrop)
+}
+
+// SpoolStats are the counters of a Spool.
+type SpoolStats struct {
+	// Pending and PendingBytes are the number and size of the payloads waiting to be sent.
+	Pending      int
+	PendingBytes int64
+	// Sent is the number of payloads accepted by the intake.
+	Sent uint64
+	// Dropped is the number of payloads dropped, for any reason.
+	Dropped uint64
+}
+
+// Spool is a write-ahead queue of payloads on disk: payloads are written to a directory before they are
+// sent, in order, and removed once the intake accepted them. Payloads failing with a Retryable error, e.g.
+// because the network is down, are sent again until they are accepted, and the payloads left when the
+// process stops are sent when the spool is opened again. The size of the payloads on disk is capped, and
+// the dropped payloads are reported to SpoolOptions.OnDrop.
+//
+//	spool, err := intake.OpenSpool(ctx, "/var/lib/app/spool", intake.SpoolOptions{
+//		Senders: map[string]intake.SpoolSender{
+//			"v1.EventsApi.CreateEvent": intake.CreateEventSender(datadogV1.NewEventsApi(apiClient)),
+//		},
+//	})
+//	defer spool.Close()
+//	err = spool.Enqueue("v1.EventsApi.CreateEvent", event)
+//
+// A directory must be used by a single Spool at a time. It is safe for concurrent use.
+type Spool struct {
+	dir     string
+	options SpoolOptions
+
+	mu      sync.Mutex
+	entries []spoolEntry
+	bytes   int64
+	next    uint64
+	// sending is the sequence number of the entry being sent, if any, which is not evicted.
+	sending uint64
+	sent    uint64
+	dropped uint64
+	closed  bool
+
+	wake    chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+	// drained is closed and replaced when the spool becomes empty.
+	drained chan struct{}
+}
+
+type spoolEntry struct {
+	seq         uint64
+	operationID string
+	size        int64
+	enqueuedAt  time.Time
+}
+
+// OpenSpool opens the spool of a directory, which is created if needed, and sends the payloads left in it and
+// the ones enqueued with ctx in the background, until it is closed.
+func OpenSpool(ctx context.Context, dir string, options SpoolOptions) (*Spool, error) {
+	if options.MaxBytes <= 0 {
+		options.MaxBytes = DefaultSpoolMaxBytes
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	s := &Spool{
+		dir:     dir,
+		options: options,
+		next:    1,
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		drained: make(chan struct{}),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	if len(s.entries) == 0 {
+		close(s.drained)
+	}
+	go s.run(ctx)
+	return s, nil
+}
+
+// Enqueue writes the payload of an operation, encoded in JSON, to the spool, to be sent after the payloads
+// enqueued before it. It returns ErrNoSpoolSender if no sender is configured for the operation, and
+// ErrSpoolFull if the payload is dropped because it doesn't fit in the spool. Payloads enqueued after Close
+// are kept on disk, to be sent when the spool is opened again.
+func (s *Spool) Enqueue(operationID string, body interface{}) error {
+	if _, ok := s.options.Senders[operationID]; !ok {
+		return fmt.Errorf("%w: %s", ErrNoSpoolSender, operationID)
+	}
+	payload, err := datadog.Marshal(body)
+	if err != nil {
+		return err
+	}
+	entry := spoolEntry{operationID: operationID, enqueuedAt: time.Now()}
+	header := spoolHeader(entry)
+	entry.size = int64(len(header) + len(payload))
+
+	s.mu.Lock()
+	if !s.makeRoom(entry) {
+		s.drop(entry, SpoolDropFull, nil)
+		s.mu.Unlock()
+		return ErrSpoolFull
+	}
+	// The sequence number and the room of the entry are reserved, and its file is written and synced without
+	// holding the lock, so that the other payloads are enqueued and sent meanwhile.
+	entry.seq = s.next
+	s.next++
+	s.bytes += entry.size
+	s.mu.Unlock()
+
+	err = s.write(entry.seq, header, payload)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.bytes -= entry.size
+		return err
+	}
+	if len(s.entries) == 0 {
+		s.drained = make(chan struct{})
+	}
+	// The entries enqueued concurrently are kept in the order of their sequence numbers, whatever the order
+	// their files were written in.
+	i := sort.Search(len(s.entries), func(i int) bool { return s.entries[i].seq > entry.seq })
+	s.entries = append(s.entries, spoolEntry{})
+	copy(s.entries[i+1:], s.entries[i:])
+	s.entries[i] = entry
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Drain waits until all the payloads of the spool are sent or dropped, or ctx is canceled.
+func (s *Spool) Drain(ctx context.Context) error {
+	s.mu.Lock()
+	drained := s.drained
+	s.mu.Unlock()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// Close stops sending the payloads, waiting for the one being sent. The payloads left are kept on disk, to
+// be sent when the spool is opened again.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+	s.mu.Unlock()
+	<-s.stopped
+	return nil
+}
+
+// Stats returns the counters of the spool.
+func (s *Spool) Stats() SpoolStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return SpoolStats{Pending: len(s.entries), PendingBytes: s.bytes, Sent: s.sent, Dropped: s.dropped}
+}
+
+// run sends the payloads in order, waiting between the attempts of a payload failing with a Retryable error.
+func (s *Spool) run(ctx context.Context) {
+	defer close(s.stopped)
+	retry := s.options.Retry.withDefaults()
+	backoff := retry.Backoff
+	for {
+		entry, ok := s.head()
+		if !ok {
+			select {
+			case <-s.done:
+				return
+			case <-ctx.Done():
+				return
+			case <-s.wake:
+				continue
+			}
+		}
+		err := s.send(ctx, entry)
+		s.mu.Lock()
+		s.sending = 0
+		s.mu.Unlock()
+		if err == nil {
+			backoff = retry.Backoff
+			continue
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-s.done:
+			timer.Stop()
+			return
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > retry.MaxBackoff {
+			backoff = retry.MaxBackoff
+		}
+	}
+}
+
+// head returns the oldest entry, marked as being sent, after dropping the expired ones.
+func (s *Spool) head() (spoolEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.entries) > 0 {
+		entry := s.entries[0]
+		if s.options.MaxAge > 0 && time.Since(entry.enqueuedAt) > s.options.MaxAge {
+			s.remove(entry, SpoolDropExpired, nil)
+			continue
+		}
+		s.sending = entry.seq
+		return entry, true
+	}
+	return spoolEntry{}, false
+}
+
+// send sends an entry, and removes it unless it failed with a Retryable error, which is returned.
+func (s *Spool) send(ctx context.Context, entry spoolEntry) error {
+	reason := SpoolDropRejected
+	_, payload, err := s.read(entry.seq)
+	if err != nil {
+		reason = SpoolDropCorrupted
+	} else {
+		err = s.options.Senders[entry.operationID](ctx, payload)
+		// The payloads failing because ctx is canceled are kept, to be sent when the spool is opened again.
+		if Retryable(err) || (err != nil && ctx.Err() != nil) {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case err == nil:
+		s.sent++
+		s.remove(entry, "", nil)
+	case reason == SpoolDropCorrupted && errors.Is(err, os.ErrNotExist):
+		// The entry was removed while it was sent.
+	default:
+		s.remove(entry, reason, err)
+	}
+	return nil
+}
+
+// makeRoom evicts entries until the given one fits in the spool, and returns false if it doesn't.
+func (s *Spool) makeRoom(entry spoolEntry) bool {
+	if entry.size > s.options.MaxBytes {
+		return false
+	}
+	for s.bytes+entry.size > s.options.MaxBytes {
+		if s.options.Eviction == RejectNewest {
+			return false
+		}
+		evicted := false
+		for _, oldest := range s.entries {
+			if oldest.seq != s.sending {
+				s.remove(oldest, SpoolDropEvicted, nil)
+				evicted = true
+				break
+			}
+		}
+		if !evicted {
+			return false
+		}
+	}
+	return true
+}
+
+// remove removes an entry from the spool, and reports it as dropped if reason is not empty.
+func (s *Spool) remove(entry spoolEntry, reason SpoolDropReason, err error) {
+	for i := range s.entries {
+		if s.entries[i].seq == entry.seq {
+			s.entries = append(s.entries[:i], s.entries[i+1:]...)
+			s.bytes -= entry.size
+			if len(s.entries) == 0 {
+				close(s.drained)
+			}
+			break
+		}
+	}
+	os.Remove(s.path(entry.seq))
+	if reason != "" {
+		s.drop(entry, reason, err)
+	}
+}
+
+func (s *Spool) drop(entry spoolEntry, reason SpoolDropReason, err error) {
+	s.dropped++
+	if s.options.OnDrop != nil {
+		s.options.OnDrop(SpoolDrop{OperationID: entry.operationID, Size: int(entry.size), EnqueuedAt: entry.enqueuedAt, Reason: reason, Err: err})
+	}
+}
+
+// load reads the entries left in the directory, in order, and removes the files which were not fully written.
+func (s *Spool) load() error {
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	var seqs []uint64
+	for _, file := range files {
+		name := file.Name()
+		switch filepath.Ext(name) {
+		case spoolTempExtension:
+			os.Remove(filepath.Join(s.dir, name))
+		case spoolExtension:
+			if seq, err := strconv.ParseUint(strings.TrimSuffix(name, spoolExtension), 10, 64); err == nil {
+				seqs = append(seqs, seq)
+			}
+		}
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	for _, seq := range seqs {
+		s.next = seq + 1
+		entry, _, err := s.read(seq)
+		if err != nil {
+			info, _ := os.Stat(s.path(seq))
+			if info != nil {
+				entry.size = info.Size()
+			}
+			os.Remove(s.path(seq))
+			s.drop(entry, SpoolDropCorrupted, err)
+			continue
+		}
+		s.entries = append(s.entries, entry)
+		s.bytes += entry.size
+	}
+	return nil
+}
+
+func (s *Spool) path(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, spoolExtension))
+}
+
+// write writes an entry to a temporary file, synced and renamed, so that the files of the spool are complete.
+func (s *Spool) write(seq uint64, header string, payload []byte) error {
+	temp := strings.TrimSuffix(s.path(seq), spoolExtension) + spoolTempExtension
+	file, err := os.OpenFile(temp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, io.MultiReader(strings.NewReader(header), bytes.NewReader(payload)))
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(temp, s.path(seq))
+	}
+	if err != nil {
+		os.Remove(temp)
+	}
+	return err
+}
+
+// read reads the entry and the payload of a file.
+func (s *Spool) read(seq uint64) (spoolEntry, []byte, error) {
+	entry := spoolEntry{seq: seq}
+	content, err := os.ReadFile(s.path(seq))
+	if err != nil {
+		return entry, nil, err
+	}
+	entry.size = int64(len(content))
+	reader := bufio.NewReader(bytes.NewReader(content))
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return entry, nil, fmt.Errorf("invalid spool file header: %w", err)
+	}
+	fields := strings.Fields(header)
+	if len(fields) != 2 {
+		return entry, nil, fmt.Errorf("invalid spool file header %q", header)
+	}
+	enqueuedAt, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return entry, nil, fmt.Errorf("invalid spool file header %q: %w", header, err)
+	}
+	entry.operationID, entry.enqueuedAt = fields[0], time.Unix(0, enqueuedAt)
+	return entry, content[len(header):], nil
+}
+
+// spoolHeader returns the first line of the file of an entry: its operation ID and enqueue time.
+func spoolHeader(entry spoolEntry) string {
+	return fmt.Sprintf("%s %d\n", entry.operationID, entry.enqueuedAt.UnixNano())
+}
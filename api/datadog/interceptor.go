@@ -0,0 +1,114 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// InterceptorInfo describes the API call an interceptor is invoked for.
+type InterceptorInfo struct {
+	// OperationID is the fully qualified operation ID, e.g. "v2.LogsApi.SubmitLog".
+	OperationID string
+	// Attempt is the 1-based number of the current attempt. It is incremented on every retry.
+	Attempt int
+	// Idempotent is true when the operation can be sent more than once without duplicating its side effects.
+	Idempotent bool
+	// APIKeys holds the keys passed via ContextAPIKeys, if any.
+	APIKeys map[string]APIKey
+	// BasicAuth holds the credentials passed via ContextBasicAuth, if any.
+	BasicAuth *BasicAuth
+	// AccessToken holds the token passed via ContextAccessToken, if any.
+	AccessToken string
+}
+
+// RequestInterceptor is called before every attempt is sent and may modify the request.
+// Returning an error aborts the call and the error is returned to the caller, after the response hooks of the
+// interceptors whose request hook already ran are called with it.
+type RequestInterceptor func(req *http.Request, info InterceptorInfo) error
+
+// ResponseInterceptor is called after every attempt with the response or the transport error, or with the
+// error of a request hook which aborted the call. Returning an error aborts the call: the other response hooks
+// are still called, and their errors are joined and returned to the caller.
+type ResponseInterceptor func(req *http.Request, resp *http.Response, err error, info InterceptorInfo) error
+
+// Interceptor groups the request and response hooks registered on the configuration.
+// Either hook can be left nil.
+type Interceptor struct {
+	Request  RequestInterceptor
+	Response ResponseInterceptor
+}
+
+// WithOperationID returns a copy of ctx carrying the given operation ID.
+func WithOperationID(ctx context.Context, operationID string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ContextOperationID, operationID)
+}
+
+// OperationIDFromContext returns the operation ID set by WithOperationID, if any.
+func OperationIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if operationID, ok := ctx.Value(ContextOperationID).(string); ok {
+		return operationID
+	}
+	return ""
+}
+
+// newInterceptorInfo extracts the operation ID and authentication from the request context.
+func newInterceptorInfo(ctx context.Context) InterceptorInfo {
+	info := InterceptorInfo{OperationID: OperationIDFromContext(ctx)}
+	if ctx == nil {
+		return info
+	}
+	if keys, ok := ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+		info.APIKeys = keys
+	}
+	if auth, ok := ctx.Value(ContextBasicAuth).(BasicAuth); ok {
+		info.BasicAuth = &auth
+	}
+	if token, ok := ctx.Value(ContextAccessToken).(string); ok {
+		info.AccessToken = token
+	}
+	return info
+}
+
+// interceptRequest runs the request hooks in registration order. It returns the number of interceptors whose
+// request hook ran, which is all of them unless one fails.
+func (c *APIClient) interceptRequest(req *http.Request, info InterceptorInfo) (int, error) {
+	for i, interceptor := range c.Cfg.Interceptors {
+		if interceptor.Request == nil {
+			continue
+		}
+		if err := interceptor.Request(req, info); err != nil {
+			return i, err
+		}
+	}
+	return len(c.Cfg.Interceptors), nil
+}
+
+// interceptResponse runs the response hooks of the first count interceptors in reverse registration order,
+// so that the first registered interceptor wraps all the others. Every hook runs, and their errors are joined.
+func (c *APIClient) interceptResponse(req *http.Request, resp *http.Response, err error, info InterceptorInfo, count int) error {
+	var errs []error
+	for i := count - 1; i >= 0; i-- {
+		interceptor := c.Cfg.Interceptors[i]
+		if interceptor.Response == nil {
+			continue
+		}
+		if ierr := interceptor.Response(req, resp, err, info); ierr != nil {
+			errs = append(errs, ierr)
+		}
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,270 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultMaxLoggedBodySize is the number of bytes of the bodies logged when LogRedaction.MaxBodySize is not set.
+const DefaultMaxLoggedBodySize = 16 << 10
+
+const (
+	redactedValue = "REDACTED"
+	// redactionMargin is the number of bytes read from a response body beyond the ones logged, so that the
+	// secrets crossing the truncation point are redacted before the body is truncated.
+	redactionMargin = 1 << 10
+)
+
+var (
+	bearerCheck     = regexp.MustCompile(`(?i)\b(bearer)\s+[a-z0-9\-._~+/]+=*`)
+	redactedHeaders = []string{
+		"DD-API-KEY",
+		"DD-APPLICATION-KEY",
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+	}
+	redactedJSONFields = []string{
+		"api_key",
+		"apiKey",
+		"app_key",
+		"appKey",
+		"application_key",
+		"access_token",
+		"refresh_token",
+		"client_secret",
+		"password",
+		"private_key",
+	}
+)
+
+// LogRedaction configures what is hidden from the requests and responses logged by the API client.
+// The keys and tokens the request is authenticated with are always redacted wherever they appear, as well as
+// the values of the DD-API-KEY, DD-APPLICATION-KEY, Authorization and Cookie headers, bearer tokens, and
+// JSON fields such as api_key, app_key, access_token or password.
+type LogRedaction struct {
+	// Headers lists additional headers whose values are redacted.
+	Headers []string
+	// JSONFields lists additional fields of JSON bodies whose values are redacted, at any depth.
+	// Names are compared case-insensitively.
+	JSONFields []string
+	// MaxBodySize is the number of bytes of a body logged after redaction. Zero means DefaultMaxLoggedBodySize,
+	// and a negative value logs whole bodies.
+	MaxBodySize int
+}
+
+// redactString replaces the secrets and bearer tokens found in s.
+func (r LogRedaction) redactString(s string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, redactedValue)
+		}
+	}
+	return bearerCheck.ReplaceAllString(s, "${1} "+redactedValue)
+}
+
+// redactHeaders returns the headers as a sorted list of "Name: value" lines.
+func (r LogRedaction) redactHeaders(header http.Header, secrets []string) string {
+	hidden := make(map[string]bool)
+	for _, name := range append(redactedHeaders, r.Headers...) {
+		hidden[http.CanonicalHeaderKey(name)] = true
+	}
+	lines := make([]string, 0, len(header))
+	for name, values := range header {
+		value := strings.Join(values, ", ")
+		if hidden[http.CanonicalHeaderKey(name)] {
+			value = redactedValue
+		}
+		lines = append(lines, name+": "+r.redactString(value, secrets))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// maxBodySize returns the number of bytes of a body logged, or a negative value if whole bodies are logged.
+func (r LogRedaction) maxBodySize() int {
+	if r.MaxBodySize == 0 {
+		return DefaultMaxLoggedBodySize
+	}
+	return r.MaxBodySize
+}
+
+// redactBody returns the body to log, with its JSON fields and secrets redacted, and truncated.
+// A partial body is the beginning of a longer one, which is logged as truncated.
+func (r LogRedaction) redactBody(body []byte, partial bool, header http.Header, secrets []string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if encoding := header.Get("Content-Encoding"); encoding != "" && encoding != "identity" {
+		if partial {
+			return fmt.Sprintf("<more than %d bytes encoded with %s>", len(body), encoding)
+		}
+		return fmt.Sprintf("<%d bytes encoded with %s>", len(body), encoding)
+	}
+	if jsonCheck.MatchString(header.Get("Content-Type")) {
+		fields := make(map[string]bool)
+		for _, name := range append(redactedJSONFields, r.JSONFields...) {
+			fields[strings.ToLower(name)] = true
+		}
+		var out bytes.Buffer
+		dec := NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		// A partial body ends in the middle of a value, and only what was copied before it is kept.
+		if err := redactJSON(dec, &out, fields); err == nil || partial {
+			body = out.Bytes()
+		}
+	}
+	logged := r.redactString(string(body), secrets)
+
+	limit := r.maxBodySize()
+	switch {
+	case limit > 0 && len(logged) > limit && partial:
+		logged = fmt.Sprintf("%s... (truncated)", strings.ToValidUTF8(logged[:limit], ""))
+	case limit > 0 && len(logged) > limit:
+		logged = fmt.Sprintf("%s... (%d bytes truncated)", strings.ToValidUTF8(logged[:limit], ""), len(logged)-limit)
+	case partial:
+		logged += "... (truncated)"
+	}
+	return logged
+}
+
+// redactJSON copies the next JSON value of dec to out, replacing the values of the given fields.
+func redactJSON(dec *jsonDecoder, out *bytes.Buffer, fields map[string]bool) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := token.(jsonDelim)
+	if !ok {
+		return writeJSON(out, token)
+	}
+	out.WriteRune(rune(delim))
+	for i := 0; dec.More(); i++ {
+		if i > 0 {
+			out.WriteByte(',')
+		}
+		if delim == '{' {
+			token, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, _ := token.(string)
+			if err := writeJSON(out, key); err != nil {
+				return err
+			}
+			out.WriteByte(':')
+			if fields[strings.ToLower(key)] {
+				var skipped jsonRawMessage
+				if err := dec.Decode(&skipped); err != nil {
+					return err
+				}
+				out.WriteString(`"` + redactedValue + `"`)
+				continue
+			}
+		}
+		if err := redactJSON(dec, out, fields); err != nil {
+			return err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	if delim == '{' {
+		out.WriteByte('}')
+	} else {
+		out.WriteByte(']')
+	}
+	return nil
+}
+
+// writeJSON writes a JSON value to out, without escaping HTML characters.
+func writeJSON(out *bytes.Buffer, v interface{}) error {
+	enc := NewEncoder(out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	// Drop the newline added by Encode.
+	out.Truncate(out.Len() - 1)
+	return nil
+}
+
+// secrets returns the credentials of the request, to redact them from the logs.
+func (info InterceptorInfo) secrets() []string {
+	secrets := make([]string, 0, len(info.APIKeys)+2)
+	for _, key := range info.APIKeys {
+		secrets = append(secrets, key.Key)
+	}
+	if info.AccessToken != "" {
+		secrets = append(secrets, info.AccessToken)
+	}
+	if info.BasicAuth != nil && info.BasicAuth.Password != "" {
+		secrets = append(secrets, info.BasicAuth.Password)
+	}
+	return secrets
+}
+
+// logRequest logs an attempt of a request, with its headers and body, at debug level.
+func (c *APIClient) logRequest(request *http.Request, body []byte, info InterceptorInfo) {
+	redaction := c.Cfg.LogRedaction
+	secrets := info.secrets()
+	c.Cfg.Log(request.Context(), LevelDebug, "Sending request",
+		"attempt", info.Attempt,
+		"method", request.Method,
+		"url", redaction.redactString(request.URL.String(), secrets),
+		"headers", redaction.redactHeaders(request.Header, secrets),
+		"body", redaction.redactBody(body, false, request.Header, secrets),
+	)
+}
+
+// logResponse logs the outcome of an attempt, with the headers and body of the response, at debug level.
+func (c *APIClient) logResponse(request *http.Request, resp *http.Response, err error, info InterceptorInfo, duration time.Duration) {
+	if err != nil || resp == nil {
+		c.Cfg.Log(request.Context(), LevelDebug, "Request failed",
+			"attempt", info.Attempt,
+			"duration", duration,
+			"error", err,
+		)
+		return
+	}
+	// Only the beginning of the body is read, so that a streamed response is not loaded in memory.
+	redaction := c.Cfg.LogRedaction
+	var body []byte
+	partial := false
+	if limit := redaction.maxBodySize(); limit < 0 {
+		body, _ = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewBuffer(body))
+	} else {
+		body, _ = io.ReadAll(io.LimitReader(resp.Body, int64(limit+redactionMargin+1)))
+		partial = len(body) > limit+redactionMargin
+		resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), Closer: resp.Body}
+	}
+
+	secrets := info.secrets()
+	c.Cfg.Log(request.Context(), LevelDebug, "Received response",
+		"attempt", info.Attempt,
+		"status", resp.StatusCode,
+		"request_id", requestIDFromHeader(resp.Header),
+		"duration", duration,
+		"headers", redaction.redactHeaders(resp.Header, secrets),
+		"body", redaction.redactBody(body, partial, resp.Header, secrets),
+	)
+}
+
+// readCloser is a body read from Reader and closed with Closer.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,154 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// LogLevel is the severity of a log entry. Its values are the ones of log/slog.
+type LogLevel int
+
+// Levels of the log entries of the API client.
+const (
+	LevelDebug LogLevel = LogLevel(slog.LevelDebug)
+	LevelInfo  LogLevel = LogLevel(slog.LevelInfo)
+	LevelWarn  LogLevel = LogLevel(slog.LevelWarn)
+	LevelError LogLevel = LogLevel(slog.LevelError)
+)
+
+// String returns the name of the level.
+func (l LogLevel) String() string {
+	return slog.Level(l).String()
+}
+
+// Logger receives the log entries of the API client. The request and response dumps are logged at
+// LevelDebug, and only built when debug entries are enabled.
+type Logger interface {
+	// Enabled reports whether entries of the given level are logged.
+	Enabled(ctx context.Context, level LogLevel) bool
+	// Log logs an entry, with fields given as alternating keys and values like log/slog.
+	Log(ctx context.Context, level LogLevel, msg string, args ...interface{})
+}
+
+// NewSlogLogger returns a Logger writing to the given slog logger, or to slog.Default() if it is nil.
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return slogLogger{logger: logger}
+}
+
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func (l slogLogger) slog() *slog.Logger {
+	if l.logger == nil {
+		return slog.Default()
+	}
+	return l.logger
+}
+
+func (l slogLogger) Enabled(ctx context.Context, level LogLevel) bool {
+	return l.slog().Enabled(ctx, slog.Level(level))
+}
+
+func (l slogLogger) Log(ctx context.Context, level LogLevel, msg string, args ...interface{}) {
+	l.slog().Log(ctx, slog.Level(level), msg, args...)
+}
+
+// NewStdLogger returns a Logger writing lines like "WARNING: msg key=value" to the given logger of the
+// log package, or to the standard logger if it is nil. Debug entries are only logged if debug is true.
+// It is the logger used when Configuration.Logger is not set, with Configuration.Debug.
+func NewStdLogger(logger *log.Logger, debug bool) Logger {
+	return stdLogger{logger: logger, debug: debug}
+}
+
+type stdLogger struct {
+	logger *log.Logger
+	debug  bool
+}
+
+func (l stdLogger) Enabled(_ context.Context, level LogLevel) bool {
+	return level > LevelDebug || l.debug
+}
+
+func (l stdLogger) Log(ctx context.Context, level LogLevel, msg string, args ...interface{}) {
+	if !l.Enabled(ctx, level) {
+		return
+	}
+	name := level.String()
+	if level == LevelWarn {
+		name = "WARNING"
+	}
+	var line strings.Builder
+	line.WriteString(name + ": " + msg)
+	for i := 0; i < len(args); i += 2 {
+		key, value := fmt.Sprint(args[i]), "!MISSING"
+		if i+1 < len(args) {
+			value = fmt.Sprint(args[i+1])
+		}
+		if strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+		line.WriteString(" " + key + "=" + value)
+	}
+	if l.logger == nil {
+		log.Print(line.String())
+		return
+	}
+	l.logger.Print(line.String())
+}
+
+// WithLogFields returns a copy of ctx whose requests are logged with the given fields, as alternating
+// keys and values, in addition to the ones of the parent context.
+func WithLogFields(ctx context.Context, args ...interface{}) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	fields, _ := ctx.Value(ContextLogFields).([]interface{})
+	return context.WithValue(ctx, ContextLogFields, append(fields[:len(fields):len(fields)], args...))
+}
+
+// logFields returns the fields correlating the log entries of a request: the ones set with WithLogFields,
+// then the operation ID and organization.
+func logFields(ctx context.Context) []interface{} {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(ContextLogFields).([]interface{})
+	fields = fields[:len(fields):len(fields)]
+	if operationID := OperationIDFromContext(ctx); operationID != "" {
+		fields = append(fields, "operation_id", operationID)
+	}
+	if org, ok := OrgFromContext(ctx); ok {
+		fields = append(fields, "org", org)
+	}
+	return fields
+}
+
+// logger returns the configured logger, or the standard logger.
+func (c *Configuration) logger() Logger {
+	if c.Logger != nil {
+		return c.Logger
+	}
+	return NewStdLogger(nil, c.Debug)
+}
+
+// Log logs an entry with the configured logger, with the fields of the context set with WithLogFields
+// and the operation ID and organization of the context.
+func (c *Configuration) Log(ctx context.Context, level LogLevel, msg string, args ...interface{}) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	logger := c.logger()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+	logger.Log(ctx, level, msg, append(logFields(ctx), args...)...)
+}
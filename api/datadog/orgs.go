@@ -0,0 +1,177 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownOrg is returned when calling an operation for an organization missing from the registry.
+var ErrUnknownOrg = errors.New("unknown organization")
+
+// Org describes an organization the client can call operations for.
+type Org struct {
+	// Name identifies the organization in the registry and in WithOrg.
+	Name string
+	// Site is the Datadog site of the organization, e.g. "datadoghq.eu". Empty keeps the site of the context.
+	Site string
+	// Credentials provides the keys of the organization. Nil keeps the keys of the context.
+	Credentials CredentialProvider
+}
+
+// OrgRegistry holds the organizations selected with WithOrg. It is safe for concurrent use.
+type OrgRegistry struct {
+	mu   sync.RWMutex
+	orgs map[string]Org
+}
+
+// NewOrgRegistry returns a registry holding the given organizations.
+func NewOrgRegistry(orgs ...Org) *OrgRegistry {
+	r := &OrgRegistry{orgs: make(map[string]Org, len(orgs))}
+	for _, org := range orgs {
+		r.Register(org)
+	}
+	return r
+}
+
+// Register adds an organization to the registry, replacing any organization with the same name.
+func (r *OrgRegistry) Register(org Org) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.orgs == nil {
+		r.orgs = make(map[string]Org)
+	}
+	r.orgs[org.Name] = org
+}
+
+// Remove removes an organization from the registry.
+func (r *OrgRegistry) Remove(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.orgs, name)
+}
+
+// Get returns the organization with the given name.
+func (r *OrgRegistry) Get(name string) (Org, bool) {
+	if r == nil {
+		return Org{}, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	org, ok := r.orgs[name]
+	return org, ok
+}
+
+// Names returns the sorted names of the organizations of the registry.
+func (r *OrgRegistry) Names() []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.orgs))
+	for name := range r.orgs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WithOrg returns a copy of ctx in which operations are called for the given organization of
+// the registry set in Configuration.Orgs, with its site and credentials.
+func WithOrg(ctx context.Context, name string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ContextOrg, name)
+}
+
+// OrgFromContext returns the organization set by WithOrg, if any.
+func OrgFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	name, ok := ctx.Value(ContextOrg).(string)
+	return name, ok
+}
+
+// org returns the organization of the registry with the given name.
+func (c *Configuration) org(name string) (Org, error) {
+	org, ok := c.Orgs.Get(name)
+	if !ok {
+		return Org{}, fmt.Errorf("%w %q", ErrUnknownOrg, name)
+	}
+	return org, nil
+}
+
+// OrgResult is the result of an operation fanned out to an organization.
+type OrgResult[T any] struct {
+	Org   string
+	Value T
+	Err   error
+}
+
+// OrgResults are the results of an operation fanned out to all the organizations, in the order of their names.
+type OrgResults[T any] []OrgResult[T]
+
+// Err returns the errors of all the organizations joined together, or nil if all of them succeeded.
+func (r OrgResults[T]) Err() error {
+	var errs []error
+	for _, result := range r {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("org %s: %w", result.Org, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Values returns the values of the organizations for which the operation succeeded, by organization name.
+func (r OrgResults[T]) Values() map[string]T {
+	values := make(map[string]T, len(r))
+	for _, result := range r {
+		if result.Err == nil {
+			values[result.Org] = result.Value
+		}
+	}
+	return values
+}
+
+// FanOut calls fn for every organization of the registry, with a context set with WithOrg, and
+// returns all the results once they are done. At most concurrency calls run at the same time,
+// or all of them if concurrency is not positive.
+//
+//	results := datadog.FanOut(ctx, configuration.Orgs, 8, func(ctx context.Context, org string) ([]datadogV1.Monitor, error) {
+//		monitors, _, err := monitorsApi.ListMonitors(ctx)
+//		return monitors, err
+//	})
+func FanOut[T any](ctx context.Context, orgs *OrgRegistry, concurrency int, fn func(ctx context.Context, org string) (T, error)) OrgResults[T] {
+	names := orgs.Names()
+	results := make(OrgResults[T], len(names))
+	if concurrency <= 0 || concurrency > len(names) {
+		concurrency = len(names)
+	}
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		results[i].Org = name
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(result *OrgResult[T]) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := ctx.Err(); err != nil {
+				result.Err = err
+				return
+			}
+			result.Value, result.Err = fn(WithOrg(ctx, result.Org), result.Org)
+		}(&results[i])
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,220 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// PaginationStrategy is the way a paginated operation moves from one page to the next.
+type PaginationStrategy int
+
+const (
+	// CursorPagination requests the next page with the cursor returned by the previous page,
+	// until a page is not full or no cursor is returned.
+	CursorPagination PaginationStrategy = iota
+	// OffsetPagination requests the next page at the offset of the first item not returned yet,
+	// until a page is not full.
+	OffsetPagination
+	// PageNumberPagination requests pages by increasing page number, until a page is not full.
+	PageNumberPagination
+	// NextRecordIDPagination requests the next page with the record ID returned by the previous page,
+	// until no record ID is returned.
+	NextRecordIDPagination
+)
+
+// PageRequest describes a page to fetch.
+type PageRequest struct {
+	// Size is the number of items requested. Zero lets the server decide, in which case
+	// only an empty page ends the offset and page number strategies.
+	Size int64
+	// Offset is the index of the first item of the page, for OffsetPagination.
+	Offset int64
+	// Number is the number of the page, for PageNumberPagination.
+	Number int64
+	// Cursor is the cursor returned by the previous page, for CursorPagination and NextRecordIDPagination.
+	Cursor string
+}
+
+// Page is a page of items returned by a paginated operation.
+type Page[T any] struct {
+	Items []T
+	// Cursor is the cursor of the next page, for CursorPagination and NextRecordIDPagination.
+	Cursor string
+}
+
+// Paginator iterates over all the items of a paginated operation.
+// The generated *Seq and *Prefetch methods are built on top of it, as well as the *WithPagination methods
+// of the operations paged through query parameters without x-pagination.
+type Paginator[T any] struct {
+	Strategy PaginationStrategy
+	// First is the request of the first page. The following ones are derived from it.
+	First PageRequest
+	// Fetch sends the request for the given page. It must be safe for concurrent use when Prefetch is set.
+	Fetch func(ctx context.Context, page PageRequest) (Page[T], error)
+	// Prefetch is the number of pages requested concurrently, ahead of the consumer, with the offset
+	// and page number strategies. Items are still returned in order. Up to Prefetch-1 requests past
+	// the last page may be sent. Zero or one requests the pages one at a time.
+	Prefetch int
+}
+
+type pageResult[T any] struct {
+	page Page[T]
+	err  error
+}
+
+// Seq returns an iterator over all the items. Pages are requested as the iterator is consumed,
+// and iteration stops after the first error.
+func (p Paginator[T]) Seq(ctx context.Context) iter.Seq2[T, error] {
+	if p.Prefetch > 1 && p.canPrefetch() {
+		return p.prefetchSeq(ctx)
+	}
+	return func(yield func(T, error) bool) {
+		request := p.First
+		for {
+			page, err := p.Fetch(ctx, request)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			var ok bool
+			if request, ok = p.next(request, page); !ok {
+				return
+			}
+		}
+	}
+}
+
+// Channel returns a channel with all the items, fed by a goroutine until the last page,
+// the first error or the call of the returned cancel function.
+func (p Paginator[T]) Channel(ctx context.Context) (<-chan PaginationResult[T], func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	size := p.First.Size
+	if size < 1 {
+		size = 1
+	}
+	items := make(chan PaginationResult[T], size)
+	go func() {
+		defer close(items)
+		for item, err := range p.Seq(ctx) {
+			select {
+			case items <- PaginationResult[T]{Item: item, Error: err}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return items, cancel
+}
+
+// prefetchSeq keeps up to Prefetch page requests in flight, and yields their items in order.
+// It returns once all the requests it sent are done.
+func (p Paginator[T]) prefetchSeq(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer cancel()
+
+		// The page being consumed is not in the queue anymore, hence the Prefetch-1 capacity.
+		pages := make(chan chan pageResult[T], p.Prefetch-1)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(pages)
+			for request := p.First; ; request = p.advance(request, int(request.Size)) {
+				result := make(chan pageResult[T], 1)
+				select {
+				case pages <- result:
+				case <-ctx.Done():
+					return
+				}
+				wg.Add(1)
+				go func(request PageRequest) {
+					defer wg.Done()
+					page, err := p.Fetch(ctx, request)
+					result <- pageResult[T]{page: page, err: err}
+				}(request)
+			}
+		}()
+
+		for result := range pages {
+			r := <-result
+			if r.err != nil {
+				var zero T
+				yield(zero, r.err)
+				return
+			}
+			for _, item := range r.page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !isFullPage(p.First, r.page) {
+				return
+			}
+		}
+	}
+}
+
+// canPrefetch returns true if the requests of the following pages do not depend on the previous ones.
+func (p Paginator[T]) canPrefetch() bool {
+	switch p.Strategy {
+	case OffsetPagination:
+		return p.First.Size > 0
+	case PageNumberPagination:
+		return true
+	}
+	return false
+}
+
+// advance returns the request following the given one for the offset and page number strategies.
+func (p Paginator[T]) advance(request PageRequest, count int) PageRequest {
+	switch p.Strategy {
+	case OffsetPagination:
+		request.Offset += int64(count)
+	case PageNumberPagination:
+		request.Number++
+	}
+	return request
+}
+
+// isFullPage returns true if the page has as many items as requested, so that more items may follow.
+func isFullPage[T any](request PageRequest, page Page[T]) bool {
+	return len(page.Items) > 0 && (request.Size == 0 || int64(len(page.Items)) >= request.Size)
+}
+
+// next returns the request of the page following the given one, or false if it was the last page.
+func (p Paginator[T]) next(request PageRequest, page Page[T]) (PageRequest, bool) {
+	full := isFullPage(request, page)
+	switch p.Strategy {
+	case CursorPagination:
+		if !full || page.Cursor == "" || page.Cursor == request.Cursor {
+			return request, false
+		}
+		request.Cursor = page.Cursor
+	case NextRecordIDPagination:
+		if page.Cursor == "" || page.Cursor == request.Cursor {
+			return request, false
+		}
+		request.Cursor = page.Cursor
+	case OffsetPagination, PageNumberPagination:
+		if !full {
+			return request, false
+		}
+		request = p.advance(request, len(page.Items))
+	default:
+		return request, false
+	}
+	return request, true
+}
@@ -0,0 +1,187 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitBucket is a snapshot of the state of a Datadog rate limit bucket,
+// as reported by the X-RateLimit-* headers of the last response.
+type RateLimitBucket struct {
+	// Name is the value of X-RateLimit-Name, or the operation ID when the header is missing.
+	Name string
+	// Limit is the number of requests allowed per period.
+	Limit int
+	// Period is the length of the rate limit window.
+	Period time.Duration
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// ResetAt is the time at which the current window ends.
+	ResetAt time.Time
+	// InFlight is the number of requests admitted by the limiter and still waiting for a response.
+	InFlight int
+	// UpdatedAt is the time of the last response received for this bucket.
+	UpdatedAt time.Time
+}
+
+// RateLimiter tracks the rate limit buckets reported by Datadog and blocks callers
+// before a bucket is exhausted, instead of waiting for a 429 response.
+// A RateLimiter is safe for concurrent use and is meant to be shared by all the API clients
+// talking to the same organization.
+type RateLimiter struct {
+	mu         sync.Mutex
+	buckets    map[string]*RateLimitBucket
+	operations map[string]string
+}
+
+// NewRateLimiter returns a new RateLimiter with no known bucket.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets:    make(map[string]*RateLimitBucket),
+		operations: make(map[string]string),
+	}
+}
+
+// Wait blocks until a request for the given operation can be sent without exhausting its bucket,
+// or until the context is done. Operations for which no response was received yet are never blocked.
+// The returned function must be called with the response headers (or nil) once the request completes.
+func (l *RateLimiter) Wait(ctx context.Context, operationID string) (func(http.Header), error) {
+	for {
+		l.mu.Lock()
+		bucket := l.bucketForOperation(operationID)
+		if bucket == nil {
+			l.mu.Unlock()
+			return l.release(operationID, ""), nil
+		}
+		now := time.Now()
+		if !now.Before(bucket.ResetAt) {
+			// The window is over: assume the bucket is full until the next response says otherwise.
+			bucket.Remaining = bucket.Limit
+			bucket.ResetAt = now.Add(bucket.Period)
+		}
+		if bucket.Remaining-bucket.InFlight > 0 {
+			bucket.InFlight++
+			l.mu.Unlock()
+			return l.release(operationID, bucket.Name), nil
+		}
+		wait := bucket.ResetAt.Sub(now)
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Update records the rate limit headers returned for the given operation.
+func (l *RateLimiter) Update(operationID string, header http.Header) {
+	update, ok := rateLimitFromHeader(header, operationID)
+	if !ok {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.operations[operationID] = update.Name
+	if bucket, ok := l.buckets[update.Name]; ok {
+		update.InFlight = bucket.InFlight
+	}
+	l.buckets[update.Name] = &update
+}
+
+// Bucket returns a snapshot of the bucket with the given name.
+func (l *RateLimiter) Bucket(name string) (RateLimitBucket, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if bucket, ok := l.buckets[name]; ok {
+		return *bucket, true
+	}
+	return RateLimitBucket{}, false
+}
+
+// Buckets returns a snapshot of all the known buckets, sorted by name.
+func (l *RateLimiter) Buckets() []RateLimitBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	buckets := make([]RateLimitBucket, 0, len(l.buckets))
+	for _, bucket := range l.buckets {
+		buckets = append(buckets, *bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Name < buckets[j].Name })
+	return buckets
+}
+
+// BucketName returns the name of the bucket the given operation was last reported in.
+func (l *RateLimiter) BucketName(operationID string) (string, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	name, ok := l.operations[operationID]
+	return name, ok
+}
+
+func (l *RateLimiter) bucketForOperation(operationID string) *RateLimitBucket {
+	if name, ok := l.operations[operationID]; ok {
+		return l.buckets[name]
+	}
+	return nil
+}
+
+func (l *RateLimiter) release(operationID string, name string) func(http.Header) {
+	var once sync.Once
+	return func(header http.Header) {
+		once.Do(func() {
+			if name != "" {
+				l.mu.Lock()
+				if bucket, ok := l.buckets[name]; ok && bucket.InFlight > 0 {
+					bucket.InFlight--
+				}
+				l.mu.Unlock()
+			}
+			l.Update(operationID, header)
+		})
+	}
+}
+
+// rateLimitFromHeader parses the X-RateLimit-* headers of a response.
+// The operation ID is used as bucket name when X-RateLimit-Name is missing.
+func rateLimitFromHeader(header http.Header, operationID string) (RateLimitBucket, bool) {
+	if header == nil {
+		return RateLimitBucket{}, false
+	}
+	limit, err := strconv.Atoi(header.Get(rateLimitLimitHeader))
+	if err != nil {
+		return RateLimitBucket{}, false
+	}
+	now := time.Now()
+	bucket := RateLimitBucket{
+		Name:      header.Get(rateLimitNameHeader),
+		Limit:     limit,
+		Remaining: limit,
+		UpdatedAt: now,
+	}
+	if bucket.Name == "" {
+		bucket.Name = operationID
+	}
+	if v, err := strconv.Atoi(header.Get(rateLimitRemainingHeader)); err == nil {
+		bucket.Remaining = v
+	}
+	if v, err := strconv.Atoi(header.Get(rateLimitPeriodHeader)); err == nil {
+		bucket.Period = time.Duration(v) * time.Second
+	}
+	if v, err := strconv.Atoi(header.Get(rateLimitResetHeader)); err == nil {
+		bucket.ResetAt = now.Add(time.Duration(v) * time.Second)
+	} else {
+		bucket.ResetAt = now.Add(bucket.Period)
+	}
+	return bucket, true
+}
@@ -0,0 +1,141 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ResponseMeta describes how a call was answered: the identifiers to give to the support, the rate limit
+// of the operation, the number of retries and the time it took.
+type ResponseMeta struct {
+	// OperationID is the fully qualified operation ID, e.g. "v2.LogsApi.SubmitLog".
+	OperationID string
+	// RequestID is the ID Datadog assigned to the request, to give to the support.
+	RequestID string
+	// StatusCode is the status code of the last response, or 0 if no response was received.
+	StatusCode int
+	// RateLimit is the rate limit bucket of the operation reported by the last response, if any.
+	RateLimit *RateLimitBucket
+	// Retries is the number of attempts made after the first one.
+	Retries int
+	// Latency is the time between the call and the reception of the headers of the last response,
+	// including the retries and the waits between them.
+	Latency time.Duration
+	// ServerTiming lists the metrics of the Server-Timing header of the last response.
+	ServerTiming []ServerTiming
+}
+
+// ServerTiming is a metric of a Server-Timing header.
+type ServerTiming struct {
+	Name        string
+	Duration    time.Duration
+	Description string
+}
+
+// LogValue returns the metadata as a group of attributes, so that it can be logged with log/slog.
+func (m ResponseMeta) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("operation_id", m.OperationID),
+		slog.String("request_id", m.RequestID),
+		slog.Int("status", m.StatusCode),
+		slog.Int("retries", m.Retries),
+		slog.Duration("latency", m.Latency),
+	}
+	if m.RateLimit != nil {
+		attrs = append(attrs,
+			slog.String("rate_limit_name", m.RateLimit.Name),
+			slog.Int("rate_limit_remaining", m.RateLimit.Remaining),
+		)
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// WithResponseMeta returns a copy of ctx whose calls fill meta when they return, also when they fail.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ContextResponseMeta, meta)
+}
+
+// ResponseMetaFromResponse returns the metadata of the call which returned the given response.
+func ResponseMetaFromResponse(resp *http.Response) (ResponseMeta, bool) {
+	if resp == nil || resp.Request == nil {
+		return ResponseMeta{}, false
+	}
+	meta, ok := resp.Request.Context().Value(ContextResponseMeta).(*ResponseMeta)
+	if !ok || meta == nil {
+		return ResponseMeta{}, false
+	}
+	return *meta, true
+}
+
+// AttemptResponseMeta returns the metadata of the response to an attempt of a call, as received by a
+// ResponseInterceptor. The latency is left unset.
+func AttemptResponseMeta(resp *http.Response, info InterceptorInfo) ResponseMeta {
+	return newResponseMeta(info.OperationID, resp, info.Attempt-1, 0)
+}
+
+// newResponseMeta returns the metadata of a call, given its last response, if any.
+func newResponseMeta(operationID string, resp *http.Response, retries int, latency time.Duration) ResponseMeta {
+	meta := ResponseMeta{
+		OperationID: operationID,
+		Retries:     retries,
+		Latency:     latency,
+	}
+	if resp == nil {
+		return meta
+	}
+	meta.StatusCode = resp.StatusCode
+	meta.RequestID = requestIDFromHeader(resp.Header)
+	if bucket, ok := rateLimitFromHeader(resp.Header, operationID); ok {
+		meta.RateLimit = &bucket
+	}
+	meta.ServerTiming = parseServerTiming(resp.Header.Values("Server-Timing"))
+	return meta
+}
+
+// setResponseMeta makes the metadata of a call available from its response and its context.
+func setResponseMeta(ctx context.Context, resp *http.Response, meta ResponseMeta) {
+	if holder, ok := ctx.Value(ContextResponseMeta).(*ResponseMeta); ok && holder != nil {
+		*holder = meta
+	}
+	if resp != nil && resp.Request != nil {
+		resp.Request = resp.Request.WithContext(context.WithValue(resp.Request.Context(), ContextResponseMeta, &meta))
+	}
+}
+
+// parseServerTiming parses the values of Server-Timing headers, e.g. `db;dur=53, app;dur=47.2;desc="App"`.
+func parseServerTiming(values []string) []ServerTiming {
+	var timings []ServerTiming
+	for _, value := range values {
+		for _, metric := range strings.Split(value, ",") {
+			params := strings.Split(metric, ";")
+			timing := ServerTiming{Name: strings.TrimSpace(params[0])}
+			if timing.Name == "" {
+				continue
+			}
+			for _, param := range params[1:] {
+				key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				switch strings.ToLower(strings.TrimSpace(key)) {
+				case "dur":
+					if ms, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+						timing.Duration = time.Duration(ms * float64(time.Millisecond))
+					}
+				case "desc":
+					timing.Description = strings.Trim(strings.TrimSpace(value), `"`)
+				}
+			}
+			timings = append(timings, timing)
+		}
+	}
+	return timings
+}
@@ -0,0 +1,131 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy decides whether a failed attempt is retried and how long to wait before the next one.
+// It is consulted by APIClient.CallAPI when RetryConfiguration.EnableRetry is true. Without a RetryPolicy,
+// CallAPI retries rate-limited requests and the server errors of idempotent operations with the back-off of
+// RetryConfiguration, but not transport errors.
+type RetryPolicy interface {
+	// ShouldRetry receives the response of the attempt, or the transport error if no response was received.
+	ShouldRetry(req *http.Request, resp *http.Response, err error, info InterceptorInfo) (time.Duration, bool)
+}
+
+// DefaultRetryPolicy retries rate-limited requests, server errors and transient transport errors
+// with an exponential back-off and full jitter. Server errors and transport errors that may have
+// reached the server are only retried for idempotent operations.
+type DefaultRetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// BackOffBase is the back-off ceiling of the first retry.
+	BackOffBase time.Duration
+	// BackOffMultiplier is applied to the back-off ceiling on every retry.
+	BackOffMultiplier float64
+	// MaxBackOff caps the back-off ceiling. Zero means no cap.
+	MaxBackOff time.Duration
+	// RetryTransportErrors enables retries of transient network errors.
+	RetryTransportErrors bool
+}
+
+// NewDefaultRetryPolicy returns a DefaultRetryPolicy with the back-off settings of the given retry configuration.
+func NewDefaultRetryPolicy(cfg RetryConfiguration) *DefaultRetryPolicy {
+	return &DefaultRetryPolicy{
+		MaxRetries:           cfg.MaxRetries,
+		BackOffBase:          time.Duration(cfg.BackOffBase * float64(time.Second)),
+		BackOffMultiplier:    cfg.BackOffMultiplier,
+		MaxBackOff:           cfg.HTTPRetryTimeout,
+		RetryTransportErrors: true,
+	}
+}
+
+// ShouldRetry implements RetryPolicy.
+func (p *DefaultRetryPolicy) ShouldRetry(req *http.Request, resp *http.Response, err error, info InterceptorInfo) (time.Duration, bool) {
+	if info.Attempt > p.MaxRetries {
+		return 0, false
+	}
+	if err != nil {
+		if !p.RetryTransportErrors || req.Context().Err() != nil {
+			return 0, false
+		}
+		// A refused connection never reached the server, so it is safe to retry any operation.
+		if errors.Is(err, syscall.ECONNREFUSED) || (info.Idempotent && isTransientError(err)) {
+			return p.backOff(info.Attempt), true
+		}
+		return 0, false
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		// Rate-limited requests were not processed and can always be retried.
+		if d, ok := retryAfter(resp.Header); ok {
+			return d, true
+		}
+		if v, err := strconv.ParseInt(resp.Header.Get(rateLimitResetHeader), 10, 64); err == nil {
+			return time.Duration(v) * time.Second, true
+		}
+		return p.backOff(info.Attempt), true
+	case resp.StatusCode >= 500 && info.Idempotent:
+		if d, ok := retryAfter(resp.Header); ok {
+			return d, true
+		}
+		return p.backOff(info.Attempt), true
+	}
+	return 0, false
+}
+
+// backOff returns a random duration between zero and the exponential back-off ceiling of the attempt.
+func (p *DefaultRetryPolicy) backOff(attempt int) time.Duration {
+	ceiling := float64(p.BackOffBase) * math.Pow(p.BackOffMultiplier, float64(attempt-1))
+	if p.MaxBackOff > 0 {
+		ceiling = math.Min(ceiling, float64(p.MaxBackOff))
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// retryAfter parses the Retry-After header, expressed either in seconds or as an HTTP date.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	v := header.Get(retryAfterHeader)
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isTransientError returns true for network errors that may succeed when retried.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
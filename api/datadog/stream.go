@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// itemStream decodes the items of an array field of a JSON response one at a time.
+type itemStream struct {
+	field  string
+	decode func(dec *jsonDecoder) error
+}
+
+// WithItemStream returns a context in which the items of the given top-level array field of a
+// successful JSON response are decoded one at a time and passed to fn, instead of being buffered
+// with the rest of the body. The field is left empty in the decoded response.
+// Decoding stops at the first error returned by fn, which is returned by the operation.
+// It is used by the generated *Stream methods.
+func WithItemStream[T any](ctx context.Context, field string, fn func(T) error) context.Context {
+	return context.WithValue(ctx, ContextItemStream, &itemStream{
+		field: field,
+		decode: func(dec *jsonDecoder) error {
+			var item T
+			if err := dec.Decode(&item); err != nil {
+				return err
+			}
+			return fn(item)
+		},
+	})
+}
+
+// streamBody streams the items of the response body and returns the rest of the JSON object.
+func (s *itemStream) streamBody(body io.Reader) ([]byte, error) {
+	dec := NewDecoder(body)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	var rest bytes.Buffer
+	rest.WriteByte('{')
+	found := false
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected token %v in response object", token)
+		}
+		if rest.Len() > 1 {
+			rest.WriteByte(',')
+		}
+		name, _ := Marshal(key)
+		rest.Write(name)
+		rest.WriteByte(':')
+
+		if key != s.field || found {
+			var raw jsonRawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, err
+			}
+			rest.Write(raw)
+			continue
+		}
+		found = true
+		// Keep an empty array in place of the items, so that required fields are still set.
+		rest.WriteString("[]")
+		if err := s.streamItems(dec); err != nil {
+			return nil, err
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	rest.WriteByte('}')
+	return rest.Bytes(), nil
+}
+
+func (s *itemStream) streamItems(dec *jsonDecoder) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if delim, ok := token.(jsonDelim); !ok || delim != '[' {
+		return fmt.Errorf("expected array for field %s, got %v", s.field, token)
+	}
+	for dec.More() {
+		if err := s.decode(dec); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *jsonDecoder, expected jsonDelim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(jsonDelim); !ok || delim != expected {
+		return fmt.Errorf("expected %v in response body, got %v", expected, token)
+	}
+	return nil
+}
+
+// itemStreamFromResponse returns the item stream of the request, if the response can be streamed.
+func itemStreamFromResponse(response *http.Response) *itemStream {
+	if response.Request == nil || response.StatusCode >= 300 {
+		return nil
+	}
+	if !strings.Contains(response.Header.Get("Content-Type"), "json") {
+		return nil
+	}
+	stream, _ := response.Request.Context().Value(ContextItemStream).(*itemStream)
+	return stream
+}
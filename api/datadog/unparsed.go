@@ -0,0 +1,112 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Unparsed is a part of a decoded value that did not fit the models: an object or array stored in an
+// UnparsedObject field, e.g. a widget definition of a type added to the API after the client was generated,
+// or an unknown field stored in AdditionalProperties.
+type Unparsed struct {
+	// Path is the JSON path of the value, e.g. "$.widgets[2].definition".
+	Path string
+	// Value is the raw value.
+	Value interface{}
+	// AdditionalProperty is true if the value is an unknown field stored in AdditionalProperties, and
+	// false if it is stored in UnparsedObject.
+	AdditionalProperty bool
+}
+
+// UnparsedError is returned by APIClient.Decode when Configuration.StrictDecoding is set and parts of the
+// response did not fit the models. The decoded value is still returned with it.
+type UnparsedError struct {
+	Unparsed []Unparsed
+}
+
+func (e *UnparsedError) Error() string {
+	paths := make([]string, len(e.Unparsed))
+	for i, unparsed := range e.Unparsed {
+		paths[i] = unparsed.Path
+	}
+	return fmt.Sprintf("strict decoding: %d values did not fit the models: %s", len(paths), strings.Join(paths, ", "))
+}
+
+// FindUnparsed walks a decoded value, following pointers, slices, maps and nullable values, and returns the
+// parts that did not fit the models, in the order of the fields of the models.
+func FindUnparsed(v interface{}) []Unparsed {
+	if v == nil {
+		return nil
+	}
+	var found []Unparsed
+	findUnparsed(reflect.ValueOf(v), "$", &found)
+	return found
+}
+
+func findUnparsed(value reflect.Value, path string, found *[]Unparsed) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			findUnparsed(value.Index(i), fmt.Sprintf("%s[%d]", path, i), found)
+		}
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface()) })
+		for _, key := range keys {
+			findUnparsed(value.MapIndex(key), path+"."+fmt.Sprint(key.Interface()), found)
+		}
+	case reflect.Struct:
+		findUnparsedInStruct(value, path, found)
+	}
+}
+
+func findUnparsedInStruct(value reflect.Value, path string, found *[]Unparsed) {
+	// Nullable values hold the value in an unexported field, returned by Get.
+	if get, isSet := value.MethodByName("Get"), value.MethodByName("IsSet"); get.IsValid() && isSet.IsValid() && get.Type().NumIn() == 0 && get.Type().NumOut() == 1 {
+		findUnparsed(get.Call(nil)[0], path, found)
+		return
+	}
+	if unparsed := value.FieldByName("UnparsedObject"); unparsed.IsValid() && unparsed.CanInterface() && !unparsed.IsZero() {
+		*found = append(*found, Unparsed{Path: path, Value: unparsed.Interface()})
+		return
+	}
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if !field.IsExported() || field.Name == "UnparsedObject" {
+			continue
+		}
+		if field.Name == "AdditionalProperties" {
+			additional := value.Field(i)
+			if additional.Kind() != reflect.Map {
+				continue
+			}
+			keys := additional.MapKeys()
+			sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+			for _, key := range keys {
+				*found = append(*found, Unparsed{Path: path + "." + key.String(), Value: additional.MapIndex(key).Interface(), AdditionalProperty: true})
+			}
+			continue
+		}
+		fieldPath := path
+		// The fields of oneOf models have no JSON name: their value is the value of the model.
+		if name := strings.Split(field.Tag.Get("json"), ",")[0]; name == "-" {
+			continue
+		} else if name != "" {
+			fieldPath = path + "." + name
+		}
+		findUnparsed(value.Field(i), fieldPath, found)
+	}
+}
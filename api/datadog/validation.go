@@ -0,0 +1,236 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+package datadog
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Validator is implemented by the generated models, to check their values against the constraints of the spec
+// before they are sent.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError is a value breaking a constraint of the spec. Validate methods return them joined with
+// errors.Join, so that they can be listed with errors.As or by unwrapping the joined error.
+type ValidationError struct {
+	// Field is the path of the value in the request body, e.g. "data.attributes.tags[2]".
+	Field string
+	// Message describes the broken constraint.
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Constraints are the constraints of the spec on a value, checked by ValidateValue. Nil fields are not checked.
+type Constraints struct {
+	// MinLength and MaxLength bound the number of characters of a string.
+	MinLength *int64
+	MaxLength *int64
+	// Pattern is a regular expression strings must match. Patterns not supported by the regexp package are ignored.
+	Pattern string
+	// Minimum and Maximum bound a number, excluding the bound itself if ExclusiveMinimum or ExclusiveMaximum is set.
+	Minimum          *float64
+	ExclusiveMinimum bool
+	Maximum          *float64
+	ExclusiveMaximum bool
+	// MinItems and MaxItems bound the number of items of an array.
+	MinItems *int64
+	MaxItems *int64
+	// Items are the constraints on the items of an array.
+	Items *Constraints
+}
+
+// nullable is implemented by the Nullable types, whose Get method returns a pointer to their value.
+type nullable interface {
+	IsSet() bool
+}
+
+var (
+	validatorType = reflect.TypeOf((*Validator)(nil)).Elem()
+	patterns      sync.Map
+)
+
+// ValidateValue checks a value against the given constraints, which may be nil, and validates it if it
+// implements Validator. Pointers are followed, nil pointers and slices are not checked, the value of a Nullable
+// type is checked only when it is set and not null, and the items of slices and maps are checked with
+// Constraints.Items and validated too.
+func ValidateValue(field string, value interface{}, constraints *Constraints) error {
+	if value == nil {
+		return nil
+	}
+	return validateValue(field, reflect.ValueOf(value), constraints)
+}
+
+func validateValue(field string, value reflect.Value, constraints *Constraints) error {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		if validator, ok := value.Interface().(Validator); ok {
+			return prefixValidationErrors(field, validator.Validate())
+		}
+		value = value.Elem()
+	}
+	if nullable, ok := asNullable(value); ok {
+		if !nullable.IsSet() {
+			return nil
+		}
+		return validateValue(field, value.MethodByName("Get").Call(nil)[0], constraints)
+	}
+	if validator, ok := asValidator(value); ok {
+		return prefixValidationErrors(field, validator.Validate())
+	}
+	if constraints == nil {
+		constraints = &Constraints{}
+	}
+
+	var errs []error
+	invalid := func(format string, args ...interface{}) {
+		errs = append(errs, &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+	switch value.Kind() {
+	case reflect.String:
+		s := value.String()
+		length := int64(utf8.RuneCountInString(s))
+		if constraints.MinLength != nil && length < *constraints.MinLength {
+			invalid("length must be at least %d, got %d", *constraints.MinLength, length)
+		}
+		if constraints.MaxLength != nil && length > *constraints.MaxLength {
+			invalid("length must be at most %d, got %d", *constraints.MaxLength, length)
+		}
+		if constraints.Pattern != "" {
+			if pattern := compilePattern(constraints.Pattern); pattern != nil && !pattern.MatchString(s) {
+				invalid("must match the pattern %s", constraints.Pattern)
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		validateNumber(float64(value.Int()), constraints, invalid)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		validateNumber(float64(value.Uint()), constraints, invalid)
+	case reflect.Float32, reflect.Float64:
+		validateNumber(value.Float(), constraints, invalid)
+	case reflect.Slice:
+		if value.IsNil() {
+			return nil
+		}
+		length := int64(value.Len())
+		if constraints.MinItems != nil && length < *constraints.MinItems {
+			invalid("must have at least %d items, got %d", *constraints.MinItems, length)
+		}
+		if constraints.MaxItems != nil && length > *constraints.MaxItems {
+			invalid("must have at most %d items, got %d", *constraints.MaxItems, length)
+		}
+		for i := 0; i < value.Len(); i++ {
+			errs = append(errs, validateValue(fmt.Sprintf("%s[%d]", field, i), value.Index(i), constraints.Items))
+		}
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			errs = append(errs, validateValue(joinField(field, fmt.Sprint(iter.Key().Interface())), iter.Value(), constraints.Items))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateNumber(number float64, constraints *Constraints, invalid func(format string, args ...interface{})) {
+	if minimum := constraints.Minimum; minimum != nil {
+		if constraints.ExclusiveMinimum && number <= *minimum {
+			invalid("must be greater than %v, got %v", *minimum, number)
+		} else if number < *minimum {
+			invalid("must be greater than or equal to %v, got %v", *minimum, number)
+		}
+	}
+	if maximum := constraints.Maximum; maximum != nil {
+		if constraints.ExclusiveMaximum && number >= *maximum {
+			invalid("must be less than %v, got %v", *maximum, number)
+		} else if number > *maximum {
+			invalid("must be less than or equal to %v, got %v", *maximum, number)
+		}
+	}
+}
+
+// asValidator returns the value as a Validator, also when only its pointer implements it.
+func asValidator(value reflect.Value) (Validator, bool) {
+	if !value.IsValid() {
+		return nil, false
+	}
+	if validator, ok := value.Interface().(Validator); ok {
+		return validator, true
+	}
+	if reflect.PointerTo(value.Type()).Implements(validatorType) {
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+		return pointer.Interface().(Validator), true
+	}
+	return nil, false
+}
+
+// asNullable returns the value as a nullable if it is a Nullable type.
+func asNullable(value reflect.Value) (nullable, bool) {
+	if value.Kind() != reflect.Struct || !value.MethodByName("Get").IsValid() {
+		return nil, false
+	}
+	nullable, ok := value.Interface().(nullable)
+	return nullable, ok
+}
+
+// compilePattern returns the compiled pattern, or nil if it is not supported.
+func compilePattern(pattern string) *regexp.Regexp {
+	if compiled, ok := patterns.Load(pattern); ok {
+		return compiled.(*regexp.Regexp)
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		compiled = nil
+	}
+	patterns.Store(pattern, compiled)
+	return compiled
+}
+
+// prefixValidationErrors prefixes the fields of the validation errors of a nested value with its path.
+func prefixValidationErrors(field string, err error) error {
+	if err == nil || field == "" {
+		return err
+	}
+	var validationError *ValidationError
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs := joined.Unwrap()
+		prefixed := make([]error, len(errs))
+		for i, err := range errs {
+			prefixed[i] = prefixValidationErrors(field, err)
+		}
+		return errors.Join(prefixed...)
+	} else if errors.As(err, &validationError) {
+		return &ValidationError{Field: joinField(field, validationError.Field), Message: validationError.Message}
+	}
+	return &ValidationError{Field: field, Message: err.Error()}
+}
+
+// joinField returns the path of a field or item of a value.
+func joinField(parent, field string) string {
+	switch {
+	case parent == "":
+		return field
+	case field == "":
+		return parent
+	case strings.HasPrefix(field, "["):
+		return parent + field
+	default:
+		return parent + "." + field
+	}
+}
@@ -24,6 +24,7 @@ func (a *AuthenticationApi) Validate(ctx _context.Context) (AuthenticationValida
 		localVarReturnValue AuthenticationValidationResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AuthenticationApi.Validate")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AuthenticationApi.Validate")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -57,13 +58,10 @@ func (a *AuthenticationApi) Validate(ctx _context.Context) (AuthenticationValida
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -77,6 +75,7 @@ func (a *AuthenticationApi) Validate(ctx _context.Context) (AuthenticationValida
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -84,6 +83,13 @@ func (a *AuthenticationApi) Validate(ctx _context.Context) (AuthenticationValida
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// AuthenticationApiInterface lists the operations of AuthenticationApi, so that code depending on it can be tested with mocks.
+type AuthenticationApiInterface interface {
+	Validate(ctx _context.Context) (AuthenticationValidationResponse, *_nethttp.Response, error)
+}
+
+var _ AuthenticationApiInterface = (*AuthenticationApi)(nil)
+
 // NewAuthenticationApi Returns NewAuthenticationApi.
 func NewAuthenticationApi(client *datadog.APIClient) *AuthenticationApi {
 	return &AuthenticationApi{
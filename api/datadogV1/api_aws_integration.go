@@ -27,6 +27,7 @@ func (a *AWSIntegrationApi) CreateAWSAccount(ctx _context.Context, body AWSAccou
 		localVarReturnValue AWSAccountCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.CreateAWSAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.CreateAWSAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -64,13 +65,10 @@ func (a *AWSIntegrationApi) CreateAWSAccount(ctx _context.Context, body AWSAccou
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -84,6 +82,7 @@ func (a *AWSIntegrationApi) CreateAWSAccount(ctx _context.Context, body AWSAccou
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -100,6 +99,7 @@ func (a *AWSIntegrationApi) CreateAWSEventBridgeSource(ctx _context.Context, bod
 		localVarReturnValue AWSEventBridgeCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.CreateAWSEventBridgeSource")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.CreateAWSEventBridgeSource")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -137,13 +137,10 @@ func (a *AWSIntegrationApi) CreateAWSEventBridgeSource(ctx _context.Context, bod
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -157,6 +154,7 @@ func (a *AWSIntegrationApi) CreateAWSEventBridgeSource(ctx _context.Context, bod
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -173,6 +171,7 @@ func (a *AWSIntegrationApi) CreateAWSTagFilter(ctx _context.Context, body AWSTag
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.CreateAWSTagFilter")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.CreateAWSTagFilter")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -210,13 +209,10 @@ func (a *AWSIntegrationApi) CreateAWSTagFilter(ctx _context.Context, body AWSTag
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -230,6 +226,7 @@ func (a *AWSIntegrationApi) CreateAWSTagFilter(ctx _context.Context, body AWSTag
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -246,6 +243,7 @@ func (a *AWSIntegrationApi) CreateNewAWSExternalID(ctx _context.Context, body AW
 		localVarReturnValue AWSAccountCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.CreateNewAWSExternalID")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.CreateNewAWSExternalID")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -283,13 +281,10 @@ func (a *AWSIntegrationApi) CreateNewAWSExternalID(ctx _context.Context, body AW
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -303,6 +298,7 @@ func (a *AWSIntegrationApi) CreateNewAWSExternalID(ctx _context.Context, body AW
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -319,6 +315,7 @@ func (a *AWSIntegrationApi) DeleteAWSAccount(ctx _context.Context, body AWSAccou
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.DeleteAWSAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.DeleteAWSAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -356,13 +353,10 @@ func (a *AWSIntegrationApi) DeleteAWSAccount(ctx _context.Context, body AWSAccou
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -376,6 +370,7 @@ func (a *AWSIntegrationApi) DeleteAWSAccount(ctx _context.Context, body AWSAccou
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -392,6 +387,7 @@ func (a *AWSIntegrationApi) DeleteAWSEventBridgeSource(ctx _context.Context, bod
 		localVarReturnValue AWSEventBridgeDeleteResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.DeleteAWSEventBridgeSource")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.DeleteAWSEventBridgeSource")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -429,13 +425,10 @@ func (a *AWSIntegrationApi) DeleteAWSEventBridgeSource(ctx _context.Context, bod
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -449,6 +442,7 @@ func (a *AWSIntegrationApi) DeleteAWSEventBridgeSource(ctx _context.Context, bod
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -465,6 +459,7 @@ func (a *AWSIntegrationApi) DeleteAWSTagFilter(ctx _context.Context, body AWSTag
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.DeleteAWSTagFilter")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.DeleteAWSTagFilter")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -502,13 +497,10 @@ func (a *AWSIntegrationApi) DeleteAWSTagFilter(ctx _context.Context, body AWSTag
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -522,6 +514,7 @@ func (a *AWSIntegrationApi) DeleteAWSTagFilter(ctx _context.Context, body AWSTag
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -577,6 +570,7 @@ func (a *AWSIntegrationApi) ListAWSAccounts(ctx _context.Context, o ...ListAWSAc
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.ListAWSAccounts")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.ListAWSAccounts")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -620,13 +614,10 @@ func (a *AWSIntegrationApi) ListAWSAccounts(ctx _context.Context, o ...ListAWSAc
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -640,6 +631,7 @@ func (a *AWSIntegrationApi) ListAWSAccounts(ctx _context.Context, o ...ListAWSAc
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -656,6 +648,7 @@ func (a *AWSIntegrationApi) ListAWSEventBridgeSources(ctx _context.Context) (AWS
 		localVarReturnValue AWSEventBridgeListResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.ListAWSEventBridgeSources")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.ListAWSEventBridgeSources")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -690,13 +683,10 @@ func (a *AWSIntegrationApi) ListAWSEventBridgeSources(ctx _context.Context) (AWS
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -710,6 +700,7 @@ func (a *AWSIntegrationApi) ListAWSEventBridgeSources(ctx _context.Context) (AWS
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -726,6 +717,7 @@ func (a *AWSIntegrationApi) ListAWSTagFilters(ctx _context.Context, accountId st
 		localVarReturnValue AWSTagFilterListResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.ListAWSTagFilters")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.ListAWSTagFilters")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -761,13 +753,10 @@ func (a *AWSIntegrationApi) ListAWSTagFilters(ctx _context.Context, accountId st
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -781,6 +770,7 @@ func (a *AWSIntegrationApi) ListAWSTagFilters(ctx _context.Context, accountId st
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -797,6 +787,7 @@ func (a *AWSIntegrationApi) ListAvailableAWSNamespaces(ctx _context.Context) ([]
 		localVarReturnValue []string
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.ListAvailableAWSNamespaces")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.ListAvailableAWSNamespaces")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -831,13 +822,10 @@ func (a *AWSIntegrationApi) ListAvailableAWSNamespaces(ctx _context.Context) ([]
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -851,6 +839,7 @@ func (a *AWSIntegrationApi) ListAvailableAWSNamespaces(ctx _context.Context) ([]
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -906,6 +895,7 @@ func (a *AWSIntegrationApi) UpdateAWSAccount(ctx _context.Context, body AWSAccou
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSIntegrationApi.UpdateAWSAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSIntegrationApi.UpdateAWSAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -952,13 +942,10 @@ func (a *AWSIntegrationApi) UpdateAWSAccount(ctx _context.Context, body AWSAccou
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -972,6 +959,7 @@ func (a *AWSIntegrationApi) UpdateAWSAccount(ctx _context.Context, body AWSAccou
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -979,6 +967,24 @@ func (a *AWSIntegrationApi) UpdateAWSAccount(ctx _context.Context, body AWSAccou
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// AWSIntegrationApiInterface lists the operations of AWSIntegrationApi, so that code depending on it can be tested with mocks.
+type AWSIntegrationApiInterface interface {
+	CreateAWSAccount(ctx _context.Context, body AWSAccount) (AWSAccountCreateResponse, *_nethttp.Response, error)
+	CreateAWSEventBridgeSource(ctx _context.Context, body AWSEventBridgeCreateRequest) (AWSEventBridgeCreateResponse, *_nethttp.Response, error)
+	CreateAWSTagFilter(ctx _context.Context, body AWSTagFilterCreateRequest) (interface{}, *_nethttp.Response, error)
+	CreateNewAWSExternalID(ctx _context.Context, body AWSAccount) (AWSAccountCreateResponse, *_nethttp.Response, error)
+	DeleteAWSAccount(ctx _context.Context, body AWSAccountDeleteRequest) (interface{}, *_nethttp.Response, error)
+	DeleteAWSEventBridgeSource(ctx _context.Context, body AWSEventBridgeDeleteRequest) (AWSEventBridgeDeleteResponse, *_nethttp.Response, error)
+	DeleteAWSTagFilter(ctx _context.Context, body AWSTagFilterDeleteRequest) (interface{}, *_nethttp.Response, error)
+	ListAWSAccounts(ctx _context.Context, o ...ListAWSAccountsOptionalParameters) (AWSAccountListResponse, *_nethttp.Response, error)
+	ListAWSEventBridgeSources(ctx _context.Context) (AWSEventBridgeListResponse, *_nethttp.Response, error)
+	ListAWSTagFilters(ctx _context.Context, accountId string) (AWSTagFilterListResponse, *_nethttp.Response, error)
+	ListAvailableAWSNamespaces(ctx _context.Context) ([]string, *_nethttp.Response, error)
+	UpdateAWSAccount(ctx _context.Context, body AWSAccount, o ...UpdateAWSAccountOptionalParameters) (interface{}, *_nethttp.Response, error)
+}
+
+var _ AWSIntegrationApiInterface = (*AWSIntegrationApi)(nil)
+
 // NewAWSIntegrationApi Returns NewAWSIntegrationApi.
 func NewAWSIntegrationApi(client *datadog.APIClient) *AWSIntegrationApi {
 	return &AWSIntegrationApi{
@@ -31,6 +31,7 @@ func (a *AWSLogsIntegrationApi) CheckAWSLogsLambdaAsync(ctx _context.Context, bo
 		localVarReturnValue AWSLogsAsyncResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSLogsIntegrationApi.CheckAWSLogsLambdaAsync")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSLogsIntegrationApi.CheckAWSLogsLambdaAsync")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -68,13 +69,10 @@ func (a *AWSLogsIntegrationApi) CheckAWSLogsLambdaAsync(ctx _context.Context, bo
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -88,6 +86,7 @@ func (a *AWSLogsIntegrationApi) CheckAWSLogsLambdaAsync(ctx _context.Context, bo
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -113,6 +112,7 @@ func (a *AWSLogsIntegrationApi) CheckAWSLogsServicesAsync(ctx _context.Context,
 		localVarReturnValue AWSLogsAsyncResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSLogsIntegrationApi.CheckAWSLogsServicesAsync")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSLogsIntegrationApi.CheckAWSLogsServicesAsync")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -150,13 +150,10 @@ func (a *AWSLogsIntegrationApi) CheckAWSLogsServicesAsync(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -170,6 +167,7 @@ func (a *AWSLogsIntegrationApi) CheckAWSLogsServicesAsync(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -186,6 +184,7 @@ func (a *AWSLogsIntegrationApi) CreateAWSLambdaARN(ctx _context.Context, body AW
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSLogsIntegrationApi.CreateAWSLambdaARN")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSLogsIntegrationApi.CreateAWSLambdaARN")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -223,13 +222,10 @@ func (a *AWSLogsIntegrationApi) CreateAWSLambdaARN(ctx _context.Context, body AW
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -243,6 +239,7 @@ func (a *AWSLogsIntegrationApi) CreateAWSLambdaARN(ctx _context.Context, body AW
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -259,6 +256,7 @@ func (a *AWSLogsIntegrationApi) DeleteAWSLambdaARN(ctx _context.Context, body AW
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSLogsIntegrationApi.DeleteAWSLambdaARN")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSLogsIntegrationApi.DeleteAWSLambdaARN")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -296,13 +294,10 @@ func (a *AWSLogsIntegrationApi) DeleteAWSLambdaARN(ctx _context.Context, body AW
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -316,6 +311,7 @@ func (a *AWSLogsIntegrationApi) DeleteAWSLambdaARN(ctx _context.Context, body AW
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -332,6 +328,7 @@ func (a *AWSLogsIntegrationApi) EnableAWSLogServices(ctx _context.Context, body
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSLogsIntegrationApi.EnableAWSLogServices")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSLogsIntegrationApi.EnableAWSLogServices")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -369,13 +366,10 @@ func (a *AWSLogsIntegrationApi) EnableAWSLogServices(ctx _context.Context, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -389,6 +383,7 @@ func (a *AWSLogsIntegrationApi) EnableAWSLogServices(ctx _context.Context, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -405,6 +400,7 @@ func (a *AWSLogsIntegrationApi) ListAWSLogsIntegrations(ctx _context.Context) ([
 		localVarReturnValue []AWSLogsListResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSLogsIntegrationApi.ListAWSLogsIntegrations")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSLogsIntegrationApi.ListAWSLogsIntegrations")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -439,13 +435,10 @@ func (a *AWSLogsIntegrationApi) ListAWSLogsIntegrations(ctx _context.Context) ([
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -459,6 +452,7 @@ func (a *AWSLogsIntegrationApi) ListAWSLogsIntegrations(ctx _context.Context) ([
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -475,6 +469,7 @@ func (a *AWSLogsIntegrationApi) ListAWSLogsServices(ctx _context.Context) ([]AWS
 		localVarReturnValue []AWSLogsListServicesResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AWSLogsIntegrationApi.ListAWSLogsServices")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AWSLogsIntegrationApi.ListAWSLogsServices")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -509,13 +504,10 @@ func (a *AWSLogsIntegrationApi) ListAWSLogsServices(ctx _context.Context) ([]AWS
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -529,6 +521,7 @@ func (a *AWSLogsIntegrationApi) ListAWSLogsServices(ctx _context.Context) ([]AWS
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -536,6 +529,19 @@ func (a *AWSLogsIntegrationApi) ListAWSLogsServices(ctx _context.Context) ([]AWS
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// AWSLogsIntegrationApiInterface lists the operations of AWSLogsIntegrationApi, so that code depending on it can be tested with mocks.
+type AWSLogsIntegrationApiInterface interface {
+	CheckAWSLogsLambdaAsync(ctx _context.Context, body AWSAccountAndLambdaRequest) (AWSLogsAsyncResponse, *_nethttp.Response, error)
+	CheckAWSLogsServicesAsync(ctx _context.Context, body AWSLogsServicesRequest) (AWSLogsAsyncResponse, *_nethttp.Response, error)
+	CreateAWSLambdaARN(ctx _context.Context, body AWSAccountAndLambdaRequest) (interface{}, *_nethttp.Response, error)
+	DeleteAWSLambdaARN(ctx _context.Context, body AWSAccountAndLambdaRequest) (interface{}, *_nethttp.Response, error)
+	EnableAWSLogServices(ctx _context.Context, body AWSLogsServicesRequest) (interface{}, *_nethttp.Response, error)
+	ListAWSLogsIntegrations(ctx _context.Context) ([]AWSLogsListResponse, *_nethttp.Response, error)
+	ListAWSLogsServices(ctx _context.Context) ([]AWSLogsListServicesResponse, *_nethttp.Response, error)
+}
+
+var _ AWSLogsIntegrationApiInterface = (*AWSLogsIntegrationApi)(nil)
+
 // NewAWSLogsIntegrationApi Returns NewAWSLogsIntegrationApi.
 func NewAWSLogsIntegrationApi(client *datadog.APIClient) *AWSLogsIntegrationApi {
 	return &AWSLogsIntegrationApi{
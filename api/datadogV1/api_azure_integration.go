@@ -30,6 +30,7 @@ func (a *AzureIntegrationApi) CreateAzureIntegration(ctx _context.Context, body
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AzureIntegrationApi.CreateAzureIntegration")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AzureIntegrationApi.CreateAzureIntegration")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -67,13 +68,10 @@ func (a *AzureIntegrationApi) CreateAzureIntegration(ctx _context.Context, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -87,6 +85,7 @@ func (a *AzureIntegrationApi) CreateAzureIntegration(ctx _context.Context, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -103,6 +102,7 @@ func (a *AzureIntegrationApi) DeleteAzureIntegration(ctx _context.Context, body
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AzureIntegrationApi.DeleteAzureIntegration")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AzureIntegrationApi.DeleteAzureIntegration")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -140,13 +140,10 @@ func (a *AzureIntegrationApi) DeleteAzureIntegration(ctx _context.Context, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -160,6 +157,7 @@ func (a *AzureIntegrationApi) DeleteAzureIntegration(ctx _context.Context, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -176,6 +174,7 @@ func (a *AzureIntegrationApi) ListAzureIntegration(ctx _context.Context) ([]Azur
 		localVarReturnValue []AzureAccount
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AzureIntegrationApi.ListAzureIntegration")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AzureIntegrationApi.ListAzureIntegration")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -210,13 +209,10 @@ func (a *AzureIntegrationApi) ListAzureIntegration(ctx _context.Context) ([]Azur
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -230,6 +226,7 @@ func (a *AzureIntegrationApi) ListAzureIntegration(ctx _context.Context) ([]Azur
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -246,6 +243,7 @@ func (a *AzureIntegrationApi) UpdateAzureHostFilters(ctx _context.Context, body
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AzureIntegrationApi.UpdateAzureHostFilters")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AzureIntegrationApi.UpdateAzureHostFilters")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -283,13 +281,10 @@ func (a *AzureIntegrationApi) UpdateAzureHostFilters(ctx _context.Context, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -303,6 +298,7 @@ func (a *AzureIntegrationApi) UpdateAzureHostFilters(ctx _context.Context, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -321,6 +317,7 @@ func (a *AzureIntegrationApi) UpdateAzureIntegration(ctx _context.Context, body
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.AzureIntegrationApi.UpdateAzureIntegration")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.AzureIntegrationApi.UpdateAzureIntegration")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -358,13 +355,10 @@ func (a *AzureIntegrationApi) UpdateAzureIntegration(ctx _context.Context, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -378,6 +372,7 @@ func (a *AzureIntegrationApi) UpdateAzureIntegration(ctx _context.Context, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -385,6 +380,17 @@ func (a *AzureIntegrationApi) UpdateAzureIntegration(ctx _context.Context, body
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// AzureIntegrationApiInterface lists the operations of AzureIntegrationApi, so that code depending on it can be tested with mocks.
+type AzureIntegrationApiInterface interface {
+	CreateAzureIntegration(ctx _context.Context, body AzureAccount) (interface{}, *_nethttp.Response, error)
+	DeleteAzureIntegration(ctx _context.Context, body AzureAccount) (interface{}, *_nethttp.Response, error)
+	ListAzureIntegration(ctx _context.Context) ([]AzureAccount, *_nethttp.Response, error)
+	UpdateAzureHostFilters(ctx _context.Context, body AzureAccount) (interface{}, *_nethttp.Response, error)
+	UpdateAzureIntegration(ctx _context.Context, body AzureAccount) (interface{}, *_nethttp.Response, error)
+}
+
+var _ AzureIntegrationApiInterface = (*AzureIntegrationApi)(nil)
+
 // NewAzureIntegrationApi Returns NewAzureIntegrationApi.
 func NewAzureIntegrationApi(client *datadog.APIClient) *AzureIntegrationApi {
 	return &AzureIntegrationApi{
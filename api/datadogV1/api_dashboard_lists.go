@@ -25,6 +25,7 @@ func (a *DashboardListsApi) CreateDashboardList(ctx _context.Context, body Dashb
 		localVarReturnValue DashboardList
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardListsApi.CreateDashboardList")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardListsApi.CreateDashboardList")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *DashboardListsApi) CreateDashboardList(ctx _context.Context, body Dashb
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *DashboardListsApi) CreateDashboardList(ctx _context.Context, body Dashb
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -98,6 +97,7 @@ func (a *DashboardListsApi) DeleteDashboardList(ctx _context.Context, listId int
 		localVarReturnValue DashboardListDeleteResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardListsApi.DeleteDashboardList")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardListsApi.DeleteDashboardList")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -133,13 +133,10 @@ func (a *DashboardListsApi) DeleteDashboardList(ctx _context.Context, listId int
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -153,6 +150,7 @@ func (a *DashboardListsApi) DeleteDashboardList(ctx _context.Context, listId int
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -169,6 +167,7 @@ func (a *DashboardListsApi) GetDashboardList(ctx _context.Context, listId int64)
 		localVarReturnValue DashboardList
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardListsApi.GetDashboardList")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardListsApi.GetDashboardList")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -204,13 +203,10 @@ func (a *DashboardListsApi) GetDashboardList(ctx _context.Context, listId int64)
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -224,6 +220,7 @@ func (a *DashboardListsApi) GetDashboardList(ctx _context.Context, listId int64)
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -240,6 +237,7 @@ func (a *DashboardListsApi) ListDashboardLists(ctx _context.Context) (DashboardL
 		localVarReturnValue DashboardListListResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardListsApi.ListDashboardLists")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardListsApi.ListDashboardLists")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -274,13 +272,10 @@ func (a *DashboardListsApi) ListDashboardLists(ctx _context.Context) (DashboardL
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -294,6 +289,7 @@ func (a *DashboardListsApi) ListDashboardLists(ctx _context.Context) (DashboardL
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -310,6 +306,7 @@ func (a *DashboardListsApi) UpdateDashboardList(ctx _context.Context, listId int
 		localVarReturnValue DashboardList
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardListsApi.UpdateDashboardList")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardListsApi.UpdateDashboardList")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -348,13 +345,10 @@ func (a *DashboardListsApi) UpdateDashboardList(ctx _context.Context, listId int
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -368,6 +362,7 @@ func (a *DashboardListsApi) UpdateDashboardList(ctx _context.Context, listId int
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -375,6 +370,17 @@ func (a *DashboardListsApi) UpdateDashboardList(ctx _context.Context, listId int
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// DashboardListsApiInterface lists the operations of DashboardListsApi, so that code depending on it can be tested with mocks.
+type DashboardListsApiInterface interface {
+	CreateDashboardList(ctx _context.Context, body DashboardList) (DashboardList, *_nethttp.Response, error)
+	DeleteDashboardList(ctx _context.Context, listId int64) (DashboardListDeleteResponse, *_nethttp.Response, error)
+	GetDashboardList(ctx _context.Context, listId int64) (DashboardList, *_nethttp.Response, error)
+	ListDashboardLists(ctx _context.Context) (DashboardListListResponse, *_nethttp.Response, error)
+	UpdateDashboardList(ctx _context.Context, listId int64, body DashboardList) (DashboardList, *_nethttp.Response, error)
+}
+
+var _ DashboardListsApiInterface = (*DashboardListsApi)(nil)
+
 // NewDashboardListsApi Returns NewDashboardListsApi.
 func NewDashboardListsApi(client *datadog.APIClient) *DashboardListsApi {
 	return &DashboardListsApi{
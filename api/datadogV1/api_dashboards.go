@@ -6,6 +6,7 @@ package datadogV1
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -26,6 +27,7 @@ func (a *DashboardsApi) CreateDashboard(ctx _context.Context, body Dashboard) (D
 		localVarReturnValue Dashboard
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.CreateDashboard")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.CreateDashboard")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -63,13 +65,10 @@ func (a *DashboardsApi) CreateDashboard(ctx _context.Context, body Dashboard) (D
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -83,6 +82,7 @@ func (a *DashboardsApi) CreateDashboard(ctx _context.Context, body Dashboard) (D
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -99,6 +99,7 @@ func (a *DashboardsApi) CreatePublicDashboard(ctx _context.Context, body SharedD
 		localVarReturnValue SharedDashboard
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.CreatePublicDashboard")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.CreatePublicDashboard")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -136,13 +137,10 @@ func (a *DashboardsApi) CreatePublicDashboard(ctx _context.Context, body SharedD
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -156,6 +154,7 @@ func (a *DashboardsApi) CreatePublicDashboard(ctx _context.Context, body SharedD
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -172,6 +171,7 @@ func (a *DashboardsApi) DeleteDashboard(ctx _context.Context, dashboardId string
 		localVarReturnValue DashboardDeleteResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.DeleteDashboard")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.DeleteDashboard")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -207,13 +207,10 @@ func (a *DashboardsApi) DeleteDashboard(ctx _context.Context, dashboardId string
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -227,6 +224,7 @@ func (a *DashboardsApi) DeleteDashboard(ctx _context.Context, dashboardId string
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -242,6 +240,7 @@ func (a *DashboardsApi) DeleteDashboards(ctx _context.Context, body DashboardBul
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.DeleteDashboards")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.DeleteDashboards")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -279,13 +278,10 @@ func (a *DashboardsApi) DeleteDashboards(ctx _context.Context, body DashboardBul
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -306,6 +302,7 @@ func (a *DashboardsApi) DeletePublicDashboard(ctx _context.Context, token string
 		localVarReturnValue DeleteSharedDashboardResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.DeletePublicDashboard")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.DeletePublicDashboard")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -341,13 +338,10 @@ func (a *DashboardsApi) DeletePublicDashboard(ctx _context.Context, token string
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -361,6 +355,7 @@ func (a *DashboardsApi) DeletePublicDashboard(ctx _context.Context, token string
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -376,6 +371,7 @@ func (a *DashboardsApi) DeletePublicDashboardInvitation(ctx _context.Context, to
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.DeletePublicDashboardInvitation")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.DeletePublicDashboardInvitation")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -414,13 +410,10 @@ func (a *DashboardsApi) DeletePublicDashboardInvitation(ctx _context.Context, to
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -441,6 +434,7 @@ func (a *DashboardsApi) GetDashboard(ctx _context.Context, dashboardId string) (
 		localVarReturnValue Dashboard
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.GetDashboard")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.GetDashboard")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -476,13 +470,10 @@ func (a *DashboardsApi) GetDashboard(ctx _context.Context, dashboardId string) (
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -496,6 +487,7 @@ func (a *DashboardsApi) GetDashboard(ctx _context.Context, dashboardId string) (
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -512,6 +504,7 @@ func (a *DashboardsApi) GetPublicDashboard(ctx _context.Context, token string) (
 		localVarReturnValue SharedDashboard
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.GetPublicDashboard")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.GetPublicDashboard")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -547,13 +540,10 @@ func (a *DashboardsApi) GetPublicDashboard(ctx _context.Context, token string) (
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -567,6 +557,7 @@ func (a *DashboardsApi) GetPublicDashboard(ctx _context.Context, token string) (
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -615,6 +606,7 @@ func (a *DashboardsApi) GetPublicDashboardInvitations(ctx _context.Context, toke
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.GetPublicDashboardInvitations")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.GetPublicDashboardInvitations")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -656,13 +648,10 @@ func (a *DashboardsApi) GetPublicDashboardInvitations(ctx _context.Context, toke
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -676,6 +665,7 @@ func (a *DashboardsApi) GetPublicDashboardInvitations(ctx _context.Context, toke
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -741,6 +731,7 @@ func (a *DashboardsApi) ListDashboards(ctx _context.Context, o ...ListDashboards
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.ListDashboards")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.ListDashboards")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -787,13 +778,10 @@ func (a *DashboardsApi) ListDashboards(ctx _context.Context, o ...ListDashboards
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -807,6 +795,7 @@ func (a *DashboardsApi) ListDashboards(ctx _context.Context, o ...ListDashboards
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -864,6 +853,54 @@ func (a *DashboardsApi) ListDashboardsWithPagination(ctx _context.Context, o ...
 	return items, cancel
 }
 
+// ListDashboardsSeq provides a paginated version of ListDashboards returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *DashboardsApi) ListDashboardsSeq(ctx _context.Context, o ...ListDashboardsOptionalParameters) iter.Seq2[DashboardSummaryDefinition, error] {
+	return a.listDashboardsPaginator(o...).Seq(ctx)
+}
+
+// ListDashboardsPrefetch provides a paginated version of ListDashboards returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *DashboardsApi) ListDashboardsPrefetch(ctx _context.Context, prefetch int, o ...ListDashboardsOptionalParameters) iter.Seq2[DashboardSummaryDefinition, error] {
+	paginator := a.listDashboardsPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *DashboardsApi) listDashboardsPaginator(o ...ListDashboardsOptionalParameters) datadog.Paginator[DashboardSummaryDefinition] {
+	var params ListDashboardsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 100}
+	if params.Count != nil {
+		first.Size = int64(*params.Count)
+	}
+	if params.Start != nil {
+		first.Offset = int64(*params.Start)
+	}
+	return datadog.Paginator[DashboardSummaryDefinition]{
+		Strategy: datadog.OffsetPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[DashboardSummaryDefinition], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.Count = &pageSize
+			}
+			pageOffset := int64(page.Offset)
+			request.Start = &pageOffset
+			resp, _, err := a.ListDashboards(ctx, request)
+			if err != nil {
+				return datadog.Page[DashboardSummaryDefinition]{}, err
+			}
+			return datadog.Page[DashboardSummaryDefinition]{
+				Items: resp.GetDashboards(),
+			}, nil
+		},
+	}
+}
+
 // RestoreDashboards Restore deleted dashboards.
 // Restore dashboards using the specified IDs. If there are any failures, no dashboards will be restored (partial success is not allowed).
 func (a *DashboardsApi) RestoreDashboards(ctx _context.Context, body DashboardRestoreRequest) (*_nethttp.Response, error) {
@@ -872,6 +909,7 @@ func (a *DashboardsApi) RestoreDashboards(ctx _context.Context, body DashboardRe
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.RestoreDashboards")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.RestoreDashboards")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -909,13 +947,10 @@ func (a *DashboardsApi) RestoreDashboards(ctx _context.Context, body DashboardRe
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -936,6 +971,7 @@ func (a *DashboardsApi) SendPublicDashboardInvitation(ctx _context.Context, toke
 		localVarReturnValue SharedDashboardInvites
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.SendPublicDashboardInvitation")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.SendPublicDashboardInvitation")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -974,13 +1010,10 @@ func (a *DashboardsApi) SendPublicDashboardInvitation(ctx _context.Context, toke
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -994,6 +1027,7 @@ func (a *DashboardsApi) SendPublicDashboardInvitation(ctx _context.Context, toke
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1010,6 +1044,7 @@ func (a *DashboardsApi) UpdateDashboard(ctx _context.Context, dashboardId string
 		localVarReturnValue Dashboard
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.UpdateDashboard")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.UpdateDashboard")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1048,13 +1083,10 @@ func (a *DashboardsApi) UpdateDashboard(ctx _context.Context, dashboardId string
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1068,6 +1100,7 @@ func (a *DashboardsApi) UpdateDashboard(ctx _context.Context, dashboardId string
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1084,6 +1117,7 @@ func (a *DashboardsApi) UpdatePublicDashboard(ctx _context.Context, token string
 		localVarReturnValue SharedDashboard
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.DashboardsApi.UpdatePublicDashboard")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.UpdatePublicDashboard")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1122,13 +1156,10 @@ func (a *DashboardsApi) UpdatePublicDashboard(ctx _context.Context, token string
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1142,6 +1173,7 @@ func (a *DashboardsApi) UpdatePublicDashboard(ctx _context.Context, token string
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1149,6 +1181,29 @@ func (a *DashboardsApi) UpdatePublicDashboard(ctx _context.Context, token string
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// DashboardsApiInterface lists the operations of DashboardsApi, so that code depending on it can be tested with mocks.
+type DashboardsApiInterface interface {
+	CreateDashboard(ctx _context.Context, body Dashboard) (Dashboard, *_nethttp.Response, error)
+	CreatePublicDashboard(ctx _context.Context, body SharedDashboard) (SharedDashboard, *_nethttp.Response, error)
+	DeleteDashboard(ctx _context.Context, dashboardId string) (DashboardDeleteResponse, *_nethttp.Response, error)
+	DeleteDashboards(ctx _context.Context, body DashboardBulkDeleteRequest) (*_nethttp.Response, error)
+	DeletePublicDashboard(ctx _context.Context, token string) (DeleteSharedDashboardResponse, *_nethttp.Response, error)
+	DeletePublicDashboardInvitation(ctx _context.Context, token string, body SharedDashboardInvites) (*_nethttp.Response, error)
+	GetDashboard(ctx _context.Context, dashboardId string) (Dashboard, *_nethttp.Response, error)
+	GetPublicDashboard(ctx _context.Context, token string) (SharedDashboard, *_nethttp.Response, error)
+	GetPublicDashboardInvitations(ctx _context.Context, token string, o ...GetPublicDashboardInvitationsOptionalParameters) (SharedDashboardInvites, *_nethttp.Response, error)
+	ListDashboards(ctx _context.Context, o ...ListDashboardsOptionalParameters) (DashboardSummary, *_nethttp.Response, error)
+	ListDashboardsWithPagination(ctx _context.Context, o ...ListDashboardsOptionalParameters) (<-chan datadog.PaginationResult[DashboardSummaryDefinition], func())
+	ListDashboardsSeq(ctx _context.Context, o ...ListDashboardsOptionalParameters) iter.Seq2[DashboardSummaryDefinition, error]
+	ListDashboardsPrefetch(ctx _context.Context, prefetch int, o ...ListDashboardsOptionalParameters) iter.Seq2[DashboardSummaryDefinition, error]
+	RestoreDashboards(ctx _context.Context, body DashboardRestoreRequest) (*_nethttp.Response, error)
+	SendPublicDashboardInvitation(ctx _context.Context, token string, body SharedDashboardInvites) (SharedDashboardInvites, *_nethttp.Response, error)
+	UpdateDashboard(ctx _context.Context, dashboardId string, body Dashboard) (Dashboard, *_nethttp.Response, error)
+	UpdatePublicDashboard(ctx _context.Context, token string, body SharedDashboardUpdateRequest) (SharedDashboard, *_nethttp.Response, error)
+}
+
+var _ DashboardsApiInterface = (*DashboardsApi)(nil)
+
 // NewDashboardsApi Returns NewDashboardsApi.
 func NewDashboardsApi(client *datadog.APIClient) *DashboardsApi {
 	return &DashboardsApi{
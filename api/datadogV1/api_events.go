@@ -6,6 +6,7 @@ package datadogV1
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -26,6 +27,7 @@ func (a *EventsApi) CreateEvent(ctx _context.Context, body EventCreateRequest) (
 		localVarReturnValue EventCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.EventsApi.CreateEvent")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.EventsApi.CreateEvent")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *EventsApi) CreateEvent(ctx _context.Context, body EventCreateRequest) (
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *EventsApi) CreateEvent(ctx _context.Context, body EventCreateRequest) (
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -101,6 +101,7 @@ func (a *EventsApi) GetEvent(ctx _context.Context, eventId int64) (EventResponse
 		localVarReturnValue EventResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.EventsApi.GetEvent")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.EventsApi.GetEvent")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -136,13 +137,10 @@ func (a *EventsApi) GetEvent(ctx _context.Context, eventId int64) (EventResponse
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -156,6 +154,7 @@ func (a *EventsApi) GetEvent(ctx _context.Context, eventId int64) (EventResponse
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -240,6 +239,7 @@ func (a *EventsApi) ListEvents(ctx _context.Context, start int64, end int64, o .
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.EventsApi.ListEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.EventsApi.ListEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -294,13 +294,10 @@ func (a *EventsApi) ListEvents(ctx _context.Context, start int64, end int64, o .
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -314,6 +311,7 @@ func (a *EventsApi) ListEvents(ctx _context.Context, start int64, end int64, o .
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -321,6 +319,64 @@ func (a *EventsApi) ListEvents(ctx _context.Context, start int64, end int64, o .
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListEventsWithPagination provides a paginated version of ListEvents returning a channel with all items.
+func (a *EventsApi) ListEventsWithPagination(ctx _context.Context, start int64, end int64, o ...ListEventsOptionalParameters) (<-chan datadog.PaginationResult[Event], func()) {
+	return a.listEventsPaginator(start, end, o...).Channel(ctx)
+}
+
+// ListEventsSeq provides a paginated version of ListEvents returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *EventsApi) ListEventsSeq(ctx _context.Context, start int64, end int64, o ...ListEventsOptionalParameters) iter.Seq2[Event, error] {
+	return a.listEventsPaginator(start, end, o...).Seq(ctx)
+}
+
+// ListEventsPrefetch provides a paginated version of ListEvents returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *EventsApi) ListEventsPrefetch(ctx _context.Context, start int64, end int64, prefetch int, o ...ListEventsOptionalParameters) iter.Seq2[Event, error] {
+	paginator := a.listEventsPaginator(start, end, o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *EventsApi) listEventsPaginator(start int64, end int64, o ...ListEventsOptionalParameters) datadog.Paginator[Event] {
+	var params ListEventsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{}
+	if params.Page != nil {
+		first.Number = int64(*params.Page)
+	}
+	return datadog.Paginator[Event]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[Event], error) {
+			request := params
+			pageNumber := int32(page.Number)
+			request.Page = &pageNumber
+			resp, _, err := a.ListEvents(ctx, start, end, request)
+			if err != nil {
+				return datadog.Page[Event]{}, err
+			}
+			return datadog.Page[Event]{
+				Items: resp.GetEvents(),
+			}, nil
+		},
+	}
+}
+
+// EventsApiInterface lists the operations of EventsApi, so that code depending on it can be tested with mocks.
+type EventsApiInterface interface {
+	CreateEvent(ctx _context.Context, body EventCreateRequest) (EventCreateResponse, *_nethttp.Response, error)
+	GetEvent(ctx _context.Context, eventId int64) (EventResponse, *_nethttp.Response, error)
+	ListEvents(ctx _context.Context, start int64, end int64, o ...ListEventsOptionalParameters) (EventListResponse, *_nethttp.Response, error)
+	ListEventsWithPagination(ctx _context.Context, start int64, end int64, o ...ListEventsOptionalParameters) (<-chan datadog.PaginationResult[Event], func())
+	ListEventsSeq(ctx _context.Context, start int64, end int64, o ...ListEventsOptionalParameters) iter.Seq2[Event, error]
+	ListEventsPrefetch(ctx _context.Context, start int64, end int64, prefetch int, o ...ListEventsOptionalParameters) iter.Seq2[Event, error]
+}
+
+var _ EventsApiInterface = (*EventsApi)(nil)
+
 // NewEventsApi Returns NewEventsApi.
 func NewEventsApi(client *datadog.APIClient) *EventsApi {
 	return &EventsApi{
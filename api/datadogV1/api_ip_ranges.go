@@ -24,6 +24,7 @@ func (a *IPRangesApi) GetIPRanges(ctx _context.Context) (IPRanges, *_nethttp.Res
 		localVarReturnValue IPRanges
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.IPRangesApi.GetIPRanges")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.IPRangesApi.GetIPRanges")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -52,13 +53,10 @@ func (a *IPRangesApi) GetIPRanges(ctx _context.Context) (IPRanges, *_nethttp.Res
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -72,6 +70,7 @@ func (a *IPRangesApi) GetIPRanges(ctx _context.Context) (IPRanges, *_nethttp.Res
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -79,6 +78,13 @@ func (a *IPRangesApi) GetIPRanges(ctx _context.Context) (IPRanges, *_nethttp.Res
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// IPRangesApiInterface lists the operations of IPRangesApi, so that code depending on it can be tested with mocks.
+type IPRangesApiInterface interface {
+	GetIPRanges(ctx _context.Context) (IPRanges, *_nethttp.Response, error)
+}
+
+var _ IPRangesApiInterface = (*IPRangesApi)(nil)
+
 // NewIPRangesApi Returns NewIPRangesApi.
 func NewIPRangesApi(client *datadog.APIClient) *IPRangesApi {
 	return &IPRangesApi{
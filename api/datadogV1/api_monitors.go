@@ -6,6 +6,7 @@ package datadogV1
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *MonitorsApi) CheckCanDeleteMonitor(ctx _context.Context, monitorIds []i
 		localVarReturnValue CheckCanDeleteMonitorResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.CheckCanDeleteMonitor")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.CheckCanDeleteMonitor")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -60,13 +62,10 @@ func (a *MonitorsApi) CheckCanDeleteMonitor(ctx _context.Context, monitorIds []i
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -75,7 +74,7 @@ func (a *MonitorsApi) CheckCanDeleteMonitor(ctx _context.Context, monitorIds []i
 		}
 		if localVarHTTPResponse.StatusCode == 409 {
 			var v CheckCanDeleteMonitorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -89,6 +88,7 @@ func (a *MonitorsApi) CheckCanDeleteMonitor(ctx _context.Context, monitorIds []i
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -296,6 +296,7 @@ func (a *MonitorsApi) CreateMonitor(ctx _context.Context, body Monitor) (Monitor
 		localVarReturnValue Monitor
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.CreateMonitor")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.CreateMonitor")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -333,13 +334,10 @@ func (a *MonitorsApi) CreateMonitor(ctx _context.Context, body Monitor) (Monitor
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -353,6 +351,7 @@ func (a *MonitorsApi) CreateMonitor(ctx _context.Context, body Monitor) (Monitor
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -394,6 +393,7 @@ func (a *MonitorsApi) DeleteMonitor(ctx _context.Context, monitorId int64, o ...
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.DeleteMonitor")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.DeleteMonitor")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -432,13 +432,10 @@ func (a *MonitorsApi) DeleteMonitor(ctx _context.Context, monitorId int64, o ...
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -452,6 +449,7 @@ func (a *MonitorsApi) DeleteMonitor(ctx _context.Context, monitorId int64, o ...
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -500,6 +498,7 @@ func (a *MonitorsApi) GetMonitor(ctx _context.Context, monitorId int64, o ...Get
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.GetMonitor")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.GetMonitor")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -541,13 +540,10 @@ func (a *MonitorsApi) GetMonitor(ctx _context.Context, monitorId int64, o ...Get
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -561,6 +557,7 @@ func (a *MonitorsApi) GetMonitor(ctx _context.Context, monitorId int64, o ...Get
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -651,6 +648,7 @@ func (a *MonitorsApi) ListMonitors(ctx _context.Context, o ...ListMonitorsOption
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.ListMonitors")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.ListMonitors")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -709,13 +707,10 @@ func (a *MonitorsApi) ListMonitors(ctx _context.Context, o ...ListMonitorsOption
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -729,6 +724,7 @@ func (a *MonitorsApi) ListMonitors(ctx _context.Context, o ...ListMonitorsOption
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -780,6 +776,54 @@ func (a *MonitorsApi) ListMonitorsWithPagination(ctx _context.Context, o ...List
 	return items, cancel
 }
 
+// ListMonitorsSeq provides a paginated version of ListMonitors returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *MonitorsApi) ListMonitorsSeq(ctx _context.Context, o ...ListMonitorsOptionalParameters) iter.Seq2[Monitor, error] {
+	return a.listMonitorsPaginator(o...).Seq(ctx)
+}
+
+// ListMonitorsPrefetch provides a paginated version of ListMonitors returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *MonitorsApi) ListMonitorsPrefetch(ctx _context.Context, prefetch int, o ...ListMonitorsOptionalParameters) iter.Seq2[Monitor, error] {
+	paginator := a.listMonitorsPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *MonitorsApi) listMonitorsPaginator(o ...ListMonitorsOptionalParameters) datadog.Paginator[Monitor] {
+	var params ListMonitorsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 100}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.Page != nil {
+		first.Number = int64(*params.Page)
+	}
+	return datadog.Paginator[Monitor]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[Monitor], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.Page = &pageNumber
+			resp, _, err := a.ListMonitors(ctx, request)
+			if err != nil {
+				return datadog.Page[Monitor]{}, err
+			}
+			return datadog.Page[Monitor]{
+				Items: resp,
+			}, nil
+		},
+	}
+}
+
 // SearchMonitorGroupsOptionalParameters holds optional parameters for SearchMonitorGroups.
 type SearchMonitorGroupsOptionalParameters struct {
 	Query   *string
@@ -835,6 +879,7 @@ func (a *MonitorsApi) SearchMonitorGroups(ctx _context.Context, o ...SearchMonit
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.SearchMonitorGroups")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.SearchMonitorGroups")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -881,13 +926,10 @@ func (a *MonitorsApi) SearchMonitorGroups(ctx _context.Context, o ...SearchMonit
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -901,6 +943,7 @@ func (a *MonitorsApi) SearchMonitorGroups(ctx _context.Context, o ...SearchMonit
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -908,6 +951,59 @@ func (a *MonitorsApi) SearchMonitorGroups(ctx _context.Context, o ...SearchMonit
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// SearchMonitorGroupsWithPagination provides a paginated version of SearchMonitorGroups returning a channel with all items.
+func (a *MonitorsApi) SearchMonitorGroupsWithPagination(ctx _context.Context, o ...SearchMonitorGroupsOptionalParameters) (<-chan datadog.PaginationResult[MonitorGroupSearchResult], func()) {
+	return a.searchMonitorGroupsPaginator(o...).Channel(ctx)
+}
+
+// SearchMonitorGroupsSeq provides a paginated version of SearchMonitorGroups returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *MonitorsApi) SearchMonitorGroupsSeq(ctx _context.Context, o ...SearchMonitorGroupsOptionalParameters) iter.Seq2[MonitorGroupSearchResult, error] {
+	return a.searchMonitorGroupsPaginator(o...).Seq(ctx)
+}
+
+// SearchMonitorGroupsPrefetch provides a paginated version of SearchMonitorGroups returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *MonitorsApi) SearchMonitorGroupsPrefetch(ctx _context.Context, prefetch int, o ...SearchMonitorGroupsOptionalParameters) iter.Seq2[MonitorGroupSearchResult, error] {
+	paginator := a.searchMonitorGroupsPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *MonitorsApi) searchMonitorGroupsPaginator(o ...SearchMonitorGroupsOptionalParameters) datadog.Paginator[MonitorGroupSearchResult] {
+	var params SearchMonitorGroupsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 30}
+	if params.PerPage != nil {
+		first.Size = int64(*params.PerPage)
+	}
+	if params.Page != nil {
+		first.Number = int64(*params.Page)
+	}
+	return datadog.Paginator[MonitorGroupSearchResult]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[MonitorGroupSearchResult], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PerPage = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.Page = &pageNumber
+			resp, _, err := a.SearchMonitorGroups(ctx, request)
+			if err != nil {
+				return datadog.Page[MonitorGroupSearchResult]{}, err
+			}
+			return datadog.Page[MonitorGroupSearchResult]{
+				Items: resp.GetGroups(),
+			}, nil
+		},
+	}
+}
+
 // SearchMonitorsOptionalParameters holds optional parameters for SearchMonitors.
 type SearchMonitorsOptionalParameters struct {
 	Query   *string
@@ -963,6 +1059,7 @@ func (a *MonitorsApi) SearchMonitors(ctx _context.Context, o ...SearchMonitorsOp
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.SearchMonitors")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.SearchMonitors")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1009,13 +1106,10 @@ func (a *MonitorsApi) SearchMonitors(ctx _context.Context, o ...SearchMonitorsOp
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1029,6 +1123,7 @@ func (a *MonitorsApi) SearchMonitors(ctx _context.Context, o ...SearchMonitorsOp
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1036,6 +1131,59 @@ func (a *MonitorsApi) SearchMonitors(ctx _context.Context, o ...SearchMonitorsOp
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// SearchMonitorsWithPagination provides a paginated version of SearchMonitors returning a channel with all items.
+func (a *MonitorsApi) SearchMonitorsWithPagination(ctx _context.Context, o ...SearchMonitorsOptionalParameters) (<-chan datadog.PaginationResult[MonitorSearchResult], func()) {
+	return a.searchMonitorsPaginator(o...).Channel(ctx)
+}
+
+// SearchMonitorsSeq provides a paginated version of SearchMonitors returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *MonitorsApi) SearchMonitorsSeq(ctx _context.Context, o ...SearchMonitorsOptionalParameters) iter.Seq2[MonitorSearchResult, error] {
+	return a.searchMonitorsPaginator(o...).Seq(ctx)
+}
+
+// SearchMonitorsPrefetch provides a paginated version of SearchMonitors returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *MonitorsApi) SearchMonitorsPrefetch(ctx _context.Context, prefetch int, o ...SearchMonitorsOptionalParameters) iter.Seq2[MonitorSearchResult, error] {
+	paginator := a.searchMonitorsPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *MonitorsApi) searchMonitorsPaginator(o ...SearchMonitorsOptionalParameters) datadog.Paginator[MonitorSearchResult] {
+	var params SearchMonitorsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 30}
+	if params.PerPage != nil {
+		first.Size = int64(*params.PerPage)
+	}
+	if params.Page != nil {
+		first.Number = int64(*params.Page)
+	}
+	return datadog.Paginator[MonitorSearchResult]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[MonitorSearchResult], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PerPage = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.Page = &pageNumber
+			resp, _, err := a.SearchMonitors(ctx, request)
+			if err != nil {
+				return datadog.Page[MonitorSearchResult]{}, err
+			}
+			return datadog.Page[MonitorSearchResult]{
+				Items: resp.GetMonitors(),
+			}, nil
+		},
+	}
+}
+
 // UpdateMonitor Edit a monitor.
 // Edit the specified monitor.
 func (a *MonitorsApi) UpdateMonitor(ctx _context.Context, monitorId int64, body MonitorUpdateRequest) (Monitor, *_nethttp.Response, error) {
@@ -1045,6 +1193,7 @@ func (a *MonitorsApi) UpdateMonitor(ctx _context.Context, monitorId int64, body
 		localVarReturnValue Monitor
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.UpdateMonitor")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.UpdateMonitor")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1083,13 +1232,10 @@ func (a *MonitorsApi) UpdateMonitor(ctx _context.Context, monitorId int64, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1103,6 +1249,7 @@ func (a *MonitorsApi) UpdateMonitor(ctx _context.Context, monitorId int64, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1119,6 +1266,7 @@ func (a *MonitorsApi) ValidateExistingMonitor(ctx _context.Context, monitorId in
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.ValidateExistingMonitor")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.ValidateExistingMonitor")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1157,13 +1305,10 @@ func (a *MonitorsApi) ValidateExistingMonitor(ctx _context.Context, monitorId in
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1177,6 +1322,7 @@ func (a *MonitorsApi) ValidateExistingMonitor(ctx _context.Context, monitorId in
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1195,6 +1341,7 @@ func (a *MonitorsApi) ValidateMonitor(ctx _context.Context, body Monitor) (inter
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.MonitorsApi.ValidateMonitor")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.ValidateMonitor")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1232,13 +1379,10 @@ func (a *MonitorsApi) ValidateMonitor(ctx _context.Context, body Monitor) (inter
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1252,6 +1396,7 @@ func (a *MonitorsApi) ValidateMonitor(ctx _context.Context, body Monitor) (inter
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1259,6 +1404,31 @@ func (a *MonitorsApi) ValidateMonitor(ctx _context.Context, body Monitor) (inter
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// MonitorsApiInterface lists the operations of MonitorsApi, so that code depending on it can be tested with mocks.
+type MonitorsApiInterface interface {
+	CheckCanDeleteMonitor(ctx _context.Context, monitorIds []int64) (CheckCanDeleteMonitorResponse, *_nethttp.Response, error)
+	CreateMonitor(ctx _context.Context, body Monitor) (Monitor, *_nethttp.Response, error)
+	DeleteMonitor(ctx _context.Context, monitorId int64, o ...DeleteMonitorOptionalParameters) (DeletedMonitor, *_nethttp.Response, error)
+	GetMonitor(ctx _context.Context, monitorId int64, o ...GetMonitorOptionalParameters) (Monitor, *_nethttp.Response, error)
+	ListMonitors(ctx _context.Context, o ...ListMonitorsOptionalParameters) ([]Monitor, *_nethttp.Response, error)
+	ListMonitorsWithPagination(ctx _context.Context, o ...ListMonitorsOptionalParameters) (<-chan datadog.PaginationResult[Monitor], func())
+	ListMonitorsSeq(ctx _context.Context, o ...ListMonitorsOptionalParameters) iter.Seq2[Monitor, error]
+	ListMonitorsPrefetch(ctx _context.Context, prefetch int, o ...ListMonitorsOptionalParameters) iter.Seq2[Monitor, error]
+	SearchMonitorGroups(ctx _context.Context, o ...SearchMonitorGroupsOptionalParameters) (MonitorGroupSearchResponse, *_nethttp.Response, error)
+	SearchMonitorGroupsWithPagination(ctx _context.Context, o ...SearchMonitorGroupsOptionalParameters) (<-chan datadog.PaginationResult[MonitorGroupSearchResult], func())
+	SearchMonitorGroupsSeq(ctx _context.Context, o ...SearchMonitorGroupsOptionalParameters) iter.Seq2[MonitorGroupSearchResult, error]
+	SearchMonitorGroupsPrefetch(ctx _context.Context, prefetch int, o ...SearchMonitorGroupsOptionalParameters) iter.Seq2[MonitorGroupSearchResult, error]
+	SearchMonitors(ctx _context.Context, o ...SearchMonitorsOptionalParameters) (MonitorSearchResponse, *_nethttp.Response, error)
+	SearchMonitorsWithPagination(ctx _context.Context, o ...SearchMonitorsOptionalParameters) (<-chan datadog.PaginationResult[MonitorSearchResult], func())
+	SearchMonitorsSeq(ctx _context.Context, o ...SearchMonitorsOptionalParameters) iter.Seq2[MonitorSearchResult, error]
+	SearchMonitorsPrefetch(ctx _context.Context, prefetch int, o ...SearchMonitorsOptionalParameters) iter.Seq2[MonitorSearchResult, error]
+	UpdateMonitor(ctx _context.Context, monitorId int64, body MonitorUpdateRequest) (Monitor, *_nethttp.Response, error)
+	ValidateExistingMonitor(ctx _context.Context, monitorId int64, body Monitor) (interface{}, *_nethttp.Response, error)
+	ValidateMonitor(ctx _context.Context, body Monitor) (interface{}, *_nethttp.Response, error)
+}
+
+var _ MonitorsApiInterface = (*MonitorsApi)(nil)
+
 // NewMonitorsApi Returns NewMonitorsApi.
 func NewMonitorsApi(client *datadog.APIClient) *MonitorsApi {
 	return &MonitorsApi{
@@ -6,6 +6,7 @@ package datadogV1
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *NotebooksApi) CreateNotebook(ctx _context.Context, body NotebookCreateR
 		localVarReturnValue NotebookResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.NotebooksApi.CreateNotebook")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.NotebooksApi.CreateNotebook")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *NotebooksApi) CreateNotebook(ctx _context.Context, body NotebookCreateR
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *NotebooksApi) CreateNotebook(ctx _context.Context, body NotebookCreateR
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +97,7 @@ func (a *NotebooksApi) DeleteNotebook(ctx _context.Context, notebookId int64) (*
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.NotebooksApi.DeleteNotebook")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.NotebooksApi.DeleteNotebook")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +133,10 @@ func (a *NotebooksApi) DeleteNotebook(ctx _context.Context, notebookId int64) (*
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +157,7 @@ func (a *NotebooksApi) GetNotebook(ctx _context.Context, notebookId int64) (Note
 		localVarReturnValue NotebookResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.NotebooksApi.GetNotebook")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.NotebooksApi.GetNotebook")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +193,10 @@ func (a *NotebooksApi) GetNotebook(ctx _context.Context, notebookId int64) (Note
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +210,7 @@ func (a *NotebooksApi) GetNotebook(ctx _context.Context, notebookId int64) (Note
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -319,6 +316,7 @@ func (a *NotebooksApi) ListNotebooks(ctx _context.Context, o ...ListNotebooksOpt
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.NotebooksApi.ListNotebooks")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.NotebooksApi.ListNotebooks")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -383,13 +381,10 @@ func (a *NotebooksApi) ListNotebooks(ctx _context.Context, o ...ListNotebooksOpt
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -403,6 +398,7 @@ func (a *NotebooksApi) ListNotebooks(ctx _context.Context, o ...ListNotebooksOpt
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -460,6 +456,54 @@ func (a *NotebooksApi) ListNotebooksWithPagination(ctx _context.Context, o ...Li
 	return items, cancel
 }
 
+// ListNotebooksSeq provides a paginated version of ListNotebooks returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *NotebooksApi) ListNotebooksSeq(ctx _context.Context, o ...ListNotebooksOptionalParameters) iter.Seq2[NotebooksResponseData, error] {
+	return a.listNotebooksPaginator(o...).Seq(ctx)
+}
+
+// ListNotebooksPrefetch provides a paginated version of ListNotebooks returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *NotebooksApi) ListNotebooksPrefetch(ctx _context.Context, prefetch int, o ...ListNotebooksOptionalParameters) iter.Seq2[NotebooksResponseData, error] {
+	paginator := a.listNotebooksPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *NotebooksApi) listNotebooksPaginator(o ...ListNotebooksOptionalParameters) datadog.Paginator[NotebooksResponseData] {
+	var params ListNotebooksOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 100}
+	if params.Count != nil {
+		first.Size = int64(*params.Count)
+	}
+	if params.Start != nil {
+		first.Offset = int64(*params.Start)
+	}
+	return datadog.Paginator[NotebooksResponseData]{
+		Strategy: datadog.OffsetPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[NotebooksResponseData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.Count = &pageSize
+			}
+			pageOffset := int64(page.Offset)
+			request.Start = &pageOffset
+			resp, _, err := a.ListNotebooks(ctx, request)
+			if err != nil {
+				return datadog.Page[NotebooksResponseData]{}, err
+			}
+			return datadog.Page[NotebooksResponseData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdateNotebook Update a notebook.
 // Update a notebook using the specified ID.
 func (a *NotebooksApi) UpdateNotebook(ctx _context.Context, notebookId int64, body NotebookUpdateRequest) (NotebookResponse, *_nethttp.Response, error) {
@@ -469,6 +513,7 @@ func (a *NotebooksApi) UpdateNotebook(ctx _context.Context, notebookId int64, bo
 		localVarReturnValue NotebookResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.NotebooksApi.UpdateNotebook")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.NotebooksApi.UpdateNotebook")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -507,13 +552,10 @@ func (a *NotebooksApi) UpdateNotebook(ctx _context.Context, notebookId int64, bo
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -527,6 +569,7 @@ func (a *NotebooksApi) UpdateNotebook(ctx _context.Context, notebookId int64, bo
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -534,6 +577,20 @@ func (a *NotebooksApi) UpdateNotebook(ctx _context.Context, notebookId int64, bo
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// NotebooksApiInterface lists the operations of NotebooksApi, so that code depending on it can be tested with mocks.
+type NotebooksApiInterface interface {
+	CreateNotebook(ctx _context.Context, body NotebookCreateRequest) (NotebookResponse, *_nethttp.Response, error)
+	DeleteNotebook(ctx _context.Context, notebookId int64) (*_nethttp.Response, error)
+	GetNotebook(ctx _context.Context, notebookId int64) (NotebookResponse, *_nethttp.Response, error)
+	ListNotebooks(ctx _context.Context, o ...ListNotebooksOptionalParameters) (NotebooksResponse, *_nethttp.Response, error)
+	ListNotebooksWithPagination(ctx _context.Context, o ...ListNotebooksOptionalParameters) (<-chan datadog.PaginationResult[NotebooksResponseData], func())
+	ListNotebooksSeq(ctx _context.Context, o ...ListNotebooksOptionalParameters) iter.Seq2[NotebooksResponseData, error]
+	ListNotebooksPrefetch(ctx _context.Context, prefetch int, o ...ListNotebooksOptionalParameters) iter.Seq2[NotebooksResponseData, error]
+	UpdateNotebook(ctx _context.Context, notebookId int64, body NotebookUpdateRequest) (NotebookResponse, *_nethttp.Response, error)
+}
+
+var _ NotebooksApiInterface = (*NotebooksApi)(nil)
+
 // NewNotebooksApi Returns NewNotebooksApi.
 func NewNotebooksApi(client *datadog.APIClient) *NotebooksApi {
 	return &NotebooksApi{
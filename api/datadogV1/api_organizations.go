@@ -35,6 +35,7 @@ func (a *OrganizationsApi) CreateChildOrg(ctx _context.Context, body Organizatio
 		localVarReturnValue OrganizationCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.OrganizationsApi.CreateChildOrg")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.OrganizationsApi.CreateChildOrg")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -72,13 +73,10 @@ func (a *OrganizationsApi) CreateChildOrg(ctx _context.Context, body Organizatio
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -92,6 +90,7 @@ func (a *OrganizationsApi) CreateChildOrg(ctx _context.Context, body Organizatio
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -108,6 +107,7 @@ func (a *OrganizationsApi) DowngradeOrg(ctx _context.Context, publicId string) (
 		localVarReturnValue OrgDowngradedResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.OrganizationsApi.DowngradeOrg")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.OrganizationsApi.DowngradeOrg")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -143,13 +143,10 @@ func (a *OrganizationsApi) DowngradeOrg(ctx _context.Context, publicId string) (
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -163,6 +160,7 @@ func (a *OrganizationsApi) DowngradeOrg(ctx _context.Context, publicId string) (
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -179,6 +177,7 @@ func (a *OrganizationsApi) GetOrg(ctx _context.Context, publicId string) (Organi
 		localVarReturnValue OrganizationResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.OrganizationsApi.GetOrg")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.OrganizationsApi.GetOrg")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -214,13 +213,10 @@ func (a *OrganizationsApi) GetOrg(ctx _context.Context, publicId string) (Organi
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -234,6 +230,7 @@ func (a *OrganizationsApi) GetOrg(ctx _context.Context, publicId string) (Organi
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -250,6 +247,7 @@ func (a *OrganizationsApi) ListOrgs(ctx _context.Context) (OrganizationListRespo
 		localVarReturnValue OrganizationListResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.OrganizationsApi.ListOrgs")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.OrganizationsApi.ListOrgs")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -284,13 +282,10 @@ func (a *OrganizationsApi) ListOrgs(ctx _context.Context) (OrganizationListRespo
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -304,6 +299,7 @@ func (a *OrganizationsApi) ListOrgs(ctx _context.Context) (OrganizationListRespo
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -320,6 +316,7 @@ func (a *OrganizationsApi) UpdateOrg(ctx _context.Context, publicId string, body
 		localVarReturnValue OrganizationResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.OrganizationsApi.UpdateOrg")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.OrganizationsApi.UpdateOrg")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -358,13 +355,10 @@ func (a *OrganizationsApi) UpdateOrg(ctx _context.Context, publicId string, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -378,6 +372,7 @@ func (a *OrganizationsApi) UpdateOrg(ctx _context.Context, publicId string, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -399,6 +394,7 @@ func (a *OrganizationsApi) UploadIdPForOrg(ctx _context.Context, publicId string
 		localVarReturnValue IdpResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.OrganizationsApi.UploadIdPForOrg")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.OrganizationsApi.UploadIdPForOrg")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -442,13 +438,10 @@ func (a *OrganizationsApi) UploadIdPForOrg(ctx _context.Context, publicId string
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 415 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -462,6 +455,7 @@ func (a *OrganizationsApi) UploadIdPForOrg(ctx _context.Context, publicId string
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -469,6 +463,18 @@ func (a *OrganizationsApi) UploadIdPForOrg(ctx _context.Context, publicId string
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// OrganizationsApiInterface lists the operations of OrganizationsApi, so that code depending on it can be tested with mocks.
+type OrganizationsApiInterface interface {
+	CreateChildOrg(ctx _context.Context, body OrganizationCreateBody) (OrganizationCreateResponse, *_nethttp.Response, error)
+	DowngradeOrg(ctx _context.Context, publicId string) (OrgDowngradedResponse, *_nethttp.Response, error)
+	GetOrg(ctx _context.Context, publicId string) (OrganizationResponse, *_nethttp.Response, error)
+	ListOrgs(ctx _context.Context) (OrganizationListResponse, *_nethttp.Response, error)
+	UpdateOrg(ctx _context.Context, publicId string, body Organization) (OrganizationResponse, *_nethttp.Response, error)
+	UploadIdPForOrg(ctx _context.Context, publicId string, idpFile _io.Reader) (IdpResponse, *_nethttp.Response, error)
+}
+
+var _ OrganizationsApiInterface = (*OrganizationsApi)(nil)
+
 // NewOrganizationsApi Returns NewOrganizationsApi.
 func NewOrganizationsApi(client *datadog.APIClient) *OrganizationsApi {
 	return &OrganizationsApi{
@@ -25,6 +25,7 @@ func (a *PagerDutyIntegrationApi) CreatePagerDutyIntegrationService(ctx _context
 		localVarReturnValue PagerDutyServiceName
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.PagerDutyIntegrationApi.CreatePagerDutyIntegrationService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.PagerDutyIntegrationApi.CreatePagerDutyIntegrationService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *PagerDutyIntegrationApi) CreatePagerDutyIntegrationService(ctx _context
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *PagerDutyIntegrationApi) CreatePagerDutyIntegrationService(ctx _context
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +96,7 @@ func (a *PagerDutyIntegrationApi) DeletePagerDutyIntegrationService(ctx _context
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.PagerDutyIntegrationApi.DeletePagerDutyIntegrationService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.PagerDutyIntegrationApi.DeletePagerDutyIntegrationService")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +132,10 @@ func (a *PagerDutyIntegrationApi) DeletePagerDutyIntegrationService(ctx _context
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +156,7 @@ func (a *PagerDutyIntegrationApi) GetPagerDutyIntegrationService(ctx _context.Co
 		localVarReturnValue PagerDutyServiceName
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.PagerDutyIntegrationApi.GetPagerDutyIntegrationService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.PagerDutyIntegrationApi.GetPagerDutyIntegrationService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +192,10 @@ func (a *PagerDutyIntegrationApi) GetPagerDutyIntegrationService(ctx _context.Co
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +209,7 @@ func (a *PagerDutyIntegrationApi) GetPagerDutyIntegrationService(ctx _context.Co
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -229,6 +225,7 @@ func (a *PagerDutyIntegrationApi) UpdatePagerDutyIntegrationService(ctx _context
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.PagerDutyIntegrationApi.UpdatePagerDutyIntegrationService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.PagerDutyIntegrationApi.UpdatePagerDutyIntegrationService")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -267,13 +264,10 @@ func (a *PagerDutyIntegrationApi) UpdatePagerDutyIntegrationService(ctx _context
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -285,6 +279,16 @@ func (a *PagerDutyIntegrationApi) UpdatePagerDutyIntegrationService(ctx _context
 	return localVarHTTPResponse, nil
 }
 
+// PagerDutyIntegrationApiInterface lists the operations of PagerDutyIntegrationApi, so that code depending on it can be tested with mocks.
+type PagerDutyIntegrationApiInterface interface {
+	CreatePagerDutyIntegrationService(ctx _context.Context, body PagerDutyService) (PagerDutyServiceName, *_nethttp.Response, error)
+	DeletePagerDutyIntegrationService(ctx _context.Context, serviceName string) (*_nethttp.Response, error)
+	GetPagerDutyIntegrationService(ctx _context.Context, serviceName string) (PagerDutyServiceName, *_nethttp.Response, error)
+	UpdatePagerDutyIntegrationService(ctx _context.Context, serviceName string, body PagerDutyServiceKey) (*_nethttp.Response, error)
+}
+
+var _ PagerDutyIntegrationApiInterface = (*PagerDutyIntegrationApi)(nil)
+
 // NewPagerDutyIntegrationApi Returns NewPagerDutyIntegrationApi.
 func NewPagerDutyIntegrationApi(client *datadog.APIClient) *PagerDutyIntegrationApi {
 	return &PagerDutyIntegrationApi{
@@ -28,6 +28,7 @@ func (a *ServiceChecksApi) SubmitServiceCheck(ctx _context.Context, body []Servi
 		localVarReturnValue IntakePayloadAccepted
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.ServiceChecksApi.SubmitServiceCheck")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.ServiceChecksApi.SubmitServiceCheck")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -64,13 +65,10 @@ func (a *ServiceChecksApi) SubmitServiceCheck(ctx _context.Context, body []Servi
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 408 || localVarHTTPResponse.StatusCode == 413 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -84,6 +82,7 @@ func (a *ServiceChecksApi) SubmitServiceCheck(ctx _context.Context, body []Servi
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -91,6 +90,13 @@ func (a *ServiceChecksApi) SubmitServiceCheck(ctx _context.Context, body []Servi
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ServiceChecksApiInterface lists the operations of ServiceChecksApi, so that code depending on it can be tested with mocks.
+type ServiceChecksApiInterface interface {
+	SubmitServiceCheck(ctx _context.Context, body []ServiceCheck) (IntakePayloadAccepted, *_nethttp.Response, error)
+}
+
+var _ ServiceChecksApiInterface = (*ServiceChecksApi)(nil)
+
 // NewServiceChecksApi Returns NewServiceChecksApi.
 func NewServiceChecksApi(client *datadog.APIClient) *ServiceChecksApi {
 	return &ServiceChecksApi{
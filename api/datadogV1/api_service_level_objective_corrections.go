@@ -6,6 +6,7 @@ package datadogV1
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) CreateSLOCorrection(ctx _context.C
 		localVarReturnValue SLOCorrectionResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.CreateSLOCorrection")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.CreateSLOCorrection")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *ServiceLevelObjectiveCorrectionsApi) CreateSLOCorrection(ctx _context.C
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) CreateSLOCorrection(ctx _context.C
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +97,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) DeleteSLOCorrection(ctx _context.C
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.DeleteSLOCorrection")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.DeleteSLOCorrection")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +133,10 @@ func (a *ServiceLevelObjectiveCorrectionsApi) DeleteSLOCorrection(ctx _context.C
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +157,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) GetSLOCorrection(ctx _context.Cont
 		localVarReturnValue SLOCorrectionResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.GetSLOCorrection")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.GetSLOCorrection")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +193,10 @@ func (a *ServiceLevelObjectiveCorrectionsApi) GetSLOCorrection(ctx _context.Cont
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +210,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) GetSLOCorrection(ctx _context.Cont
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -262,6 +259,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) ListSLOCorrection(ctx _context.Con
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.ListSLOCorrection")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.ListSLOCorrection")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -302,13 +300,10 @@ func (a *ServiceLevelObjectiveCorrectionsApi) ListSLOCorrection(ctx _context.Con
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -322,6 +317,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) ListSLOCorrection(ctx _context.Con
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -379,6 +375,54 @@ func (a *ServiceLevelObjectiveCorrectionsApi) ListSLOCorrectionWithPagination(ct
 	return items, cancel
 }
 
+// ListSLOCorrectionSeq provides a paginated version of ListSLOCorrection returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *ServiceLevelObjectiveCorrectionsApi) ListSLOCorrectionSeq(ctx _context.Context, o ...ListSLOCorrectionOptionalParameters) iter.Seq2[SLOCorrection, error] {
+	return a.listSLOCorrectionPaginator(o...).Seq(ctx)
+}
+
+// ListSLOCorrectionPrefetch provides a paginated version of ListSLOCorrection returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *ServiceLevelObjectiveCorrectionsApi) ListSLOCorrectionPrefetch(ctx _context.Context, prefetch int, o ...ListSLOCorrectionOptionalParameters) iter.Seq2[SLOCorrection, error] {
+	paginator := a.listSLOCorrectionPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *ServiceLevelObjectiveCorrectionsApi) listSLOCorrectionPaginator(o ...ListSLOCorrectionOptionalParameters) datadog.Paginator[SLOCorrection] {
+	var params ListSLOCorrectionOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 25}
+	if params.Limit != nil {
+		first.Size = int64(*params.Limit)
+	}
+	if params.Offset != nil {
+		first.Offset = int64(*params.Offset)
+	}
+	return datadog.Paginator[SLOCorrection]{
+		Strategy: datadog.OffsetPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[SLOCorrection], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.Limit = &pageSize
+			}
+			pageOffset := int64(page.Offset)
+			request.Offset = &pageOffset
+			resp, _, err := a.ListSLOCorrection(ctx, request)
+			if err != nil {
+				return datadog.Page[SLOCorrection]{}, err
+			}
+			return datadog.Page[SLOCorrection]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdateSLOCorrection Update an SLO correction.
 // Update the specified SLO correction object.
 func (a *ServiceLevelObjectiveCorrectionsApi) UpdateSLOCorrection(ctx _context.Context, sloCorrectionId string, body SLOCorrectionUpdateRequest) (SLOCorrectionResponse, *_nethttp.Response, error) {
@@ -388,6 +432,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) UpdateSLOCorrection(ctx _context.C
 		localVarReturnValue SLOCorrectionResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.UpdateSLOCorrection")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.ServiceLevelObjectiveCorrectionsApi.UpdateSLOCorrection")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -426,13 +471,10 @@ func (a *ServiceLevelObjectiveCorrectionsApi) UpdateSLOCorrection(ctx _context.C
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -446,6 +488,7 @@ func (a *ServiceLevelObjectiveCorrectionsApi) UpdateSLOCorrection(ctx _context.C
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -453,6 +496,20 @@ func (a *ServiceLevelObjectiveCorrectionsApi) UpdateSLOCorrection(ctx _context.C
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ServiceLevelObjectiveCorrectionsApiInterface lists the operations of ServiceLevelObjectiveCorrectionsApi, so that code depending on it can be tested with mocks.
+type ServiceLevelObjectiveCorrectionsApiInterface interface {
+	CreateSLOCorrection(ctx _context.Context, body SLOCorrectionCreateRequest) (SLOCorrectionResponse, *_nethttp.Response, error)
+	DeleteSLOCorrection(ctx _context.Context, sloCorrectionId string) (*_nethttp.Response, error)
+	GetSLOCorrection(ctx _context.Context, sloCorrectionId string) (SLOCorrectionResponse, *_nethttp.Response, error)
+	ListSLOCorrection(ctx _context.Context, o ...ListSLOCorrectionOptionalParameters) (SLOCorrectionListResponse, *_nethttp.Response, error)
+	ListSLOCorrectionWithPagination(ctx _context.Context, o ...ListSLOCorrectionOptionalParameters) (<-chan datadog.PaginationResult[SLOCorrection], func())
+	ListSLOCorrectionSeq(ctx _context.Context, o ...ListSLOCorrectionOptionalParameters) iter.Seq2[SLOCorrection, error]
+	ListSLOCorrectionPrefetch(ctx _context.Context, prefetch int, o ...ListSLOCorrectionOptionalParameters) iter.Seq2[SLOCorrection, error]
+	UpdateSLOCorrection(ctx _context.Context, sloCorrectionId string, body SLOCorrectionUpdateRequest) (SLOCorrectionResponse, *_nethttp.Response, error)
+}
+
+var _ ServiceLevelObjectiveCorrectionsApiInterface = (*ServiceLevelObjectiveCorrectionsApi)(nil)
+
 // NewServiceLevelObjectiveCorrectionsApi Returns NewServiceLevelObjectiveCorrectionsApi.
 func NewServiceLevelObjectiveCorrectionsApi(client *datadog.APIClient) *ServiceLevelObjectiveCorrectionsApi {
 	return &ServiceLevelObjectiveCorrectionsApi{
@@ -85,6 +85,7 @@ func (a *SnapshotsApi) GetGraphSnapshot(ctx _context.Context, start int64, end i
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v1.SnapshotsApi.GetGraphSnapshot")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.SnapshotsApi.GetGraphSnapshot")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -139,13 +140,10 @@ func (a *SnapshotsApi) GetGraphSnapshot(ctx _context.Context, start int64, end i
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -159,6 +157,7 @@ func (a *SnapshotsApi) GetGraphSnapshot(ctx _context.Context, start int64, end i
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -166,6 +165,13 @@ func (a *SnapshotsApi) GetGraphSnapshot(ctx _context.Context, start int64, end i
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// SnapshotsApiInterface lists the operations of SnapshotsApi, so that code depending on it can be tested with mocks.
+type SnapshotsApiInterface interface {
+	GetGraphSnapshot(ctx _context.Context, start int64, end int64, o ...GetGraphSnapshotOptionalParameters) (GraphSnapshot, *_nethttp.Response, error)
+}
+
+var _ SnapshotsApiInterface = (*SnapshotsApi)(nil)
+
 // NewSnapshotsApi Returns NewSnapshotsApi.
 func NewSnapshotsApi(client *datadog.APIClient) *SnapshotsApi {
 	return &SnapshotsApi{
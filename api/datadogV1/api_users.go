@@ -28,6 +28,7 @@ func (a *UsersApi) CreateUser(ctx _context.Context, body User) (UserResponse, *_
 		localVarReturnValue UserResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.UsersApi.CreateUser")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.UsersApi.CreateUser")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -65,13 +66,10 @@ func (a *UsersApi) CreateUser(ctx _context.Context, body User) (UserResponse, *_
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -85,6 +83,7 @@ func (a *UsersApi) CreateUser(ctx _context.Context, body User) (UserResponse, *_
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -104,6 +103,7 @@ func (a *UsersApi) DisableUser(ctx _context.Context, userHandle string) (UserDis
 		localVarReturnValue UserDisableResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.UsersApi.DisableUser")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.UsersApi.DisableUser")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -139,13 +139,10 @@ func (a *UsersApi) DisableUser(ctx _context.Context, userHandle string) (UserDis
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -159,6 +156,7 @@ func (a *UsersApi) DisableUser(ctx _context.Context, userHandle string) (UserDis
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -175,6 +173,7 @@ func (a *UsersApi) GetUser(ctx _context.Context, userHandle string) (UserRespons
 		localVarReturnValue UserResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.UsersApi.GetUser")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.UsersApi.GetUser")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -210,13 +209,10 @@ func (a *UsersApi) GetUser(ctx _context.Context, userHandle string) (UserRespons
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -230,6 +226,7 @@ func (a *UsersApi) GetUser(ctx _context.Context, userHandle string) (UserRespons
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -246,6 +243,7 @@ func (a *UsersApi) ListUsers(ctx _context.Context) (UserListResponse, *_nethttp.
 		localVarReturnValue UserListResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.UsersApi.ListUsers")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.UsersApi.ListUsers")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -280,13 +278,10 @@ func (a *UsersApi) ListUsers(ctx _context.Context) (UserListResponse, *_nethttp.
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -300,6 +295,7 @@ func (a *UsersApi) ListUsers(ctx _context.Context) (UserListResponse, *_nethttp.
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -318,6 +314,7 @@ func (a *UsersApi) UpdateUser(ctx _context.Context, userHandle string, body User
 		localVarReturnValue UserResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v1.UsersApi.UpdateUser")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v1.UsersApi.UpdateUser")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -356,13 +353,10 @@ func (a *UsersApi) UpdateUser(ctx _context.Context, userHandle string, body User
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -376,6 +370,7 @@ func (a *UsersApi) UpdateUser(ctx _context.Context, userHandle string, body User
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -383,6 +378,17 @@ func (a *UsersApi) UpdateUser(ctx _context.Context, userHandle string, body User
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// UsersApiInterface lists the operations of UsersApi, so that code depending on it can be tested with mocks.
+type UsersApiInterface interface {
+	CreateUser(ctx _context.Context, body User) (UserResponse, *_nethttp.Response, error)
+	DisableUser(ctx _context.Context, userHandle string) (UserDisableResponse, *_nethttp.Response, error)
+	GetUser(ctx _context.Context, userHandle string) (UserResponse, *_nethttp.Response, error)
+	ListUsers(ctx _context.Context) (UserListResponse, *_nethttp.Response, error)
+	UpdateUser(ctx _context.Context, userHandle string, body User) (UserResponse, *_nethttp.Response, error)
+}
+
+var _ UsersApiInterface = (*UsersApi)(nil)
+
 // NewUsersApi Returns NewUsersApi.
 func NewUsersApi(client *datadog.APIClient) *UsersApi {
 	return &UsersApi{
@@ -64,6 +64,14 @@ func (v AccessRole) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AccessRole) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AccessRole: valid values are %v", v, allowedAccessRoleEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AccessRole value.
 func (v AccessRole) Ptr() *AccessRole {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -323,3 +324,16 @@ func (o *AlertGraphWidgetDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AlertGraphWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("time", o.Time, nil),
+		datadog.ValidateValue("title_align", o.TitleAlign, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+		datadog.ValidateValue("viz_type", o.VizType, nil),
+	)
+}
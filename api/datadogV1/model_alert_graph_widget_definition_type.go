@@ -58,6 +58,14 @@ func (v AlertGraphWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AlertGraphWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AlertGraphWidgetDefinitionType: valid values are %v", v, allowedAlertGraphWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AlertGraphWidgetDefinitionType value.
 func (v AlertGraphWidgetDefinitionType) Ptr() *AlertGraphWidgetDefinitionType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -361,3 +362,15 @@ func (o *AlertValueWidgetDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AlertValueWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("text_align", o.TextAlign, nil),
+		datadog.ValidateValue("title_align", o.TitleAlign, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v AlertValueWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AlertValueWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AlertValueWidgetDefinitionType: valid values are %v", v, allowedAlertValueWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AlertValueWidgetDefinitionType value.
 func (v AlertValueWidgetDefinitionType) Ptr() *AlertValueWidgetDefinitionType {
 	return &v
@@ -205,3 +205,11 @@ func (o *ApiKey) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ApiKey) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("key", o.Key, &datadog.Constraints{MinLength: datadog.PtrInt64(32), MaxLength: datadog.PtrInt64(32)})
+}
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -218,3 +219,14 @@ func (o *ApmStatsQueryColumnType) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ApmStatsQueryColumnType) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("cell_display_mode", o.CellDisplayMode, nil),
+		datadog.ValidateValue("order", o.Order, nil),
+	)
+}
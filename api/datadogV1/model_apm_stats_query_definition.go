@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -307,3 +308,14 @@ func (o *ApmStatsQueryDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ApmStatsQueryDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("columns", o.Columns, nil),
+		datadog.ValidateValue("row_type", o.RowType, nil),
+	)
+}
@@ -62,6 +62,14 @@ func (v ApmStatsQueryRowType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ApmStatsQueryRowType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ApmStatsQueryRowType: valid values are %v", v, allowedApmStatsQueryRowTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ApmStatsQueryRowType value.
 func (v ApmStatsQueryRowType) Ptr() *ApmStatsQueryRowType {
 	return &v
@@ -170,3 +170,11 @@ func (o *ApplicationKey) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ApplicationKey) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("hash", o.Hash, &datadog.Constraints{MinLength: datadog.PtrInt64(40), MaxLength: datadog.PtrInt64(40)})
+}
@@ -109,3 +109,11 @@ func (o *ApplicationKeyResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ApplicationKeyResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("application_key", o.ApplicationKey, nil)
+}
@@ -100,3 +100,8 @@ func (o *AuthenticationValidationResponse) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuthenticationValidationResponse) Validate() error {
+	return nil
+}
@@ -514,3 +514,8 @@ func (o *AWSAccount) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSAccount) Validate() error {
+	return nil
+}
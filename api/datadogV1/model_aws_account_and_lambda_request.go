@@ -131,3 +131,8 @@ func (o *AWSAccountAndLambdaRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSAccountAndLambdaRequest) Validate() error {
+	return nil
+}
@@ -170,3 +170,8 @@ func (o *AWSAccountDeleteRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSAccountDeleteRequest) Validate() error {
+	return nil
+}
@@ -171,3 +171,11 @@ func (o *AWSEventBridgeAccountConfiguration) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSEventBridgeAccountConfiguration) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("eventHubs", o.EventHubs, nil)
+}
@@ -207,3 +207,8 @@ func (o *AWSEventBridgeCreateRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSEventBridgeCreateRequest) Validate() error {
+	return nil
+}
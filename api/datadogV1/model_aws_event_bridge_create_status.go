@@ -58,6 +58,14 @@ func (v AWSEventBridgeCreateStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AWSEventBridgeCreateStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AWSEventBridgeCreateStatus: valid values are %v", v, allowedAWSEventBridgeCreateStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AWSEventBridgeCreateStatus value.
 func (v AWSEventBridgeCreateStatus) Ptr() *AWSEventBridgeCreateStatus {
 	return &v
@@ -58,6 +58,14 @@ func (v AWSEventBridgeDeleteStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AWSEventBridgeDeleteStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AWSEventBridgeDeleteStatus: valid values are %v", v, allowedAWSEventBridgeDeleteStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AWSEventBridgeDeleteStatus value.
 func (v AWSEventBridgeDeleteStatus) Ptr() *AWSEventBridgeDeleteStatus {
 	return &v
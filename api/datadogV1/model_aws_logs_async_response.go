@@ -135,3 +135,11 @@ func (o *AWSLogsAsyncResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSLogsAsyncResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("errors", o.Errors, nil)
+}
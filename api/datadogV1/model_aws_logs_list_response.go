@@ -170,3 +170,11 @@ func (o *AWSLogsListResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSLogsListResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("lambdas", o.Lambdas, nil)
+}
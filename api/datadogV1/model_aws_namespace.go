@@ -70,6 +70,14 @@ func (v AWSNamespace) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AWSNamespace) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AWSNamespace: valid values are %v", v, allowedAWSNamespaceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AWSNamespace value.
 func (v AWSNamespace) Ptr() *AWSNamespace {
 	return &v
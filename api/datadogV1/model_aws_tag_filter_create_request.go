@@ -180,3 +180,11 @@ func (o *AWSTagFilterCreateRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSTagFilterCreateRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("namespace", o.Namespace, nil)
+}
@@ -145,3 +145,11 @@ func (o *AWSTagFilterDeleteRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AWSTagFilterDeleteRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("namespace", o.Namespace, nil)
+}
@@ -571,3 +571,11 @@ func (o *AzureAccount) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AzureAccount) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("metrics_config", o.MetricsConfig, nil)
+}
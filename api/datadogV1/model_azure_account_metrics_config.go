@@ -103,3 +103,8 @@ func (o *AzureAccountMetricsConfig) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AzureAccountMetricsConfig) Validate() error {
+	return nil
+}
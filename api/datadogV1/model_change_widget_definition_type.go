@@ -58,6 +58,14 @@ func (v ChangeWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ChangeWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ChangeWidgetDefinitionType: valid values are %v", v, allowedChangeWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ChangeWidgetDefinitionType value.
 func (v ChangeWidgetDefinitionType) Ptr() *ChangeWidgetDefinitionType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -745,3 +747,27 @@ func (o *ChangeWidgetRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ChangeWidgetRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("apm_query", o.ApmQuery, nil),
+		datadog.ValidateValue("change_type", o.ChangeType, nil),
+		datadog.ValidateValue("compare_to", o.CompareTo, nil),
+		datadog.ValidateValue("event_query", o.EventQuery, nil),
+		datadog.ValidateValue("formulas", o.Formulas, nil),
+		datadog.ValidateValue("log_query", o.LogQuery, nil),
+		datadog.ValidateValue("network_query", o.NetworkQuery, nil),
+		datadog.ValidateValue("order_by", o.OrderBy, nil),
+		datadog.ValidateValue("order_dir", o.OrderDir, nil),
+		datadog.ValidateValue("process_query", o.ProcessQuery, nil),
+		datadog.ValidateValue("profile_metrics_query", o.ProfileMetricsQuery, nil),
+		datadog.ValidateValue("queries", o.Queries, nil),
+		datadog.ValidateValue("response_format", o.ResponseFormat, nil),
+		datadog.ValidateValue("rum_query", o.RumQuery, nil),
+		datadog.ValidateValue("security_query", o.SecurityQuery, nil),
+	)
+}
@@ -144,3 +144,11 @@ func (o *CheckCanDeleteMonitorResponse) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CheckCanDeleteMonitorResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("data", o.Data, nil)
+}
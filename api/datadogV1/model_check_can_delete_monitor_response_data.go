@@ -100,3 +100,8 @@ func (o *CheckCanDeleteMonitorResponseData) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CheckCanDeleteMonitorResponseData) Validate() error {
+	return nil
+}
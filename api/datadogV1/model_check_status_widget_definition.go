@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -428,3 +429,16 @@ func (o *CheckStatusWidgetDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CheckStatusWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("grouping", o.Grouping, nil),
+		datadog.ValidateValue("time", o.Time, nil),
+		datadog.ValidateValue("title_align", o.TitleAlign, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v CheckStatusWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CheckStatusWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CheckStatusWidgetDefinitionType: valid values are %v", v, allowedCheckStatusWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CheckStatusWidgetDefinitionType value.
 func (v CheckStatusWidgetDefinitionType) Ptr() *CheckStatusWidgetDefinitionType {
 	return &v
@@ -181,3 +181,8 @@ func (o *Creator) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Creator) Validate() error {
+	return nil
+}
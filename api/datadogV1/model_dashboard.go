@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -741,3 +742,18 @@ func (o *Dashboard) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Dashboard) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("layout_type", o.LayoutType, nil),
+		datadog.ValidateValue("reflow_type", o.ReflowType, nil),
+		datadog.ValidateValue("tags", o.Tags, &datadog.Constraints{MaxItems: datadog.PtrInt64(5)}),
+		datadog.ValidateValue("template_variable_presets", o.TemplateVariablePresets, nil),
+		datadog.ValidateValue("template_variables", o.TemplateVariables, nil),
+		datadog.ValidateValue("widgets", o.Widgets, nil),
+	)
+}
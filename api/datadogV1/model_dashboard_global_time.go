@@ -110,3 +110,11 @@ func (o *DashboardGlobalTime) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardGlobalTime) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("live_span", o.LiveSpan, nil)
+}
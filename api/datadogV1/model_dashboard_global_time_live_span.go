@@ -72,6 +72,14 @@ func (v DashboardGlobalTimeLiveSpan) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DashboardGlobalTimeLiveSpan) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DashboardGlobalTimeLiveSpan: valid values are %v", v, allowedDashboardGlobalTimeLiveSpanEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DashboardGlobalTimeLiveSpan value.
 func (v DashboardGlobalTimeLiveSpan) Ptr() *DashboardGlobalTimeLiveSpan {
 	return &v
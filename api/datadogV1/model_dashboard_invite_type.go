@@ -58,6 +58,14 @@ func (v DashboardInviteType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DashboardInviteType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DashboardInviteType: valid values are %v", v, allowedDashboardInviteTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DashboardInviteType value.
 func (v DashboardInviteType) Ptr() *DashboardInviteType {
 	return &v
@@ -60,6 +60,14 @@ func (v DashboardLayoutType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DashboardLayoutType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DashboardLayoutType: valid values are %v", v, allowedDashboardLayoutTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DashboardLayoutType value.
 func (v DashboardLayoutType) Ptr() *DashboardLayoutType {
 	return &v
@@ -362,3 +362,11 @@ func (o *DashboardList) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardList) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("author", o.Author, nil)
+}
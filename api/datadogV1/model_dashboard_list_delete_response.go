@@ -100,3 +100,8 @@ func (o *DashboardListDeleteResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardListDeleteResponse) Validate() error {
+	return nil
+}
@@ -62,6 +62,14 @@ func (v DashboardReflowType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DashboardReflowType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DashboardReflowType: valid values are %v", v, allowedDashboardReflowTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DashboardReflowType value.
 func (v DashboardReflowType) Ptr() *DashboardReflowType {
 	return &v
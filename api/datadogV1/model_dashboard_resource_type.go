@@ -58,6 +58,14 @@ func (v DashboardResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DashboardResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DashboardResourceType: valid values are %v", v, allowedDashboardResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DashboardResourceType value.
 func (v DashboardResourceType) Ptr() *DashboardResourceType {
 	return &v
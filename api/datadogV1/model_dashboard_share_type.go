@@ -60,6 +60,14 @@ func (v DashboardShareType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DashboardShareType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DashboardShareType: valid values are %v", v, allowedDashboardShareTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DashboardShareType value.
 func (v DashboardShareType) Ptr() *DashboardShareType {
 	return &v
@@ -417,3 +417,11 @@ func (o *DashboardSummaryDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardSummaryDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("layout_type", o.LayoutType, nil)
+}
@@ -276,3 +276,11 @@ func (o *DashboardTemplateVariable) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardTemplateVariable) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("defaults", o.Defaults, &datadog.Constraints{Items: &datadog.Constraints{MinLength: datadog.PtrInt64(1)}})
+}
@@ -174,3 +174,11 @@ func (o *DashboardTemplateVariablePresetValue) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardTemplateVariablePresetValue) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("values", o.Values, &datadog.Constraints{MinItems: datadog.PtrInt64(1), Items: &datadog.Constraints{MinLength: datadog.PtrInt64(1)}})
+}
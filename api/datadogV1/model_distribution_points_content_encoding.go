@@ -58,6 +58,14 @@ func (v DistributionPointsContentEncoding) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DistributionPointsContentEncoding) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DistributionPointsContentEncoding: valid values are %v", v, allowedDistributionPointsContentEncodingEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DistributionPointsContentEncoding value.
 func (v DistributionPointsContentEncoding) Ptr() *DistributionPointsContentEncoding {
 	return &v
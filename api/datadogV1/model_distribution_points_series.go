@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -250,3 +251,14 @@ func (o *DistributionPointsSeries) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DistributionPointsSeries) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("points", o.Points, &datadog.Constraints{Items: &datadog.Constraints{MinItems: datadog.PtrInt64(2), MaxItems: datadog.PtrInt64(2)}}),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
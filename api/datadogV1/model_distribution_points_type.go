@@ -58,6 +58,14 @@ func (v DistributionPointsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DistributionPointsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DistributionPointsType: valid values are %v", v, allowedDistributionPointsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DistributionPointsType value.
 func (v DistributionPointsType) Ptr() *DistributionPointsType {
 	return &v
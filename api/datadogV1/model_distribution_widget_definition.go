@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -516,3 +517,20 @@ func (o *DistributionWidgetDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DistributionWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("custom_links", o.CustomLinks, nil),
+		datadog.ValidateValue("markers", o.Markers, nil),
+		datadog.ValidateValue("requests", o.Requests, &datadog.Constraints{MinItems: datadog.PtrInt64(1), MaxItems: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("time", o.Time, nil),
+		datadog.ValidateValue("title_align", o.TitleAlign, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+		datadog.ValidateValue("xaxis", o.Xaxis, nil),
+		datadog.ValidateValue("yaxis", o.Yaxis, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v DistributionWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DistributionWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DistributionWidgetDefinitionType: valid values are %v", v, allowedDistributionWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DistributionWidgetDefinitionType value.
 func (v DistributionWidgetDefinitionType) Ptr() *DistributionWidgetDefinitionType {
 	return &v
@@ -135,3 +135,11 @@ func (obj *DistributionWidgetHistogramRequestQuery) GetActualInstance() interfac
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *DistributionWidgetHistogramRequestQuery) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
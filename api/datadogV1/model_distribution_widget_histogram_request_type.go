@@ -58,6 +58,14 @@ func (v DistributionWidgetHistogramRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DistributionWidgetHistogramRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DistributionWidgetHistogramRequestType: valid values are %v", v, allowedDistributionWidgetHistogramRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DistributionWidgetHistogramRequestType value.
 func (v DistributionWidgetHistogramRequestType) Ptr() *DistributionWidgetHistogramRequestType {
 	return &v
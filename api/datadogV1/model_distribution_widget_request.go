@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -560,3 +562,24 @@ func (o *DistributionWidgetRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DistributionWidgetRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("apm_query", o.ApmQuery, nil),
+		datadog.ValidateValue("apm_stats_query", o.ApmStatsQuery, nil),
+		datadog.ValidateValue("event_query", o.EventQuery, nil),
+		datadog.ValidateValue("log_query", o.LogQuery, nil),
+		datadog.ValidateValue("network_query", o.NetworkQuery, nil),
+		datadog.ValidateValue("process_query", o.ProcessQuery, nil),
+		datadog.ValidateValue("profile_metrics_query", o.ProfileMetricsQuery, nil),
+		datadog.ValidateValue("query", o.Query, nil),
+		datadog.ValidateValue("request_type", o.RequestType, nil),
+		datadog.ValidateValue("rum_query", o.RumQuery, nil),
+		datadog.ValidateValue("security_query", o.SecurityQuery, nil),
+		datadog.ValidateValue("style", o.Style, nil),
+	)
+}
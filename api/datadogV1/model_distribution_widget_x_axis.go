@@ -217,3 +217,8 @@ func (o *DistributionWidgetXAxis) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DistributionWidgetXAxis) Validate() error {
+	return nil
+}
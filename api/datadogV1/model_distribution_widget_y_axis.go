@@ -252,3 +252,8 @@ func (o *DistributionWidgetYAxis) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DistributionWidgetYAxis) Validate() error {
+	return nil
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -871,3 +873,19 @@ func (o *Downtime) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Downtime) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("active_child", o.ActiveChild, nil),
+		datadog.ValidateValue("creator_id", o.CreatorId, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+		datadog.ValidateValue("downtime_type", o.DowntimeType, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+		datadog.ValidateValue("notify_end_states", o.NotifyEndStates, nil),
+		datadog.ValidateValue("notify_end_types", o.NotifyEndTypes, nil),
+		datadog.ValidateValue("recurrence", o.Recurrence, nil),
+		datadog.ValidateValue("updater_id", o.UpdaterId, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -318,6 +320,17 @@ func (o *DowntimeRecurrence) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeRecurrence) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("period", o.Period, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+		datadog.ValidateValue("until_occurrences", o.UntilOccurrences, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
+
 // NullableDowntimeRecurrence handles when a null is used for DowntimeRecurrence.
 type NullableDowntimeRecurrence struct {
 	value *DowntimeRecurrence
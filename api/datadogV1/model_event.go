@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -553,3 +555,15 @@ func (o *Event) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Event) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("alert_type", o.AlertType, nil),
+		datadog.ValidateValue("priority", o.Priority, nil),
+		datadog.ValidateValue("text", o.Text, &datadog.Constraints{MaxLength: datadog.PtrInt64(4000)}),
+	)
+}
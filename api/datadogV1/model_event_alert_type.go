@@ -72,6 +72,14 @@ func (v EventAlertType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventAlertType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventAlertType: valid values are %v", v, allowedEventAlertTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventAlertType value.
 func (v EventAlertType) Ptr() *EventAlertType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -479,3 +480,16 @@ func (o *EventCreateRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EventCreateRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("aggregation_key", o.AggregationKey, &datadog.Constraints{MaxLength: datadog.PtrInt64(100)}),
+		datadog.ValidateValue("alert_type", o.AlertType, nil),
+		datadog.ValidateValue("priority", o.Priority, nil),
+		datadog.ValidateValue("text", o.Text, &datadog.Constraints{MaxLength: datadog.PtrInt64(4000)}),
+	)
+}
@@ -60,6 +60,14 @@ func (v EventPriority) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventPriority) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventPriority: valid values are %v", v, allowedEventPriorityEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventPriority value.
 func (v EventPriority) Ptr() *EventPriority {
 	return &v
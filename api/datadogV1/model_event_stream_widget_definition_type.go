@@ -58,6 +58,14 @@ func (v EventStreamWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventStreamWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventStreamWidgetDefinitionType: valid values are %v", v, allowedEventStreamWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventStreamWidgetDefinitionType value.
 func (v EventStreamWidgetDefinitionType) Ptr() *EventStreamWidgetDefinitionType {
 	return &v
@@ -58,6 +58,14 @@ func (v EventTimelineWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventTimelineWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventTimelineWidgetDefinitionType: valid values are %v", v, allowedEventTimelineWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventTimelineWidgetDefinitionType value.
 func (v EventTimelineWidgetDefinitionType) Ptr() *EventTimelineWidgetDefinitionType {
 	return &v
@@ -70,6 +70,14 @@ func (v FormulaAndFunctionApmDependencyStatName) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionApmDependencyStatName) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionApmDependencyStatName: valid values are %v", v, allowedFormulaAndFunctionApmDependencyStatNameEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionApmDependencyStatName value.
 func (v FormulaAndFunctionApmDependencyStatName) Ptr() *FormulaAndFunctionApmDependencyStatName {
 	return &v
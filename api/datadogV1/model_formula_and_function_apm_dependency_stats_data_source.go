@@ -58,6 +58,14 @@ func (v FormulaAndFunctionApmDependencyStatsDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionApmDependencyStatsDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionApmDependencyStatsDataSource: valid values are %v", v, allowedFormulaAndFunctionApmDependencyStatsDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionApmDependencyStatsDataSource value.
 func (v FormulaAndFunctionApmDependencyStatsDataSource) Ptr() *FormulaAndFunctionApmDependencyStatsDataSource {
 	return &v
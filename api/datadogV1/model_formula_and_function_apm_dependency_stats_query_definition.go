@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -445,3 +446,15 @@ func (o *FormulaAndFunctionApmDependencyStatsQueryDefinition) UnmarshalJSON(byte
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FormulaAndFunctionApmDependencyStatsQueryDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("cross_org_uuids", o.CrossOrgUuids, &datadog.Constraints{MaxItems: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("data_source", o.DataSource, nil),
+		datadog.ValidateValue("stat", o.Stat, nil),
+	)
+}
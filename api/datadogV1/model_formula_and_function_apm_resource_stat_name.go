@@ -78,6 +78,14 @@ func (v FormulaAndFunctionApmResourceStatName) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionApmResourceStatName) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionApmResourceStatName: valid values are %v", v, allowedFormulaAndFunctionApmResourceStatNameEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionApmResourceStatName value.
 func (v FormulaAndFunctionApmResourceStatName) Ptr() *FormulaAndFunctionApmResourceStatName {
 	return &v
@@ -58,6 +58,14 @@ func (v FormulaAndFunctionApmResourceStatsDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionApmResourceStatsDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionApmResourceStatsDataSource: valid values are %v", v, allowedFormulaAndFunctionApmResourceStatsDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionApmResourceStatsDataSource value.
 func (v FormulaAndFunctionApmResourceStatsDataSource) Ptr() *FormulaAndFunctionApmResourceStatsDataSource {
 	return &v
@@ -58,6 +58,14 @@ func (v FormulaAndFunctionCloudCostDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionCloudCostDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionCloudCostDataSource: valid values are %v", v, allowedFormulaAndFunctionCloudCostDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionCloudCostDataSource value.
 func (v FormulaAndFunctionCloudCostDataSource) Ptr() *FormulaAndFunctionCloudCostDataSource {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -247,3 +248,15 @@ func (o *FormulaAndFunctionCloudCostQueryDefinition) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FormulaAndFunctionCloudCostQueryDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("aggregator", o.Aggregator, nil),
+		datadog.ValidateValue("cross_org_uuids", o.CrossOrgUuids, &datadog.Constraints{MaxItems: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("data_source", o.DataSource, nil),
+	)
+}
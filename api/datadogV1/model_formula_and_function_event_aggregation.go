@@ -80,6 +80,14 @@ func (v FormulaAndFunctionEventAggregation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionEventAggregation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionEventAggregation: valid values are %v", v, allowedFormulaAndFunctionEventAggregationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionEventAggregation value.
 func (v FormulaAndFunctionEventAggregation) Ptr() *FormulaAndFunctionEventAggregation {
 	return &v
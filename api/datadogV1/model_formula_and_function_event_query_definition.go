@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -354,3 +355,17 @@ func (o *FormulaAndFunctionEventQueryDefinition) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FormulaAndFunctionEventQueryDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("compute", o.Compute, nil),
+		datadog.ValidateValue("cross_org_uuids", o.CrossOrgUuids, &datadog.Constraints{MaxItems: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("data_source", o.DataSource, nil),
+		datadog.ValidateValue("group_by", o.GroupBy, nil),
+		datadog.ValidateValue("search", o.Search, nil),
+	)
+}
@@ -179,3 +179,11 @@ func (o *FormulaAndFunctionEventQueryDefinitionCompute) UnmarshalJSON(bytes []by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FormulaAndFunctionEventQueryDefinitionCompute) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("aggregation", o.Aggregation, nil)
+}
@@ -178,3 +178,11 @@ func (o *FormulaAndFunctionEventQueryGroupBy) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FormulaAndFunctionEventQueryGroupBy) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("sort", o.Sort, nil)
+}
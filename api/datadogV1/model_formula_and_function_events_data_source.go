@@ -78,6 +78,14 @@ func (v FormulaAndFunctionEventsDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionEventsDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionEventsDataSource: valid values are %v", v, allowedFormulaAndFunctionEventsDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionEventsDataSource value.
 func (v FormulaAndFunctionEventsDataSource) Ptr() *FormulaAndFunctionEventsDataSource {
 	return &v
@@ -72,6 +72,14 @@ func (v FormulaAndFunctionMetricAggregation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionMetricAggregation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionMetricAggregation: valid values are %v", v, allowedFormulaAndFunctionMetricAggregationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionMetricAggregation value.
 func (v FormulaAndFunctionMetricAggregation) Ptr() *FormulaAndFunctionMetricAggregation {
 	return &v
@@ -58,6 +58,14 @@ func (v FormulaAndFunctionMetricDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionMetricDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionMetricDataSource: valid values are %v", v, allowedFormulaAndFunctionMetricDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionMetricDataSource value.
 func (v FormulaAndFunctionMetricDataSource) Ptr() *FormulaAndFunctionMetricDataSource {
 	return &v
@@ -60,6 +60,14 @@ func (v FormulaAndFunctionProcessQueryDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionProcessQueryDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionProcessQueryDataSource: valid values are %v", v, allowedFormulaAndFunctionProcessQueryDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionProcessQueryDataSource value.
 func (v FormulaAndFunctionProcessQueryDataSource) Ptr() *FormulaAndFunctionProcessQueryDataSource {
 	return &v
@@ -263,3 +263,11 @@ func (obj *FormulaAndFunctionQueryDefinition) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *FormulaAndFunctionQueryDefinition) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
@@ -62,6 +62,14 @@ func (v FormulaAndFunctionResponseFormat) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionResponseFormat) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionResponseFormat: valid values are %v", v, allowedFormulaAndFunctionResponseFormatEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionResponseFormat value.
 func (v FormulaAndFunctionResponseFormat) Ptr() *FormulaAndFunctionResponseFormat {
 	return &v
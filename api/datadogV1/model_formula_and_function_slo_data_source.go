@@ -58,6 +58,14 @@ func (v FormulaAndFunctionSLODataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionSLODataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionSLODataSource: valid values are %v", v, allowedFormulaAndFunctionSLODataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionSLODataSource value.
 func (v FormulaAndFunctionSLODataSource) Ptr() *FormulaAndFunctionSLODataSource {
 	return &v
@@ -60,6 +60,14 @@ func (v FormulaAndFunctionSLOGroupMode) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionSLOGroupMode) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionSLOGroupMode: valid values are %v", v, allowedFormulaAndFunctionSLOGroupModeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionSLOGroupMode value.
 func (v FormulaAndFunctionSLOGroupMode) Ptr() *FormulaAndFunctionSLOGroupMode {
 	return &v
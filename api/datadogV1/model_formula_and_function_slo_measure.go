@@ -68,6 +68,14 @@ func (v FormulaAndFunctionSLOMeasure) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionSLOMeasure) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionSLOMeasure: valid values are %v", v, allowedFormulaAndFunctionSLOMeasureEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionSLOMeasure value.
 func (v FormulaAndFunctionSLOMeasure) Ptr() *FormulaAndFunctionSLOMeasure {
 	return &v
@@ -60,6 +60,14 @@ func (v FormulaAndFunctionSLOQueryType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaAndFunctionSLOQueryType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaAndFunctionSLOQueryType: valid values are %v", v, allowedFormulaAndFunctionSLOQueryTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaAndFunctionSLOQueryType value.
 func (v FormulaAndFunctionSLOQueryType) Ptr() *FormulaAndFunctionSLOQueryType {
 	return &v
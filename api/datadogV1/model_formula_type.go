@@ -58,6 +58,14 @@ func (v FormulaType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FormulaType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FormulaType: valid values are %v", v, allowedFormulaTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FormulaType value.
 func (v FormulaType) Ptr() *FormulaType {
 	return &v
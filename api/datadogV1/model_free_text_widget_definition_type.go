@@ -58,6 +58,14 @@ func (v FreeTextWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FreeTextWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FreeTextWidgetDefinitionType: valid values are %v", v, allowedFreeTextWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FreeTextWidgetDefinitionType value.
 func (v FreeTextWidgetDefinitionType) Ptr() *FreeTextWidgetDefinitionType {
 	return &v
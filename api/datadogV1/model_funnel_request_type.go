@@ -58,6 +58,14 @@ func (v FunnelRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FunnelRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FunnelRequestType: valid values are %v", v, allowedFunnelRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FunnelRequestType value.
 func (v FunnelRequestType) Ptr() *FunnelRequestType {
 	return &v
@@ -58,6 +58,14 @@ func (v FunnelSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FunnelSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FunnelSource: valid values are %v", v, allowedFunnelSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FunnelSource value.
 func (v FunnelSource) Ptr() *FunnelSource {
 	return &v
@@ -58,6 +58,14 @@ func (v FunnelWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FunnelWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FunnelWidgetDefinitionType: valid values are %v", v, allowedFunnelWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FunnelWidgetDefinitionType value.
 func (v FunnelWidgetDefinitionType) Ptr() *FunnelWidgetDefinitionType {
 	return &v
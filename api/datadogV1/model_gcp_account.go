@@ -706,3 +706,8 @@ func (o *GCPAccount) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *GCPAccount) Validate() error {
+	return nil
+}
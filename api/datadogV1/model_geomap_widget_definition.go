@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -395,3 +396,19 @@ func (o *GeomapWidgetDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *GeomapWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("custom_links", o.CustomLinks, nil),
+		datadog.ValidateValue("requests", o.Requests, &datadog.Constraints{MinItems: datadog.PtrInt64(1), MaxItems: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("style", o.Style, nil),
+		datadog.ValidateValue("time", o.Time, nil),
+		datadog.ValidateValue("title_align", o.TitleAlign, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+		datadog.ValidateValue("view", o.View, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v GeomapWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v GeomapWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for GeomapWidgetDefinitionType: valid values are %v", v, allowedGeomapWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to GeomapWidgetDefinitionType value.
 func (v GeomapWidgetDefinitionType) Ptr() *GeomapWidgetDefinitionType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -440,3 +442,21 @@ func (o *GeomapWidgetRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *GeomapWidgetRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("columns", o.Columns, nil),
+		datadog.ValidateValue("formulas", o.Formulas, nil),
+		datadog.ValidateValue("log_query", o.LogQuery, nil),
+		datadog.ValidateValue("queries", o.Queries, nil),
+		datadog.ValidateValue("query", o.Query, nil),
+		datadog.ValidateValue("response_format", o.ResponseFormat, nil),
+		datadog.ValidateValue("rum_query", o.RumQuery, nil),
+		datadog.ValidateValue("security_query", o.SecurityQuery, nil),
+		datadog.ValidateValue("sort", o.Sort, nil),
+	)
+}
@@ -172,3 +172,8 @@ func (o *GraphSnapshot) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *GraphSnapshot) Validate() error {
+	return nil
+}
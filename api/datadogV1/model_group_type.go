@@ -58,6 +58,14 @@ func (v GroupType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v GroupType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for GroupType: valid values are %v", v, allowedGroupTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to GroupType value.
 func (v GroupType) Ptr() *GroupType {
 	return &v
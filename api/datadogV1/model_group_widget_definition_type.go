@@ -58,6 +58,14 @@ func (v GroupWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v GroupWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for GroupWidgetDefinitionType: valid values are %v", v, allowedGroupWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to GroupWidgetDefinitionType value.
 func (v GroupWidgetDefinitionType) Ptr() *GroupWidgetDefinitionType {
 	return &v
@@ -58,6 +58,14 @@ func (v HeatMapWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v HeatMapWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for HeatMapWidgetDefinitionType: valid values are %v", v, allowedHeatMapWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to HeatMapWidgetDefinitionType value.
 func (v HeatMapWidgetDefinitionType) Ptr() *HeatMapWidgetDefinitionType {
 	return &v
@@ -205,3 +205,8 @@ func (o *HostMapWidgetDefinitionStyle) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HostMapWidgetDefinitionStyle) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v HostMapWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v HostMapWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for HostMapWidgetDefinitionType: valid values are %v", v, allowedHostMapWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to HostMapWidgetDefinitionType value.
 func (v HostMapWidgetDefinitionType) Ptr() *HostMapWidgetDefinitionType {
 	return &v
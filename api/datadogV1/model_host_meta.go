@@ -599,3 +599,11 @@ func (o *HostMeta) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HostMeta) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("install_method", o.InstallMethod, nil)
+}
@@ -205,3 +205,8 @@ func (o *HostMuteResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HostMuteResponse) Validate() error {
+	return nil
+}
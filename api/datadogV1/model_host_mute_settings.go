@@ -170,3 +170,8 @@ func (o *HostMuteSettings) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HostMuteSettings) Validate() error {
+	return nil
+}
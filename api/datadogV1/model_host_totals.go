@@ -135,3 +135,8 @@ func (o *HostTotals) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HostTotals) Validate() error {
+	return nil
+}
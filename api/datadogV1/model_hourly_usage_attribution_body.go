@@ -401,3 +401,11 @@ func (o *HourlyUsageAttributionBody) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HourlyUsageAttributionBody) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("usage_type", o.UsageType, nil)
+}
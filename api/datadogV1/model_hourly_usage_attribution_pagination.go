@@ -111,3 +111,8 @@ func (o *HourlyUsageAttributionPagination) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HourlyUsageAttributionPagination) Validate() error {
+	return nil
+}
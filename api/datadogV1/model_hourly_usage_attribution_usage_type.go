@@ -196,6 +196,14 @@ func (v HourlyUsageAttributionUsageType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v HourlyUsageAttributionUsageType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for HourlyUsageAttributionUsageType: valid values are %v", v, allowedHourlyUsageAttributionUsageTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to HourlyUsageAttributionUsageType value.
 func (v HourlyUsageAttributionUsageType) Ptr() *HourlyUsageAttributionUsageType {
 	return &v
@@ -131,3 +131,11 @@ func (o *HTTPLogError) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HTTPLogError) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("code", o.Code, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)})
+}
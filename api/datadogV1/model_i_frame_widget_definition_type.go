@@ -58,6 +58,14 @@ func (v IFrameWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IFrameWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IFrameWidgetDefinitionType: valid values are %v", v, allowedIFrameWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IFrameWidgetDefinitionType value.
 func (v IFrameWidgetDefinitionType) Ptr() *IFrameWidgetDefinitionType {
 	return &v
@@ -100,3 +100,8 @@ func (o *IdpFormData) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IdpFormData) Validate() error {
+	return nil
+}
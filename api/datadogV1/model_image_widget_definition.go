@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -414,3 +415,17 @@ func (o *ImageWidgetDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ImageWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("horizontal_align", o.HorizontalAlign, nil),
+		datadog.ValidateValue("margin", o.Margin, nil),
+		datadog.ValidateValue("sizing", o.Sizing, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+		datadog.ValidateValue("vertical_align", o.VerticalAlign, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v ImageWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ImageWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ImageWidgetDefinitionType: valid values are %v", v, allowedImageWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ImageWidgetDefinitionType value.
 func (v ImageWidgetDefinitionType) Ptr() *ImageWidgetDefinitionType {
 	return &v
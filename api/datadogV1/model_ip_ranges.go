@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -559,3 +561,23 @@ func (o *IPRanges) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IPRanges) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("agents", o.Agents, nil),
+		datadog.ValidateValue("api", o.Api, nil),
+		datadog.ValidateValue("apm", o.Apm, nil),
+		datadog.ValidateValue("global", o.Global, nil),
+		datadog.ValidateValue("logs", o.Logs, nil),
+		datadog.ValidateValue("orchestrator", o.Orchestrator, nil),
+		datadog.ValidateValue("process", o.Process, nil),
+		datadog.ValidateValue("remote-configuration", o.RemoteConfiguration, nil),
+		datadog.ValidateValue("synthetics", o.Synthetics, nil),
+		datadog.ValidateValue("synthetics-private-locations", o.SyntheticsPrivateLocations, nil),
+		datadog.ValidateValue("webhooks", o.Webhooks, nil),
+	)
+}
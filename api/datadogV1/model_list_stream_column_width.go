@@ -62,6 +62,14 @@ func (v ListStreamColumnWidth) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ListStreamColumnWidth) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ListStreamColumnWidth: valid values are %v", v, allowedListStreamColumnWidthEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ListStreamColumnWidth value.
 func (v ListStreamColumnWidth) Ptr() *ListStreamColumnWidth {
 	return &v
@@ -86,6 +86,14 @@ func (v ListStreamComputeAggregation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ListStreamComputeAggregation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ListStreamComputeAggregation: valid values are %v", v, allowedListStreamComputeAggregationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ListStreamComputeAggregation value.
 func (v ListStreamComputeAggregation) Ptr() *ListStreamComputeAggregation {
 	return &v
@@ -99,3 +99,8 @@ func (o *ListStreamGroupByItems) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ListStreamGroupByItems) Validate() error {
+	return nil
+}
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -360,3 +361,17 @@ func (o *ListStreamQuery) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ListStreamQuery) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("compute", o.Compute, &datadog.Constraints{MinItems: datadog.PtrInt64(1), MaxItems: datadog.PtrInt64(5)}),
+		datadog.ValidateValue("data_source", o.DataSource, nil),
+		datadog.ValidateValue("event_size", o.EventSize, nil),
+		datadog.ValidateValue("group_by", o.GroupBy, &datadog.Constraints{MaxItems: datadog.PtrInt64(4)}),
+		datadog.ValidateValue("sort", o.Sort, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v ListStreamResponseFormat) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ListStreamResponseFormat) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ListStreamResponseFormat: valid values are %v", v, allowedListStreamResponseFormatEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ListStreamResponseFormat value.
 func (v ListStreamResponseFormat) Ptr() *ListStreamResponseFormat {
 	return &v
@@ -82,6 +82,14 @@ func (v ListStreamSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ListStreamSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ListStreamSource: valid values are %v", v, allowedListStreamSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ListStreamSource value.
 func (v ListStreamSource) Ptr() *ListStreamSource {
 	return &v
@@ -58,6 +58,14 @@ func (v ListStreamWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ListStreamWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ListStreamWidgetDefinitionType: valid values are %v", v, allowedListStreamWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ListStreamWidgetDefinitionType value.
 func (v ListStreamWidgetDefinitionType) Ptr() *ListStreamWidgetDefinitionType {
 	return &v
@@ -144,3 +144,11 @@ func (o *Log) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Log) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("content", o.Content, nil)
+}
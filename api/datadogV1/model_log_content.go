@@ -285,3 +285,8 @@ func (o *LogContent) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogContent) Validate() error {
+	return nil
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -252,3 +254,16 @@ func (o *LogQueryDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogQueryDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("compute", o.Compute, nil),
+		datadog.ValidateValue("group_by", o.GroupBy, nil),
+		datadog.ValidateValue("multi_compute", o.MultiCompute, nil),
+		datadog.ValidateValue("search", o.Search, nil),
+	)
+}
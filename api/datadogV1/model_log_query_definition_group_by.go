@@ -178,3 +178,11 @@ func (o *LogQueryDefinitionGroupBy) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogQueryDefinitionGroupBy) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("sort", o.Sort, nil)
+}
@@ -176,3 +176,11 @@ func (o *LogQueryDefinitionGroupBySort) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogQueryDefinitionGroupBySort) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("order", o.Order, nil)
+}
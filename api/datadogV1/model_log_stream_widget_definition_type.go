@@ -58,6 +58,14 @@ func (v LogStreamWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogStreamWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogStreamWidgetDefinitionType: valid values are %v", v, allowedLogStreamWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogStreamWidgetDefinitionType value.
 func (v LogStreamWidgetDefinitionType) Ptr() *LogStreamWidgetDefinitionType {
 	return &v
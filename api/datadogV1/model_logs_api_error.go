@@ -170,3 +170,11 @@ func (o *LogsAPIError) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsAPIError) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("details", o.Details, nil)
+}
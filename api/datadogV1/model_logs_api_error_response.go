@@ -109,3 +109,11 @@ func (o *LogsAPIErrorResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsAPIErrorResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("error", o.Error, nil)
+}
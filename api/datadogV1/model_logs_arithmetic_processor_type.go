@@ -58,6 +58,14 @@ func (v LogsArithmeticProcessorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsArithmeticProcessorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsArithmeticProcessorType: valid values are %v", v, allowedLogsArithmeticProcessorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsArithmeticProcessorType value.
 func (v LogsArithmeticProcessorType) Ptr() *LogsArithmeticProcessorType {
 	return &v
@@ -58,6 +58,14 @@ func (v LogsAttributeRemapperType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsAttributeRemapperType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsAttributeRemapperType: valid values are %v", v, allowedLogsAttributeRemapperTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsAttributeRemapperType value.
 func (v LogsAttributeRemapperType) Ptr() *LogsAttributeRemapperType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -182,3 +184,15 @@ func (o *LogsByRetention) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsByRetention) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("orgs", o.Orgs, nil),
+		datadog.ValidateValue("usage", o.Usage, nil),
+		datadog.ValidateValue("usage_by_month", o.UsageByMonth, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v LogsCategoryProcessorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsCategoryProcessorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsCategoryProcessorType: valid values are %v", v, allowedLogsCategoryProcessorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsCategoryProcessorType value.
 func (v LogsCategoryProcessorType) Ptr() *LogsCategoryProcessorType {
 	return &v
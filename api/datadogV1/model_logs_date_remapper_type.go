@@ -58,6 +58,14 @@ func (v LogsDateRemapperType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsDateRemapperType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsDateRemapperType: valid values are %v", v, allowedLogsDateRemapperTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsDateRemapperType value.
 func (v LogsDateRemapperType) Ptr() *LogsDateRemapperType {
 	return &v
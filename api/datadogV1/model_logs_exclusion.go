@@ -178,3 +178,11 @@ func (o *LogsExclusion) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsExclusion) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("filter", o.Filter, nil)
+}
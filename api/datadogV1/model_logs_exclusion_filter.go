@@ -136,3 +136,8 @@ func (o *LogsExclusionFilter) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsExclusionFilter) Validate() error {
+	return nil
+}
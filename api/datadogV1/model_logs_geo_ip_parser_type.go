@@ -58,6 +58,14 @@ func (v LogsGeoIPParserType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsGeoIPParserType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsGeoIPParserType: valid values are %v", v, allowedLogsGeoIPParserTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsGeoIPParserType value.
 func (v LogsGeoIPParserType) Ptr() *LogsGeoIPParserType {
 	return &v
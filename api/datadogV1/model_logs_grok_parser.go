@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -290,3 +291,15 @@ func (o *LogsGrokParser) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsGrokParser) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("grok", o.Grok, nil),
+		datadog.ValidateValue("samples", o.Samples, &datadog.Constraints{MaxItems: datadog.PtrInt64(5), Items: &datadog.Constraints{MaxLength: datadog.PtrInt64(5000)}}),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -138,3 +138,8 @@ func (o *LogsGrokParserRules) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsGrokParserRules) Validate() error {
+	return nil
+}
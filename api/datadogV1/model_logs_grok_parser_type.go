@@ -58,6 +58,14 @@ func (v LogsGrokParserType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsGrokParserType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsGrokParserType: valid values are %v", v, allowedLogsGrokParserTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsGrokParserType value.
 func (v LogsGrokParserType) Ptr() *LogsGrokParserType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -368,3 +369,16 @@ func (o *LogsIndexUpdateRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsIndexUpdateRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("daily_limit_reset", o.DailyLimitReset, nil),
+		datadog.ValidateValue("daily_limit_warning_threshold_percentage", o.DailyLimitWarningThresholdPercentage, &datadog.Constraints{Minimum: datadog.PtrFloat64(50), Maximum: datadog.PtrFloat64(99.99)}),
+		datadog.ValidateValue("exclusion_filters", o.ExclusionFilters, nil),
+		datadog.ValidateValue("filter", o.Filter, nil),
+	)
+}
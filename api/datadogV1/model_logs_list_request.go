@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -292,3 +293,15 @@ func (o *LogsListRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsListRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("limit", o.Limit, &datadog.Constraints{Maximum: datadog.PtrFloat64(1000)}),
+		datadog.ValidateValue("sort", o.Sort, nil),
+		datadog.ValidateValue("time", o.Time, nil),
+	)
+}
@@ -176,3 +176,8 @@ func (o *LogsListRequestTime) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsListRequestTime) Validate() error {
+	return nil
+}
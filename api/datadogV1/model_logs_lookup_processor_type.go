@@ -58,6 +58,14 @@ func (v LogsLookupProcessorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsLookupProcessorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsLookupProcessorType: valid values are %v", v, allowedLogsLookupProcessorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsLookupProcessorType value.
 func (v LogsLookupProcessorType) Ptr() *LogsLookupProcessorType {
 	return &v
@@ -58,6 +58,14 @@ func (v LogsMessageRemapperType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsMessageRemapperType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsMessageRemapperType: valid values are %v", v, allowedLogsMessageRemapperTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsMessageRemapperType value.
 func (v LogsMessageRemapperType) Ptr() *LogsMessageRemapperType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -262,3 +263,15 @@ func (o *LogsPipelineProcessor) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsPipelineProcessor) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("filter", o.Filter, nil),
+		datadog.ValidateValue("processors", o.Processors, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v LogsPipelineProcessorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsPipelineProcessorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsPipelineProcessorType: valid values are %v", v, allowedLogsPipelineProcessorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsPipelineProcessorType value.
 func (v LogsPipelineProcessorType) Ptr() *LogsPipelineProcessorType {
 	return &v
@@ -551,3 +551,11 @@ func (obj *LogsProcessor) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *LogsProcessor) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
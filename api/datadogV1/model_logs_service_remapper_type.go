@@ -58,6 +58,14 @@ func (v LogsServiceRemapperType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsServiceRemapperType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsServiceRemapperType: valid values are %v", v, allowedLogsServiceRemapperTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsServiceRemapperType value.
 func (v LogsServiceRemapperType) Ptr() *LogsServiceRemapperType {
 	return &v
@@ -58,6 +58,14 @@ func (v LogsStatusRemapperType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsStatusRemapperType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsStatusRemapperType: valid values are %v", v, allowedLogsStatusRemapperTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsStatusRemapperType value.
 func (v LogsStatusRemapperType) Ptr() *LogsStatusRemapperType {
 	return &v
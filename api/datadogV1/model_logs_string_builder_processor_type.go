@@ -58,6 +58,14 @@ func (v LogsStringBuilderProcessorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsStringBuilderProcessorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsStringBuilderProcessorType: valid values are %v", v, allowedLogsStringBuilderProcessorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsStringBuilderProcessorType value.
 func (v LogsStringBuilderProcessorType) Ptr() *LogsStringBuilderProcessorType {
 	return &v
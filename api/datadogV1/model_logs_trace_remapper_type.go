@@ -58,6 +58,14 @@ func (v LogsTraceRemapperType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsTraceRemapperType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsTraceRemapperType: valid values are %v", v, allowedLogsTraceRemapperTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsTraceRemapperType value.
 func (v LogsTraceRemapperType) Ptr() *LogsTraceRemapperType {
 	return &v
@@ -58,6 +58,14 @@ func (v LogsURLParserType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsURLParserType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsURLParserType: valid values are %v", v, allowedLogsURLParserTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsURLParserType value.
 func (v LogsURLParserType) Ptr() *LogsURLParserType {
 	return &v
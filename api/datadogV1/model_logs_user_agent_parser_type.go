@@ -58,6 +58,14 @@ func (v LogsUserAgentParserType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsUserAgentParserType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsUserAgentParserType: valid values are %v", v, allowedLogsUserAgentParserTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsUserAgentParserType value.
 func (v LogsUserAgentParserType) Ptr() *LogsUserAgentParserType {
 	return &v
@@ -217,3 +217,8 @@ func (o *MatchingDowntime) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MatchingDowntime) Validate() error {
+	return nil
+}
@@ -60,6 +60,14 @@ func (v MetricContentEncoding) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricContentEncoding) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricContentEncoding: valid values are %v", v, allowedMetricContentEncodingEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricContentEncoding value.
 func (v MetricContentEncoding) Ptr() *MetricContentEncoding {
 	return &v
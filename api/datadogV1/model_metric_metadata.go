@@ -310,3 +310,8 @@ func (o *MetricMetadata) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricMetadata) Validate() error {
+	return nil
+}
@@ -99,3 +99,11 @@ func (o *MetricsPayload) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricsPayload) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("series", o.Series, nil)
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -534,3 +536,14 @@ func (o *MetricsQueryMetadata) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricsQueryMetadata) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("pointlist", o.Pointlist, &datadog.Constraints{Items: &datadog.Constraints{MinItems: datadog.PtrInt64(2), MaxItems: datadog.PtrInt64(2)}}),
+		datadog.ValidateValue("unit", o.Unit, &datadog.Constraints{MinItems: datadog.PtrInt64(2), MaxItems: datadog.PtrInt64(2)}),
+	)
+}
@@ -380,3 +380,11 @@ func (o *MetricsQueryResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricsQueryResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("series", o.Series, nil)
+}
@@ -241,6 +241,11 @@ func (o *MetricsQueryUnit) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricsQueryUnit) Validate() error {
+	return nil
+}
+
 // NullableMetricsQueryUnit handles when a null is used for MetricsQueryUnit.
 type NullableMetricsQueryUnit struct {
 	value *MetricsQueryUnit
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -721,3 +722,18 @@ func (o *Monitor) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Monitor) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("creator", o.Creator, nil),
+		datadog.ValidateValue("matching_downtimes", o.MatchingDowntimes, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("overall_state", o.OverallState, nil),
+		datadog.ValidateValue("state", o.State, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -74,6 +74,14 @@ func (v MonitorDeviceID) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorDeviceID) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorDeviceID: valid values are %v", v, allowedMonitorDeviceIDEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorDeviceID value.
 func (v MonitorDeviceID) Ptr() *MonitorDeviceID {
 	return &v
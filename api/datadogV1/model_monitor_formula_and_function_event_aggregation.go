@@ -80,6 +80,14 @@ func (v MonitorFormulaAndFunctionEventAggregation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorFormulaAndFunctionEventAggregation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorFormulaAndFunctionEventAggregation: valid values are %v", v, allowedMonitorFormulaAndFunctionEventAggregationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorFormulaAndFunctionEventAggregation value.
 func (v MonitorFormulaAndFunctionEventAggregation) Ptr() *MonitorFormulaAndFunctionEventAggregation {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -284,3 +285,16 @@ func (o *MonitorFormulaAndFunctionEventQueryDefinition) UnmarshalJSON(bytes []by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorFormulaAndFunctionEventQueryDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("compute", o.Compute, nil),
+		datadog.ValidateValue("data_source", o.DataSource, nil),
+		datadog.ValidateValue("group_by", o.GroupBy, nil),
+		datadog.ValidateValue("search", o.Search, nil),
+	)
+}
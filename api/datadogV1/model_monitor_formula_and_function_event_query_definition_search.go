@@ -99,3 +99,8 @@ func (o *MonitorFormulaAndFunctionEventQueryDefinitionSearch) UnmarshalJSON(byte
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorFormulaAndFunctionEventQueryDefinitionSearch) Validate() error {
+	return nil
+}
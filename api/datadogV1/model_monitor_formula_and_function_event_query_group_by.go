@@ -178,3 +178,11 @@ func (o *MonitorFormulaAndFunctionEventQueryGroupBy) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorFormulaAndFunctionEventQueryGroupBy) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("sort", o.Sort, nil)
+}
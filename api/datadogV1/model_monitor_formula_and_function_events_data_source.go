@@ -74,6 +74,14 @@ func (v MonitorFormulaAndFunctionEventsDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorFormulaAndFunctionEventsDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorFormulaAndFunctionEventsDataSource: valid values are %v", v, allowedMonitorFormulaAndFunctionEventsDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorFormulaAndFunctionEventsDataSource value.
 func (v MonitorFormulaAndFunctionEventsDataSource) Ptr() *MonitorFormulaAndFunctionEventsDataSource {
 	return &v
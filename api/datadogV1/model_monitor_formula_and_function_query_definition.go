@@ -71,3 +71,11 @@ func (obj *MonitorFormulaAndFunctionQueryDefinition) GetActualInstance() interfa
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *MonitorFormulaAndFunctionQueryDefinition) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
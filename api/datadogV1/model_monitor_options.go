@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -1378,3 +1380,22 @@ func (o *MonitorOptions) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("aggregation", o.Aggregation, nil),
+		datadog.ValidateValue("device_ids", o.DeviceIds, nil),
+		datadog.ValidateValue("min_failure_duration", o.MinFailureDuration, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(7200)}),
+		datadog.ValidateValue("notification_preset_name", o.NotificationPresetName, nil),
+		datadog.ValidateValue("on_missing_data", o.OnMissingData, nil),
+		datadog.ValidateValue("renotify_statuses", o.RenotifyStatuses, nil),
+		datadog.ValidateValue("scheduling_options", o.SchedulingOptions, nil),
+		datadog.ValidateValue("threshold_windows", o.ThresholdWindows, nil),
+		datadog.ValidateValue("thresholds", o.Thresholds, nil),
+		datadog.ValidateValue("variables", o.Variables, nil),
+	)
+}
@@ -170,3 +170,8 @@ func (o *MonitorOptionsCustomScheduleRecurrence) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorOptionsCustomScheduleRecurrence) Validate() error {
+	return nil
+}
@@ -64,6 +64,14 @@ func (v MonitorOptionsNotificationPresets) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorOptionsNotificationPresets) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorOptionsNotificationPresets: valid values are %v", v, allowedMonitorOptionsNotificationPresetsEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorOptionsNotificationPresets value.
 func (v MonitorOptionsNotificationPresets) Ptr() *MonitorOptionsNotificationPresets {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -170,3 +172,14 @@ func (o *MonitorOptionsSchedulingOptionsEvaluationWindow) UnmarshalJSON(bytes []
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorOptionsSchedulingOptionsEvaluationWindow) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("hour_starts", o.HourStarts, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(59)}),
+		datadog.ValidateValue("month_starts", o.MonthStarts, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(1)}),
+	)
+}
@@ -70,6 +70,14 @@ func (v MonitorOverallStates) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorOverallStates) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorOverallStates: valid values are %v", v, allowedMonitorOverallStatesEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorOverallStates value.
 func (v MonitorOverallStates) Ptr() *MonitorOverallStates {
 	return &v
@@ -62,6 +62,14 @@ func (v MonitorRenotifyStatusType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorRenotifyStatusType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorRenotifyStatusType: valid values are %v", v, allowedMonitorRenotifyStatusTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorRenotifyStatusType value.
 func (v MonitorRenotifyStatusType) Ptr() *MonitorRenotifyStatusType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -182,3 +184,15 @@ func (o *MonitorSearchResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorSearchResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("counts", o.Counts, nil),
+		datadog.ValidateValue("metadata", o.Metadata, nil),
+		datadog.ValidateValue("monitors", o.Monitors, nil),
+	)
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -205,3 +207,16 @@ func (o *MonitorSearchResponseCounts) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorSearchResponseCounts) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("muted", o.Muted, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("tag", o.Tag, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -551,3 +553,16 @@ func (o *MonitorSearchResult) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorSearchResult) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("creator", o.Creator, nil),
+		datadog.ValidateValue("notifications", o.Notifications, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -101,3 +101,11 @@ func (o *MonitorState) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorState) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("groups", o.Groups, nil)
+}
@@ -285,3 +285,11 @@ func (o *MonitorStateGroup) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorStateGroup) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("status", o.Status, nil)
+}
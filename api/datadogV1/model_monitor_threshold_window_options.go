@@ -157,3 +157,8 @@ func (o *MonitorThresholdWindowOptions) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorThresholdWindowOptions) Validate() error {
+	return nil
+}
@@ -330,3 +330,8 @@ func (o *MonitorThresholds) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorThresholds) Validate() error {
+	return nil
+}
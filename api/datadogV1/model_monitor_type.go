@@ -92,6 +92,14 @@ func (v MonitorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorType: valid values are %v", v, allowedMonitorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorType value.
 func (v MonitorType) Ptr() *MonitorType {
 	return &v
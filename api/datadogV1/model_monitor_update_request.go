@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -691,3 +692,17 @@ func (o *MonitorUpdateRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorUpdateRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("creator", o.Creator, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("overall_state", o.OverallState, nil),
+		datadog.ValidateValue("state", o.State, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -369,3 +369,11 @@ func (o *MonthlyUsageAttributionBody) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonthlyUsageAttributionBody) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("values", o.Values, nil)
+}
@@ -111,3 +111,8 @@ func (o *MonthlyUsageAttributionPagination) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonthlyUsageAttributionPagination) Validate() error {
+	return nil
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -144,3 +146,14 @@ func (o *MonthlyUsageAttributionResponse) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonthlyUsageAttributionResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("metadata", o.Metadata, nil),
+		datadog.ValidateValue("usage", o.Usage, nil),
+	)
+}
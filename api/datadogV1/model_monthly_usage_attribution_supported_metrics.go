@@ -330,6 +330,14 @@ func (v MonthlyUsageAttributionSupportedMetrics) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonthlyUsageAttributionSupportedMetrics) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonthlyUsageAttributionSupportedMetrics: valid values are %v", v, allowedMonthlyUsageAttributionSupportedMetricsEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonthlyUsageAttributionSupportedMetrics value.
 func (v MonthlyUsageAttributionSupportedMetrics) Ptr() *MonthlyUsageAttributionSupportedMetrics {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -439,3 +440,16 @@ func (o *NoteWidgetDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NoteWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("text_align", o.TextAlign, nil),
+		datadog.ValidateValue("tick_edge", o.TickEdge, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+		datadog.ValidateValue("vertical_align", o.VerticalAlign, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v NoteWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NoteWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NoteWidgetDefinitionType: valid values are %v", v, allowedNoteWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NoteWidgetDefinitionType value.
 func (v NoteWidgetDefinitionType) Ptr() *NoteWidgetDefinitionType {
 	return &v
@@ -175,3 +175,8 @@ func (o *NotebookAbsoluteTime) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NotebookAbsoluteTime) Validate() error {
+	return nil
+}
@@ -408,3 +408,8 @@ func (o *NotebookAuthor) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NotebookAuthor) Validate() error {
+	return nil
+}
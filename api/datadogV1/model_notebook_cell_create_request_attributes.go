@@ -232,3 +232,11 @@ func (obj *NotebookCellCreateRequestAttributes) GetActualInstance() interface{}
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *NotebookCellCreateRequestAttributes) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
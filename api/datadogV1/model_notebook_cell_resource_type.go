@@ -58,6 +58,14 @@ func (v NotebookCellResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NotebookCellResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NotebookCellResourceType: valid values are %v", v, allowedNotebookCellResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NotebookCellResourceType value.
 func (v NotebookCellResourceType) Ptr() *NotebookCellResourceType {
 	return &v
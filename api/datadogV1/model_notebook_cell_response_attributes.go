@@ -232,3 +232,11 @@ func (obj *NotebookCellResponseAttributes) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *NotebookCellResponseAttributes) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
@@ -104,6 +104,14 @@ func (obj *NotebookCellTime) GetActualInstance() interface{} {
 	return nil
 }
 
+// Validate validates the actual instance.
+func (obj *NotebookCellTime) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
+
 // NullableNotebookCellTime handles when a null is used for NotebookCellTime.
 type NullableNotebookCellTime struct {
 	value *NotebookCellTime
@@ -66,6 +66,14 @@ func (v NotebookGraphSize) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NotebookGraphSize) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NotebookGraphSize: valid values are %v", v, allowedNotebookGraphSizeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NotebookGraphSize value.
 func (v NotebookGraphSize) Ptr() *NotebookGraphSize {
 	return &v
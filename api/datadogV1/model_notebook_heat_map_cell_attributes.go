@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -231,3 +232,16 @@ func (o *NotebookHeatMapCellAttributes) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NotebookHeatMapCellAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("definition", o.Definition, nil),
+		datadog.ValidateValue("graph_size", o.GraphSize, nil),
+		datadog.ValidateValue("split_by", o.SplitBy, nil),
+		datadog.ValidateValue("time", o.Time, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v NotebookMarkdownCellDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NotebookMarkdownCellDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NotebookMarkdownCellDefinitionType: valid values are %v", v, allowedNotebookMarkdownCellDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NotebookMarkdownCellDefinitionType value.
 func (v NotebookMarkdownCellDefinitionType) Ptr() *NotebookMarkdownCellDefinitionType {
 	return &v
@@ -66,6 +66,14 @@ func (v NotebookMetadataType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NotebookMetadataType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NotebookMetadataType: valid values are %v", v, allowedNotebookMetadataTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NotebookMetadataType value.
 func (v NotebookMetadataType) Ptr() *NotebookMetadataType {
 	return &v
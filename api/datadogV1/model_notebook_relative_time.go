@@ -110,6 +110,14 @@ func (o *NotebookRelativeTime) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NotebookRelativeTime) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("live_span", o.LiveSpan, nil)
+}
+
 // NullableNotebookRelativeTime handles when a null is used for NotebookRelativeTime.
 type NullableNotebookRelativeTime struct {
 	value *NotebookRelativeTime
@@ -58,6 +58,14 @@ func (v NotebookResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NotebookResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NotebookResourceType: valid values are %v", v, allowedNotebookResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NotebookResourceType value.
 func (v NotebookResourceType) Ptr() *NotebookResourceType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -367,3 +368,18 @@ func (o *NotebookResponseDataAttributes) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NotebookResponseDataAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("author", o.Author, nil),
+		datadog.ValidateValue("cells", o.Cells, nil),
+		datadog.ValidateValue("metadata", o.Metadata, nil),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MinLength: datadog.PtrInt64(0), MaxLength: datadog.PtrInt64(80)}),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("time", o.Time, nil),
+	)
+}
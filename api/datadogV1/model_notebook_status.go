@@ -58,6 +58,14 @@ func (v NotebookStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NotebookStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NotebookStatus: valid values are %v", v, allowedNotebookStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NotebookStatus value.
 func (v NotebookStatus) Ptr() *NotebookStatus {
 	return &v
@@ -62,6 +62,14 @@ func (v NotifyEndState) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NotifyEndState) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NotifyEndState: valid values are %v", v, allowedNotifyEndStateEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NotifyEndState value.
 func (v NotifyEndState) Ptr() *NotifyEndState {
 	return &v
@@ -60,6 +60,14 @@ func (v NotifyEndType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v NotifyEndType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for NotifyEndType: valid values are %v", v, allowedNotifyEndTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to NotifyEndType value.
 func (v NotifyEndType) Ptr() *NotifyEndType {
 	return &v
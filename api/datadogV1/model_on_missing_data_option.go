@@ -68,6 +68,14 @@ func (v OnMissingDataOption) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OnMissingDataOption) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OnMissingDataOption: valid values are %v", v, allowedOnMissingDataOptionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OnMissingDataOption value.
 func (v OnMissingDataOption) Ptr() *OnMissingDataOption {
 	return &v
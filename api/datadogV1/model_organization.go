@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -368,3 +370,16 @@ func (o *Organization) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Organization) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("billing", o.Billing, nil),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MaxLength: datadog.PtrInt64(32)}),
+		datadog.ValidateValue("settings", o.Settings, nil),
+		datadog.ValidateValue("subscription", o.Subscription, nil),
+	)
+}
@@ -102,3 +102,8 @@ func (o *OrganizationBilling) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrganizationBilling) Validate() error {
+	return nil
+}
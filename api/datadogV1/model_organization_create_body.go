@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -189,3 +190,15 @@ func (o *OrganizationCreateBody) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrganizationCreateBody) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("billing", o.Billing, nil),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MaxLength: datadog.PtrInt64(32)}),
+		datadog.ValidateValue("subscription", o.Subscription, nil),
+	)
+}
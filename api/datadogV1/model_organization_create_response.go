@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -223,3 +225,16 @@ func (o *OrganizationCreateResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrganizationCreateResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("api_key", o.ApiKey, nil),
+		datadog.ValidateValue("application_key", o.ApplicationKey, nil),
+		datadog.ValidateValue("org", o.Org, nil),
+		datadog.ValidateValue("user", o.User, nil),
+	)
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -449,3 +451,17 @@ func (o *OrganizationSettings) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrganizationSettings) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("saml", o.Saml, nil),
+		datadog.ValidateValue("saml_autocreate_access_role", o.SamlAutocreateAccessRole, nil),
+		datadog.ValidateValue("saml_autocreate_users_domains", o.SamlAutocreateUsersDomains, nil),
+		datadog.ValidateValue("saml_idp_initiated_login", o.SamlIdpInitiatedLogin, nil),
+		datadog.ValidateValue("saml_strict_mode", o.SamlStrictMode, nil),
+	)
+}
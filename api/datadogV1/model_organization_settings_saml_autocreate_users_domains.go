@@ -135,3 +135,8 @@ func (o *OrganizationSettingsSamlAutocreateUsersDomains) UnmarshalJSON(bytes []b
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrganizationSettingsSamlAutocreateUsersDomains) Validate() error {
+	return nil
+}
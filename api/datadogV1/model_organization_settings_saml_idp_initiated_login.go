@@ -101,3 +101,8 @@ func (o *OrganizationSettingsSamlIdpInitiatedLogin) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrganizationSettingsSamlIdpInitiatedLogin) Validate() error {
+	return nil
+}
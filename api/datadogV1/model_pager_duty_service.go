@@ -131,3 +131,8 @@ func (o *PagerDutyService) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *PagerDutyService) Validate() error {
+	return nil
+}
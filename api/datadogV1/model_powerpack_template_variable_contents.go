@@ -166,3 +166,11 @@ func (o *PowerpackTemplateVariableContents) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *PowerpackTemplateVariableContents) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("values", o.Values, &datadog.Constraints{Items: &datadog.Constraints{MinLength: datadog.PtrInt64(1)}})
+}
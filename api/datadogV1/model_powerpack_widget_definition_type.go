@@ -58,6 +58,14 @@ func (v PowerpackWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v PowerpackWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for PowerpackWidgetDefinitionType: valid values are %v", v, allowedPowerpackWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to PowerpackWidgetDefinitionType value.
 func (v PowerpackWidgetDefinitionType) Ptr() *PowerpackWidgetDefinitionType {
 	return &v
@@ -204,3 +204,11 @@ func (o *ProcessQueryDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ProcessQueryDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("limit", o.Limit, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)})
+}
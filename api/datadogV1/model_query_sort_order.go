@@ -60,6 +60,14 @@ func (v QuerySortOrder) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v QuerySortOrder) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for QuerySortOrder: valid values are %v", v, allowedQuerySortOrderEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to QuerySortOrder value.
 func (v QuerySortOrder) Ptr() *QuerySortOrder {
 	return &v
@@ -58,6 +58,14 @@ func (v QueryValueWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v QueryValueWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for QueryValueWidgetDefinitionType: valid values are %v", v, allowedQueryValueWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to QueryValueWidgetDefinitionType value.
 func (v QueryValueWidgetDefinitionType) Ptr() *QueryValueWidgetDefinitionType {
 	return &v
@@ -58,6 +58,14 @@ func (v RunWorkflowWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RunWorkflowWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RunWorkflowWidgetDefinitionType: valid values are %v", v, allowedRunWorkflowWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RunWorkflowWidgetDefinitionType value.
 func (v RunWorkflowWidgetDefinitionType) Ptr() *RunWorkflowWidgetDefinitionType {
 	return &v
@@ -64,6 +64,14 @@ func (v ScatterplotDimension) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ScatterplotDimension) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ScatterplotDimension: valid values are %v", v, allowedScatterplotDimensionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ScatterplotDimension value.
 func (v ScatterplotDimension) Ptr() *ScatterplotDimension {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -180,3 +182,15 @@ func (o *ScatterplotTableRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ScatterplotTableRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("formulas", o.Formulas, nil),
+		datadog.ValidateValue("queries", o.Queries, nil),
+		datadog.ValidateValue("response_format", o.ResponseFormat, nil),
+	)
+}
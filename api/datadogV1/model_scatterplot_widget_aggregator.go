@@ -66,6 +66,14 @@ func (v ScatterplotWidgetAggregator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ScatterplotWidgetAggregator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ScatterplotWidgetAggregator: valid values are %v", v, allowedScatterplotWidgetAggregatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ScatterplotWidgetAggregator value.
 func (v ScatterplotWidgetAggregator) Ptr() *ScatterplotWidgetAggregator {
 	return &v
@@ -176,3 +176,11 @@ func (o *ScatterplotWidgetFormula) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ScatterplotWidgetFormula) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("dimension", o.Dimension, nil)
+}
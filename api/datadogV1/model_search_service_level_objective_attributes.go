@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -707,3 +709,18 @@ func (o *SearchServiceLevelObjectiveAttributes) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchServiceLevelObjectiveAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("creator", o.Creator, nil),
+		datadog.ValidateValue("overall_status", o.OverallStatus, nil),
+		datadog.ValidateValue("query", o.Query, nil),
+		datadog.ValidateValue("slo_type", o.SloType, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("thresholds", o.Thresholds, nil),
+	)
+}
@@ -182,3 +182,11 @@ func (o *SearchServiceLevelObjectiveData) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchServiceLevelObjectiveData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("attributes", o.Attributes, nil)
+}
@@ -185,6 +185,11 @@ func (o *SearchSLOQuery) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchSLOQuery) Validate() error {
+	return nil
+}
+
 // NullableSearchSLOQuery handles when a null is used for SearchSLOQuery.
 type NullableSearchSLOQuery struct {
 	value *SearchSLOQuery
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -185,3 +187,15 @@ func (o *SearchSLOResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchSLOResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("data", o.Data, nil),
+		datadog.ValidateValue("links", o.Links, nil),
+		datadog.ValidateValue("meta", o.Meta, nil),
+	)
+}
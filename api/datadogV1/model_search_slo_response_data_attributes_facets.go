@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -345,3 +347,20 @@ func (o *SearchSLOResponseDataAttributesFacets) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchSLOResponseDataAttributesFacets) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("all_tags", o.AllTags, nil),
+		datadog.ValidateValue("creator_name", o.CreatorName, nil),
+		datadog.ValidateValue("env_tags", o.EnvTags, nil),
+		datadog.ValidateValue("service_tags", o.ServiceTags, nil),
+		datadog.ValidateValue("slo_type", o.SloType, nil),
+		datadog.ValidateValue("target", o.Target, nil),
+		datadog.ValidateValue("team_tags", o.TeamTags, nil),
+		datadog.ValidateValue("timeframe", o.Timeframe, nil),
+	)
+}
@@ -135,3 +135,8 @@ func (o *SearchSLOResponseDataAttributesFacetsObjectString) UnmarshalJSON(bytes
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchSLOResponseDataAttributesFacetsObjectString) Validate() error {
+	return nil
+}
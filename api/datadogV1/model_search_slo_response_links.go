@@ -262,3 +262,8 @@ func (o *SearchSLOResponseLinks) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchSLOResponseLinks) Validate() error {
+	return nil
+}
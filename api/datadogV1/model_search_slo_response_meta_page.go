@@ -345,3 +345,8 @@ func (o *SearchSLOResponseMetaPage) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchSLOResponseMetaPage) Validate() error {
+	return nil
+}
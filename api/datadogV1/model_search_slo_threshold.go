@@ -277,3 +277,11 @@ func (o *SearchSLOThreshold) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SearchSLOThreshold) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("timeframe", o.Timeframe, nil)
+}
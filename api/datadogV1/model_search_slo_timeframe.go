@@ -62,6 +62,14 @@ func (v SearchSLOTimeframe) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SearchSLOTimeframe) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SearchSLOTimeframe: valid values are %v", v, allowedSearchSLOTimeframeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SearchSLOTimeframe value.
 func (v SearchSLOTimeframe) Ptr() *SearchSLOTimeframe {
 	return &v
@@ -216,3 +216,8 @@ func (o *SelectableTemplateVariableItems) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SelectableTemplateVariableItems) Validate() error {
+	return nil
+}
@@ -289,3 +289,11 @@ func (o *Series) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Series) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("points", o.Points, &datadog.Constraints{Items: &datadog.Constraints{MinItems: datadog.PtrInt64(2), MaxItems: datadog.PtrInt64(2)}})
+}
@@ -64,6 +64,14 @@ func (v ServiceCheckStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceCheckStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceCheckStatus: valid values are %v", v, allowedServiceCheckStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceCheckStatus value.
 func (v ServiceCheckStatus) Ptr() *ServiceCheckStatus {
 	return &v
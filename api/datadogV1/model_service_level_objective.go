@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -714,3 +715,18 @@ func (o *ServiceLevelObjective) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceLevelObjective) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("creator", o.Creator, nil),
+		datadog.ValidateValue("query", o.Query, nil),
+		datadog.ValidateValue("sli_specification", o.SliSpecification, nil),
+		datadog.ValidateValue("thresholds", o.Thresholds, nil),
+		datadog.ValidateValue("timeframe", o.Timeframe, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
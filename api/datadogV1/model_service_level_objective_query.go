@@ -133,3 +133,8 @@ func (o *ServiceLevelObjectiveQuery) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceLevelObjectiveQuery) Validate() error {
+	return nil
+}
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -525,3 +526,17 @@ func (o *ServiceLevelObjectiveRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceLevelObjectiveRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("query", o.Query, nil),
+		datadog.ValidateValue("sli_specification", o.SliSpecification, nil),
+		datadog.ValidateValue("thresholds", o.Thresholds, nil),
+		datadog.ValidateValue("timeframe", o.Timeframe, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v ServiceMapWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceMapWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceMapWidgetDefinitionType: valid values are %v", v, allowedServiceMapWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceMapWidgetDefinitionType value.
 func (v ServiceMapWidgetDefinitionType) Ptr() *ServiceMapWidgetDefinitionType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -505,3 +506,17 @@ func (o *SharedDashboard) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SharedDashboard) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("author", o.Author, nil),
+		datadog.ValidateValue("dashboard_type", o.DashboardType, nil),
+		datadog.ValidateValue("global_time", o.GlobalTime, nil),
+		datadog.ValidateValue("selectable_template_vars", o.SelectableTemplateVars, nil),
+		datadog.ValidateValue("share_type", o.ShareType, nil),
+	)
+}
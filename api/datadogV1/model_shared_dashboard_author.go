@@ -146,3 +146,8 @@ func (o *SharedDashboardAuthor) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SharedDashboardAuthor) Validate() error {
+	return nil
+}
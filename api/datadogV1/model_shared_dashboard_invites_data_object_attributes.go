@@ -296,3 +296,8 @@ func (o *SharedDashboardInvitesDataObjectAttributes) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SharedDashboardInvitesDataObjectAttributes) Validate() error {
+	return nil
+}
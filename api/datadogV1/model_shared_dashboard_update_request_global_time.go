@@ -111,6 +111,14 @@ func (o *SharedDashboardUpdateRequestGlobalTime) UnmarshalJSON(bytes []byte) (er
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SharedDashboardUpdateRequestGlobalTime) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("live_span", o.LiveSpan, nil)
+}
+
 // NullableSharedDashboardUpdateRequestGlobalTime handles when a null is used for SharedDashboardUpdateRequestGlobalTime.
 type NullableSharedDashboardUpdateRequestGlobalTime struct {
 	value *SharedDashboardUpdateRequestGlobalTime
@@ -66,6 +66,14 @@ func (v SignalArchiveReason) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SignalArchiveReason) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SignalArchiveReason: valid values are %v", v, allowedSignalArchiveReasonEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SignalArchiveReason value.
 func (v SignalArchiveReason) Ptr() *SignalArchiveReason {
 	return &v
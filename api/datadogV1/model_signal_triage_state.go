@@ -62,6 +62,14 @@ func (v SignalTriageState) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SignalTriageState) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SignalTriageState: valid values are %v", v, allowedSignalTriageStateEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SignalTriageState value.
 func (v SignalTriageState) Ptr() *SignalTriageState {
 	return &v
@@ -221,3 +221,8 @@ func (o *SlackIntegrationChannelDisplay) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SlackIntegrationChannelDisplay) Validate() error {
+	return nil
+}
@@ -175,3 +175,11 @@ func (o *SLOBulkDeleteError) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOBulkDeleteError) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("timeframe", o.Timeframe, nil)
+}
@@ -138,3 +138,8 @@ func (o *SLOBulkDeleteResponseData) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOBulkDeleteResponseData) Validate() error {
+	return nil
+}
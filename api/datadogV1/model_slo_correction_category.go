@@ -64,6 +64,14 @@ func (v SLOCorrectionCategory) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOCorrectionCategory) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOCorrectionCategory: valid values are %v", v, allowedSLOCorrectionCategoryEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOCorrectionCategory value.
 func (v SLOCorrectionCategory) Ptr() *SLOCorrectionCategory {
 	return &v
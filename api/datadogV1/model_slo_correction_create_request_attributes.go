@@ -349,3 +349,11 @@ func (o *SLOCorrectionCreateRequestAttributes) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOCorrectionCreateRequestAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("category", o.Category, nil)
+}
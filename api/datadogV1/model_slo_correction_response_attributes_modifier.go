@@ -171,6 +171,11 @@ func (o *SLOCorrectionResponseAttributesModifier) UnmarshalJSON(bytes []byte) (e
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOCorrectionResponseAttributesModifier) Validate() error {
+	return nil
+}
+
 // NullableSLOCorrectionResponseAttributesModifier handles when a null is used for SLOCorrectionResponseAttributesModifier.
 type NullableSLOCorrectionResponseAttributesModifier struct {
 	value *SLOCorrectionResponseAttributesModifier
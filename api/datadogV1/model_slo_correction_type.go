@@ -58,6 +58,14 @@ func (v SLOCorrectionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOCorrectionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOCorrectionType: valid values are %v", v, allowedSLOCorrectionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOCorrectionType value.
 func (v SLOCorrectionType) Ptr() *SLOCorrectionType {
 	return &v
@@ -182,6 +182,11 @@ func (o *SLOCreator) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOCreator) Validate() error {
+	return nil
+}
+
 // NullableSLOCreator handles when a null is used for SLOCreator.
 type NullableSLOCreator struct {
 	value *SLOCreator
@@ -135,3 +135,8 @@ func (o *SLODeleteResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLODeleteResponse) Validate() error {
+	return nil
+}
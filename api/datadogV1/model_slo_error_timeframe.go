@@ -65,6 +65,14 @@ func (v SLOErrorTimeframe) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOErrorTimeframe) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOErrorTimeframe: valid values are %v", v, allowedSLOErrorTimeframeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOErrorTimeframe value.
 func (v SLOErrorTimeframe) Ptr() *SLOErrorTimeframe {
 	return &v
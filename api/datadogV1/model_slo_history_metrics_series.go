@@ -208,3 +208,11 @@ func (o *SLOHistoryMetricsSeries) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOHistoryMetricsSeries) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("metadata", o.Metadata, nil)
+}
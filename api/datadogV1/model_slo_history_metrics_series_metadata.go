@@ -278,3 +278,11 @@ func (o *SLOHistoryMetricsSeriesMetadata) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOHistoryMetricsSeriesMetadata) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("unit", o.Unit, nil)
+}
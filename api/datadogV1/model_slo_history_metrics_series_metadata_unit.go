@@ -298,6 +298,11 @@ func (o *SLOHistoryMetricsSeriesMetadataUnit) UnmarshalJSON(bytes []byte) (err e
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOHistoryMetricsSeriesMetadataUnit) Validate() error {
+	return nil
+}
+
 // NullableSLOHistoryMetricsSeriesMetadataUnit handles when a null is used for SLOHistoryMetricsSeriesMetadataUnit.
 type NullableSLOHistoryMetricsSeriesMetadataUnit struct {
 	value *SLOHistoryMetricsSeriesMetadataUnit
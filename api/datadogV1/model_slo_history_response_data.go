@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -446,3 +448,19 @@ func (o *SLOHistoryResponseData) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOHistoryResponseData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("groups", o.Groups, nil),
+		datadog.ValidateValue("monitors", o.Monitors, nil),
+		datadog.ValidateValue("overall", o.Overall, nil),
+		datadog.ValidateValue("series", o.Series, nil),
+		datadog.ValidateValue("thresholds", o.Thresholds, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+		datadog.ValidateValue("type_id", o.TypeId, nil),
+	)
+}
@@ -100,3 +100,8 @@ func (o *SLOHistoryResponseError) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOHistoryResponseError) Validate() error {
+	return nil
+}
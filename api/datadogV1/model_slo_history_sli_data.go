@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -520,3 +522,14 @@ func (o *SLOHistorySLIData) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOHistorySLIData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("errors", o.Errors, nil),
+		datadog.ValidateValue("history", o.History, &datadog.Constraints{Items: &datadog.Constraints{MinItems: datadog.PtrInt64(2), MaxItems: datadog.PtrInt64(2)}}),
+	)
+}
@@ -58,6 +58,14 @@ func (v SLOListWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOListWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOListWidgetDefinitionType: valid values are %v", v, allowedSLOListWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOListWidgetDefinitionType value.
 func (v SLOListWidgetDefinitionType) Ptr() *SLOListWidgetDefinitionType {
 	return &v
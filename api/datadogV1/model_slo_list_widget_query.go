@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -173,3 +174,14 @@ func (o *SLOListWidgetQuery) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOListWidgetQuery) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("limit", o.Limit, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(100)}),
+		datadog.ValidateValue("sort", o.Sort, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v SLOListWidgetRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOListWidgetRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOListWidgetRequestType: valid values are %v", v, allowedSLOListWidgetRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOListWidgetRequestType value.
 func (v SLOListWidgetRequestType) Ptr() *SLOListWidgetRequestType {
 	return &v
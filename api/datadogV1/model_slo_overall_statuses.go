@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -451,3 +453,15 @@ func (o *SLOOverallStatuses) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOOverallStatuses) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("raw_error_budget_remaining", o.RawErrorBudgetRemaining, nil),
+		datadog.ValidateValue("state", o.State, nil),
+		datadog.ValidateValue("timeframe", o.Timeframe, nil),
+	)
+}
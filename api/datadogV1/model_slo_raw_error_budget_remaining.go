@@ -136,6 +136,11 @@ func (o *SLORawErrorBudgetRemaining) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLORawErrorBudgetRemaining) Validate() error {
+	return nil
+}
+
 // NullableSLORawErrorBudgetRemaining handles when a null is used for SLORawErrorBudgetRemaining.
 type NullableSLORawErrorBudgetRemaining struct {
 	value *SLORawErrorBudgetRemaining
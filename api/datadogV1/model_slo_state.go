@@ -64,6 +64,14 @@ func (v SLOState) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOState) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOState: valid values are %v", v, allowedSLOStateEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOState value.
 func (v SLOState) Ptr() *SLOState {
 	return &v
@@ -64,6 +64,14 @@ func (v SLOTimeSliceComparator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOTimeSliceComparator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOTimeSliceComparator: valid values are %v", v, allowedSLOTimeSliceComparatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOTimeSliceComparator value.
 func (v SLOTimeSliceComparator) Ptr() *SLOTimeSliceComparator {
 	return &v
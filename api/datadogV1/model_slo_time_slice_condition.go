@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -218,3 +219,15 @@ func (o *SLOTimeSliceCondition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOTimeSliceCondition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("comparator", o.Comparator, nil),
+		datadog.ValidateValue("query", o.Query, nil),
+		datadog.ValidateValue("query_interval_seconds", o.QueryIntervalSeconds, nil),
+	)
+}
@@ -62,6 +62,14 @@ func (v SLOTimeSliceInterval) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOTimeSliceInterval) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOTimeSliceInterval: valid values are %v", v, allowedSLOTimeSliceIntervalEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOTimeSliceInterval value.
 func (v SLOTimeSliceInterval) Ptr() *SLOTimeSliceInterval {
 	return &v
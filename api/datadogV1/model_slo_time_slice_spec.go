@@ -94,3 +94,11 @@ func (o *SLOTimeSliceSpec) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOTimeSliceSpec) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("time_slice", o.TimeSlice, nil)
+}
@@ -65,6 +65,14 @@ func (v SLOTimeframe) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOTimeframe) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOTimeframe: valid values are %v", v, allowedSLOTimeframeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOTimeframe value.
 func (v SLOTimeframe) Ptr() *SLOTimeframe {
 	return &v
@@ -62,6 +62,14 @@ func (v SLOType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOType: valid values are %v", v, allowedSLOTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOType value.
 func (v SLOType) Ptr() *SLOType {
 	return &v
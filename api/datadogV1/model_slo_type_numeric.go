@@ -64,6 +64,14 @@ func (v SLOTypeNumeric) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOTypeNumeric) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOTypeNumeric: valid values are %v", v, allowedSLOTypeNumericEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOTypeNumeric value.
 func (v SLOTypeNumeric) Ptr() *SLOTypeNumeric {
 	return &v
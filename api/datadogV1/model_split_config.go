@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -207,3 +208,16 @@ func (o *SplitConfig) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SplitConfig) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("limit", o.Limit, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(500)}),
+		datadog.ValidateValue("sort", o.Sort, nil),
+		datadog.ValidateValue("split_dimensions", o.SplitDimensions, &datadog.Constraints{MinItems: datadog.PtrInt64(1), MaxItems: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("static_splits", o.StaticSplits, &datadog.Constraints{MaxItems: datadog.PtrInt64(500), Items: &datadog.Constraints{MinItems: datadog.PtrInt64(1)}}),
+	)
+}
@@ -99,3 +99,8 @@ func (o *SplitDimension) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SplitDimension) Validate() error {
+	return nil
+}
@@ -327,3 +327,11 @@ func (obj *SplitGraphSourceWidgetDefinition) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *SplitGraphSourceWidgetDefinition) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
@@ -64,6 +64,14 @@ func (v SplitGraphVizSize) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SplitGraphVizSize) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SplitGraphVizSize: valid values are %v", v, allowedSplitGraphVizSizeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SplitGraphVizSize value.
 func (v SplitGraphVizSize) Ptr() *SplitGraphVizSize {
 	return &v
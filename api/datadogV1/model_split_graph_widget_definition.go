@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -319,3 +320,17 @@ func (o *SplitGraphWidgetDefinition) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SplitGraphWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("size", o.Size, nil),
+		datadog.ValidateValue("source_widget_definition", o.SourceWidgetDefinition, nil),
+		datadog.ValidateValue("split_config", o.SplitConfig, nil),
+		datadog.ValidateValue("time", o.Time, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v SplitGraphWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SplitGraphWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SplitGraphWidgetDefinitionType: valid values are %v", v, allowedSplitGraphWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SplitGraphWidgetDefinitionType value.
 func (v SplitGraphWidgetDefinitionType) Ptr() *SplitGraphWidgetDefinitionType {
 	return &v
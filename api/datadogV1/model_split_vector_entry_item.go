@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -131,3 +132,14 @@ func (o *SplitVectorEntryItem) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SplitVectorEntryItem) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("tag_key", o.TagKey, &datadog.Constraints{MinLength: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("tag_values", o.TagValues, &datadog.Constraints{Items: &datadog.Constraints{MinLength: datadog.PtrInt64(1)}}),
+	)
+}
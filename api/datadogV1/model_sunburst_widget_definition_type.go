@@ -58,6 +58,14 @@ func (v SunburstWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SunburstWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SunburstWidgetDefinitionType: valid values are %v", v, allowedSunburstWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SunburstWidgetDefinitionType value.
 func (v SunburstWidgetDefinitionType) Ptr() *SunburstWidgetDefinitionType {
 	return &v
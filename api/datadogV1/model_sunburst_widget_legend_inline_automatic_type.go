@@ -60,6 +60,14 @@ func (v SunburstWidgetLegendInlineAutomaticType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SunburstWidgetLegendInlineAutomaticType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SunburstWidgetLegendInlineAutomaticType: valid values are %v", v, allowedSunburstWidgetLegendInlineAutomaticTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SunburstWidgetLegendInlineAutomaticType value.
 func (v SunburstWidgetLegendInlineAutomaticType) Ptr() *SunburstWidgetLegendInlineAutomaticType {
 	return &v
@@ -60,6 +60,14 @@ func (v SunburstWidgetLegendTableType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SunburstWidgetLegendTableType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SunburstWidgetLegendTableType: valid values are %v", v, allowedSunburstWidgetLegendTableTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SunburstWidgetLegendTableType value.
 func (v SunburstWidgetLegendTableType) Ptr() *SunburstWidgetLegendTableType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -462,3 +463,17 @@ func (o *SyntheticsAPITest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAPITest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("config", o.Config, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("subtype", o.Subtype, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -108,6 +108,14 @@ func (v SyntheticsApiTestFailureCode) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsApiTestFailureCode) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsApiTestFailureCode: valid values are %v", v, allowedSyntheticsApiTestFailureCodeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsApiTestFailureCode value.
 func (v SyntheticsApiTestFailureCode) Ptr() *SyntheticsApiTestFailureCode {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -400,3 +402,16 @@ func (o *SyntheticsAPITestResultData) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAPITestResultData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("cert", o.Cert, nil),
+		datadog.ValidateValue("eventType", o.EventType, nil),
+		datadog.ValidateValue("failure", o.Failure, nil),
+		datadog.ValidateValue("timings", o.Timings, nil),
+	)
+}
@@ -108,3 +108,11 @@ func (o *SyntheticsAPITestResultFullCheck) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAPITestResultFullCheck) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("config", o.Config, nil)
+}
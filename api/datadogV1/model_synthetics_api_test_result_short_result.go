@@ -145,3 +145,11 @@ func (o *SyntheticsAPITestResultShortResult) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAPITestResultShortResult) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("timings", o.Timings, nil)
+}
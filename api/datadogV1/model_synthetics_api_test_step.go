@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -352,3 +353,17 @@ func (o *SyntheticsAPITestStep) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAPITestStep) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("assertions", o.Assertions, nil),
+		datadog.ValidateValue("extractedValues", o.ExtractedValues, nil),
+		datadog.ValidateValue("request", o.Request, nil),
+		datadog.ValidateValue("retry", o.Retry, nil),
+		datadog.ValidateValue("subtype", o.Subtype, nil),
+	)
+}
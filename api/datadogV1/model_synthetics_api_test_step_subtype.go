@@ -60,6 +60,14 @@ func (v SyntheticsAPITestStepSubtype) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAPITestStepSubtype) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAPITestStepSubtype: valid values are %v", v, allowedSyntheticsAPITestStepSubtypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAPITestStepSubtype value.
 func (v SyntheticsAPITestStepSubtype) Ptr() *SyntheticsAPITestStepSubtype {
 	return &v
@@ -58,6 +58,14 @@ func (v SyntheticsAPITestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAPITestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAPITestType: valid values are %v", v, allowedSyntheticsAPITestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAPITestType value.
 func (v SyntheticsAPITestType) Ptr() *SyntheticsAPITestType {
 	return &v
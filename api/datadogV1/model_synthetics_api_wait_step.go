@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -173,3 +174,14 @@ func (o *SyntheticsAPIWaitStep) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAPIWaitStep) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("subtype", o.Subtype, nil),
+		datadog.ValidateValue("value", o.Value, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(180)}),
+	)
+}
@@ -58,6 +58,14 @@ func (v SyntheticsAPIWaitStepSubtype) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAPIWaitStepSubtype) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAPIWaitStepSubtype: valid values are %v", v, allowedSyntheticsAPIWaitStepSubtypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAPIWaitStepSubtype value.
 func (v SyntheticsAPIWaitStepSubtype) Ptr() *SyntheticsAPIWaitStepSubtype {
 	return &v
@@ -62,6 +62,14 @@ func (v SyntheticsAssertionBodyHashOperator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionBodyHashOperator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionBodyHashOperator: valid values are %v", v, allowedSyntheticsAssertionBodyHashOperatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionBodyHashOperator value.
 func (v SyntheticsAssertionBodyHashOperator) Ptr() *SyntheticsAssertionBodyHashOperator {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -177,3 +178,14 @@ func (o *SyntheticsAssertionBodyHashTarget) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAssertionBodyHashTarget) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("operator", o.Operator, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v SyntheticsAssertionBodyHashType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionBodyHashType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionBodyHashType: valid values are %v", v, allowedSyntheticsAssertionBodyHashTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionBodyHashType value.
 func (v SyntheticsAssertionBodyHashType) Ptr() *SyntheticsAssertionBodyHashType {
 	return &v
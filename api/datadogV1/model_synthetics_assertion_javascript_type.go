@@ -58,6 +58,14 @@ func (v SyntheticsAssertionJavascriptType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionJavascriptType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionJavascriptType: valid values are %v", v, allowedSyntheticsAssertionJavascriptTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionJavascriptType value.
 func (v SyntheticsAssertionJavascriptType) Ptr() *SyntheticsAssertionJavascriptType {
 	return &v
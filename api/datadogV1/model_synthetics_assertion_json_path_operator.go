@@ -58,6 +58,14 @@ func (v SyntheticsAssertionJSONPathOperator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionJSONPathOperator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionJSONPathOperator: valid values are %v", v, allowedSyntheticsAssertionJSONPathOperatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionJSONPathOperator value.
 func (v SyntheticsAssertionJSONPathOperator) Ptr() *SyntheticsAssertionJSONPathOperator {
 	return &v
@@ -60,6 +60,14 @@ func (v SyntheticsAssertionJSONSchemaMetaSchema) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionJSONSchemaMetaSchema) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionJSONSchemaMetaSchema: valid values are %v", v, allowedSyntheticsAssertionJSONSchemaMetaSchemaEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionJSONSchemaMetaSchema value.
 func (v SyntheticsAssertionJSONSchemaMetaSchema) Ptr() *SyntheticsAssertionJSONSchemaMetaSchema {
 	return &v
@@ -58,6 +58,14 @@ func (v SyntheticsAssertionJSONSchemaOperator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionJSONSchemaOperator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionJSONSchemaOperator: valid values are %v", v, allowedSyntheticsAssertionJSONSchemaOperatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionJSONSchemaOperator value.
 func (v SyntheticsAssertionJSONSchemaOperator) Ptr() *SyntheticsAssertionJSONSchemaOperator {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -183,3 +184,15 @@ func (o *SyntheticsAssertionJSONSchemaTarget) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAssertionJSONSchemaTarget) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("operator", o.Operator, nil),
+		datadog.ValidateValue("target", o.Target, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -145,3 +145,11 @@ func (o *SyntheticsAssertionJSONSchemaTargetTarget) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAssertionJSONSchemaTargetTarget) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("metaSchema", o.MetaSchema, nil)
+}
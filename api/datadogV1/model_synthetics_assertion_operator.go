@@ -86,6 +86,14 @@ func (v SyntheticsAssertionOperator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionOperator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionOperator: valid values are %v", v, allowedSyntheticsAssertionOperatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionOperator value.
 func (v SyntheticsAssertionOperator) Ptr() *SyntheticsAssertionOperator {
 	return &v
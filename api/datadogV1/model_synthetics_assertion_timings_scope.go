@@ -60,6 +60,14 @@ func (v SyntheticsAssertionTimingsScope) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionTimingsScope) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionTimingsScope: valid values are %v", v, allowedSyntheticsAssertionTimingsScopeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionTimingsScope value.
 func (v SyntheticsAssertionTimingsScope) Ptr() *SyntheticsAssertionTimingsScope {
 	return &v
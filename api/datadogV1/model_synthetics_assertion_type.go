@@ -94,6 +94,14 @@ func (v SyntheticsAssertionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionType: valid values are %v", v, allowedSyntheticsAssertionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionType value.
 func (v SyntheticsAssertionType) Ptr() *SyntheticsAssertionType {
 	return &v
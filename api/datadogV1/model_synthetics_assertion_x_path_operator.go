@@ -58,6 +58,14 @@ func (v SyntheticsAssertionXPathOperator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsAssertionXPathOperator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsAssertionXPathOperator: valid values are %v", v, allowedSyntheticsAssertionXPathOperatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsAssertionXPathOperator value.
 func (v SyntheticsAssertionXPathOperator) Ptr() *SyntheticsAssertionXPathOperator {
 	return &v
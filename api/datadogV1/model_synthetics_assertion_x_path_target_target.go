@@ -170,3 +170,8 @@ func (o *SyntheticsAssertionXPathTargetTarget) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsAssertionXPathTargetTarget) Validate() error {
+	return nil
+}
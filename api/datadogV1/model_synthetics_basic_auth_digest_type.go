@@ -58,6 +58,14 @@ func (v SyntheticsBasicAuthDigestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBasicAuthDigestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBasicAuthDigestType: valid values are %v", v, allowedSyntheticsBasicAuthDigestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBasicAuthDigestType value.
 func (v SyntheticsBasicAuthDigestType) Ptr() *SyntheticsBasicAuthDigestType {
 	return &v
@@ -58,6 +58,14 @@ func (v SyntheticsBasicAuthNTLMType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBasicAuthNTLMType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBasicAuthNTLMType: valid values are %v", v, allowedSyntheticsBasicAuthNTLMTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBasicAuthNTLMType value.
 func (v SyntheticsBasicAuthNTLMType) Ptr() *SyntheticsBasicAuthNTLMType {
 	return &v
@@ -58,6 +58,14 @@ func (v SyntheticsBasicAuthOauthClientType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBasicAuthOauthClientType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBasicAuthOauthClientType: valid values are %v", v, allowedSyntheticsBasicAuthOauthClientTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBasicAuthOauthClientType value.
 func (v SyntheticsBasicAuthOauthClientType) Ptr() *SyntheticsBasicAuthOauthClientType {
 	return &v
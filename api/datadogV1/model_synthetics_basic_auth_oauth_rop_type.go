@@ -58,6 +58,14 @@ func (v SyntheticsBasicAuthOauthROPType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBasicAuthOauthROPType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBasicAuthOauthROPType: valid values are %v", v, allowedSyntheticsBasicAuthOauthROPTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBasicAuthOauthROPType value.
 func (v SyntheticsBasicAuthOauthROPType) Ptr() *SyntheticsBasicAuthOauthROPType {
 	return &v
@@ -60,6 +60,14 @@ func (v SyntheticsBasicAuthOauthTokenApiAuthentication) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBasicAuthOauthTokenApiAuthentication) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBasicAuthOauthTokenApiAuthentication: valid values are %v", v, allowedSyntheticsBasicAuthOauthTokenApiAuthenticationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBasicAuthOauthTokenApiAuthentication value.
 func (v SyntheticsBasicAuthOauthTokenApiAuthentication) Ptr() *SyntheticsBasicAuthOauthTokenApiAuthentication {
 	return &v
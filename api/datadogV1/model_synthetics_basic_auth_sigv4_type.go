@@ -58,6 +58,14 @@ func (v SyntheticsBasicAuthSigv4Type) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBasicAuthSigv4Type) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBasicAuthSigv4Type: valid values are %v", v, allowedSyntheticsBasicAuthSigv4TypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBasicAuthSigv4Type value.
 func (v SyntheticsBasicAuthSigv4Type) Ptr() *SyntheticsBasicAuthSigv4Type {
 	return &v
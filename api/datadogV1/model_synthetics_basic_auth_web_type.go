@@ -58,6 +58,14 @@ func (v SyntheticsBasicAuthWebType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBasicAuthWebType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBasicAuthWebType: valid values are %v", v, allowedSyntheticsBasicAuthWebTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBasicAuthWebType value.
 func (v SyntheticsBasicAuthWebType) Ptr() *SyntheticsBasicAuthWebType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -437,3 +439,16 @@ func (o *SyntheticsBatchResult) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsBatchResult) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("device", o.Device, nil),
+		datadog.ValidateValue("execution_rule", o.ExecutionRule, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("test_type", o.TestType, nil),
+	)
+}
@@ -60,6 +60,14 @@ func (v SyntheticsBrowserErrorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBrowserErrorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBrowserErrorType: valid values are %v", v, allowedSyntheticsBrowserErrorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBrowserErrorType value.
 func (v SyntheticsBrowserErrorType) Ptr() *SyntheticsBrowserErrorType {
 	return &v
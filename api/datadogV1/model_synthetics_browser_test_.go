@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -457,3 +458,17 @@ func (o *SyntheticsBrowserTest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsBrowserTest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("config", o.Config, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("steps", o.Steps, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
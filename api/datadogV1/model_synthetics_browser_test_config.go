@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -245,3 +246,16 @@ func (o *SyntheticsBrowserTestConfig) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsBrowserTestConfig) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("assertions", o.Assertions, nil),
+		datadog.ValidateValue("configVariables", o.ConfigVariables, nil),
+		datadog.ValidateValue("request", o.Request, nil),
+		datadog.ValidateValue("variables", o.Variables, nil),
+	)
+}
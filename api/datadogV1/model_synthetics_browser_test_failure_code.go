@@ -122,6 +122,14 @@ func (v SyntheticsBrowserTestFailureCode) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBrowserTestFailureCode) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBrowserTestFailureCode: valid values are %v", v, allowedSyntheticsBrowserTestFailureCodeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBrowserTestFailureCode value.
 func (v SyntheticsBrowserTestFailureCode) Ptr() *SyntheticsBrowserTestFailureCode {
 	return &v
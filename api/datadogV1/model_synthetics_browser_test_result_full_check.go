@@ -108,3 +108,11 @@ func (o *SyntheticsBrowserTestResultFullCheck) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsBrowserTestResultFullCheck) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("config", o.Config, nil)
+}
@@ -249,3 +249,11 @@ func (o *SyntheticsBrowserTestResultShortResult) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsBrowserTestResultShortResult) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("device", o.Device, nil)
+}
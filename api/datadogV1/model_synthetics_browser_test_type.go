@@ -58,6 +58,14 @@ func (v SyntheticsBrowserTestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBrowserTestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBrowserTestType: valid values are %v", v, allowedSyntheticsBrowserTestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBrowserTestType value.
 func (v SyntheticsBrowserTestType) Ptr() *SyntheticsBrowserTestType {
 	return &v
@@ -66,6 +66,14 @@ func (v SyntheticsBrowserVariableType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsBrowserVariableType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsBrowserVariableType: valid values are %v", v, allowedSyntheticsBrowserVariableTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsBrowserVariableType value.
 func (v SyntheticsBrowserVariableType) Ptr() *SyntheticsBrowserVariableType {
 	return &v
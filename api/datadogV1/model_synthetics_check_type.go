@@ -84,6 +84,14 @@ func (v SyntheticsCheckType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsCheckType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsCheckType: valid values are %v", v, allowedSyntheticsCheckTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsCheckType value.
 func (v SyntheticsCheckType) Ptr() *SyntheticsCheckType {
 	return &v
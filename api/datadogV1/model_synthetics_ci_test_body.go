@@ -100,3 +100,11 @@ func (o *SyntheticsCITestBody) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsCITestBody) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("tests", o.Tests, nil)
+}
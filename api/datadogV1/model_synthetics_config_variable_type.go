@@ -62,6 +62,14 @@ func (v SyntheticsConfigVariableType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsConfigVariableType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsConfigVariableType: valid values are %v", v, allowedSyntheticsConfigVariableTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsConfigVariableType value.
 func (v SyntheticsConfigVariableType) Ptr() *SyntheticsConfigVariableType {
 	return &v
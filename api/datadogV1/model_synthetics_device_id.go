@@ -80,6 +80,14 @@ func (v SyntheticsDeviceID) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsDeviceID) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsDeviceID: valid values are %v", v, allowedSyntheticsDeviceIDEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsDeviceID value.
 func (v SyntheticsDeviceID) Ptr() *SyntheticsDeviceID {
 	return &v
@@ -163,3 +163,8 @@ func (o *SyntheticsFetchUptimesPayload) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsFetchUptimesPayload) Validate() error {
+	return nil
+}
@@ -135,3 +135,11 @@ func (o *SyntheticsGetAPITestLatestResultsResponse) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsGetAPITestLatestResultsResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("results", o.Results, nil)
+}
@@ -135,3 +135,11 @@ func (o *SyntheticsGetBrowserTestLatestResultsResponse) UnmarshalJSON(bytes []by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsGetBrowserTestLatestResultsResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("results", o.Results, nil)
+}
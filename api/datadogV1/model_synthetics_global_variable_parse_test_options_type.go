@@ -64,6 +64,14 @@ func (v SyntheticsGlobalVariableParseTestOptionsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsGlobalVariableParseTestOptionsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsGlobalVariableParseTestOptionsType: valid values are %v", v, allowedSyntheticsGlobalVariableParseTestOptionsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsGlobalVariableParseTestOptionsType value.
 func (v SyntheticsGlobalVariableParseTestOptionsType) Ptr() *SyntheticsGlobalVariableParseTestOptionsType {
 	return &v
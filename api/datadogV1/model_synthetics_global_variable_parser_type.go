@@ -64,6 +64,14 @@ func (v SyntheticsGlobalVariableParserType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsGlobalVariableParserType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsGlobalVariableParserType: valid values are %v", v, allowedSyntheticsGlobalVariableParserTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsGlobalVariableParserType value.
 func (v SyntheticsGlobalVariableParserType) Ptr() *SyntheticsGlobalVariableParserType {
 	return &v
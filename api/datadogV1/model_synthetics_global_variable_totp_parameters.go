@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -135,3 +137,14 @@ func (o *SyntheticsGlobalVariableTOTPParameters) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsGlobalVariableTOTPParameters) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("digits", o.Digits, &datadog.Constraints{Minimum: datadog.PtrFloat64(4), Maximum: datadog.PtrFloat64(10)}),
+		datadog.ValidateValue("refresh_interval", o.RefreshInterval, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(999)}),
+	)
+}
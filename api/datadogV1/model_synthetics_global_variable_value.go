@@ -180,3 +180,11 @@ func (o *SyntheticsGlobalVariableValue) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsGlobalVariableValue) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("options", o.Options, nil)
+}
@@ -100,3 +100,11 @@ func (o *SyntheticsListGlobalVariablesResponse) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsListGlobalVariablesResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("variables", o.Variables, nil)
+}
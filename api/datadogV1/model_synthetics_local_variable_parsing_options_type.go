@@ -66,6 +66,14 @@ func (v SyntheticsLocalVariableParsingOptionsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsLocalVariableParsingOptionsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsLocalVariableParsingOptionsType: valid values are %v", v, allowedSyntheticsLocalVariableParsingOptionsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsLocalVariableParsingOptionsType value.
 func (v SyntheticsLocalVariableParsingOptionsType) Ptr() *SyntheticsLocalVariableParsingOptionsType {
 	return &v
@@ -100,3 +100,11 @@ func (o *SyntheticsLocations) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsLocations) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("locations", o.Locations, nil)
+}
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -386,3 +387,15 @@ func (o *SyntheticsMobileStep) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileStep) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MaxLength: datadog.PtrInt64(1500)}),
+		datadog.ValidateValue("params", o.Params, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -540,3 +542,19 @@ func (o *SyntheticsMobileStepParams) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileStepParams) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("check", o.Check, nil),
+		datadog.ValidateValue("delay", o.Delay, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(5000)}),
+		datadog.ValidateValue("direction", o.Direction, nil),
+		datadog.ValidateValue("element", o.Element, nil),
+		datadog.ValidateValue("positions", o.Positions, nil),
+		datadog.ValidateValue("value", o.Value, nil),
+		datadog.ValidateValue("variable", o.Variable, nil),
+	)
+}
@@ -64,6 +64,14 @@ func (v SyntheticsMobileStepParamsDirection) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsMobileStepParamsDirection) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsMobileStepParamsDirection: valid values are %v", v, allowedSyntheticsMobileStepParamsDirectionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsMobileStepParamsDirection value.
 func (v SyntheticsMobileStepParamsDirection) Ptr() *SyntheticsMobileStepParamsDirection {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -361,3 +363,15 @@ func (o *SyntheticsMobileStepParamsElement) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileStepParamsElement) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("contextType", o.ContextType, nil),
+		datadog.ValidateValue("relativePosition", o.RelativePosition, nil),
+		datadog.ValidateValue("userLocator", o.UserLocator, nil),
+	)
+}
@@ -60,6 +60,14 @@ func (v SyntheticsMobileStepParamsElementContextType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsMobileStepParamsElementContextType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsMobileStepParamsElementContextType: valid values are %v", v, allowedSyntheticsMobileStepParamsElementContextTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsMobileStepParamsElementContextType value.
 func (v SyntheticsMobileStepParamsElementContextType) Ptr() *SyntheticsMobileStepParamsElementContextType {
 	return &v
@@ -135,3 +135,11 @@ func (o *SyntheticsMobileStepParamsElementUserLocator) UnmarshalJSON(bytes []byt
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileStepParamsElementUserLocator) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("values", o.Values, nil)
+}
@@ -145,3 +145,11 @@ func (o *SyntheticsMobileStepParamsElementUserLocatorValuesItems) UnmarshalJSON(
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileStepParamsElementUserLocatorValuesItems) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
@@ -66,6 +66,14 @@ func (v SyntheticsMobileStepParamsElementUserLocatorValuesItemsType) IsValid() b
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsMobileStepParamsElementUserLocatorValuesItemsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsMobileStepParamsElementUserLocatorValuesItemsType: valid values are %v", v, allowedSyntheticsMobileStepParamsElementUserLocatorValuesItemsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsMobileStepParamsElementUserLocatorValuesItemsType value.
 func (v SyntheticsMobileStepParamsElementUserLocatorValuesItemsType) Ptr() *SyntheticsMobileStepParamsElementUserLocatorValuesItemsType {
 	return &v
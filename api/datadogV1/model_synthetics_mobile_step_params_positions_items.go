@@ -135,3 +135,8 @@ func (o *SyntheticsMobileStepParamsPositionsItems) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileStepParamsPositionsItems) Validate() error {
+	return nil
+}
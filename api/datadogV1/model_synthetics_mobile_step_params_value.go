@@ -103,3 +103,11 @@ func (obj *SyntheticsMobileStepParamsValue) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *SyntheticsMobileStepParamsValue) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
@@ -131,3 +131,8 @@ func (o *SyntheticsMobileStepParamsVariable) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileStepParamsVariable) Validate() error {
+	return nil
+}
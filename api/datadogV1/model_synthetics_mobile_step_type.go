@@ -90,6 +90,14 @@ func (v SyntheticsMobileStepType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsMobileStepType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsMobileStepType: valid values are %v", v, allowedSyntheticsMobileStepTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsMobileStepType value.
 func (v SyntheticsMobileStepType) Ptr() *SyntheticsMobileStepType {
 	return &v
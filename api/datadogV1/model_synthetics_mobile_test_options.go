@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -675,3 +676,23 @@ func (o *SyntheticsMobileTestOptions) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileTestOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("bindings", o.Bindings, nil),
+		datadog.ValidateValue("ci", o.Ci, nil),
+		datadog.ValidateValue("defaultStepTimeout", o.DefaultStepTimeout, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(300)}),
+		datadog.ValidateValue("min_failure_duration", o.MinFailureDuration, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(7200)}),
+		datadog.ValidateValue("mobileApplication", o.MobileApplication, nil),
+		datadog.ValidateValue("monitor_options", o.MonitorOptions, nil),
+		datadog.ValidateValue("monitor_priority", o.MonitorPriority, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(5)}),
+		datadog.ValidateValue("retry", o.Retry, nil),
+		datadog.ValidateValue("scheduling", o.Scheduling, nil),
+		datadog.ValidateValue("tick_every", o.TickEvery, &datadog.Constraints{Minimum: datadog.PtrFloat64(300), Maximum: datadog.PtrFloat64(604800)}),
+		datadog.ValidateValue("verbosity", o.Verbosity, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(5)}),
+	)
+}
@@ -58,6 +58,14 @@ func (v SyntheticsMobileTestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsMobileTestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsMobileTestType: valid values are %v", v, allowedSyntheticsMobileTestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsMobileTestType value.
 func (v SyntheticsMobileTestType) Ptr() *SyntheticsMobileTestType {
 	return &v
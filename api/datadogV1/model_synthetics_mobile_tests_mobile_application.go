@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -173,3 +174,15 @@ func (o *SyntheticsMobileTestsMobileApplication) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsMobileTestsMobileApplication) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("applicationId", o.ApplicationId, &datadog.Constraints{MaxLength: datadog.PtrInt64(1500)}),
+		datadog.ValidateValue("referenceId", o.ReferenceId, &datadog.Constraints{MaxLength: datadog.PtrInt64(1500)}),
+		datadog.ValidateValue("referenceType", o.ReferenceType, nil),
+	)
+}
@@ -60,6 +60,14 @@ func (v SyntheticsMobileTestsMobileApplicationReferenceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsMobileTestsMobileApplicationReferenceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsMobileTestsMobileApplicationReferenceType: valid values are %v", v, allowedSyntheticsMobileTestsMobileApplicationReferenceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsMobileTestsMobileApplicationReferenceType value.
 func (v SyntheticsMobileTestsMobileApplicationReferenceType) Ptr() *SyntheticsMobileTestsMobileApplicationReferenceType {
 	return &v
@@ -180,3 +180,11 @@ func (o *SyntheticsPatchTestOperation) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsPatchTestOperation) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("op", o.Op, nil)
+}
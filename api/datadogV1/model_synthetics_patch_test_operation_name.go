@@ -68,6 +68,14 @@ func (v SyntheticsPatchTestOperationName) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsPatchTestOperationName) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsPatchTestOperationName: valid values are %v", v, allowedSyntheticsPatchTestOperationNameEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsPatchTestOperationName value.
 func (v SyntheticsPatchTestOperationName) Ptr() *SyntheticsPatchTestOperationName {
 	return &v
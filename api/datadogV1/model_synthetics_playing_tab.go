@@ -66,6 +66,14 @@ func (v SyntheticsPlayingTab) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsPlayingTab) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsPlayingTab: valid values are %v", v, allowedSyntheticsPlayingTabEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsPlayingTab value.
 func (v SyntheticsPlayingTab) Ptr() *SyntheticsPlayingTab {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -182,3 +184,14 @@ func (o *SyntheticsPrivateLocationCreationResponse) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsPrivateLocationCreationResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("private_location", o.PrivateLocation, nil),
+		datadog.ValidateValue("result_encryption", o.ResultEncryption, nil),
+	)
+}
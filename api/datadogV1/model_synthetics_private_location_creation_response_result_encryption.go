@@ -135,3 +135,8 @@ func (o *SyntheticsPrivateLocationCreationResponseResultEncryption) UnmarshalJSO
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsPrivateLocationCreationResponseResultEncryption) Validate() error {
+	return nil
+}
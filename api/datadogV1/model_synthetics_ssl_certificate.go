@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -507,3 +508,14 @@ func (o *SyntheticsSSLCertificate) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsSSLCertificate) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("issuer", o.Issuer, nil),
+		datadog.ValidateValue("subject", o.Subject, nil),
+	)
+}
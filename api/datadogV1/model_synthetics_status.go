@@ -62,6 +62,14 @@ func (v SyntheticsStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsStatus: valid values are %v", v, allowedSyntheticsStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsStatus value.
 func (v SyntheticsStatus) Ptr() *SyntheticsStatus {
 	return &v
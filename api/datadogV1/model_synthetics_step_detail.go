@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -752,3 +754,20 @@ func (o *SyntheticsStepDetail) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsStepDetail) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("browserErrors", o.BrowserErrors, nil),
+		datadog.ValidateValue("checkType", o.CheckType, nil),
+		datadog.ValidateValue("failure", o.Failure, nil),
+		datadog.ValidateValue("playingTab", o.PlayingTab, nil),
+		datadog.ValidateValue("subTestStepDetails", o.SubTestStepDetails, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+		datadog.ValidateValue("vitalsMetrics", o.VitalsMetrics, nil),
+		datadog.ValidateValue("warnings", o.Warnings, nil),
+	)
+}
@@ -106,6 +106,14 @@ func (v SyntheticsStepType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsStepType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsStepType: valid values are %v", v, allowedSyntheticsStepTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsStepType value.
 func (v SyntheticsStepType) Ptr() *SyntheticsStepType {
 	return &v
@@ -60,6 +60,14 @@ func (v SyntheticsTestCallType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestCallType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestCallType: valid values are %v", v, allowedSyntheticsTestCallTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestCallType value.
 func (v SyntheticsTestCallType) Ptr() *SyntheticsTestCallType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -214,3 +216,16 @@ func (o *SyntheticsTestConfig) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestConfig) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("assertions", o.Assertions, nil),
+		datadog.ValidateValue("configVariables", o.ConfigVariables, nil),
+		datadog.ValidateValue("request", o.Request, nil),
+		datadog.ValidateValue("variables", o.Variables, nil),
+	)
+}
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -549,3 +551,19 @@ func (o *SyntheticsTestDetails) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestDetails) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("config", o.Config, nil),
+		datadog.ValidateValue("creator", o.Creator, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("steps", o.Steps, nil),
+		datadog.ValidateValue("subtype", o.Subtype, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -75,6 +75,14 @@ func (v SyntheticsTestDetailsSubType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestDetailsSubType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestDetailsSubType: valid values are %v", v, allowedSyntheticsTestDetailsSubTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestDetailsSubType value.
 func (v SyntheticsTestDetailsSubType) Ptr() *SyntheticsTestDetailsSubType {
 	return &v
@@ -62,6 +62,14 @@ func (v SyntheticsTestDetailsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestDetailsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestDetailsType: valid values are %v", v, allowedSyntheticsTestDetailsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestDetailsType value.
 func (v SyntheticsTestDetailsType) Ptr() *SyntheticsTestDetailsType {
 	return &v
@@ -62,6 +62,14 @@ func (v SyntheticsTestExecutionRule) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestExecutionRule) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestExecutionRule: valid values are %v", v, allowedSyntheticsTestExecutionRuleEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestExecutionRule value.
 func (v SyntheticsTestExecutionRule) Ptr() *SyntheticsTestExecutionRule {
 	return &v
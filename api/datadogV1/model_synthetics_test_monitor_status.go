@@ -65,6 +65,14 @@ func (v SyntheticsTestMonitorStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestMonitorStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestMonitorStatus: valid values are %v", v, allowedSyntheticsTestMonitorStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestMonitorStatus value.
 func (v SyntheticsTestMonitorStatus) Ptr() *SyntheticsTestMonitorStatus {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -947,3 +949,21 @@ func (o *SyntheticsTestOptions) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("ci", o.Ci, nil),
+		datadog.ValidateValue("device_ids", o.DeviceIds, nil),
+		datadog.ValidateValue("httpVersion", o.HttpVersion, nil),
+		datadog.ValidateValue("monitor_options", o.MonitorOptions, nil),
+		datadog.ValidateValue("monitor_priority", o.MonitorPriority, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(5)}),
+		datadog.ValidateValue("retry", o.Retry, nil),
+		datadog.ValidateValue("rumSettings", o.RumSettings, nil),
+		datadog.ValidateValue("scheduling", o.Scheduling, nil),
+		datadog.ValidateValue("tick_every", o.TickEvery, &datadog.Constraints{Minimum: datadog.PtrFloat64(30), Maximum: datadog.PtrFloat64(604800)}),
+	)
+}
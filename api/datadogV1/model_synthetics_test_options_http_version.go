@@ -62,6 +62,14 @@ func (v SyntheticsTestOptionsHTTPVersion) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestOptionsHTTPVersion) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestOptionsHTTPVersion: valid values are %v", v, allowedSyntheticsTestOptionsHTTPVersionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestOptionsHTTPVersion value.
 func (v SyntheticsTestOptionsHTTPVersion) Ptr() *SyntheticsTestOptionsHTTPVersion {
 	return &v
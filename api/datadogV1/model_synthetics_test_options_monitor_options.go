@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -217,3 +219,14 @@ func (o *SyntheticsTestOptionsMonitorOptions) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestOptionsMonitorOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("notification_preset_name", o.NotificationPresetName, nil),
+		datadog.ValidateValue("renotify_interval", o.RenotifyInterval, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+	)
+}
@@ -64,6 +64,14 @@ func (v SyntheticsTestOptionsMonitorOptionsNotificationPresetName) IsValid() boo
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestOptionsMonitorOptionsNotificationPresetName) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestOptionsMonitorOptionsNotificationPresetName: valid values are %v", v, allowedSyntheticsTestOptionsMonitorOptionsNotificationPresetNameEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestOptionsMonitorOptionsNotificationPresetName value.
 func (v SyntheticsTestOptionsMonitorOptionsNotificationPresetName) Ptr() *SyntheticsTestOptionsMonitorOptionsNotificationPresetName {
 	return &v
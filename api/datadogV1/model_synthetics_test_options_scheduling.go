@@ -131,3 +131,11 @@ func (o *SyntheticsTestOptionsScheduling) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestOptionsScheduling) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("timeframes", o.Timeframes, nil)
+}
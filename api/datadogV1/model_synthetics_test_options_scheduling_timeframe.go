@@ -163,3 +163,11 @@ func (o *SyntheticsTestOptionsSchedulingTimeframe) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestOptionsSchedulingTimeframe) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("day", o.Day, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(7)})
+}
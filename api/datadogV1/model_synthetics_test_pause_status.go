@@ -61,6 +61,14 @@ func (v SyntheticsTestPauseStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestPauseStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestPauseStatus: valid values are %v", v, allowedSyntheticsTestPauseStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestPauseStatus value.
 func (v SyntheticsTestPauseStatus) Ptr() *SyntheticsTestPauseStatus {
 	return &v
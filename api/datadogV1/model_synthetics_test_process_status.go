@@ -64,6 +64,14 @@ func (v SyntheticsTestProcessStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestProcessStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestProcessStatus: valid values are %v", v, allowedSyntheticsTestProcessStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestProcessStatus value.
 func (v SyntheticsTestProcessStatus) Ptr() *SyntheticsTestProcessStatus {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -1141,3 +1143,21 @@ func (o *SyntheticsTestRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("basicAuth", o.BasicAuth, nil),
+		datadog.ValidateValue("bodyType", o.BodyType, nil),
+		datadog.ValidateValue("callType", o.CallType, nil),
+		datadog.ValidateValue("certificate", o.Certificate, nil),
+		datadog.ValidateValue("files", o.Files, nil),
+		datadog.ValidateValue("httpVersion", o.HttpVersion, nil),
+		datadog.ValidateValue("numberOfPackets", o.NumberOfPackets, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(10)}),
+		datadog.ValidateValue("port", o.Port, nil),
+		datadog.ValidateValue("proxy", o.Proxy, nil),
+	)
+}
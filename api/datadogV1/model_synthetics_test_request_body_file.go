@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -275,3 +277,17 @@ func (o *SyntheticsTestRequestBodyFile) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestRequestBodyFile) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("content", o.Content, &datadog.Constraints{MaxLength: datadog.PtrInt64(3145728)}),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MaxLength: datadog.PtrInt64(1500)}),
+		datadog.ValidateValue("originalFileName", o.OriginalFileName, &datadog.Constraints{MaxLength: datadog.PtrInt64(1500)}),
+		datadog.ValidateValue("size", o.Size, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(3145728)}),
+		datadog.ValidateValue("type", o.Type, &datadog.Constraints{MaxLength: datadog.PtrInt64(1500)}),
+	)
+}
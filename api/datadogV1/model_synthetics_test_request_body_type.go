@@ -72,6 +72,14 @@ func (v SyntheticsTestRequestBodyType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestRequestBodyType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestRequestBodyType: valid values are %v", v, allowedSyntheticsTestRequestBodyTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestRequestBodyType value.
 func (v SyntheticsTestRequestBodyType) Ptr() *SyntheticsTestRequestBodyType {
 	return &v
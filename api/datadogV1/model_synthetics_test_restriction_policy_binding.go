@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -145,3 +147,14 @@ func (o *SyntheticsTestRestrictionPolicyBinding) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestRestrictionPolicyBinding) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("principals", o.Principals, &datadog.Constraints{Items: &datadog.Constraints{MaxLength: datadog.PtrInt64(1500)}}),
+		datadog.ValidateValue("relation", o.Relation, nil),
+	)
+}
@@ -60,6 +60,14 @@ func (v SyntheticsTestRestrictionPolicyBindingRelation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsTestRestrictionPolicyBindingRelation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsTestRestrictionPolicyBindingRelation: valid values are %v", v, allowedSyntheticsTestRestrictionPolicyBindingRelationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsTestRestrictionPolicyBindingRelation value.
 func (v SyntheticsTestRestrictionPolicyBindingRelation) Ptr() *SyntheticsTestRestrictionPolicyBindingRelation {
 	return &v
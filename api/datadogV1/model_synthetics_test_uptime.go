@@ -214,3 +214,11 @@ func (o *SyntheticsTestUptime) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTestUptime) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("overall", o.Overall, nil)
+}
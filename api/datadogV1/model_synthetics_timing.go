@@ -381,3 +381,8 @@ func (o *SyntheticsTiming) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTiming) Validate() error {
+	return nil
+}
@@ -99,3 +99,11 @@ func (o *SyntheticsTriggerBody) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTriggerBody) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("tests", o.Tests, nil)
+}
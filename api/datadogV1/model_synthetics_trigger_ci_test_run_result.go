@@ -215,3 +215,11 @@ func (o *SyntheticsTriggerCITestRunResult) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTriggerCITestRunResult) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("device", o.Device, nil)
+}
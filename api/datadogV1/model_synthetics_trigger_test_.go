@@ -143,3 +143,11 @@ func (o *SyntheticsTriggerTest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsTriggerTest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("metadata", o.Metadata, nil)
+}
@@ -111,3 +111,11 @@ func (o *SyntheticsUpdateTestPauseStatusPayload) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SyntheticsUpdateTestPauseStatusPayload) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("new_status", o.NewStatus, nil)
+}
@@ -58,6 +58,14 @@ func (v SyntheticsWarningType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SyntheticsWarningType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SyntheticsWarningType: valid values are %v", v, allowedSyntheticsWarningTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SyntheticsWarningType value.
 func (v SyntheticsWarningType) Ptr() *SyntheticsWarningType {
 	return &v
@@ -60,6 +60,14 @@ func (v TableWidgetCellDisplayMode) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TableWidgetCellDisplayMode) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TableWidgetCellDisplayMode: valid values are %v", v, allowedTableWidgetCellDisplayModeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TableWidgetCellDisplayMode value.
 func (v TableWidgetCellDisplayMode) Ptr() *TableWidgetCellDisplayMode {
 	return &v
@@ -62,6 +62,14 @@ func (v TableWidgetHasSearchBar) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TableWidgetHasSearchBar) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TableWidgetHasSearchBar: valid values are %v", v, allowedTableWidgetHasSearchBarEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TableWidgetHasSearchBar value.
 func (v TableWidgetHasSearchBar) Ptr() *TableWidgetHasSearchBar {
 	return &v
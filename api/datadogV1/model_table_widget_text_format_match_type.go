@@ -68,6 +68,14 @@ func (v TableWidgetTextFormatMatchType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TableWidgetTextFormatMatchType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TableWidgetTextFormatMatchType: valid values are %v", v, allowedTableWidgetTextFormatMatchTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TableWidgetTextFormatMatchType value.
 func (v TableWidgetTextFormatMatchType) Ptr() *TableWidgetTextFormatMatchType {
 	return &v
@@ -78,6 +78,14 @@ func (v TableWidgetTextFormatPalette) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TableWidgetTextFormatPalette) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TableWidgetTextFormatPalette: valid values are %v", v, allowedTableWidgetTextFormatPaletteEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TableWidgetTextFormatPalette value.
 func (v TableWidgetTextFormatPalette) Ptr() *TableWidgetTextFormatPalette {
 	return &v
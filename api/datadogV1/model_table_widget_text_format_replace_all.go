@@ -141,3 +141,11 @@ func (o *TableWidgetTextFormatReplaceAll) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *TableWidgetTextFormatReplaceAll) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
@@ -58,6 +58,14 @@ func (v TableWidgetTextFormatReplaceAllType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TableWidgetTextFormatReplaceAllType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TableWidgetTextFormatReplaceAllType: valid values are %v", v, allowedTableWidgetTextFormatReplaceAllTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TableWidgetTextFormatReplaceAllType value.
 func (v TableWidgetTextFormatReplaceAllType) Ptr() *TableWidgetTextFormatReplaceAllType {
 	return &v
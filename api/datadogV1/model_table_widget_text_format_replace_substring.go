@@ -173,3 +173,11 @@ func (o *TableWidgetTextFormatReplaceSubstring) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *TableWidgetTextFormatReplaceSubstring) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
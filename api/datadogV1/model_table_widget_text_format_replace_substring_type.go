@@ -58,6 +58,14 @@ func (v TableWidgetTextFormatReplaceSubstringType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TableWidgetTextFormatReplaceSubstringType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TableWidgetTextFormatReplaceSubstringType: valid values are %v", v, allowedTableWidgetTextFormatReplaceSubstringTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TableWidgetTextFormatReplaceSubstringType value.
 func (v TableWidgetTextFormatReplaceSubstringType) Ptr() *TableWidgetTextFormatReplaceSubstringType {
 	return &v
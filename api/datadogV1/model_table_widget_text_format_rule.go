@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -256,3 +257,15 @@ func (o *TableWidgetTextFormatRule) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *TableWidgetTextFormatRule) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("match", o.Match, nil),
+		datadog.ValidateValue("palette", o.Palette, nil),
+		datadog.ValidateValue("replace", o.Replace, nil),
+	)
+}
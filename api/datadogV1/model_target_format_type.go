@@ -66,6 +66,14 @@ func (v TargetFormatType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TargetFormatType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TargetFormatType: valid values are %v", v, allowedTargetFormatTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TargetFormatType value.
 func (v TargetFormatType) Ptr() *TargetFormatType {
 	return &v
@@ -60,6 +60,14 @@ func (v TimeseriesBackgroundType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TimeseriesBackgroundType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TimeseriesBackgroundType: valid values are %v", v, allowedTimeseriesBackgroundTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TimeseriesBackgroundType value.
 func (v TimeseriesBackgroundType) Ptr() *TimeseriesBackgroundType {
 	return &v
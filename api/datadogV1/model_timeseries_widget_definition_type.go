@@ -58,6 +58,14 @@ func (v TimeseriesWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TimeseriesWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TimeseriesWidgetDefinitionType: valid values are %v", v, allowedTimeseriesWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TimeseriesWidgetDefinitionType value.
 func (v TimeseriesWidgetDefinitionType) Ptr() *TimeseriesWidgetDefinitionType {
 	return &v
@@ -134,3 +134,8 @@ func (o *TimeseriesWidgetExpressionAlias) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *TimeseriesWidgetExpressionAlias) Validate() error {
+	return nil
+}
@@ -66,6 +66,14 @@ func (v TimeseriesWidgetLegendColumn) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TimeseriesWidgetLegendColumn) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TimeseriesWidgetLegendColumn: valid values are %v", v, allowedTimeseriesWidgetLegendColumnEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TimeseriesWidgetLegendColumn value.
 func (v TimeseriesWidgetLegendColumn) Ptr() *TimeseriesWidgetLegendColumn {
 	return &v
@@ -62,6 +62,14 @@ func (v TimeseriesWidgetLegendLayout) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TimeseriesWidgetLegendLayout) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TimeseriesWidgetLegendLayout: valid values are %v", v, allowedTimeseriesWidgetLegendLayoutEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TimeseriesWidgetLegendLayout value.
 func (v TimeseriesWidgetLegendLayout) Ptr() *TimeseriesWidgetLegendLayout {
 	return &v
@@ -58,6 +58,14 @@ func (v ToplistWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ToplistWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ToplistWidgetDefinitionType: valid values are %v", v, allowedToplistWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ToplistWidgetDefinitionType value.
 func (v ToplistWidgetDefinitionType) Ptr() *ToplistWidgetDefinitionType {
 	return &v
@@ -58,6 +58,14 @@ func (v ToplistWidgetFlatType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ToplistWidgetFlatType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ToplistWidgetFlatType: valid values are %v", v, allowedToplistWidgetFlatTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ToplistWidgetFlatType value.
 func (v ToplistWidgetFlatType) Ptr() *ToplistWidgetFlatType {
 	return &v
@@ -62,6 +62,14 @@ func (v ToplistWidgetLegend) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ToplistWidgetLegend) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ToplistWidgetLegend: valid values are %v", v, allowedToplistWidgetLegendEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ToplistWidgetLegend value.
 func (v ToplistWidgetLegend) Ptr() *ToplistWidgetLegend {
 	return &v
@@ -60,6 +60,14 @@ func (v ToplistWidgetScaling) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ToplistWidgetScaling) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ToplistWidgetScaling: valid values are %v", v, allowedToplistWidgetScalingEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ToplistWidgetScaling value.
 func (v ToplistWidgetScaling) Ptr() *ToplistWidgetScaling {
 	return &v
@@ -58,6 +58,14 @@ func (v ToplistWidgetStackedType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ToplistWidgetStackedType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ToplistWidgetStackedType: valid values are %v", v, allowedToplistWidgetStackedTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ToplistWidgetStackedType value.
 func (v ToplistWidgetStackedType) Ptr() *ToplistWidgetStackedType {
 	return &v
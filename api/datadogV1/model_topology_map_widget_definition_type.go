@@ -58,6 +58,14 @@ func (v TopologyMapWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TopologyMapWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TopologyMapWidgetDefinitionType: valid values are %v", v, allowedTopologyMapWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TopologyMapWidgetDefinitionType value.
 func (v TopologyMapWidgetDefinitionType) Ptr() *TopologyMapWidgetDefinitionType {
 	return &v
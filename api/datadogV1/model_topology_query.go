@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -180,3 +182,14 @@ func (o *TopologyQuery) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *TopologyQuery) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("data_source", o.DataSource, nil),
+		datadog.ValidateValue("filters", o.Filters, &datadog.Constraints{MinItems: datadog.PtrInt64(1)}),
+	)
+}
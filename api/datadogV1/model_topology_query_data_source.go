@@ -60,6 +60,14 @@ func (v TopologyQueryDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TopologyQueryDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TopologyQueryDataSource: valid values are %v", v, allowedTopologyQueryDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TopologyQueryDataSource value.
 func (v TopologyQueryDataSource) Ptr() *TopologyQueryDataSource {
 	return &v
@@ -58,6 +58,14 @@ func (v TopologyRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TopologyRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TopologyRequestType: valid values are %v", v, allowedTopologyRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TopologyRequestType value.
 func (v TopologyRequestType) Ptr() *TopologyRequestType {
 	return &v
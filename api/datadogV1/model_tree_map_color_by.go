@@ -58,6 +58,14 @@ func (v TreeMapColorBy) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TreeMapColorBy) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TreeMapColorBy: valid values are %v", v, allowedTreeMapColorByEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TreeMapColorBy value.
 func (v TreeMapColorBy) Ptr() *TreeMapColorBy {
 	return &v
@@ -62,6 +62,14 @@ func (v TreeMapGroupBy) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TreeMapGroupBy) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TreeMapGroupBy: valid values are %v", v, allowedTreeMapGroupByEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TreeMapGroupBy value.
 func (v TreeMapGroupBy) Ptr() *TreeMapGroupBy {
 	return &v
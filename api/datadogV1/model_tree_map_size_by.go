@@ -60,6 +60,14 @@ func (v TreeMapSizeBy) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TreeMapSizeBy) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TreeMapSizeBy: valid values are %v", v, allowedTreeMapSizeByEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TreeMapSizeBy value.
 func (v TreeMapSizeBy) Ptr() *TreeMapSizeBy {
 	return &v
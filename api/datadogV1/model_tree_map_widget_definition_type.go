@@ -58,6 +58,14 @@ func (v TreeMapWidgetDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TreeMapWidgetDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TreeMapWidgetDefinitionType: valid values are %v", v, allowedTreeMapWidgetDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TreeMapWidgetDefinitionType value.
 func (v TreeMapWidgetDefinitionType) Ptr() *TreeMapWidgetDefinitionType {
 	return &v
@@ -222,3 +222,8 @@ func (o *UsageAnalyzedLogsHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageAnalyzedLogsHour) Validate() error {
+	return nil
+}
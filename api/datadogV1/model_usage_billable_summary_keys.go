@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -3453,3 +3455,101 @@ func (o *UsageBillableSummaryKeys) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageBillableSummaryKeys) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("apm_fargate_average", o.ApmFargateAverage, nil),
+		datadog.ValidateValue("apm_fargate_sum", o.ApmFargateSum, nil),
+		datadog.ValidateValue("apm_host_sum", o.ApmHostSum, nil),
+		datadog.ValidateValue("apm_host_top99p", o.ApmHostTop99p, nil),
+		datadog.ValidateValue("apm_profiler_host_sum", o.ApmProfilerHostSum, nil),
+		datadog.ValidateValue("apm_profiler_host_top99p", o.ApmProfilerHostTop99p, nil),
+		datadog.ValidateValue("apm_trace_search_sum", o.ApmTraceSearchSum, nil),
+		datadog.ValidateValue("application_security_fargate_average", o.ApplicationSecurityFargateAverage, nil),
+		datadog.ValidateValue("application_security_host_sum", o.ApplicationSecurityHostSum, nil),
+		datadog.ValidateValue("application_security_host_top99p", o.ApplicationSecurityHostTop99p, nil),
+		datadog.ValidateValue("ci_pipeline_indexed_spans_sum", o.CiPipelineIndexedSpansSum, nil),
+		datadog.ValidateValue("ci_pipeline_maximum", o.CiPipelineMaximum, nil),
+		datadog.ValidateValue("ci_pipeline_sum", o.CiPipelineSum, nil),
+		datadog.ValidateValue("ci_test_indexed_spans_sum", o.CiTestIndexedSpansSum, nil),
+		datadog.ValidateValue("ci_testing_maximum", o.CiTestingMaximum, nil),
+		datadog.ValidateValue("ci_testing_sum", o.CiTestingSum, nil),
+		datadog.ValidateValue("cloud_cost_management_average", o.CloudCostManagementAverage, nil),
+		datadog.ValidateValue("cloud_cost_management_sum", o.CloudCostManagementSum, nil),
+		datadog.ValidateValue("cspm_container_sum", o.CspmContainerSum, nil),
+		datadog.ValidateValue("cspm_host_sum", o.CspmHostSum, nil),
+		datadog.ValidateValue("cspm_host_top99p", o.CspmHostTop99p, nil),
+		datadog.ValidateValue("custom_event_sum", o.CustomEventSum, nil),
+		datadog.ValidateValue("cws_container_sum", o.CwsContainerSum, nil),
+		datadog.ValidateValue("cws_host_sum", o.CwsHostSum, nil),
+		datadog.ValidateValue("cws_host_top99p", o.CwsHostTop99p, nil),
+		datadog.ValidateValue("dbm_host_sum", o.DbmHostSum, nil),
+		datadog.ValidateValue("dbm_host_top99p", o.DbmHostTop99p, nil),
+		datadog.ValidateValue("dbm_normalized_queries_average", o.DbmNormalizedQueriesAverage, nil),
+		datadog.ValidateValue("dbm_normalized_queries_sum", o.DbmNormalizedQueriesSum, nil),
+		datadog.ValidateValue("fargate_container_apm_and_profiler_average", o.FargateContainerApmAndProfilerAverage, nil),
+		datadog.ValidateValue("fargate_container_apm_and_profiler_sum", o.FargateContainerApmAndProfilerSum, nil),
+		datadog.ValidateValue("fargate_container_average", o.FargateContainerAverage, nil),
+		datadog.ValidateValue("fargate_container_profiler_average", o.FargateContainerProfilerAverage, nil),
+		datadog.ValidateValue("fargate_container_profiler_sum", o.FargateContainerProfilerSum, nil),
+		datadog.ValidateValue("fargate_container_sum", o.FargateContainerSum, nil),
+		datadog.ValidateValue("incident_management_maximum", o.IncidentManagementMaximum, nil),
+		datadog.ValidateValue("incident_management_sum", o.IncidentManagementSum, nil),
+		datadog.ValidateValue("infra_and_apm_host_sum", o.InfraAndApmHostSum, nil),
+		datadog.ValidateValue("infra_and_apm_host_top99p", o.InfraAndApmHostTop99p, nil),
+		datadog.ValidateValue("infra_container_sum", o.InfraContainerSum, nil),
+		datadog.ValidateValue("infra_host_sum", o.InfraHostSum, nil),
+		datadog.ValidateValue("infra_host_top99p", o.InfraHostTop99p, nil),
+		datadog.ValidateValue("ingested_spans_sum", o.IngestedSpansSum, nil),
+		datadog.ValidateValue("ingested_timeseries_average", o.IngestedTimeseriesAverage, nil),
+		datadog.ValidateValue("ingested_timeseries_sum", o.IngestedTimeseriesSum, nil),
+		datadog.ValidateValue("iot_sum", o.IotSum, nil),
+		datadog.ValidateValue("iot_top99p", o.IotTop99p, nil),
+		datadog.ValidateValue("lambda_function_average", o.LambdaFunctionAverage, nil),
+		datadog.ValidateValue("lambda_function_sum", o.LambdaFunctionSum, nil),
+		datadog.ValidateValue("logs_forwarding_sum", o.LogsForwardingSum, nil),
+		datadog.ValidateValue("logs_indexed_15day_sum", o.LogsIndexed15daySum, nil),
+		datadog.ValidateValue("logs_indexed_180day_sum", o.LogsIndexed180daySum, nil),
+		datadog.ValidateValue("logs_indexed_1day_sum", o.LogsIndexed1daySum, nil),
+		datadog.ValidateValue("logs_indexed_30day_sum", o.LogsIndexed30daySum, nil),
+		datadog.ValidateValue("logs_indexed_360day_sum", o.LogsIndexed360daySum, nil),
+		datadog.ValidateValue("logs_indexed_3day_sum", o.LogsIndexed3daySum, nil),
+		datadog.ValidateValue("logs_indexed_45day_sum", o.LogsIndexed45daySum, nil),
+		datadog.ValidateValue("logs_indexed_60day_sum", o.LogsIndexed60daySum, nil),
+		datadog.ValidateValue("logs_indexed_7day_sum", o.LogsIndexed7daySum, nil),
+		datadog.ValidateValue("logs_indexed_90day_sum", o.LogsIndexed90daySum, nil),
+		datadog.ValidateValue("logs_indexed_custom_retention_sum", o.LogsIndexedCustomRetentionSum, nil),
+		datadog.ValidateValue("logs_indexed_sum", o.LogsIndexedSum, nil),
+		datadog.ValidateValue("logs_ingested_sum", o.LogsIngestedSum, nil),
+		datadog.ValidateValue("network_device_sum", o.NetworkDeviceSum, nil),
+		datadog.ValidateValue("network_device_top99p", o.NetworkDeviceTop99p, nil),
+		datadog.ValidateValue("npm_flow_sum", o.NpmFlowSum, nil),
+		datadog.ValidateValue("npm_host_sum", o.NpmHostSum, nil),
+		datadog.ValidateValue("npm_host_top99p", o.NpmHostTop99p, nil),
+		datadog.ValidateValue("observability_pipeline_sum", o.ObservabilityPipelineSum, nil),
+		datadog.ValidateValue("online_archive_sum", o.OnlineArchiveSum, nil),
+		datadog.ValidateValue("prof_container_sum", o.ProfContainerSum, nil),
+		datadog.ValidateValue("prof_host_sum", o.ProfHostSum, nil),
+		datadog.ValidateValue("prof_host_top99p", o.ProfHostTop99p, nil),
+		datadog.ValidateValue("rum_lite_sum", o.RumLiteSum, nil),
+		datadog.ValidateValue("rum_replay_sum", o.RumReplaySum, nil),
+		datadog.ValidateValue("rum_sum", o.RumSum, nil),
+		datadog.ValidateValue("rum_units_sum", o.RumUnitsSum, nil),
+		datadog.ValidateValue("sensitive_data_scanner_sum", o.SensitiveDataScannerSum, nil),
+		datadog.ValidateValue("serverless_apm_sum", o.ServerlessApmSum, nil),
+		datadog.ValidateValue("serverless_infra_average", o.ServerlessInfraAverage, nil),
+		datadog.ValidateValue("serverless_infra_sum", o.ServerlessInfraSum, nil),
+		datadog.ValidateValue("serverless_invocation_sum", o.ServerlessInvocationSum, nil),
+		datadog.ValidateValue("siem_sum", o.SiemSum, nil),
+		datadog.ValidateValue("standard_timeseries_average", o.StandardTimeseriesAverage, nil),
+		datadog.ValidateValue("synthetics_api_tests_sum", o.SyntheticsApiTestsSum, nil),
+		datadog.ValidateValue("synthetics_app_testing_maximum", o.SyntheticsAppTestingMaximum, nil),
+		datadog.ValidateValue("synthetics_browser_checks_sum", o.SyntheticsBrowserChecksSum, nil),
+		datadog.ValidateValue("timeseries_average", o.TimeseriesAverage, nil),
+		datadog.ValidateValue("timeseries_sum", o.TimeseriesSum, nil),
+	)
+}
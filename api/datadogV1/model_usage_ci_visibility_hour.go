@@ -365,3 +365,8 @@ func (o *UsageCIVisibilityHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageCIVisibilityHour) Validate() error {
+	return nil
+}
@@ -498,3 +498,8 @@ func (o *UsageCloudSecurityPostureManagementHour) UnmarshalJSON(bytes []byte) (e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageCloudSecurityPostureManagementHour) Validate() error {
+	return nil
+}
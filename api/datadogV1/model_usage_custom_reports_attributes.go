@@ -240,3 +240,8 @@ func (o *UsageCustomReportsAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageCustomReportsAttributes) Validate() error {
+	return nil
+}
@@ -268,3 +268,8 @@ func (o *UsageCWSHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageCWSHour) Validate() error {
+	return nil
+}
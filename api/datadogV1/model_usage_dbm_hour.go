@@ -268,3 +268,8 @@ func (o *UsageDBMHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageDBMHour) Validate() error {
+	return nil
+}
@@ -360,3 +360,8 @@ func (o *UsageFargateHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageFargateHour) Validate() error {
+	return nil
+}
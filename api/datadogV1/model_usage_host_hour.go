@@ -836,3 +836,8 @@ func (o *UsageHostHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageHostHour) Validate() error {
+	return nil
+}
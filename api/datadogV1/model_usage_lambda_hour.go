@@ -269,3 +269,8 @@ func (o *UsageLambdaHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageLambdaHour) Validate() error {
+	return nil
+}
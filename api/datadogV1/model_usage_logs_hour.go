@@ -544,3 +544,8 @@ func (o *UsageLogsHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageLogsHour) Validate() error {
+	return nil
+}
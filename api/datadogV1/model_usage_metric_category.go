@@ -60,6 +60,14 @@ func (v UsageMetricCategory) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UsageMetricCategory) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UsageMetricCategory: valid values are %v", v, allowedUsageMetricCategoryEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UsageMetricCategory value.
 func (v UsageMetricCategory) Ptr() *UsageMetricCategory {
 	return &v
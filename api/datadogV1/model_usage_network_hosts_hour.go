@@ -222,3 +222,8 @@ func (o *UsageNetworkHostsHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageNetworkHostsHour) Validate() error {
+	return nil
+}
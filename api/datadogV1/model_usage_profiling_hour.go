@@ -314,3 +314,8 @@ func (o *UsageProfilingHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageProfilingHour) Validate() error {
+	return nil
+}
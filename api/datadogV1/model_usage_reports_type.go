@@ -58,6 +58,14 @@ func (v UsageReportsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UsageReportsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UsageReportsType: valid values are %v", v, allowedUsageReportsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UsageReportsType value.
 func (v UsageReportsType) Ptr() *UsageReportsType {
 	return &v
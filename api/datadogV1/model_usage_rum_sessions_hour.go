@@ -441,3 +441,8 @@ func (o *UsageRumSessionsHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageRumSessionsHour) Validate() error {
+	return nil
+}
@@ -406,3 +406,8 @@ func (o *UsageSDSHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageSDSHour) Validate() error {
+	return nil
+}
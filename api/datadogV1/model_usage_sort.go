@@ -64,6 +64,14 @@ func (v UsageSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UsageSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UsageSort: valid values are %v", v, allowedUsageSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UsageSort value.
 func (v UsageSort) Ptr() *UsageSort {
 	return &v
@@ -60,6 +60,14 @@ func (v UsageSortDirection) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UsageSortDirection) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UsageSortDirection: valid values are %v", v, allowedUsageSortDirectionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UsageSortDirection value.
 func (v UsageSortDirection) Ptr() *UsageSortDirection {
 	return &v
@@ -275,3 +275,8 @@ func (o *UsageSpecifiedCustomReportsAttributes) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageSpecifiedCustomReportsAttributes) Validate() error {
+	return nil
+}
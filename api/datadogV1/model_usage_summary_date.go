@@ -4922,3 +4922,11 @@ func (o *UsageSummaryDate) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageSummaryDate) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("orgs", o.Orgs, nil)
+}
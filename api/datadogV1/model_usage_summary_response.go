@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -5336,3 +5337,14 @@ func (o *UsageSummaryResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageSummaryResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("logs_by_retention", o.LogsByRetention, nil),
+		datadog.ValidateValue("usage", o.Usage, nil),
+	)
+}
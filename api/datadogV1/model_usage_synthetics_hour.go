@@ -211,3 +211,8 @@ func (o *UsageSyntheticsHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageSyntheticsHour) Validate() error {
+	return nil
+}
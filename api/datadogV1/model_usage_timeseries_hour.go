@@ -281,3 +281,8 @@ func (o *UsageTimeseriesHour) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageTimeseriesHour) Validate() error {
+	return nil
+}
@@ -331,3 +331,11 @@ func (o *User) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *User) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("access_role", o.AccessRole, nil)
+}
@@ -278,3 +278,11 @@ func (o *WebhooksIntegration) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WebhooksIntegration) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("encode_as", o.EncodeAs, nil)
+}
@@ -164,3 +164,8 @@ func (o *WebhooksIntegrationCustomVariable) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WebhooksIntegrationCustomVariable) Validate() error {
+	return nil
+}
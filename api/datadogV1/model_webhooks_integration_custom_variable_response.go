@@ -167,3 +167,8 @@ func (o *WebhooksIntegrationCustomVariableResponse) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WebhooksIntegrationCustomVariableResponse) Validate() error {
+	return nil
+}
@@ -173,3 +173,8 @@ func (o *WebhooksIntegrationCustomVariableUpdateRequest) UnmarshalJSON(bytes []b
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WebhooksIntegrationCustomVariableUpdateRequest) Validate() error {
+	return nil
+}
@@ -60,6 +60,14 @@ func (v WebhooksIntegrationEncoding) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WebhooksIntegrationEncoding) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WebhooksIntegrationEncoding: valid values are %v", v, allowedWebhooksIntegrationEncodingEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WebhooksIntegrationEncoding value.
 func (v WebhooksIntegrationEncoding) Ptr() *WebhooksIntegrationEncoding {
 	return &v
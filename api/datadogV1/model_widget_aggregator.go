@@ -68,6 +68,14 @@ func (v WidgetAggregator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetAggregator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetAggregator: valid values are %v", v, allowedWidgetAggregatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetAggregator value.
 func (v WidgetAggregator) Ptr() *WidgetAggregator {
 	return &v
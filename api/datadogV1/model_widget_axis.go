@@ -252,3 +252,8 @@ func (o *WidgetAxis) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetAxis) Validate() error {
+	return nil
+}
@@ -60,6 +60,14 @@ func (v WidgetChangeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetChangeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetChangeType: valid values are %v", v, allowedWidgetChangeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetChangeType value.
 func (v WidgetChangeType) Ptr() *WidgetChangeType {
 	return &v
@@ -60,6 +60,14 @@ func (v WidgetColorPreference) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetColorPreference) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetColorPreference: valid values are %v", v, allowedWidgetColorPreferenceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetColorPreference value.
 func (v WidgetColorPreference) Ptr() *WidgetColorPreference {
 	return &v
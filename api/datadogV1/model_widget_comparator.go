@@ -66,6 +66,14 @@ func (v WidgetComparator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetComparator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetComparator: valid values are %v", v, allowedWidgetComparatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetComparator value.
 func (v WidgetComparator) Ptr() *WidgetComparator {
 	return &v
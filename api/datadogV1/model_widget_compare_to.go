@@ -64,6 +64,14 @@ func (v WidgetCompareTo) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetCompareTo) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetCompareTo: valid values are %v", v, allowedWidgetCompareToEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetCompareTo value.
 func (v WidgetCompareTo) Ptr() *WidgetCompareTo {
 	return &v
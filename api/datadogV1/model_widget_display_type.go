@@ -64,6 +64,14 @@ func (v WidgetDisplayType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetDisplayType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetDisplayType: valid values are %v", v, allowedWidgetDisplayTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetDisplayType value.
 func (v WidgetDisplayType) Ptr() *WidgetDisplayType {
 	return &v
@@ -137,3 +137,8 @@ func (o *WidgetEvent) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetEvent) Validate() error {
+	return nil
+}
@@ -60,6 +60,14 @@ func (v WidgetEventSize) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetEventSize) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetEventSize: valid values are %v", v, allowedWidgetEventSizeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetEventSize value.
 func (v WidgetEventSize) Ptr() *WidgetEventSize {
 	return &v
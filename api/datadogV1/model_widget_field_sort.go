@@ -141,3 +141,11 @@ func (o *WidgetFieldSort) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetFieldSort) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("order", o.Order, nil)
+}
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -290,3 +291,16 @@ func (o *WidgetFormula) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetFormula) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("cell_display_mode", o.CellDisplayMode, nil),
+		datadog.ValidateValue("conditional_formats", o.ConditionalFormats, nil),
+		datadog.ValidateValue("limit", o.Limit, nil),
+		datadog.ValidateValue("style", o.Style, nil),
+	)
+}
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -177,3 +178,15 @@ func (o *WidgetFormulaSort) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetFormulaSort) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("index", o.Index, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("order", o.Order, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -60,6 +60,14 @@ func (v WidgetGrouping) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetGrouping) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetGrouping: valid values are %v", v, allowedWidgetGroupingEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetGrouping value.
 func (v WidgetGrouping) Ptr() *WidgetGrouping {
 	return &v
@@ -62,6 +62,14 @@ func (v WidgetHorizontalAlign) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetHorizontalAlign) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetHorizontalAlign: valid values are %v", v, allowedWidgetHorizontalAlignEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetHorizontalAlign value.
 func (v WidgetHorizontalAlign) Ptr() *WidgetHorizontalAlign {
 	return &v
@@ -73,6 +73,14 @@ func (v WidgetImageSizing) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetImageSizing) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetImageSizing: valid values are %v", v, allowedWidgetImageSizingEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetImageSizing value.
 func (v WidgetImageSizing) Ptr() *WidgetImageSizing {
 	return &v
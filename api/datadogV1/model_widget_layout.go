@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -231,3 +232,16 @@ func (o *WidgetLayout) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetLayout) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("height", o.Height, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("width", o.Width, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("x", o.X, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("y", o.Y, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+	)
+}
@@ -58,6 +58,14 @@ func (v WidgetLayoutType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetLayoutType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetLayoutType: valid values are %v", v, allowedWidgetLayoutTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetLayoutType value.
 func (v WidgetLayoutType) Ptr() *WidgetLayoutType {
 	return &v
@@ -95,3 +95,11 @@ func (o *WidgetLegacyLiveSpan) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetLegacyLiveSpan) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("live_span", o.LiveSpan, nil)
+}
@@ -62,6 +62,14 @@ func (v WidgetLineType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetLineType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetLineType: valid values are %v", v, allowedWidgetLineTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetLineType value.
 func (v WidgetLineType) Ptr() *WidgetLineType {
 	return &v
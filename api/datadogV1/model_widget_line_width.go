@@ -62,6 +62,14 @@ func (v WidgetLineWidth) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetLineWidth) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetLineWidth: valid values are %v", v, allowedWidgetLineWidthEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetLineWidth value.
 func (v WidgetLineWidth) Ptr() *WidgetLineWidth {
 	return &v
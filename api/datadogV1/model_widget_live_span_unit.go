@@ -68,6 +68,14 @@ func (v WidgetLiveSpanUnit) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetLiveSpanUnit) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetLiveSpanUnit: valid values are %v", v, allowedWidgetLiveSpanUnitEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetLiveSpanUnit value.
 func (v WidgetLiveSpanUnit) Ptr() *WidgetLiveSpanUnit {
 	return &v
@@ -67,6 +67,14 @@ func (v WidgetMargin) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetMargin) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetMargin: valid values are %v", v, allowedWidgetMarginEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetMargin value.
 func (v WidgetMargin) Ptr() *WidgetMargin {
 	return &v
@@ -208,3 +208,8 @@ func (o *WidgetMarker) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetMarker) Validate() error {
+	return nil
+}
@@ -62,6 +62,14 @@ func (v WidgetMessageDisplay) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetMessageDisplay) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetMessageDisplay: valid values are %v", v, allowedWidgetMessageDisplayEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetMessageDisplay value.
 func (v WidgetMessageDisplay) Ptr() *WidgetMessageDisplay {
 	return &v
@@ -62,6 +62,14 @@ func (v WidgetMonitorSummaryDisplayFormat) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetMonitorSummaryDisplayFormat) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetMonitorSummaryDisplayFormat: valid values are %v", v, allowedWidgetMonitorSummaryDisplayFormatEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetMonitorSummaryDisplayFormat value.
 func (v WidgetMonitorSummaryDisplayFormat) Ptr() *WidgetMonitorSummaryDisplayFormat {
 	return &v
@@ -90,6 +90,14 @@ func (v WidgetMonitorSummarySort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetMonitorSummarySort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetMonitorSummarySort: valid values are %v", v, allowedWidgetMonitorSummarySortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetMonitorSummarySort value.
 func (v WidgetMonitorSummarySort) Ptr() *WidgetMonitorSummarySort {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -173,3 +174,15 @@ func (o *WidgetNewFixedSpan) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetNewFixedSpan) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("from", o.From, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("to", o.To, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
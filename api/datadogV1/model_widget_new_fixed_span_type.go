@@ -58,6 +58,14 @@ func (v WidgetNewFixedSpanType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetNewFixedSpanType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetNewFixedSpanType: valid values are %v", v, allowedWidgetNewFixedSpanTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetNewFixedSpanType value.
 func (v WidgetNewFixedSpanType) Ptr() *WidgetNewFixedSpanType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -177,3 +178,15 @@ func (o *WidgetNewLiveSpan) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetNewLiveSpan) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("type", o.Type, nil),
+		datadog.ValidateValue("unit", o.Unit, nil),
+		datadog.ValidateValue("value", o.Value, &datadog.Constraints{Minimum: datadog.PtrFloat64(1)}),
+	)
+}
@@ -58,6 +58,14 @@ func (v WidgetNewLiveSpanType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetNewLiveSpanType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetNewLiveSpanType: valid values are %v", v, allowedWidgetNewLiveSpanTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetNewLiveSpanType value.
 func (v WidgetNewLiveSpanType) Ptr() *WidgetNewLiveSpanType {
 	return &v
@@ -60,6 +60,14 @@ func (v WidgetNodeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetNodeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetNodeType: valid values are %v", v, allowedWidgetNodeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetNodeType value.
 func (v WidgetNodeType) Ptr() *WidgetNodeType {
 	return &v
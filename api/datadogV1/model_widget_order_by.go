@@ -64,6 +64,14 @@ func (v WidgetOrderBy) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetOrderBy) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetOrderBy: valid values are %v", v, allowedWidgetOrderByEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetOrderBy value.
 func (v WidgetOrderBy) Ptr() *WidgetOrderBy {
 	return &v
@@ -94,6 +94,14 @@ func (v WidgetPalette) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetPalette) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetPalette: valid values are %v", v, allowedWidgetPaletteEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetPalette value.
 func (v WidgetPalette) Ptr() *WidgetPalette {
 	return &v
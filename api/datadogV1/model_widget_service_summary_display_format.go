@@ -62,6 +62,14 @@ func (v WidgetServiceSummaryDisplayFormat) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetServiceSummaryDisplayFormat) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetServiceSummaryDisplayFormat: valid values are %v", v, allowedWidgetServiceSummaryDisplayFormatEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetServiceSummaryDisplayFormat value.
 func (v WidgetServiceSummaryDisplayFormat) Ptr() *WidgetServiceSummaryDisplayFormat {
 	return &v
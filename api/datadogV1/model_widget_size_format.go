@@ -62,6 +62,14 @@ func (v WidgetSizeFormat) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetSizeFormat) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetSizeFormat: valid values are %v", v, allowedWidgetSizeFormatEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetSizeFormat value.
 func (v WidgetSizeFormat) Ptr() *WidgetSizeFormat {
 	return &v
@@ -60,6 +60,14 @@ func (v WidgetSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetSort: valid values are %v", v, allowedWidgetSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetSort value.
 func (v WidgetSort) Ptr() *WidgetSort {
 	return &v
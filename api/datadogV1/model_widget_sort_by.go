@@ -5,6 +5,8 @@
 package datadogV1
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -135,3 +137,14 @@ func (o *WidgetSortBy) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WidgetSortBy) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("count", o.Count, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("order_by", o.OrderBy, nil),
+	)
+}
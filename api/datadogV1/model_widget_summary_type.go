@@ -62,6 +62,14 @@ func (v WidgetSummaryType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetSummaryType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetSummaryType: valid values are %v", v, allowedWidgetSummaryTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetSummaryType value.
 func (v WidgetSummaryType) Ptr() *WidgetSummaryType {
 	return &v
@@ -62,6 +62,14 @@ func (v WidgetTextAlign) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetTextAlign) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetTextAlign: valid values are %v", v, allowedWidgetTextAlignEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetTextAlign value.
 func (v WidgetTextAlign) Ptr() *WidgetTextAlign {
 	return &v
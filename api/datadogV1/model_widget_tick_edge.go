@@ -64,6 +64,14 @@ func (v WidgetTickEdge) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetTickEdge) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetTickEdge: valid values are %v", v, allowedWidgetTickEdgeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetTickEdge value.
 func (v WidgetTickEdge) Ptr() *WidgetTickEdge {
 	return &v
@@ -72,6 +72,14 @@ func (v WidgetTimeWindows) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetTimeWindows) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetTimeWindows: valid values are %v", v, allowedWidgetTimeWindowsEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetTimeWindows value.
 func (v WidgetTimeWindows) Ptr() *WidgetTimeWindows {
 	return &v
@@ -62,6 +62,14 @@ func (v WidgetVerticalAlign) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetVerticalAlign) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetVerticalAlign: valid values are %v", v, allowedWidgetVerticalAlignEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetVerticalAlign value.
 func (v WidgetVerticalAlign) Ptr() *WidgetVerticalAlign {
 	return &v
@@ -62,6 +62,14 @@ func (v WidgetViewMode) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetViewMode) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetViewMode: valid values are %v", v, allowedWidgetViewModeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetViewMode value.
 func (v WidgetViewMode) Ptr() *WidgetViewMode {
 	return &v
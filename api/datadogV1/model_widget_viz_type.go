@@ -60,6 +60,14 @@ func (v WidgetVizType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetVizType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetVizType: valid values are %v", v, allowedWidgetVizTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetVizType value.
 func (v WidgetVizType) Ptr() *WidgetVizType {
 	return &v
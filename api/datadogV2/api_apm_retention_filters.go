@@ -28,6 +28,7 @@ func (a *APMRetentionFiltersApi) CreateApmRetentionFilter(ctx _context.Context,
 		localVarReturnValue RetentionFilterCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.APMRetentionFiltersApi.CreateApmRetentionFilter")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.APMRetentionFiltersApi.CreateApmRetentionFilter")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -65,13 +66,10 @@ func (a *APMRetentionFiltersApi) CreateApmRetentionFilter(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -85,6 +83,7 @@ func (a *APMRetentionFiltersApi) CreateApmRetentionFilter(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -102,6 +101,7 @@ func (a *APMRetentionFiltersApi) DeleteApmRetentionFilter(ctx _context.Context,
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.APMRetentionFiltersApi.DeleteApmRetentionFilter")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.APMRetentionFiltersApi.DeleteApmRetentionFilter")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -137,13 +137,10 @@ func (a *APMRetentionFiltersApi) DeleteApmRetentionFilter(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -164,6 +161,7 @@ func (a *APMRetentionFiltersApi) GetApmRetentionFilter(ctx _context.Context, fil
 		localVarReturnValue RetentionFilterResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.APMRetentionFiltersApi.GetApmRetentionFilter")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.APMRetentionFiltersApi.GetApmRetentionFilter")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -199,13 +197,10 @@ func (a *APMRetentionFiltersApi) GetApmRetentionFilter(ctx _context.Context, fil
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -219,6 +214,7 @@ func (a *APMRetentionFiltersApi) GetApmRetentionFilter(ctx _context.Context, fil
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -235,6 +231,7 @@ func (a *APMRetentionFiltersApi) ListApmRetentionFilters(ctx _context.Context) (
 		localVarReturnValue RetentionFiltersResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.APMRetentionFiltersApi.ListApmRetentionFilters")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.APMRetentionFiltersApi.ListApmRetentionFilters")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -269,13 +266,10 @@ func (a *APMRetentionFiltersApi) ListApmRetentionFilters(ctx _context.Context) (
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -289,6 +283,7 @@ func (a *APMRetentionFiltersApi) ListApmRetentionFilters(ctx _context.Context) (
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -304,6 +299,7 @@ func (a *APMRetentionFiltersApi) ReorderApmRetentionFilters(ctx _context.Context
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.APMRetentionFiltersApi.ReorderApmRetentionFilters")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.APMRetentionFiltersApi.ReorderApmRetentionFilters")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -341,13 +337,10 @@ func (a *APMRetentionFiltersApi) ReorderApmRetentionFilters(ctx _context.Context
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -370,6 +363,7 @@ func (a *APMRetentionFiltersApi) UpdateApmRetentionFilter(ctx _context.Context,
 		localVarReturnValue RetentionFilterResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.APMRetentionFiltersApi.UpdateApmRetentionFilter")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.APMRetentionFiltersApi.UpdateApmRetentionFilter")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -408,13 +402,10 @@ func (a *APMRetentionFiltersApi) UpdateApmRetentionFilter(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -428,6 +419,7 @@ func (a *APMRetentionFiltersApi) UpdateApmRetentionFilter(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -435,6 +427,18 @@ func (a *APMRetentionFiltersApi) UpdateApmRetentionFilter(ctx _context.Context,
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// APMRetentionFiltersApiInterface lists the operations of APMRetentionFiltersApi, so that code depending on it can be tested with mocks.
+type APMRetentionFiltersApiInterface interface {
+	CreateApmRetentionFilter(ctx _context.Context, body RetentionFilterCreateRequest) (RetentionFilterCreateResponse, *_nethttp.Response, error)
+	DeleteApmRetentionFilter(ctx _context.Context, filterId string) (*_nethttp.Response, error)
+	GetApmRetentionFilter(ctx _context.Context, filterId string) (RetentionFilterResponse, *_nethttp.Response, error)
+	ListApmRetentionFilters(ctx _context.Context) (RetentionFiltersResponse, *_nethttp.Response, error)
+	ReorderApmRetentionFilters(ctx _context.Context, body ReorderRetentionFiltersRequest) (*_nethttp.Response, error)
+	UpdateApmRetentionFilter(ctx _context.Context, filterId string, body RetentionFilterUpdateRequest) (RetentionFilterResponse, *_nethttp.Response, error)
+}
+
+var _ APMRetentionFiltersApiInterface = (*APMRetentionFiltersApi)(nil)
+
 // NewAPMRetentionFiltersApi Returns NewAPMRetentionFiltersApi.
 func NewAPMRetentionFiltersApi(client *datadog.APIClient) *APMRetentionFiltersApi {
 	return &APMRetentionFiltersApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"time"
@@ -90,6 +91,7 @@ func (a *AuditApi) ListAuditLogs(ctx _context.Context, o ...ListAuditLogsOptiona
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.AuditApi.ListAuditLogs")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.AuditApi.ListAuditLogs")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -142,13 +144,10 @@ func (a *AuditApi) ListAuditLogs(ctx _context.Context, o ...ListAuditLogsOptiona
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -162,6 +161,7 @@ func (a *AuditApi) ListAuditLogs(ctx _context.Context, o ...ListAuditLogsOptiona
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -227,6 +227,58 @@ func (a *AuditApi) ListAuditLogsWithPagination(ctx _context.Context, o ...ListAu
 	return items, cancel
 }
 
+// ListAuditLogsSeq provides a paginated version of ListAuditLogs returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *AuditApi) ListAuditLogsSeq(ctx _context.Context, o ...ListAuditLogsOptionalParameters) iter.Seq2[AuditLogsEvent, error] {
+	return a.listAuditLogsPaginator(o...).Seq(ctx)
+}
+
+func (a *AuditApi) listAuditLogsPaginator(o ...ListAuditLogsOptionalParameters) datadog.Paginator[AuditLogsEvent] {
+	var params ListAuditLogsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[AuditLogsEvent]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[AuditLogsEvent], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageLimit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListAuditLogs(ctx, request)
+			if err != nil {
+				return datadog.Page[AuditLogsEvent]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[AuditLogsEvent]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// ListAuditLogsStream provides a streaming version of ListAuditLogs, passing each item to fn as it is decoded
+// instead of buffering the whole page. The items are not included in the returned response.
+func (a *AuditApi) ListAuditLogsStream(ctx _context.Context, fn func(AuditLogsEvent) error, o ...ListAuditLogsOptionalParameters) (AuditLogsEventsResponse, *_nethttp.Response, error) {
+	ctx = datadog.WithItemStream(ctx, "data", fn)
+	return a.ListAuditLogs(ctx, o...)
+}
+
 // SearchAuditLogsOptionalParameters holds optional parameters for SearchAuditLogs.
 type SearchAuditLogsOptionalParameters struct {
 	Body *AuditLogsSearchEventsRequest
@@ -266,6 +318,7 @@ func (a *AuditApi) SearchAuditLogs(ctx _context.Context, o ...SearchAuditLogsOpt
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.AuditApi.SearchAuditLogs")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.AuditApi.SearchAuditLogs")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -305,13 +358,10 @@ func (a *AuditApi) SearchAuditLogs(ctx _context.Context, o ...SearchAuditLogsOpt
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -325,6 +375,7 @@ func (a *AuditApi) SearchAuditLogs(ctx _context.Context, o ...SearchAuditLogsOpt
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -396,6 +447,85 @@ func (a *AuditApi) SearchAuditLogsWithPagination(ctx _context.Context, o ...Sear
 	return items, cancel
 }
 
+// SearchAuditLogsSeq provides a paginated version of SearchAuditLogs returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *AuditApi) SearchAuditLogsSeq(ctx _context.Context, o ...SearchAuditLogsOptionalParameters) iter.Seq2[AuditLogsEvent, error] {
+	return a.searchAuditLogsPaginator(o...).Seq(ctx)
+}
+
+func (a *AuditApi) searchAuditLogsPaginator(o ...SearchAuditLogsOptionalParameters) datadog.Paginator[AuditLogsEvent] {
+	var params SearchAuditLogsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Limit != nil {
+		first.Size = int64(*params.Body.Page.Limit)
+	}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Cursor != nil {
+		first.Cursor = *params.Body.Page.Cursor
+	}
+	return datadog.Paginator[AuditLogsEvent]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[AuditLogsEvent], error) {
+			request := params
+			// The nested parameters are copied, not to modify the ones of the caller.
+			if request.Body == nil {
+				request.Body = NewAuditLogsSearchEventsRequest()
+			} else {
+				auditLogsSearchEventsRequest := *request.Body
+				request.Body = &auditLogsSearchEventsRequest
+			}
+			if request.Body.Page == nil {
+				request.Body.Page = NewAuditLogsQueryPageOptions()
+			} else {
+				auditLogsQueryPageOptions := *request.Body.Page
+				request.Body.Page = &auditLogsQueryPageOptions
+			}
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.Body.Page.Limit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.Body.Page.Cursor = &cursor
+			}
+			resp, _, err := a.SearchAuditLogs(ctx, request)
+			if err != nil {
+				return datadog.Page[AuditLogsEvent]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[AuditLogsEvent]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// SearchAuditLogsStream provides a streaming version of SearchAuditLogs, passing each item to fn as it is decoded
+// instead of buffering the whole page. The items are not included in the returned response.
+func (a *AuditApi) SearchAuditLogsStream(ctx _context.Context, fn func(AuditLogsEvent) error, o ...SearchAuditLogsOptionalParameters) (AuditLogsEventsResponse, *_nethttp.Response, error) {
+	ctx = datadog.WithItemStream(ctx, "data", fn)
+	return a.SearchAuditLogs(ctx, o...)
+}
+
+// AuditApiInterface lists the operations of AuditApi, so that code depending on it can be tested with mocks.
+type AuditApiInterface interface {
+	ListAuditLogs(ctx _context.Context, o ...ListAuditLogsOptionalParameters) (AuditLogsEventsResponse, *_nethttp.Response, error)
+	ListAuditLogsWithPagination(ctx _context.Context, o ...ListAuditLogsOptionalParameters) (<-chan datadog.PaginationResult[AuditLogsEvent], func())
+	ListAuditLogsSeq(ctx _context.Context, o ...ListAuditLogsOptionalParameters) iter.Seq2[AuditLogsEvent, error]
+	ListAuditLogsStream(ctx _context.Context, fn func(AuditLogsEvent) error, o ...ListAuditLogsOptionalParameters) (AuditLogsEventsResponse, *_nethttp.Response, error)
+	SearchAuditLogs(ctx _context.Context, o ...SearchAuditLogsOptionalParameters) (AuditLogsEventsResponse, *_nethttp.Response, error)
+	SearchAuditLogsWithPagination(ctx _context.Context, o ...SearchAuditLogsOptionalParameters) (<-chan datadog.PaginationResult[AuditLogsEvent], func())
+	SearchAuditLogsSeq(ctx _context.Context, o ...SearchAuditLogsOptionalParameters) iter.Seq2[AuditLogsEvent, error]
+	SearchAuditLogsStream(ctx _context.Context, fn func(AuditLogsEvent) error, o ...SearchAuditLogsOptionalParameters) (AuditLogsEventsResponse, *_nethttp.Response, error)
+}
+
+var _ AuditApiInterface = (*AuditApi)(nil)
+
 // NewAuditApi Returns NewAuditApi.
 func NewAuditApi(client *datadog.APIClient) *AuditApi {
 	return &AuditApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *AuthNMappingsApi) CreateAuthNMapping(ctx _context.Context, body AuthNMa
 		localVarReturnValue AuthNMappingResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.AuthNMappingsApi.CreateAuthNMapping")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.AuthNMappingsApi.CreateAuthNMapping")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *AuthNMappingsApi) CreateAuthNMapping(ctx _context.Context, body AuthNMa
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *AuthNMappingsApi) CreateAuthNMapping(ctx _context.Context, body AuthNMa
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +97,7 @@ func (a *AuthNMappingsApi) DeleteAuthNMapping(ctx _context.Context, authnMapping
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.AuthNMappingsApi.DeleteAuthNMapping")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.AuthNMappingsApi.DeleteAuthNMapping")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +133,10 @@ func (a *AuthNMappingsApi) DeleteAuthNMapping(ctx _context.Context, authnMapping
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +157,7 @@ func (a *AuthNMappingsApi) GetAuthNMapping(ctx _context.Context, authnMappingId
 		localVarReturnValue AuthNMappingResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.AuthNMappingsApi.GetAuthNMapping")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.AuthNMappingsApi.GetAuthNMapping")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +193,10 @@ func (a *AuthNMappingsApi) GetAuthNMapping(ctx _context.Context, authnMappingId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +210,7 @@ func (a *AuthNMappingsApi) GetAuthNMapping(ctx _context.Context, authnMappingId
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -283,6 +280,7 @@ func (a *AuthNMappingsApi) ListAuthNMappings(ctx _context.Context, o ...ListAuth
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.AuthNMappingsApi.ListAuthNMappings")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.AuthNMappingsApi.ListAuthNMappings")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -332,13 +330,10 @@ func (a *AuthNMappingsApi) ListAuthNMappings(ctx _context.Context, o ...ListAuth
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -352,6 +347,7 @@ func (a *AuthNMappingsApi) ListAuthNMappings(ctx _context.Context, o ...ListAuth
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -359,6 +355,59 @@ func (a *AuthNMappingsApi) ListAuthNMappings(ctx _context.Context, o ...ListAuth
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListAuthNMappingsWithPagination provides a paginated version of ListAuthNMappings returning a channel with all items.
+func (a *AuthNMappingsApi) ListAuthNMappingsWithPagination(ctx _context.Context, o ...ListAuthNMappingsOptionalParameters) (<-chan datadog.PaginationResult[AuthNMapping], func()) {
+	return a.listAuthNMappingsPaginator(o...).Channel(ctx)
+}
+
+// ListAuthNMappingsSeq provides a paginated version of ListAuthNMappings returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *AuthNMappingsApi) ListAuthNMappingsSeq(ctx _context.Context, o ...ListAuthNMappingsOptionalParameters) iter.Seq2[AuthNMapping, error] {
+	return a.listAuthNMappingsPaginator(o...).Seq(ctx)
+}
+
+// ListAuthNMappingsPrefetch provides a paginated version of ListAuthNMappings returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *AuthNMappingsApi) ListAuthNMappingsPrefetch(ctx _context.Context, prefetch int, o ...ListAuthNMappingsOptionalParameters) iter.Seq2[AuthNMapping, error] {
+	paginator := a.listAuthNMappingsPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *AuthNMappingsApi) listAuthNMappingsPaginator(o ...ListAuthNMappingsOptionalParameters) datadog.Paginator[AuthNMapping] {
+	var params ListAuthNMappingsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[AuthNMapping]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[AuthNMapping], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListAuthNMappings(ctx, request)
+			if err != nil {
+				return datadog.Page[AuthNMapping]{}, err
+			}
+			return datadog.Page[AuthNMapping]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdateAuthNMapping Edit an AuthN Mapping.
 // Edit an AuthN Mapping.
 func (a *AuthNMappingsApi) UpdateAuthNMapping(ctx _context.Context, authnMappingId string, body AuthNMappingUpdateRequest) (AuthNMappingResponse, *_nethttp.Response, error) {
@@ -368,6 +417,7 @@ func (a *AuthNMappingsApi) UpdateAuthNMapping(ctx _context.Context, authnMapping
 		localVarReturnValue AuthNMappingResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.AuthNMappingsApi.UpdateAuthNMapping")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.AuthNMappingsApi.UpdateAuthNMapping")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -406,13 +456,10 @@ func (a *AuthNMappingsApi) UpdateAuthNMapping(ctx _context.Context, authnMapping
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 422 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -426,6 +473,7 @@ func (a *AuthNMappingsApi) UpdateAuthNMapping(ctx _context.Context, authnMapping
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -433,6 +481,20 @@ func (a *AuthNMappingsApi) UpdateAuthNMapping(ctx _context.Context, authnMapping
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// AuthNMappingsApiInterface lists the operations of AuthNMappingsApi, so that code depending on it can be tested with mocks.
+type AuthNMappingsApiInterface interface {
+	CreateAuthNMapping(ctx _context.Context, body AuthNMappingCreateRequest) (AuthNMappingResponse, *_nethttp.Response, error)
+	DeleteAuthNMapping(ctx _context.Context, authnMappingId string) (*_nethttp.Response, error)
+	GetAuthNMapping(ctx _context.Context, authnMappingId string) (AuthNMappingResponse, *_nethttp.Response, error)
+	ListAuthNMappings(ctx _context.Context, o ...ListAuthNMappingsOptionalParameters) (AuthNMappingsResponse, *_nethttp.Response, error)
+	ListAuthNMappingsWithPagination(ctx _context.Context, o ...ListAuthNMappingsOptionalParameters) (<-chan datadog.PaginationResult[AuthNMapping], func())
+	ListAuthNMappingsSeq(ctx _context.Context, o ...ListAuthNMappingsOptionalParameters) iter.Seq2[AuthNMapping, error]
+	ListAuthNMappingsPrefetch(ctx _context.Context, prefetch int, o ...ListAuthNMappingsOptionalParameters) iter.Seq2[AuthNMapping, error]
+	UpdateAuthNMapping(ctx _context.Context, authnMappingId string, body AuthNMappingUpdateRequest) (AuthNMappingResponse, *_nethttp.Response, error)
+}
+
+var _ AuthNMappingsApiInterface = (*AuthNMappingsApi)(nil)
+
 // NewAuthNMappingsApi Returns NewAuthNMappingsApi.
 func NewAuthNMappingsApi(client *datadog.APIClient) *AuthNMappingsApi {
 	return &AuthNMappingsApi{
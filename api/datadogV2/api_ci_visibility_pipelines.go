@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"time"
@@ -25,6 +26,7 @@ func (a *CIVisibilityPipelinesApi) AggregateCIAppPipelineEvents(ctx _context.Con
 		localVarReturnValue CIAppPipelinesAnalyticsAggregateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.CIVisibilityPipelinesApi.AggregateCIAppPipelineEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CIVisibilityPipelinesApi.AggregateCIAppPipelineEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *CIVisibilityPipelinesApi) AggregateCIAppPipelineEvents(ctx _context.Con
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *CIVisibilityPipelinesApi) AggregateCIAppPipelineEvents(ctx _context.Con
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -100,6 +100,7 @@ func (a *CIVisibilityPipelinesApi) CreateCIAppPipelineEvent(ctx _context.Context
 		localVarReturnValue interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.CIVisibilityPipelinesApi.CreateCIAppPipelineEvent")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CIVisibilityPipelinesApi.CreateCIAppPipelineEvent")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -136,13 +137,10 @@ func (a *CIVisibilityPipelinesApi) CreateCIAppPipelineEvent(ctx _context.Context
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 408 || localVarHTTPResponse.StatusCode == 413 || localVarHTTPResponse.StatusCode == 429 || localVarHTTPResponse.StatusCode == 500 || localVarHTTPResponse.StatusCode == 503 {
 			var v HTTPCIAppErrors
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -156,6 +154,7 @@ func (a *CIVisibilityPipelinesApi) CreateCIAppPipelineEvent(ctx _context.Context
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -235,6 +234,7 @@ func (a *CIVisibilityPipelinesApi) ListCIAppPipelineEvents(ctx _context.Context,
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.CIVisibilityPipelinesApi.ListCIAppPipelineEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CIVisibilityPipelinesApi.ListCIAppPipelineEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -287,13 +287,10 @@ func (a *CIVisibilityPipelinesApi) ListCIAppPipelineEvents(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -307,6 +304,7 @@ func (a *CIVisibilityPipelinesApi) ListCIAppPipelineEvents(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -372,6 +370,51 @@ func (a *CIVisibilityPipelinesApi) ListCIAppPipelineEventsWithPagination(ctx _co
 	return items, cancel
 }
 
+// ListCIAppPipelineEventsSeq provides a paginated version of ListCIAppPipelineEvents returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *CIVisibilityPipelinesApi) ListCIAppPipelineEventsSeq(ctx _context.Context, o ...ListCIAppPipelineEventsOptionalParameters) iter.Seq2[CIAppPipelineEvent, error] {
+	return a.listCIAppPipelineEventsPaginator(o...).Seq(ctx)
+}
+
+func (a *CIVisibilityPipelinesApi) listCIAppPipelineEventsPaginator(o ...ListCIAppPipelineEventsOptionalParameters) datadog.Paginator[CIAppPipelineEvent] {
+	var params ListCIAppPipelineEventsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[CIAppPipelineEvent]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[CIAppPipelineEvent], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageLimit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListCIAppPipelineEvents(ctx, request)
+			if err != nil {
+				return datadog.Page[CIAppPipelineEvent]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[CIAppPipelineEvent]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
 // SearchCIAppPipelineEventsOptionalParameters holds optional parameters for SearchCIAppPipelineEvents.
 type SearchCIAppPipelineEventsOptionalParameters struct {
 	Body *CIAppPipelineEventsRequest
@@ -409,6 +452,7 @@ func (a *CIVisibilityPipelinesApi) SearchCIAppPipelineEvents(ctx _context.Contex
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.CIVisibilityPipelinesApi.SearchCIAppPipelineEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CIVisibilityPipelinesApi.SearchCIAppPipelineEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -448,13 +492,10 @@ func (a *CIVisibilityPipelinesApi) SearchCIAppPipelineEvents(ctx _context.Contex
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -468,6 +509,7 @@ func (a *CIVisibilityPipelinesApi) SearchCIAppPipelineEvents(ctx _context.Contex
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -539,6 +581,78 @@ func (a *CIVisibilityPipelinesApi) SearchCIAppPipelineEventsWithPagination(ctx _
 	return items, cancel
 }
 
+// SearchCIAppPipelineEventsSeq provides a paginated version of SearchCIAppPipelineEvents returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *CIVisibilityPipelinesApi) SearchCIAppPipelineEventsSeq(ctx _context.Context, o ...SearchCIAppPipelineEventsOptionalParameters) iter.Seq2[CIAppPipelineEvent, error] {
+	return a.searchCIAppPipelineEventsPaginator(o...).Seq(ctx)
+}
+
+func (a *CIVisibilityPipelinesApi) searchCIAppPipelineEventsPaginator(o ...SearchCIAppPipelineEventsOptionalParameters) datadog.Paginator[CIAppPipelineEvent] {
+	var params SearchCIAppPipelineEventsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Limit != nil {
+		first.Size = int64(*params.Body.Page.Limit)
+	}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Cursor != nil {
+		first.Cursor = *params.Body.Page.Cursor
+	}
+	return datadog.Paginator[CIAppPipelineEvent]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[CIAppPipelineEvent], error) {
+			request := params
+			// The nested parameters are copied, not to modify the ones of the caller.
+			if request.Body == nil {
+				request.Body = NewCIAppPipelineEventsRequest()
+			} else {
+				cIAppPipelineEventsRequest := *request.Body
+				request.Body = &cIAppPipelineEventsRequest
+			}
+			if request.Body.Page == nil {
+				request.Body.Page = NewCIAppQueryPageOptions()
+			} else {
+				cIAppQueryPageOptions := *request.Body.Page
+				request.Body.Page = &cIAppQueryPageOptions
+			}
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.Body.Page.Limit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.Body.Page.Cursor = &cursor
+			}
+			resp, _, err := a.SearchCIAppPipelineEvents(ctx, request)
+			if err != nil {
+				return datadog.Page[CIAppPipelineEvent]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[CIAppPipelineEvent]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// CIVisibilityPipelinesApiInterface lists the operations of CIVisibilityPipelinesApi, so that code depending on it can be tested with mocks.
+type CIVisibilityPipelinesApiInterface interface {
+	AggregateCIAppPipelineEvents(ctx _context.Context, body CIAppPipelinesAggregateRequest) (CIAppPipelinesAnalyticsAggregateResponse, *_nethttp.Response, error)
+	CreateCIAppPipelineEvent(ctx _context.Context, body CIAppCreatePipelineEventRequest) (interface{}, *_nethttp.Response, error)
+	ListCIAppPipelineEvents(ctx _context.Context, o ...ListCIAppPipelineEventsOptionalParameters) (CIAppPipelineEventsResponse, *_nethttp.Response, error)
+	ListCIAppPipelineEventsWithPagination(ctx _context.Context, o ...ListCIAppPipelineEventsOptionalParameters) (<-chan datadog.PaginationResult[CIAppPipelineEvent], func())
+	ListCIAppPipelineEventsSeq(ctx _context.Context, o ...ListCIAppPipelineEventsOptionalParameters) iter.Seq2[CIAppPipelineEvent, error]
+	SearchCIAppPipelineEvents(ctx _context.Context, o ...SearchCIAppPipelineEventsOptionalParameters) (CIAppPipelineEventsResponse, *_nethttp.Response, error)
+	SearchCIAppPipelineEventsWithPagination(ctx _context.Context, o ...SearchCIAppPipelineEventsOptionalParameters) (<-chan datadog.PaginationResult[CIAppPipelineEvent], func())
+	SearchCIAppPipelineEventsSeq(ctx _context.Context, o ...SearchCIAppPipelineEventsOptionalParameters) iter.Seq2[CIAppPipelineEvent, error]
+}
+
+var _ CIVisibilityPipelinesApiInterface = (*CIVisibilityPipelinesApi)(nil)
+
 // NewCIVisibilityPipelinesApi Returns NewCIVisibilityPipelinesApi.
 func NewCIVisibilityPipelinesApi(client *datadog.APIClient) *CIVisibilityPipelinesApi {
 	return &CIVisibilityPipelinesApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"time"
@@ -25,6 +26,7 @@ func (a *CIVisibilityTestsApi) AggregateCIAppTestEvents(ctx _context.Context, bo
 		localVarReturnValue CIAppTestsAnalyticsAggregateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.CIVisibilityTestsApi.AggregateCIAppTestEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CIVisibilityTestsApi.AggregateCIAppTestEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *CIVisibilityTestsApi) AggregateCIAppTestEvents(ctx _context.Context, bo
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *CIVisibilityTestsApi) AggregateCIAppTestEvents(ctx _context.Context, bo
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -161,6 +161,7 @@ func (a *CIVisibilityTestsApi) ListCIAppTestEvents(ctx _context.Context, o ...Li
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.CIVisibilityTestsApi.ListCIAppTestEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CIVisibilityTestsApi.ListCIAppTestEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -213,13 +214,10 @@ func (a *CIVisibilityTestsApi) ListCIAppTestEvents(ctx _context.Context, o ...Li
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -233,6 +231,7 @@ func (a *CIVisibilityTestsApi) ListCIAppTestEvents(ctx _context.Context, o ...Li
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -298,6 +297,51 @@ func (a *CIVisibilityTestsApi) ListCIAppTestEventsWithPagination(ctx _context.Co
 	return items, cancel
 }
 
+// ListCIAppTestEventsSeq provides a paginated version of ListCIAppTestEvents returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *CIVisibilityTestsApi) ListCIAppTestEventsSeq(ctx _context.Context, o ...ListCIAppTestEventsOptionalParameters) iter.Seq2[CIAppTestEvent, error] {
+	return a.listCIAppTestEventsPaginator(o...).Seq(ctx)
+}
+
+func (a *CIVisibilityTestsApi) listCIAppTestEventsPaginator(o ...ListCIAppTestEventsOptionalParameters) datadog.Paginator[CIAppTestEvent] {
+	var params ListCIAppTestEventsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[CIAppTestEvent]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[CIAppTestEvent], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageLimit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListCIAppTestEvents(ctx, request)
+			if err != nil {
+				return datadog.Page[CIAppTestEvent]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[CIAppTestEvent]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
 // SearchCIAppTestEventsOptionalParameters holds optional parameters for SearchCIAppTestEvents.
 type SearchCIAppTestEventsOptionalParameters struct {
 	Body *CIAppTestEventsRequest
@@ -335,6 +379,7 @@ func (a *CIVisibilityTestsApi) SearchCIAppTestEvents(ctx _context.Context, o ...
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.CIVisibilityTestsApi.SearchCIAppTestEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CIVisibilityTestsApi.SearchCIAppTestEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -374,13 +419,10 @@ func (a *CIVisibilityTestsApi) SearchCIAppTestEvents(ctx _context.Context, o ...
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -394,6 +436,7 @@ func (a *CIVisibilityTestsApi) SearchCIAppTestEvents(ctx _context.Context, o ...
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -465,6 +508,77 @@ func (a *CIVisibilityTestsApi) SearchCIAppTestEventsWithPagination(ctx _context.
 	return items, cancel
 }
 
+// SearchCIAppTestEventsSeq provides a paginated version of SearchCIAppTestEvents returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *CIVisibilityTestsApi) SearchCIAppTestEventsSeq(ctx _context.Context, o ...SearchCIAppTestEventsOptionalParameters) iter.Seq2[CIAppTestEvent, error] {
+	return a.searchCIAppTestEventsPaginator(o...).Seq(ctx)
+}
+
+func (a *CIVisibilityTestsApi) searchCIAppTestEventsPaginator(o ...SearchCIAppTestEventsOptionalParameters) datadog.Paginator[CIAppTestEvent] {
+	var params SearchCIAppTestEventsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Limit != nil {
+		first.Size = int64(*params.Body.Page.Limit)
+	}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Cursor != nil {
+		first.Cursor = *params.Body.Page.Cursor
+	}
+	return datadog.Paginator[CIAppTestEvent]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[CIAppTestEvent], error) {
+			request := params
+			// The nested parameters are copied, not to modify the ones of the caller.
+			if request.Body == nil {
+				request.Body = NewCIAppTestEventsRequest()
+			} else {
+				cIAppTestEventsRequest := *request.Body
+				request.Body = &cIAppTestEventsRequest
+			}
+			if request.Body.Page == nil {
+				request.Body.Page = NewCIAppQueryPageOptions()
+			} else {
+				cIAppQueryPageOptions := *request.Body.Page
+				request.Body.Page = &cIAppQueryPageOptions
+			}
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.Body.Page.Limit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.Body.Page.Cursor = &cursor
+			}
+			resp, _, err := a.SearchCIAppTestEvents(ctx, request)
+			if err != nil {
+				return datadog.Page[CIAppTestEvent]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[CIAppTestEvent]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// CIVisibilityTestsApiInterface lists the operations of CIVisibilityTestsApi, so that code depending on it can be tested with mocks.
+type CIVisibilityTestsApiInterface interface {
+	AggregateCIAppTestEvents(ctx _context.Context, body CIAppTestsAggregateRequest) (CIAppTestsAnalyticsAggregateResponse, *_nethttp.Response, error)
+	ListCIAppTestEvents(ctx _context.Context, o ...ListCIAppTestEventsOptionalParameters) (CIAppTestEventsResponse, *_nethttp.Response, error)
+	ListCIAppTestEventsWithPagination(ctx _context.Context, o ...ListCIAppTestEventsOptionalParameters) (<-chan datadog.PaginationResult[CIAppTestEvent], func())
+	ListCIAppTestEventsSeq(ctx _context.Context, o ...ListCIAppTestEventsOptionalParameters) iter.Seq2[CIAppTestEvent, error]
+	SearchCIAppTestEvents(ctx _context.Context, o ...SearchCIAppTestEventsOptionalParameters) (CIAppTestEventsResponse, *_nethttp.Response, error)
+	SearchCIAppTestEventsWithPagination(ctx _context.Context, o ...SearchCIAppTestEventsOptionalParameters) (<-chan datadog.PaginationResult[CIAppTestEvent], func())
+	SearchCIAppTestEventsSeq(ctx _context.Context, o ...SearchCIAppTestEventsOptionalParameters) iter.Seq2[CIAppTestEvent, error]
+}
+
+var _ CIVisibilityTestsApiInterface = (*CIVisibilityTestsApi)(nil)
+
 // NewCIVisibilityTestsApi Returns NewCIVisibilityTestsApi.
 func NewCIVisibilityTestsApi(client *datadog.APIClient) *CIVisibilityTestsApi {
 	return &CIVisibilityTestsApi{
@@ -25,6 +25,7 @@ func (a *CloudflareIntegrationApi) CreateCloudflareAccount(ctx _context.Context,
 		localVarReturnValue CloudflareAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.CloudflareIntegrationApi.CreateCloudflareAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CloudflareIntegrationApi.CreateCloudflareAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *CloudflareIntegrationApi) CreateCloudflareAccount(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *CloudflareIntegrationApi) CreateCloudflareAccount(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +96,7 @@ func (a *CloudflareIntegrationApi) DeleteCloudflareAccount(ctx _context.Context,
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.CloudflareIntegrationApi.DeleteCloudflareAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CloudflareIntegrationApi.DeleteCloudflareAccount")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +132,10 @@ func (a *CloudflareIntegrationApi) DeleteCloudflareAccount(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +156,7 @@ func (a *CloudflareIntegrationApi) GetCloudflareAccount(ctx _context.Context, ac
 		localVarReturnValue CloudflareAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.CloudflareIntegrationApi.GetCloudflareAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CloudflareIntegrationApi.GetCloudflareAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +192,10 @@ func (a *CloudflareIntegrationApi) GetCloudflareAccount(ctx _context.Context, ac
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +209,7 @@ func (a *CloudflareIntegrationApi) GetCloudflareAccount(ctx _context.Context, ac
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -230,6 +226,7 @@ func (a *CloudflareIntegrationApi) ListCloudflareAccounts(ctx _context.Context)
 		localVarReturnValue CloudflareAccountsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.CloudflareIntegrationApi.ListCloudflareAccounts")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CloudflareIntegrationApi.ListCloudflareAccounts")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -264,13 +261,10 @@ func (a *CloudflareIntegrationApi) ListCloudflareAccounts(ctx _context.Context)
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -284,6 +278,7 @@ func (a *CloudflareIntegrationApi) ListCloudflareAccounts(ctx _context.Context)
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -300,6 +295,7 @@ func (a *CloudflareIntegrationApi) UpdateCloudflareAccount(ctx _context.Context,
 		localVarReturnValue CloudflareAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.CloudflareIntegrationApi.UpdateCloudflareAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.CloudflareIntegrationApi.UpdateCloudflareAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -338,13 +334,10 @@ func (a *CloudflareIntegrationApi) UpdateCloudflareAccount(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -358,6 +351,7 @@ func (a *CloudflareIntegrationApi) UpdateCloudflareAccount(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -365,6 +359,17 @@ func (a *CloudflareIntegrationApi) UpdateCloudflareAccount(ctx _context.Context,
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// CloudflareIntegrationApiInterface lists the operations of CloudflareIntegrationApi, so that code depending on it can be tested with mocks.
+type CloudflareIntegrationApiInterface interface {
+	CreateCloudflareAccount(ctx _context.Context, body CloudflareAccountCreateRequest) (CloudflareAccountResponse, *_nethttp.Response, error)
+	DeleteCloudflareAccount(ctx _context.Context, accountId string) (*_nethttp.Response, error)
+	GetCloudflareAccount(ctx _context.Context, accountId string) (CloudflareAccountResponse, *_nethttp.Response, error)
+	ListCloudflareAccounts(ctx _context.Context) (CloudflareAccountsResponse, *_nethttp.Response, error)
+	UpdateCloudflareAccount(ctx _context.Context, accountId string, body CloudflareAccountUpdateRequest) (CloudflareAccountResponse, *_nethttp.Response, error)
+}
+
+var _ CloudflareIntegrationApiInterface = (*CloudflareIntegrationApi)(nil)
+
 // NewCloudflareIntegrationApi Returns NewCloudflareIntegrationApi.
 func NewCloudflareIntegrationApi(client *datadog.APIClient) *CloudflareIntegrationApi {
 	return &CloudflareIntegrationApi{
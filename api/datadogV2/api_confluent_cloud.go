@@ -25,6 +25,7 @@ func (a *ConfluentCloudApi) CreateConfluentAccount(ctx _context.Context, body Co
 		localVarReturnValue ConfluentAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.CreateConfluentAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.CreateConfluentAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *ConfluentCloudApi) CreateConfluentAccount(ctx _context.Context, body Co
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *ConfluentCloudApi) CreateConfluentAccount(ctx _context.Context, body Co
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -98,6 +97,7 @@ func (a *ConfluentCloudApi) CreateConfluentResource(ctx _context.Context, accoun
 		localVarReturnValue ConfluentResourceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.CreateConfluentResource")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.CreateConfluentResource")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -136,13 +136,10 @@ func (a *ConfluentCloudApi) CreateConfluentResource(ctx _context.Context, accoun
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -156,6 +153,7 @@ func (a *ConfluentCloudApi) CreateConfluentResource(ctx _context.Context, accoun
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -171,6 +169,7 @@ func (a *ConfluentCloudApi) DeleteConfluentAccount(ctx _context.Context, account
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.DeleteConfluentAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.DeleteConfluentAccount")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -206,13 +205,10 @@ func (a *ConfluentCloudApi) DeleteConfluentAccount(ctx _context.Context, account
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -232,6 +228,7 @@ func (a *ConfluentCloudApi) DeleteConfluentResource(ctx _context.Context, accoun
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.DeleteConfluentResource")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.DeleteConfluentResource")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -268,13 +265,10 @@ func (a *ConfluentCloudApi) DeleteConfluentResource(ctx _context.Context, accoun
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -295,6 +289,7 @@ func (a *ConfluentCloudApi) GetConfluentAccount(ctx _context.Context, accountId
 		localVarReturnValue ConfluentAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.GetConfluentAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.GetConfluentAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -330,13 +325,10 @@ func (a *ConfluentCloudApi) GetConfluentAccount(ctx _context.Context, accountId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -350,6 +342,7 @@ func (a *ConfluentCloudApi) GetConfluentAccount(ctx _context.Context, accountId
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -366,6 +359,7 @@ func (a *ConfluentCloudApi) GetConfluentResource(ctx _context.Context, accountId
 		localVarReturnValue ConfluentResourceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.GetConfluentResource")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.GetConfluentResource")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -402,13 +396,10 @@ func (a *ConfluentCloudApi) GetConfluentResource(ctx _context.Context, accountId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -422,6 +413,7 @@ func (a *ConfluentCloudApi) GetConfluentResource(ctx _context.Context, accountId
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -438,6 +430,7 @@ func (a *ConfluentCloudApi) ListConfluentAccount(ctx _context.Context) (Confluen
 		localVarReturnValue ConfluentAccountsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.ListConfluentAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.ListConfluentAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -472,13 +465,10 @@ func (a *ConfluentCloudApi) ListConfluentAccount(ctx _context.Context) (Confluen
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -492,6 +482,7 @@ func (a *ConfluentCloudApi) ListConfluentAccount(ctx _context.Context) (Confluen
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -508,6 +499,7 @@ func (a *ConfluentCloudApi) ListConfluentResource(ctx _context.Context, accountI
 		localVarReturnValue ConfluentResourcesResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.ListConfluentResource")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.ListConfluentResource")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -543,13 +535,10 @@ func (a *ConfluentCloudApi) ListConfluentResource(ctx _context.Context, accountI
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -563,6 +552,7 @@ func (a *ConfluentCloudApi) ListConfluentResource(ctx _context.Context, accountI
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -579,6 +569,7 @@ func (a *ConfluentCloudApi) UpdateConfluentAccount(ctx _context.Context, account
 		localVarReturnValue ConfluentAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.UpdateConfluentAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.UpdateConfluentAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -617,13 +608,10 @@ func (a *ConfluentCloudApi) UpdateConfluentAccount(ctx _context.Context, account
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -637,6 +625,7 @@ func (a *ConfluentCloudApi) UpdateConfluentAccount(ctx _context.Context, account
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -653,6 +642,7 @@ func (a *ConfluentCloudApi) UpdateConfluentResource(ctx _context.Context, accoun
 		localVarReturnValue ConfluentResourceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ConfluentCloudApi.UpdateConfluentResource")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ConfluentCloudApi.UpdateConfluentResource")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -692,13 +682,10 @@ func (a *ConfluentCloudApi) UpdateConfluentResource(ctx _context.Context, accoun
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -712,6 +699,7 @@ func (a *ConfluentCloudApi) UpdateConfluentResource(ctx _context.Context, accoun
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -719,6 +707,22 @@ func (a *ConfluentCloudApi) UpdateConfluentResource(ctx _context.Context, accoun
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ConfluentCloudApiInterface lists the operations of ConfluentCloudApi, so that code depending on it can be tested with mocks.
+type ConfluentCloudApiInterface interface {
+	CreateConfluentAccount(ctx _context.Context, body ConfluentAccountCreateRequest) (ConfluentAccountResponse, *_nethttp.Response, error)
+	CreateConfluentResource(ctx _context.Context, accountId string, body ConfluentResourceRequest) (ConfluentResourceResponse, *_nethttp.Response, error)
+	DeleteConfluentAccount(ctx _context.Context, accountId string) (*_nethttp.Response, error)
+	DeleteConfluentResource(ctx _context.Context, accountId string, resourceId string) (*_nethttp.Response, error)
+	GetConfluentAccount(ctx _context.Context, accountId string) (ConfluentAccountResponse, *_nethttp.Response, error)
+	GetConfluentResource(ctx _context.Context, accountId string, resourceId string) (ConfluentResourceResponse, *_nethttp.Response, error)
+	ListConfluentAccount(ctx _context.Context) (ConfluentAccountsResponse, *_nethttp.Response, error)
+	ListConfluentResource(ctx _context.Context, accountId string) (ConfluentResourcesResponse, *_nethttp.Response, error)
+	UpdateConfluentAccount(ctx _context.Context, accountId string, body ConfluentAccountUpdateRequest) (ConfluentAccountResponse, *_nethttp.Response, error)
+	UpdateConfluentResource(ctx _context.Context, accountId string, resourceId string, body ConfluentResourceRequest) (ConfluentResourceResponse, *_nethttp.Response, error)
+}
+
+var _ ConfluentCloudApiInterface = (*ConfluentCloudApi)(nil)
+
 // NewConfluentCloudApi Returns NewConfluentCloudApi.
 func NewConfluentCloudApi(client *datadog.APIClient) *ConfluentCloudApi {
 	return &ConfluentCloudApi{
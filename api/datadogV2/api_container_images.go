@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 
@@ -77,6 +78,7 @@ func (a *ContainerImagesApi) ListContainerImages(ctx _context.Context, o ...List
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.ContainerImagesApi.ListContainerImages")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ContainerImagesApi.ListContainerImages")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -126,13 +128,10 @@ func (a *ContainerImagesApi) ListContainerImages(ctx _context.Context, o ...List
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -146,6 +145,7 @@ func (a *ContainerImagesApi) ListContainerImages(ctx _context.Context, o ...List
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -211,6 +211,60 @@ func (a *ContainerImagesApi) ListContainerImagesWithPagination(ctx _context.Cont
 	return items, cancel
 }
 
+// ListContainerImagesSeq provides a paginated version of ListContainerImages returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *ContainerImagesApi) ListContainerImagesSeq(ctx _context.Context, o ...ListContainerImagesOptionalParameters) iter.Seq2[ContainerImageItem, error] {
+	return a.listContainerImagesPaginator(o...).Seq(ctx)
+}
+
+func (a *ContainerImagesApi) listContainerImagesPaginator(o ...ListContainerImagesOptionalParameters) datadog.Paginator[ContainerImageItem] {
+	var params ListContainerImagesOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 1000}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[ContainerImageItem]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[ContainerImageItem], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageSize = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListContainerImages(ctx, request)
+			if err != nil {
+				return datadog.Page[ContainerImageItem]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPagination := cursorMeta.GetPagination()
+			return datadog.Page[ContainerImageItem]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPagination.GetNextCursor(),
+			}, nil
+		},
+	}
+}
+
+// ContainerImagesApiInterface lists the operations of ContainerImagesApi, so that code depending on it can be tested with mocks.
+type ContainerImagesApiInterface interface {
+	ListContainerImages(ctx _context.Context, o ...ListContainerImagesOptionalParameters) (ContainerImagesResponse, *_nethttp.Response, error)
+	ListContainerImagesWithPagination(ctx _context.Context, o ...ListContainerImagesOptionalParameters) (<-chan datadog.PaginationResult[ContainerImageItem], func())
+	ListContainerImagesSeq(ctx _context.Context, o ...ListContainerImagesOptionalParameters) iter.Seq2[ContainerImageItem, error]
+}
+
+var _ ContainerImagesApiInterface = (*ContainerImagesApi)(nil)
+
 // NewContainerImagesApi Returns NewContainerImagesApi.
 func NewContainerImagesApi(client *datadog.APIClient) *ContainerImagesApi {
 	return &ContainerImagesApi{
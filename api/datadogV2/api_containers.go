@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 
@@ -77,6 +78,7 @@ func (a *ContainersApi) ListContainers(ctx _context.Context, o ...ListContainers
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.ContainersApi.ListContainers")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ContainersApi.ListContainers")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -126,13 +128,10 @@ func (a *ContainersApi) ListContainers(ctx _context.Context, o ...ListContainers
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -146,6 +145,7 @@ func (a *ContainersApi) ListContainers(ctx _context.Context, o ...ListContainers
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -211,6 +211,60 @@ func (a *ContainersApi) ListContainersWithPagination(ctx _context.Context, o ...
 	return items, cancel
 }
 
+// ListContainersSeq provides a paginated version of ListContainers returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *ContainersApi) ListContainersSeq(ctx _context.Context, o ...ListContainersOptionalParameters) iter.Seq2[ContainerItem, error] {
+	return a.listContainersPaginator(o...).Seq(ctx)
+}
+
+func (a *ContainersApi) listContainersPaginator(o ...ListContainersOptionalParameters) datadog.Paginator[ContainerItem] {
+	var params ListContainersOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 1000}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[ContainerItem]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[ContainerItem], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageSize = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListContainers(ctx, request)
+			if err != nil {
+				return datadog.Page[ContainerItem]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPagination := cursorMeta.GetPagination()
+			return datadog.Page[ContainerItem]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPagination.GetNextCursor(),
+			}, nil
+		},
+	}
+}
+
+// ContainersApiInterface lists the operations of ContainersApi, so that code depending on it can be tested with mocks.
+type ContainersApiInterface interface {
+	ListContainers(ctx _context.Context, o ...ListContainersOptionalParameters) (ContainersResponse, *_nethttp.Response, error)
+	ListContainersWithPagination(ctx _context.Context, o ...ListContainersOptionalParameters) (<-chan datadog.PaginationResult[ContainerItem], func())
+	ListContainersSeq(ctx _context.Context, o ...ListContainersOptionalParameters) iter.Seq2[ContainerItem, error]
+}
+
+var _ ContainersApiInterface = (*ContainersApi)(nil)
+
 // NewContainersApi Returns NewContainersApi.
 func NewContainersApi(client *datadog.APIClient) *ContainersApi {
 	return &ContainersApi{
@@ -25,6 +25,7 @@ func (a *DashboardListsApi) CreateDashboardListItems(ctx _context.Context, dashb
 		localVarReturnValue DashboardListAddItemsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DashboardListsApi.CreateDashboardListItems")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DashboardListsApi.CreateDashboardListItems")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -63,13 +64,10 @@ func (a *DashboardListsApi) CreateDashboardListItems(ctx _context.Context, dashb
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -83,6 +81,7 @@ func (a *DashboardListsApi) CreateDashboardListItems(ctx _context.Context, dashb
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -99,6 +98,7 @@ func (a *DashboardListsApi) DeleteDashboardListItems(ctx _context.Context, dashb
 		localVarReturnValue DashboardListDeleteItemsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DashboardListsApi.DeleteDashboardListItems")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DashboardListsApi.DeleteDashboardListItems")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -137,13 +137,10 @@ func (a *DashboardListsApi) DeleteDashboardListItems(ctx _context.Context, dashb
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -157,6 +154,7 @@ func (a *DashboardListsApi) DeleteDashboardListItems(ctx _context.Context, dashb
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -173,6 +171,7 @@ func (a *DashboardListsApi) GetDashboardListItems(ctx _context.Context, dashboar
 		localVarReturnValue DashboardListItems
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DashboardListsApi.GetDashboardListItems")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DashboardListsApi.GetDashboardListItems")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -208,13 +207,10 @@ func (a *DashboardListsApi) GetDashboardListItems(ctx _context.Context, dashboar
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -228,6 +224,7 @@ func (a *DashboardListsApi) GetDashboardListItems(ctx _context.Context, dashboar
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -244,6 +241,7 @@ func (a *DashboardListsApi) UpdateDashboardListItems(ctx _context.Context, dashb
 		localVarReturnValue DashboardListUpdateItemsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DashboardListsApi.UpdateDashboardListItems")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DashboardListsApi.UpdateDashboardListItems")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -282,13 +280,10 @@ func (a *DashboardListsApi) UpdateDashboardListItems(ctx _context.Context, dashb
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -302,6 +297,7 @@ func (a *DashboardListsApi) UpdateDashboardListItems(ctx _context.Context, dashb
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -309,6 +305,16 @@ func (a *DashboardListsApi) UpdateDashboardListItems(ctx _context.Context, dashb
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// DashboardListsApiInterface lists the operations of DashboardListsApi, so that code depending on it can be tested with mocks.
+type DashboardListsApiInterface interface {
+	CreateDashboardListItems(ctx _context.Context, dashboardListId int64, body DashboardListAddItemsRequest) (DashboardListAddItemsResponse, *_nethttp.Response, error)
+	DeleteDashboardListItems(ctx _context.Context, dashboardListId int64, body DashboardListDeleteItemsRequest) (DashboardListDeleteItemsResponse, *_nethttp.Response, error)
+	GetDashboardListItems(ctx _context.Context, dashboardListId int64) (DashboardListItems, *_nethttp.Response, error)
+	UpdateDashboardListItems(ctx _context.Context, dashboardListId int64, body DashboardListUpdateItemsRequest) (DashboardListUpdateItemsResponse, *_nethttp.Response, error)
+}
+
+var _ DashboardListsApiInterface = (*DashboardListsApi)(nil)
+
 // NewDashboardListsApi Returns NewDashboardListsApi.
 func NewDashboardListsApi(client *datadog.APIClient) *DashboardListsApi {
 	return &DashboardListsApi{
@@ -24,6 +24,7 @@ func (a *DomainAllowlistApi) GetDomainAllowlist(ctx _context.Context) (DomainAll
 		localVarReturnValue DomainAllowlistResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DomainAllowlistApi.GetDomainAllowlist")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DomainAllowlistApi.GetDomainAllowlist")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -58,13 +59,10 @@ func (a *DomainAllowlistApi) GetDomainAllowlist(ctx _context.Context) (DomainAll
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -78,6 +76,7 @@ func (a *DomainAllowlistApi) GetDomainAllowlist(ctx _context.Context) (DomainAll
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -94,6 +93,7 @@ func (a *DomainAllowlistApi) PatchDomainAllowlist(ctx _context.Context, body Dom
 		localVarReturnValue DomainAllowlistResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DomainAllowlistApi.PatchDomainAllowlist")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DomainAllowlistApi.PatchDomainAllowlist")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -131,13 +131,10 @@ func (a *DomainAllowlistApi) PatchDomainAllowlist(ctx _context.Context, body Dom
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -151,6 +148,7 @@ func (a *DomainAllowlistApi) PatchDomainAllowlist(ctx _context.Context, body Dom
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -158,6 +156,14 @@ func (a *DomainAllowlistApi) PatchDomainAllowlist(ctx _context.Context, body Dom
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// DomainAllowlistApiInterface lists the operations of DomainAllowlistApi, so that code depending on it can be tested with mocks.
+type DomainAllowlistApiInterface interface {
+	GetDomainAllowlist(ctx _context.Context) (DomainAllowlistResponse, *_nethttp.Response, error)
+	PatchDomainAllowlist(ctx _context.Context, body DomainAllowlistRequest) (DomainAllowlistResponse, *_nethttp.Response, error)
+}
+
+var _ DomainAllowlistApiInterface = (*DomainAllowlistApi)(nil)
+
 // NewDomainAllowlistApi Returns NewDomainAllowlistApi.
 func NewDomainAllowlistApi(client *datadog.APIClient) *DomainAllowlistApi {
 	return &DomainAllowlistApi{
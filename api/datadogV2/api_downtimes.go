@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -24,6 +25,7 @@ func (a *DowntimesApi) CancelDowntime(ctx _context.Context, downtimeId string) (
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DowntimesApi.CancelDowntime")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DowntimesApi.CancelDowntime")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -59,13 +61,10 @@ func (a *DowntimesApi) CancelDowntime(ctx _context.Context, downtimeId string) (
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -86,6 +85,7 @@ func (a *DowntimesApi) CreateDowntime(ctx _context.Context, body DowntimeCreateR
 		localVarReturnValue DowntimeResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DowntimesApi.CreateDowntime")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DowntimesApi.CreateDowntime")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -123,13 +123,10 @@ func (a *DowntimesApi) CreateDowntime(ctx _context.Context, body DowntimeCreateR
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -143,6 +140,7 @@ func (a *DowntimesApi) CreateDowntime(ctx _context.Context, body DowntimeCreateR
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -184,6 +182,7 @@ func (a *DowntimesApi) GetDowntime(ctx _context.Context, downtimeId string, o ..
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.DowntimesApi.GetDowntime")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DowntimesApi.GetDowntime")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -222,13 +221,10 @@ func (a *DowntimesApi) GetDowntime(ctx _context.Context, downtimeId string, o ..
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -242,6 +238,7 @@ func (a *DowntimesApi) GetDowntime(ctx _context.Context, downtimeId string, o ..
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -304,6 +301,7 @@ func (a *DowntimesApi) ListDowntimes(ctx _context.Context, o ...ListDowntimesOpt
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.DowntimesApi.ListDowntimes")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DowntimesApi.ListDowntimes")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -350,13 +348,10 @@ func (a *DowntimesApi) ListDowntimes(ctx _context.Context, o ...ListDowntimesOpt
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -370,6 +365,7 @@ func (a *DowntimesApi) ListDowntimes(ctx _context.Context, o ...ListDowntimesOpt
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -427,6 +423,54 @@ func (a *DowntimesApi) ListDowntimesWithPagination(ctx _context.Context, o ...Li
 	return items, cancel
 }
 
+// ListDowntimesSeq provides a paginated version of ListDowntimes returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *DowntimesApi) ListDowntimesSeq(ctx _context.Context, o ...ListDowntimesOptionalParameters) iter.Seq2[DowntimeResponseData, error] {
+	return a.listDowntimesPaginator(o...).Seq(ctx)
+}
+
+// ListDowntimesPrefetch provides a paginated version of ListDowntimes returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *DowntimesApi) ListDowntimesPrefetch(ctx _context.Context, prefetch int, o ...ListDowntimesOptionalParameters) iter.Seq2[DowntimeResponseData, error] {
+	paginator := a.listDowntimesPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *DowntimesApi) listDowntimesPaginator(o ...ListDowntimesOptionalParameters) datadog.Paginator[DowntimeResponseData] {
+	var params ListDowntimesOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 30}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageOffset != nil {
+		first.Offset = int64(*params.PageOffset)
+	}
+	return datadog.Paginator[DowntimeResponseData]{
+		Strategy: datadog.OffsetPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[DowntimeResponseData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageLimit = &pageSize
+			}
+			pageOffset := int64(page.Offset)
+			request.PageOffset = &pageOffset
+			resp, _, err := a.ListDowntimes(ctx, request)
+			if err != nil {
+				return datadog.Page[DowntimeResponseData]{}, err
+			}
+			return datadog.Page[DowntimeResponseData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // ListMonitorDowntimesOptionalParameters holds optional parameters for ListMonitorDowntimes.
 type ListMonitorDowntimesOptionalParameters struct {
 	PageOffset *int64
@@ -468,6 +512,7 @@ func (a *DowntimesApi) ListMonitorDowntimes(ctx _context.Context, monitorId int6
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.DowntimesApi.ListMonitorDowntimes")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DowntimesApi.ListMonitorDowntimes")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -509,13 +554,10 @@ func (a *DowntimesApi) ListMonitorDowntimes(ctx _context.Context, monitorId int6
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -529,6 +571,7 @@ func (a *DowntimesApi) ListMonitorDowntimes(ctx _context.Context, monitorId int6
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -586,6 +629,54 @@ func (a *DowntimesApi) ListMonitorDowntimesWithPagination(ctx _context.Context,
 	return items, cancel
 }
 
+// ListMonitorDowntimesSeq provides a paginated version of ListMonitorDowntimes returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *DowntimesApi) ListMonitorDowntimesSeq(ctx _context.Context, monitorId int64, o ...ListMonitorDowntimesOptionalParameters) iter.Seq2[MonitorDowntimeMatchResponseData, error] {
+	return a.listMonitorDowntimesPaginator(monitorId, o...).Seq(ctx)
+}
+
+// ListMonitorDowntimesPrefetch provides a paginated version of ListMonitorDowntimes returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *DowntimesApi) ListMonitorDowntimesPrefetch(ctx _context.Context, monitorId int64, prefetch int, o ...ListMonitorDowntimesOptionalParameters) iter.Seq2[MonitorDowntimeMatchResponseData, error] {
+	paginator := a.listMonitorDowntimesPaginator(monitorId, o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *DowntimesApi) listMonitorDowntimesPaginator(monitorId int64, o ...ListMonitorDowntimesOptionalParameters) datadog.Paginator[MonitorDowntimeMatchResponseData] {
+	var params ListMonitorDowntimesOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 30}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageOffset != nil {
+		first.Offset = int64(*params.PageOffset)
+	}
+	return datadog.Paginator[MonitorDowntimeMatchResponseData]{
+		Strategy: datadog.OffsetPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[MonitorDowntimeMatchResponseData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageLimit = &pageSize
+			}
+			pageOffset := int64(page.Offset)
+			request.PageOffset = &pageOffset
+			resp, _, err := a.ListMonitorDowntimes(ctx, monitorId, request)
+			if err != nil {
+				return datadog.Page[MonitorDowntimeMatchResponseData]{}, err
+			}
+			return datadog.Page[MonitorDowntimeMatchResponseData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdateDowntime Update a downtime.
 // Update a downtime by `downtime_id`.
 func (a *DowntimesApi) UpdateDowntime(ctx _context.Context, downtimeId string, body DowntimeUpdateRequest) (DowntimeResponse, *_nethttp.Response, error) {
@@ -595,6 +686,7 @@ func (a *DowntimesApi) UpdateDowntime(ctx _context.Context, downtimeId string, b
 		localVarReturnValue DowntimeResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.DowntimesApi.UpdateDowntime")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.DowntimesApi.UpdateDowntime")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -633,13 +725,10 @@ func (a *DowntimesApi) UpdateDowntime(ctx _context.Context, downtimeId string, b
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -653,6 +742,7 @@ func (a *DowntimesApi) UpdateDowntime(ctx _context.Context, downtimeId string, b
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -660,6 +750,24 @@ func (a *DowntimesApi) UpdateDowntime(ctx _context.Context, downtimeId string, b
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// DowntimesApiInterface lists the operations of DowntimesApi, so that code depending on it can be tested with mocks.
+type DowntimesApiInterface interface {
+	CancelDowntime(ctx _context.Context, downtimeId string) (*_nethttp.Response, error)
+	CreateDowntime(ctx _context.Context, body DowntimeCreateRequest) (DowntimeResponse, *_nethttp.Response, error)
+	GetDowntime(ctx _context.Context, downtimeId string, o ...GetDowntimeOptionalParameters) (DowntimeResponse, *_nethttp.Response, error)
+	ListDowntimes(ctx _context.Context, o ...ListDowntimesOptionalParameters) (ListDowntimesResponse, *_nethttp.Response, error)
+	ListDowntimesWithPagination(ctx _context.Context, o ...ListDowntimesOptionalParameters) (<-chan datadog.PaginationResult[DowntimeResponseData], func())
+	ListDowntimesSeq(ctx _context.Context, o ...ListDowntimesOptionalParameters) iter.Seq2[DowntimeResponseData, error]
+	ListDowntimesPrefetch(ctx _context.Context, prefetch int, o ...ListDowntimesOptionalParameters) iter.Seq2[DowntimeResponseData, error]
+	ListMonitorDowntimes(ctx _context.Context, monitorId int64, o ...ListMonitorDowntimesOptionalParameters) (MonitorDowntimeMatchResponse, *_nethttp.Response, error)
+	ListMonitorDowntimesWithPagination(ctx _context.Context, monitorId int64, o ...ListMonitorDowntimesOptionalParameters) (<-chan datadog.PaginationResult[MonitorDowntimeMatchResponseData], func())
+	ListMonitorDowntimesSeq(ctx _context.Context, monitorId int64, o ...ListMonitorDowntimesOptionalParameters) iter.Seq2[MonitorDowntimeMatchResponseData, error]
+	ListMonitorDowntimesPrefetch(ctx _context.Context, monitorId int64, prefetch int, o ...ListMonitorDowntimesOptionalParameters) iter.Seq2[MonitorDowntimeMatchResponseData, error]
+	UpdateDowntime(ctx _context.Context, downtimeId string, body DowntimeUpdateRequest) (DowntimeResponse, *_nethttp.Response, error)
+}
+
+var _ DowntimesApiInterface = (*DowntimesApi)(nil)
+
 // NewDowntimesApi Returns NewDowntimesApi.
 func NewDowntimesApi(client *datadog.APIClient) *DowntimesApi {
 	return &DowntimesApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 
@@ -87,6 +88,7 @@ func (a *EventsApi) ListEvents(ctx _context.Context, o ...ListEventsOptionalPara
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.EventsApi.ListEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.EventsApi.ListEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -139,13 +141,10 @@ func (a *EventsApi) ListEvents(ctx _context.Context, o ...ListEventsOptionalPara
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -159,6 +158,7 @@ func (a *EventsApi) ListEvents(ctx _context.Context, o ...ListEventsOptionalPara
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -224,6 +224,51 @@ func (a *EventsApi) ListEventsWithPagination(ctx _context.Context, o ...ListEven
 	return items, cancel
 }
 
+// ListEventsSeq provides a paginated version of ListEvents returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *EventsApi) ListEventsSeq(ctx _context.Context, o ...ListEventsOptionalParameters) iter.Seq2[EventResponse, error] {
+	return a.listEventsPaginator(o...).Seq(ctx)
+}
+
+func (a *EventsApi) listEventsPaginator(o ...ListEventsOptionalParameters) datadog.Paginator[EventResponse] {
+	var params ListEventsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[EventResponse]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[EventResponse], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageLimit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListEvents(ctx, request)
+			if err != nil {
+				return datadog.Page[EventResponse]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[EventResponse]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
 // SearchEventsOptionalParameters holds optional parameters for SearchEvents.
 type SearchEventsOptionalParameters struct {
 	Body *EventsListRequest
@@ -261,6 +306,7 @@ func (a *EventsApi) SearchEvents(ctx _context.Context, o ...SearchEventsOptional
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.EventsApi.SearchEvents")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.EventsApi.SearchEvents")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -300,13 +346,10 @@ func (a *EventsApi) SearchEvents(ctx _context.Context, o ...SearchEventsOptional
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -320,6 +363,7 @@ func (a *EventsApi) SearchEvents(ctx _context.Context, o ...SearchEventsOptional
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -391,6 +435,76 @@ func (a *EventsApi) SearchEventsWithPagination(ctx _context.Context, o ...Search
 	return items, cancel
 }
 
+// SearchEventsSeq provides a paginated version of SearchEvents returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *EventsApi) SearchEventsSeq(ctx _context.Context, o ...SearchEventsOptionalParameters) iter.Seq2[EventResponse, error] {
+	return a.searchEventsPaginator(o...).Seq(ctx)
+}
+
+func (a *EventsApi) searchEventsPaginator(o ...SearchEventsOptionalParameters) datadog.Paginator[EventResponse] {
+	var params SearchEventsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Limit != nil {
+		first.Size = int64(*params.Body.Page.Limit)
+	}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Cursor != nil {
+		first.Cursor = *params.Body.Page.Cursor
+	}
+	return datadog.Paginator[EventResponse]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[EventResponse], error) {
+			request := params
+			// The nested parameters are copied, not to modify the ones of the caller.
+			if request.Body == nil {
+				request.Body = NewEventsListRequest()
+			} else {
+				eventsListRequest := *request.Body
+				request.Body = &eventsListRequest
+			}
+			if request.Body.Page == nil {
+				request.Body.Page = NewEventsRequestPage()
+			} else {
+				eventsRequestPage := *request.Body.Page
+				request.Body.Page = &eventsRequestPage
+			}
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.Body.Page.Limit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.Body.Page.Cursor = &cursor
+			}
+			resp, _, err := a.SearchEvents(ctx, request)
+			if err != nil {
+				return datadog.Page[EventResponse]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[EventResponse]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// EventsApiInterface lists the operations of EventsApi, so that code depending on it can be tested with mocks.
+type EventsApiInterface interface {
+	ListEvents(ctx _context.Context, o ...ListEventsOptionalParameters) (EventsListResponse, *_nethttp.Response, error)
+	ListEventsWithPagination(ctx _context.Context, o ...ListEventsOptionalParameters) (<-chan datadog.PaginationResult[EventResponse], func())
+	ListEventsSeq(ctx _context.Context, o ...ListEventsOptionalParameters) iter.Seq2[EventResponse, error]
+	SearchEvents(ctx _context.Context, o ...SearchEventsOptionalParameters) (EventsListResponse, *_nethttp.Response, error)
+	SearchEventsWithPagination(ctx _context.Context, o ...SearchEventsOptionalParameters) (<-chan datadog.PaginationResult[EventResponse], func())
+	SearchEventsSeq(ctx _context.Context, o ...SearchEventsOptionalParameters) iter.Seq2[EventResponse, error]
+}
+
+var _ EventsApiInterface = (*EventsApi)(nil)
+
 // NewEventsApi Returns NewEventsApi.
 func NewEventsApi(client *datadog.APIClient) *EventsApi {
 	return &EventsApi{
@@ -25,6 +25,7 @@ func (a *FastlyIntegrationApi) CreateFastlyAccount(ctx _context.Context, body Fa
 		localVarReturnValue FastlyAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.CreateFastlyAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.CreateFastlyAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *FastlyIntegrationApi) CreateFastlyAccount(ctx _context.Context, body Fa
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *FastlyIntegrationApi) CreateFastlyAccount(ctx _context.Context, body Fa
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -98,6 +97,7 @@ func (a *FastlyIntegrationApi) CreateFastlyService(ctx _context.Context, account
 		localVarReturnValue FastlyServiceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.CreateFastlyService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.CreateFastlyService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -136,13 +136,10 @@ func (a *FastlyIntegrationApi) CreateFastlyService(ctx _context.Context, account
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -156,6 +153,7 @@ func (a *FastlyIntegrationApi) CreateFastlyService(ctx _context.Context, account
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -171,6 +169,7 @@ func (a *FastlyIntegrationApi) DeleteFastlyAccount(ctx _context.Context, account
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.DeleteFastlyAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.DeleteFastlyAccount")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -206,13 +205,10 @@ func (a *FastlyIntegrationApi) DeleteFastlyAccount(ctx _context.Context, account
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -232,6 +228,7 @@ func (a *FastlyIntegrationApi) DeleteFastlyService(ctx _context.Context, account
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.DeleteFastlyService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.DeleteFastlyService")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -268,13 +265,10 @@ func (a *FastlyIntegrationApi) DeleteFastlyService(ctx _context.Context, account
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -295,6 +289,7 @@ func (a *FastlyIntegrationApi) GetFastlyAccount(ctx _context.Context, accountId
 		localVarReturnValue FastlyAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.GetFastlyAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.GetFastlyAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -330,13 +325,10 @@ func (a *FastlyIntegrationApi) GetFastlyAccount(ctx _context.Context, accountId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -350,6 +342,7 @@ func (a *FastlyIntegrationApi) GetFastlyAccount(ctx _context.Context, accountId
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -366,6 +359,7 @@ func (a *FastlyIntegrationApi) GetFastlyService(ctx _context.Context, accountId
 		localVarReturnValue FastlyServiceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.GetFastlyService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.GetFastlyService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -402,13 +396,10 @@ func (a *FastlyIntegrationApi) GetFastlyService(ctx _context.Context, accountId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -422,6 +413,7 @@ func (a *FastlyIntegrationApi) GetFastlyService(ctx _context.Context, accountId
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -438,6 +430,7 @@ func (a *FastlyIntegrationApi) ListFastlyAccounts(ctx _context.Context) (FastlyA
 		localVarReturnValue FastlyAccountsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.ListFastlyAccounts")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.ListFastlyAccounts")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -472,13 +465,10 @@ func (a *FastlyIntegrationApi) ListFastlyAccounts(ctx _context.Context) (FastlyA
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -492,6 +482,7 @@ func (a *FastlyIntegrationApi) ListFastlyAccounts(ctx _context.Context) (FastlyA
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -508,6 +499,7 @@ func (a *FastlyIntegrationApi) ListFastlyServices(ctx _context.Context, accountI
 		localVarReturnValue FastlyServicesResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.ListFastlyServices")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.ListFastlyServices")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -543,13 +535,10 @@ func (a *FastlyIntegrationApi) ListFastlyServices(ctx _context.Context, accountI
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -563,6 +552,7 @@ func (a *FastlyIntegrationApi) ListFastlyServices(ctx _context.Context, accountI
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -579,6 +569,7 @@ func (a *FastlyIntegrationApi) UpdateFastlyAccount(ctx _context.Context, account
 		localVarReturnValue FastlyAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.UpdateFastlyAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.UpdateFastlyAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -617,13 +608,10 @@ func (a *FastlyIntegrationApi) UpdateFastlyAccount(ctx _context.Context, account
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -637,6 +625,7 @@ func (a *FastlyIntegrationApi) UpdateFastlyAccount(ctx _context.Context, account
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -653,6 +642,7 @@ func (a *FastlyIntegrationApi) UpdateFastlyService(ctx _context.Context, account
 		localVarReturnValue FastlyServiceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.FastlyIntegrationApi.UpdateFastlyService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.FastlyIntegrationApi.UpdateFastlyService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -692,13 +682,10 @@ func (a *FastlyIntegrationApi) UpdateFastlyService(ctx _context.Context, account
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -712,6 +699,7 @@ func (a *FastlyIntegrationApi) UpdateFastlyService(ctx _context.Context, account
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -719,6 +707,22 @@ func (a *FastlyIntegrationApi) UpdateFastlyService(ctx _context.Context, account
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// FastlyIntegrationApiInterface lists the operations of FastlyIntegrationApi, so that code depending on it can be tested with mocks.
+type FastlyIntegrationApiInterface interface {
+	CreateFastlyAccount(ctx _context.Context, body FastlyAccountCreateRequest) (FastlyAccountResponse, *_nethttp.Response, error)
+	CreateFastlyService(ctx _context.Context, accountId string, body FastlyServiceRequest) (FastlyServiceResponse, *_nethttp.Response, error)
+	DeleteFastlyAccount(ctx _context.Context, accountId string) (*_nethttp.Response, error)
+	DeleteFastlyService(ctx _context.Context, accountId string, serviceId string) (*_nethttp.Response, error)
+	GetFastlyAccount(ctx _context.Context, accountId string) (FastlyAccountResponse, *_nethttp.Response, error)
+	GetFastlyService(ctx _context.Context, accountId string, serviceId string) (FastlyServiceResponse, *_nethttp.Response, error)
+	ListFastlyAccounts(ctx _context.Context) (FastlyAccountsResponse, *_nethttp.Response, error)
+	ListFastlyServices(ctx _context.Context, accountId string) (FastlyServicesResponse, *_nethttp.Response, error)
+	UpdateFastlyAccount(ctx _context.Context, accountId string, body FastlyAccountUpdateRequest) (FastlyAccountResponse, *_nethttp.Response, error)
+	UpdateFastlyService(ctx _context.Context, accountId string, serviceId string, body FastlyServiceRequest) (FastlyServiceResponse, *_nethttp.Response, error)
+}
+
+var _ FastlyIntegrationApiInterface = (*FastlyIntegrationApi)(nil)
+
 // NewFastlyIntegrationApi Returns NewFastlyIntegrationApi.
 func NewFastlyIntegrationApi(client *datadog.APIClient) *FastlyIntegrationApi {
 	return &FastlyIntegrationApi{
@@ -25,6 +25,7 @@ func (a *GCPIntegrationApi) CreateGCPSTSAccount(ctx _context.Context, body GCPST
 		localVarReturnValue GCPSTSServiceAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.GCPIntegrationApi.CreateGCPSTSAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.GCPIntegrationApi.CreateGCPSTSAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *GCPIntegrationApi) CreateGCPSTSAccount(ctx _context.Context, body GCPST
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *GCPIntegrationApi) CreateGCPSTSAccount(ctx _context.Context, body GCPST
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +96,7 @@ func (a *GCPIntegrationApi) DeleteGCPSTSAccount(ctx _context.Context, accountId
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.GCPIntegrationApi.DeleteGCPSTSAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.GCPIntegrationApi.DeleteGCPSTSAccount")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +132,10 @@ func (a *GCPIntegrationApi) DeleteGCPSTSAccount(ctx _context.Context, accountId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +156,7 @@ func (a *GCPIntegrationApi) GetGCPSTSDelegate(ctx _context.Context) (GCPSTSDeleg
 		localVarReturnValue GCPSTSDelegateAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.GCPIntegrationApi.GetGCPSTSDelegate")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.GCPIntegrationApi.GetGCPSTSDelegate")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -193,13 +191,10 @@ func (a *GCPIntegrationApi) GetGCPSTSDelegate(ctx _context.Context) (GCPSTSDeleg
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -213,6 +208,7 @@ func (a *GCPIntegrationApi) GetGCPSTSDelegate(ctx _context.Context) (GCPSTSDeleg
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -229,6 +225,7 @@ func (a *GCPIntegrationApi) ListGCPSTSAccounts(ctx _context.Context) (GCPSTSServ
 		localVarReturnValue GCPSTSServiceAccountsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.GCPIntegrationApi.ListGCPSTSAccounts")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.GCPIntegrationApi.ListGCPSTSAccounts")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -263,13 +260,10 @@ func (a *GCPIntegrationApi) ListGCPSTSAccounts(ctx _context.Context) (GCPSTSServ
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -283,6 +277,7 @@ func (a *GCPIntegrationApi) ListGCPSTSAccounts(ctx _context.Context) (GCPSTSServ
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -324,6 +319,7 @@ func (a *GCPIntegrationApi) MakeGCPSTSDelegate(ctx _context.Context, o ...MakeGC
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.GCPIntegrationApi.MakeGCPSTSDelegate")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.GCPIntegrationApi.MakeGCPSTSDelegate")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -363,13 +359,10 @@ func (a *GCPIntegrationApi) MakeGCPSTSDelegate(ctx _context.Context, o ...MakeGC
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -383,6 +376,7 @@ func (a *GCPIntegrationApi) MakeGCPSTSDelegate(ctx _context.Context, o ...MakeGC
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -399,6 +393,7 @@ func (a *GCPIntegrationApi) UpdateGCPSTSAccount(ctx _context.Context, accountId
 		localVarReturnValue GCPSTSServiceAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.GCPIntegrationApi.UpdateGCPSTSAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.GCPIntegrationApi.UpdateGCPSTSAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -437,13 +432,10 @@ func (a *GCPIntegrationApi) UpdateGCPSTSAccount(ctx _context.Context, accountId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -457,6 +449,7 @@ func (a *GCPIntegrationApi) UpdateGCPSTSAccount(ctx _context.Context, accountId
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -464,6 +457,18 @@ func (a *GCPIntegrationApi) UpdateGCPSTSAccount(ctx _context.Context, accountId
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// GCPIntegrationApiInterface lists the operations of GCPIntegrationApi, so that code depending on it can be tested with mocks.
+type GCPIntegrationApiInterface interface {
+	CreateGCPSTSAccount(ctx _context.Context, body GCPSTSServiceAccountCreateRequest) (GCPSTSServiceAccountResponse, *_nethttp.Response, error)
+	DeleteGCPSTSAccount(ctx _context.Context, accountId string) (*_nethttp.Response, error)
+	GetGCPSTSDelegate(ctx _context.Context) (GCPSTSDelegateAccountResponse, *_nethttp.Response, error)
+	ListGCPSTSAccounts(ctx _context.Context) (GCPSTSServiceAccountsResponse, *_nethttp.Response, error)
+	MakeGCPSTSDelegate(ctx _context.Context, o ...MakeGCPSTSDelegateOptionalParameters) (GCPSTSDelegateAccountResponse, *_nethttp.Response, error)
+	UpdateGCPSTSAccount(ctx _context.Context, accountId string, body GCPSTSServiceAccountUpdateRequest) (GCPSTSServiceAccountResponse, *_nethttp.Response, error)
+}
+
+var _ GCPIntegrationApiInterface = (*GCPIntegrationApi)(nil)
+
 // NewGCPIntegrationApi Returns NewGCPIntegrationApi.
 func NewGCPIntegrationApi(client *datadog.APIClient) *GCPIntegrationApi {
 	return &GCPIntegrationApi{
@@ -7,7 +7,7 @@ package datadogV2
 import (
 	_context "context"
 	_fmt "fmt"
-	_log "log"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -31,11 +31,12 @@ func (a *IncidentServicesApi) CreateIncidentService(ctx _context.Context, body I
 
 	operationId := "v2.CreateIncidentService"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentServicesApi.CreateIncidentService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentServicesApi.CreateIncidentService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -73,13 +74,10 @@ func (a *IncidentServicesApi) CreateIncidentService(ctx _context.Context, body I
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -93,6 +91,7 @@ func (a *IncidentServicesApi) CreateIncidentService(ctx _context.Context, body I
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -112,11 +111,12 @@ func (a *IncidentServicesApi) DeleteIncidentService(ctx _context.Context, servic
 
 	operationId := "v2.DeleteIncidentService"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentServicesApi.DeleteIncidentService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentServicesApi.DeleteIncidentService")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -152,13 +152,10 @@ func (a *IncidentServicesApi) DeleteIncidentService(ctx _context.Context, servic
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -209,11 +206,12 @@ func (a *IncidentServicesApi) GetIncidentService(ctx _context.Context, serviceId
 
 	operationId := "v2.GetIncidentService"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentServicesApi.GetIncidentService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentServicesApi.GetIncidentService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -252,13 +250,10 @@ func (a *IncidentServicesApi) GetIncidentService(ctx _context.Context, serviceId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -272,6 +267,7 @@ func (a *IncidentServicesApi) GetIncidentService(ctx _context.Context, serviceId
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -338,11 +334,12 @@ func (a *IncidentServicesApi) ListIncidentServices(ctx _context.Context, o ...Li
 
 	operationId := "v2.ListIncidentServices"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentServicesApi.ListIncidentServices")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentServicesApi.ListIncidentServices")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -389,13 +386,10 @@ func (a *IncidentServicesApi) ListIncidentServices(ctx _context.Context, o ...Li
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -409,6 +403,7 @@ func (a *IncidentServicesApi) ListIncidentServices(ctx _context.Context, o ...Li
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -416,6 +411,59 @@ func (a *IncidentServicesApi) ListIncidentServices(ctx _context.Context, o ...Li
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListIncidentServicesWithPagination provides a paginated version of ListIncidentServices returning a channel with all items.
+func (a *IncidentServicesApi) ListIncidentServicesWithPagination(ctx _context.Context, o ...ListIncidentServicesOptionalParameters) (<-chan datadog.PaginationResult[IncidentServiceResponseData], func()) {
+	return a.listIncidentServicesPaginator(o...).Channel(ctx)
+}
+
+// ListIncidentServicesSeq provides a paginated version of ListIncidentServices returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *IncidentServicesApi) ListIncidentServicesSeq(ctx _context.Context, o ...ListIncidentServicesOptionalParameters) iter.Seq2[IncidentServiceResponseData, error] {
+	return a.listIncidentServicesPaginator(o...).Seq(ctx)
+}
+
+// ListIncidentServicesPrefetch provides a paginated version of ListIncidentServices returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *IncidentServicesApi) ListIncidentServicesPrefetch(ctx _context.Context, prefetch int, o ...ListIncidentServicesOptionalParameters) iter.Seq2[IncidentServiceResponseData, error] {
+	paginator := a.listIncidentServicesPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *IncidentServicesApi) listIncidentServicesPaginator(o ...ListIncidentServicesOptionalParameters) datadog.Paginator[IncidentServiceResponseData] {
+	var params ListIncidentServicesOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageOffset != nil {
+		first.Offset = int64(*params.PageOffset)
+	}
+	return datadog.Paginator[IncidentServiceResponseData]{
+		Strategy: datadog.OffsetPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[IncidentServiceResponseData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageOffset := int64(page.Offset)
+			request.PageOffset = &pageOffset
+			resp, _, err := a.ListIncidentServices(ctx, request)
+			if err != nil {
+				return datadog.Page[IncidentServiceResponseData]{}, err
+			}
+			return datadog.Page[IncidentServiceResponseData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdateIncidentService Update an existing incident service.
 // Updates an existing incident service. Only provide the attributes which should be updated as this request is a partial update.
 //
@@ -429,11 +477,12 @@ func (a *IncidentServicesApi) UpdateIncidentService(ctx _context.Context, servic
 
 	operationId := "v2.UpdateIncidentService"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentServicesApi.UpdateIncidentService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentServicesApi.UpdateIncidentService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -472,13 +521,10 @@ func (a *IncidentServicesApi) UpdateIncidentService(ctx _context.Context, servic
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -492,6 +538,7 @@ func (a *IncidentServicesApi) UpdateIncidentService(ctx _context.Context, servic
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -499,6 +546,20 @@ func (a *IncidentServicesApi) UpdateIncidentService(ctx _context.Context, servic
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// IncidentServicesApiInterface lists the operations of IncidentServicesApi, so that code depending on it can be tested with mocks.
+type IncidentServicesApiInterface interface {
+	CreateIncidentService(ctx _context.Context, body IncidentServiceCreateRequest) (IncidentServiceResponse, *_nethttp.Response, error)
+	DeleteIncidentService(ctx _context.Context, serviceId string) (*_nethttp.Response, error)
+	GetIncidentService(ctx _context.Context, serviceId string, o ...GetIncidentServiceOptionalParameters) (IncidentServiceResponse, *_nethttp.Response, error)
+	ListIncidentServices(ctx _context.Context, o ...ListIncidentServicesOptionalParameters) (IncidentServicesResponse, *_nethttp.Response, error)
+	ListIncidentServicesWithPagination(ctx _context.Context, o ...ListIncidentServicesOptionalParameters) (<-chan datadog.PaginationResult[IncidentServiceResponseData], func())
+	ListIncidentServicesSeq(ctx _context.Context, o ...ListIncidentServicesOptionalParameters) iter.Seq2[IncidentServiceResponseData, error]
+	ListIncidentServicesPrefetch(ctx _context.Context, prefetch int, o ...ListIncidentServicesOptionalParameters) iter.Seq2[IncidentServiceResponseData, error]
+	UpdateIncidentService(ctx _context.Context, serviceId string, body IncidentServiceUpdateRequest) (IncidentServiceResponse, *_nethttp.Response, error)
+}
+
+var _ IncidentServicesApiInterface = (*IncidentServicesApi)(nil)
+
 // NewIncidentServicesApi Returns NewIncidentServicesApi.
 func NewIncidentServicesApi(client *datadog.APIClient) *IncidentServicesApi {
 	return &IncidentServicesApi{
@@ -7,7 +7,7 @@ package datadogV2
 import (
 	_context "context"
 	_fmt "fmt"
-	_log "log"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -31,11 +31,12 @@ func (a *IncidentTeamsApi) CreateIncidentTeam(ctx _context.Context, body Inciden
 
 	operationId := "v2.CreateIncidentTeam"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentTeamsApi.CreateIncidentTeam")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentTeamsApi.CreateIncidentTeam")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -73,13 +74,10 @@ func (a *IncidentTeamsApi) CreateIncidentTeam(ctx _context.Context, body Inciden
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -93,6 +91,7 @@ func (a *IncidentTeamsApi) CreateIncidentTeam(ctx _context.Context, body Inciden
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -112,11 +111,12 @@ func (a *IncidentTeamsApi) DeleteIncidentTeam(ctx _context.Context, teamId strin
 
 	operationId := "v2.DeleteIncidentTeam"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentTeamsApi.DeleteIncidentTeam")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentTeamsApi.DeleteIncidentTeam")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -152,13 +152,10 @@ func (a *IncidentTeamsApi) DeleteIncidentTeam(ctx _context.Context, teamId strin
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -209,11 +206,12 @@ func (a *IncidentTeamsApi) GetIncidentTeam(ctx _context.Context, teamId string,
 
 	operationId := "v2.GetIncidentTeam"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentTeamsApi.GetIncidentTeam")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentTeamsApi.GetIncidentTeam")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -252,13 +250,10 @@ func (a *IncidentTeamsApi) GetIncidentTeam(ctx _context.Context, teamId string,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -272,6 +267,7 @@ func (a *IncidentTeamsApi) GetIncidentTeam(ctx _context.Context, teamId string,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -338,11 +334,12 @@ func (a *IncidentTeamsApi) ListIncidentTeams(ctx _context.Context, o ...ListInci
 
 	operationId := "v2.ListIncidentTeams"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentTeamsApi.ListIncidentTeams")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentTeamsApi.ListIncidentTeams")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -389,13 +386,10 @@ func (a *IncidentTeamsApi) ListIncidentTeams(ctx _context.Context, o ...ListInci
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -409,6 +403,7 @@ func (a *IncidentTeamsApi) ListIncidentTeams(ctx _context.Context, o ...ListInci
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -416,6 +411,59 @@ func (a *IncidentTeamsApi) ListIncidentTeams(ctx _context.Context, o ...ListInci
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListIncidentTeamsWithPagination provides a paginated version of ListIncidentTeams returning a channel with all items.
+func (a *IncidentTeamsApi) ListIncidentTeamsWithPagination(ctx _context.Context, o ...ListIncidentTeamsOptionalParameters) (<-chan datadog.PaginationResult[IncidentTeamResponseData], func()) {
+	return a.listIncidentTeamsPaginator(o...).Channel(ctx)
+}
+
+// ListIncidentTeamsSeq provides a paginated version of ListIncidentTeams returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *IncidentTeamsApi) ListIncidentTeamsSeq(ctx _context.Context, o ...ListIncidentTeamsOptionalParameters) iter.Seq2[IncidentTeamResponseData, error] {
+	return a.listIncidentTeamsPaginator(o...).Seq(ctx)
+}
+
+// ListIncidentTeamsPrefetch provides a paginated version of ListIncidentTeams returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *IncidentTeamsApi) ListIncidentTeamsPrefetch(ctx _context.Context, prefetch int, o ...ListIncidentTeamsOptionalParameters) iter.Seq2[IncidentTeamResponseData, error] {
+	paginator := a.listIncidentTeamsPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *IncidentTeamsApi) listIncidentTeamsPaginator(o ...ListIncidentTeamsOptionalParameters) datadog.Paginator[IncidentTeamResponseData] {
+	var params ListIncidentTeamsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageOffset != nil {
+		first.Offset = int64(*params.PageOffset)
+	}
+	return datadog.Paginator[IncidentTeamResponseData]{
+		Strategy: datadog.OffsetPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[IncidentTeamResponseData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageOffset := int64(page.Offset)
+			request.PageOffset = &pageOffset
+			resp, _, err := a.ListIncidentTeams(ctx, request)
+			if err != nil {
+				return datadog.Page[IncidentTeamResponseData]{}, err
+			}
+			return datadog.Page[IncidentTeamResponseData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdateIncidentTeam Update an existing incident team.
 // Updates an existing incident team. Only provide the attributes which should be updated as this request is a partial update.
 //
@@ -429,11 +477,12 @@ func (a *IncidentTeamsApi) UpdateIncidentTeam(ctx _context.Context, teamId strin
 
 	operationId := "v2.UpdateIncidentTeam"
 	if a.Client.Cfg.IsUnstableOperationEnabled(operationId) {
-		_log.Printf("WARNING: Using unstable operation '%s'", operationId)
+		a.Client.Cfg.Log(ctx, datadog.LevelWarn, _fmt.Sprintf("Using unstable operation '%s'", operationId))
 	} else {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: _fmt.Sprintf("Unstable operation '%s' is disabled", operationId)}
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.IncidentTeamsApi.UpdateIncidentTeam")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IncidentTeamsApi.UpdateIncidentTeam")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -472,13 +521,10 @@ func (a *IncidentTeamsApi) UpdateIncidentTeam(ctx _context.Context, teamId strin
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -492,6 +538,7 @@ func (a *IncidentTeamsApi) UpdateIncidentTeam(ctx _context.Context, teamId strin
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -499,6 +546,20 @@ func (a *IncidentTeamsApi) UpdateIncidentTeam(ctx _context.Context, teamId strin
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// IncidentTeamsApiInterface lists the operations of IncidentTeamsApi, so that code depending on it can be tested with mocks.
+type IncidentTeamsApiInterface interface {
+	CreateIncidentTeam(ctx _context.Context, body IncidentTeamCreateRequest) (IncidentTeamResponse, *_nethttp.Response, error)
+	DeleteIncidentTeam(ctx _context.Context, teamId string) (*_nethttp.Response, error)
+	GetIncidentTeam(ctx _context.Context, teamId string, o ...GetIncidentTeamOptionalParameters) (IncidentTeamResponse, *_nethttp.Response, error)
+	ListIncidentTeams(ctx _context.Context, o ...ListIncidentTeamsOptionalParameters) (IncidentTeamsResponse, *_nethttp.Response, error)
+	ListIncidentTeamsWithPagination(ctx _context.Context, o ...ListIncidentTeamsOptionalParameters) (<-chan datadog.PaginationResult[IncidentTeamResponseData], func())
+	ListIncidentTeamsSeq(ctx _context.Context, o ...ListIncidentTeamsOptionalParameters) iter.Seq2[IncidentTeamResponseData, error]
+	ListIncidentTeamsPrefetch(ctx _context.Context, prefetch int, o ...ListIncidentTeamsOptionalParameters) iter.Seq2[IncidentTeamResponseData, error]
+	UpdateIncidentTeam(ctx _context.Context, teamId string, body IncidentTeamUpdateRequest) (IncidentTeamResponse, *_nethttp.Response, error)
+}
+
+var _ IncidentTeamsApiInterface = (*IncidentTeamsApi)(nil)
+
 // NewIncidentTeamsApi Returns NewIncidentTeamsApi.
 func NewIncidentTeamsApi(client *datadog.APIClient) *IncidentTeamsApi {
 	return &IncidentTeamsApi{
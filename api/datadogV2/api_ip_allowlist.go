@@ -24,6 +24,7 @@ func (a *IPAllowlistApi) GetIPAllowlist(ctx _context.Context) (IPAllowlistRespon
 		localVarReturnValue IPAllowlistResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.IPAllowlistApi.GetIPAllowlist")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IPAllowlistApi.GetIPAllowlist")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -58,13 +59,10 @@ func (a *IPAllowlistApi) GetIPAllowlist(ctx _context.Context) (IPAllowlistRespon
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -78,6 +76,7 @@ func (a *IPAllowlistApi) GetIPAllowlist(ctx _context.Context) (IPAllowlistRespon
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -94,6 +93,7 @@ func (a *IPAllowlistApi) UpdateIPAllowlist(ctx _context.Context, body IPAllowlis
 		localVarReturnValue IPAllowlistResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.IPAllowlistApi.UpdateIPAllowlist")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.IPAllowlistApi.UpdateIPAllowlist")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -131,13 +131,10 @@ func (a *IPAllowlistApi) UpdateIPAllowlist(ctx _context.Context, body IPAllowlis
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -151,6 +148,7 @@ func (a *IPAllowlistApi) UpdateIPAllowlist(ctx _context.Context, body IPAllowlis
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -158,6 +156,14 @@ func (a *IPAllowlistApi) UpdateIPAllowlist(ctx _context.Context, body IPAllowlis
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// IPAllowlistApiInterface lists the operations of IPAllowlistApi, so that code depending on it can be tested with mocks.
+type IPAllowlistApiInterface interface {
+	GetIPAllowlist(ctx _context.Context) (IPAllowlistResponse, *_nethttp.Response, error)
+	UpdateIPAllowlist(ctx _context.Context, body IPAllowlistUpdateRequest) (IPAllowlistResponse, *_nethttp.Response, error)
+}
+
+var _ IPAllowlistApiInterface = (*IPAllowlistApi)(nil)
+
 // NewIPAllowlistApi Returns NewIPAllowlistApi.
 func NewIPAllowlistApi(client *datadog.APIClient) *IPAllowlistApi {
 	return &IPAllowlistApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *KeyManagementApi) CreateAPIKey(ctx _context.Context, body APIKeyCreateR
 		localVarReturnValue APIKeyResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.CreateAPIKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.CreateAPIKey")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *KeyManagementApi) CreateAPIKey(ctx _context.Context, body APIKeyCreateR
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *KeyManagementApi) CreateAPIKey(ctx _context.Context, body APIKeyCreateR
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -98,6 +98,7 @@ func (a *KeyManagementApi) CreateCurrentUserApplicationKey(ctx _context.Context,
 		localVarReturnValue ApplicationKeyResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.CreateCurrentUserApplicationKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.CreateCurrentUserApplicationKey")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -135,13 +136,10 @@ func (a *KeyManagementApi) CreateCurrentUserApplicationKey(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -155,6 +153,7 @@ func (a *KeyManagementApi) CreateCurrentUserApplicationKey(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -170,6 +169,7 @@ func (a *KeyManagementApi) DeleteAPIKey(ctx _context.Context, apiKeyId string) (
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.DeleteAPIKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.DeleteAPIKey")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -205,13 +205,10 @@ func (a *KeyManagementApi) DeleteAPIKey(ctx _context.Context, apiKeyId string) (
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -231,6 +228,7 @@ func (a *KeyManagementApi) DeleteApplicationKey(ctx _context.Context, appKeyId s
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.DeleteApplicationKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.DeleteApplicationKey")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -266,13 +264,10 @@ func (a *KeyManagementApi) DeleteApplicationKey(ctx _context.Context, appKeyId s
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -292,6 +287,7 @@ func (a *KeyManagementApi) DeleteCurrentUserApplicationKey(ctx _context.Context,
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.DeleteCurrentUserApplicationKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.DeleteCurrentUserApplicationKey")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -327,13 +323,10 @@ func (a *KeyManagementApi) DeleteCurrentUserApplicationKey(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -379,6 +372,7 @@ func (a *KeyManagementApi) GetAPIKey(ctx _context.Context, apiKeyId string, o ..
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.GetAPIKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.GetAPIKey")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -417,13 +411,10 @@ func (a *KeyManagementApi) GetAPIKey(ctx _context.Context, apiKeyId string, o ..
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -437,6 +428,7 @@ func (a *KeyManagementApi) GetAPIKey(ctx _context.Context, apiKeyId string, o ..
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -478,6 +470,7 @@ func (a *KeyManagementApi) GetApplicationKey(ctx _context.Context, appKeyId stri
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.GetApplicationKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.GetApplicationKey")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -516,13 +509,10 @@ func (a *KeyManagementApi) GetApplicationKey(ctx _context.Context, appKeyId stri
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -536,6 +526,7 @@ func (a *KeyManagementApi) GetApplicationKey(ctx _context.Context, appKeyId stri
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -552,6 +543,7 @@ func (a *KeyManagementApi) GetCurrentUserApplicationKey(ctx _context.Context, ap
 		localVarReturnValue ApplicationKeyResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.GetCurrentUserApplicationKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.GetCurrentUserApplicationKey")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -587,13 +579,10 @@ func (a *KeyManagementApi) GetCurrentUserApplicationKey(ctx _context.Context, ap
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -607,6 +596,7 @@ func (a *KeyManagementApi) GetCurrentUserApplicationKey(ctx _context.Context, ap
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -718,6 +708,7 @@ func (a *KeyManagementApi) ListAPIKeys(ctx _context.Context, o ...ListAPIKeysOpt
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.ListAPIKeys")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.ListAPIKeys")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -785,13 +776,10 @@ func (a *KeyManagementApi) ListAPIKeys(ctx _context.Context, o ...ListAPIKeysOpt
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -805,6 +793,7 @@ func (a *KeyManagementApi) ListAPIKeys(ctx _context.Context, o ...ListAPIKeysOpt
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -812,6 +801,59 @@ func (a *KeyManagementApi) ListAPIKeys(ctx _context.Context, o ...ListAPIKeysOpt
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListAPIKeysWithPagination provides a paginated version of ListAPIKeys returning a channel with all items.
+func (a *KeyManagementApi) ListAPIKeysWithPagination(ctx _context.Context, o ...ListAPIKeysOptionalParameters) (<-chan datadog.PaginationResult[PartialAPIKey], func()) {
+	return a.listAPIKeysPaginator(o...).Channel(ctx)
+}
+
+// ListAPIKeysSeq provides a paginated version of ListAPIKeys returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *KeyManagementApi) ListAPIKeysSeq(ctx _context.Context, o ...ListAPIKeysOptionalParameters) iter.Seq2[PartialAPIKey, error] {
+	return a.listAPIKeysPaginator(o...).Seq(ctx)
+}
+
+// ListAPIKeysPrefetch provides a paginated version of ListAPIKeys returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *KeyManagementApi) ListAPIKeysPrefetch(ctx _context.Context, prefetch int, o ...ListAPIKeysOptionalParameters) iter.Seq2[PartialAPIKey, error] {
+	paginator := a.listAPIKeysPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *KeyManagementApi) listAPIKeysPaginator(o ...ListAPIKeysOptionalParameters) datadog.Paginator[PartialAPIKey] {
+	var params ListAPIKeysOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[PartialAPIKey]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[PartialAPIKey], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListAPIKeys(ctx, request)
+			if err != nil {
+				return datadog.Page[PartialAPIKey]{}, err
+			}
+			return datadog.Page[PartialAPIKey]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // ListApplicationKeysOptionalParameters holds optional parameters for ListApplicationKeys.
 type ListApplicationKeysOptionalParameters struct {
 	PageSize             *int64
@@ -888,6 +930,7 @@ func (a *KeyManagementApi) ListApplicationKeys(ctx _context.Context, o ...ListAp
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.ListApplicationKeys")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.ListApplicationKeys")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -943,13 +986,10 @@ func (a *KeyManagementApi) ListApplicationKeys(ctx _context.Context, o ...ListAp
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -963,6 +1003,7 @@ func (a *KeyManagementApi) ListApplicationKeys(ctx _context.Context, o ...ListAp
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -970,6 +1011,59 @@ func (a *KeyManagementApi) ListApplicationKeys(ctx _context.Context, o ...ListAp
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListApplicationKeysWithPagination provides a paginated version of ListApplicationKeys returning a channel with all items.
+func (a *KeyManagementApi) ListApplicationKeysWithPagination(ctx _context.Context, o ...ListApplicationKeysOptionalParameters) (<-chan datadog.PaginationResult[PartialApplicationKey], func()) {
+	return a.listApplicationKeysPaginator(o...).Channel(ctx)
+}
+
+// ListApplicationKeysSeq provides a paginated version of ListApplicationKeys returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *KeyManagementApi) ListApplicationKeysSeq(ctx _context.Context, o ...ListApplicationKeysOptionalParameters) iter.Seq2[PartialApplicationKey, error] {
+	return a.listApplicationKeysPaginator(o...).Seq(ctx)
+}
+
+// ListApplicationKeysPrefetch provides a paginated version of ListApplicationKeys returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *KeyManagementApi) ListApplicationKeysPrefetch(ctx _context.Context, prefetch int, o ...ListApplicationKeysOptionalParameters) iter.Seq2[PartialApplicationKey, error] {
+	paginator := a.listApplicationKeysPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *KeyManagementApi) listApplicationKeysPaginator(o ...ListApplicationKeysOptionalParameters) datadog.Paginator[PartialApplicationKey] {
+	var params ListApplicationKeysOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[PartialApplicationKey]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[PartialApplicationKey], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListApplicationKeys(ctx, request)
+			if err != nil {
+				return datadog.Page[PartialApplicationKey]{}, err
+			}
+			return datadog.Page[PartialApplicationKey]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // ListCurrentUserApplicationKeysOptionalParameters holds optional parameters for ListCurrentUserApplicationKeys.
 type ListCurrentUserApplicationKeysOptionalParameters struct {
 	PageSize             *int64
@@ -1046,6 +1140,7 @@ func (a *KeyManagementApi) ListCurrentUserApplicationKeys(ctx _context.Context,
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.ListCurrentUserApplicationKeys")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.ListCurrentUserApplicationKeys")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1101,13 +1196,10 @@ func (a *KeyManagementApi) ListCurrentUserApplicationKeys(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1121,6 +1213,7 @@ func (a *KeyManagementApi) ListCurrentUserApplicationKeys(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1128,6 +1221,59 @@ func (a *KeyManagementApi) ListCurrentUserApplicationKeys(ctx _context.Context,
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListCurrentUserApplicationKeysWithPagination provides a paginated version of ListCurrentUserApplicationKeys returning a channel with all items.
+func (a *KeyManagementApi) ListCurrentUserApplicationKeysWithPagination(ctx _context.Context, o ...ListCurrentUserApplicationKeysOptionalParameters) (<-chan datadog.PaginationResult[PartialApplicationKey], func()) {
+	return a.listCurrentUserApplicationKeysPaginator(o...).Channel(ctx)
+}
+
+// ListCurrentUserApplicationKeysSeq provides a paginated version of ListCurrentUserApplicationKeys returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *KeyManagementApi) ListCurrentUserApplicationKeysSeq(ctx _context.Context, o ...ListCurrentUserApplicationKeysOptionalParameters) iter.Seq2[PartialApplicationKey, error] {
+	return a.listCurrentUserApplicationKeysPaginator(o...).Seq(ctx)
+}
+
+// ListCurrentUserApplicationKeysPrefetch provides a paginated version of ListCurrentUserApplicationKeys returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *KeyManagementApi) ListCurrentUserApplicationKeysPrefetch(ctx _context.Context, prefetch int, o ...ListCurrentUserApplicationKeysOptionalParameters) iter.Seq2[PartialApplicationKey, error] {
+	paginator := a.listCurrentUserApplicationKeysPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *KeyManagementApi) listCurrentUserApplicationKeysPaginator(o ...ListCurrentUserApplicationKeysOptionalParameters) datadog.Paginator[PartialApplicationKey] {
+	var params ListCurrentUserApplicationKeysOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[PartialApplicationKey]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[PartialApplicationKey], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListCurrentUserApplicationKeys(ctx, request)
+			if err != nil {
+				return datadog.Page[PartialApplicationKey]{}, err
+			}
+			return datadog.Page[PartialApplicationKey]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdateAPIKey Edit an API key.
 // Update an API key.
 func (a *KeyManagementApi) UpdateAPIKey(ctx _context.Context, apiKeyId string, body APIKeyUpdateRequest) (APIKeyResponse, *_nethttp.Response, error) {
@@ -1137,6 +1283,7 @@ func (a *KeyManagementApi) UpdateAPIKey(ctx _context.Context, apiKeyId string, b
 		localVarReturnValue APIKeyResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.UpdateAPIKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.UpdateAPIKey")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1175,13 +1322,10 @@ func (a *KeyManagementApi) UpdateAPIKey(ctx _context.Context, apiKeyId string, b
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1195,6 +1339,7 @@ func (a *KeyManagementApi) UpdateAPIKey(ctx _context.Context, apiKeyId string, b
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1211,6 +1356,7 @@ func (a *KeyManagementApi) UpdateApplicationKey(ctx _context.Context, appKeyId s
 		localVarReturnValue ApplicationKeyResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.UpdateApplicationKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.UpdateApplicationKey")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1249,13 +1395,10 @@ func (a *KeyManagementApi) UpdateApplicationKey(ctx _context.Context, appKeyId s
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1269,6 +1412,7 @@ func (a *KeyManagementApi) UpdateApplicationKey(ctx _context.Context, appKeyId s
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1285,6 +1429,7 @@ func (a *KeyManagementApi) UpdateCurrentUserApplicationKey(ctx _context.Context,
 		localVarReturnValue ApplicationKeyResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.KeyManagementApi.UpdateCurrentUserApplicationKey")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.KeyManagementApi.UpdateCurrentUserApplicationKey")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1323,13 +1468,10 @@ func (a *KeyManagementApi) UpdateCurrentUserApplicationKey(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1343,6 +1485,7 @@ func (a *KeyManagementApi) UpdateCurrentUserApplicationKey(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1350,6 +1493,35 @@ func (a *KeyManagementApi) UpdateCurrentUserApplicationKey(ctx _context.Context,
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// KeyManagementApiInterface lists the operations of KeyManagementApi, so that code depending on it can be tested with mocks.
+type KeyManagementApiInterface interface {
+	CreateAPIKey(ctx _context.Context, body APIKeyCreateRequest) (APIKeyResponse, *_nethttp.Response, error)
+	CreateCurrentUserApplicationKey(ctx _context.Context, body ApplicationKeyCreateRequest) (ApplicationKeyResponse, *_nethttp.Response, error)
+	DeleteAPIKey(ctx _context.Context, apiKeyId string) (*_nethttp.Response, error)
+	DeleteApplicationKey(ctx _context.Context, appKeyId string) (*_nethttp.Response, error)
+	DeleteCurrentUserApplicationKey(ctx _context.Context, appKeyId string) (*_nethttp.Response, error)
+	GetAPIKey(ctx _context.Context, apiKeyId string, o ...GetAPIKeyOptionalParameters) (APIKeyResponse, *_nethttp.Response, error)
+	GetApplicationKey(ctx _context.Context, appKeyId string, o ...GetApplicationKeyOptionalParameters) (ApplicationKeyResponse, *_nethttp.Response, error)
+	GetCurrentUserApplicationKey(ctx _context.Context, appKeyId string) (ApplicationKeyResponse, *_nethttp.Response, error)
+	ListAPIKeys(ctx _context.Context, o ...ListAPIKeysOptionalParameters) (APIKeysResponse, *_nethttp.Response, error)
+	ListAPIKeysWithPagination(ctx _context.Context, o ...ListAPIKeysOptionalParameters) (<-chan datadog.PaginationResult[PartialAPIKey], func())
+	ListAPIKeysSeq(ctx _context.Context, o ...ListAPIKeysOptionalParameters) iter.Seq2[PartialAPIKey, error]
+	ListAPIKeysPrefetch(ctx _context.Context, prefetch int, o ...ListAPIKeysOptionalParameters) iter.Seq2[PartialAPIKey, error]
+	ListApplicationKeys(ctx _context.Context, o ...ListApplicationKeysOptionalParameters) (ListApplicationKeysResponse, *_nethttp.Response, error)
+	ListApplicationKeysWithPagination(ctx _context.Context, o ...ListApplicationKeysOptionalParameters) (<-chan datadog.PaginationResult[PartialApplicationKey], func())
+	ListApplicationKeysSeq(ctx _context.Context, o ...ListApplicationKeysOptionalParameters) iter.Seq2[PartialApplicationKey, error]
+	ListApplicationKeysPrefetch(ctx _context.Context, prefetch int, o ...ListApplicationKeysOptionalParameters) iter.Seq2[PartialApplicationKey, error]
+	ListCurrentUserApplicationKeys(ctx _context.Context, o ...ListCurrentUserApplicationKeysOptionalParameters) (ListApplicationKeysResponse, *_nethttp.Response, error)
+	ListCurrentUserApplicationKeysWithPagination(ctx _context.Context, o ...ListCurrentUserApplicationKeysOptionalParameters) (<-chan datadog.PaginationResult[PartialApplicationKey], func())
+	ListCurrentUserApplicationKeysSeq(ctx _context.Context, o ...ListCurrentUserApplicationKeysOptionalParameters) iter.Seq2[PartialApplicationKey, error]
+	ListCurrentUserApplicationKeysPrefetch(ctx _context.Context, prefetch int, o ...ListCurrentUserApplicationKeysOptionalParameters) iter.Seq2[PartialApplicationKey, error]
+	UpdateAPIKey(ctx _context.Context, apiKeyId string, body APIKeyUpdateRequest) (APIKeyResponse, *_nethttp.Response, error)
+	UpdateApplicationKey(ctx _context.Context, appKeyId string, body ApplicationKeyUpdateRequest) (ApplicationKeyResponse, *_nethttp.Response, error)
+	UpdateCurrentUserApplicationKey(ctx _context.Context, appKeyId string, body ApplicationKeyUpdateRequest) (ApplicationKeyResponse, *_nethttp.Response, error)
+}
+
+var _ KeyManagementApiInterface = (*KeyManagementApi)(nil)
+
 // NewKeyManagementApi Returns NewKeyManagementApi.
 func NewKeyManagementApi(client *datadog.APIClient) *KeyManagementApi {
 	return &KeyManagementApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"time"
@@ -25,6 +26,7 @@ func (a *LogsApi) AggregateLogs(ctx _context.Context, body LogsAggregateRequest)
 		localVarReturnValue LogsAggregateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsApi.AggregateLogs")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsApi.AggregateLogs")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *LogsApi) AggregateLogs(ctx _context.Context, body LogsAggregateRequest)
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *LogsApi) AggregateLogs(ctx _context.Context, body LogsAggregateRequest)
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -133,6 +133,7 @@ func (a *LogsApi) ListLogs(ctx _context.Context, o ...ListLogsOptionalParameters
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsApi.ListLogs")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsApi.ListLogs")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -172,13 +173,10 @@ func (a *LogsApi) ListLogs(ctx _context.Context, o ...ListLogsOptionalParameters
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -192,6 +190,7 @@ func (a *LogsApi) ListLogs(ctx _context.Context, o ...ListLogsOptionalParameters
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -263,6 +262,71 @@ func (a *LogsApi) ListLogsWithPagination(ctx _context.Context, o ...ListLogsOpti
 	return items, cancel
 }
 
+// ListLogsSeq provides a paginated version of ListLogs returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *LogsApi) ListLogsSeq(ctx _context.Context, o ...ListLogsOptionalParameters) iter.Seq2[Log, error] {
+	return a.listLogsPaginator(o...).Seq(ctx)
+}
+
+func (a *LogsApi) listLogsPaginator(o ...ListLogsOptionalParameters) datadog.Paginator[Log] {
+	var params ListLogsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Limit != nil {
+		first.Size = int64(*params.Body.Page.Limit)
+	}
+	if params.Body != nil && params.Body.Page != nil && params.Body.Page.Cursor != nil {
+		first.Cursor = *params.Body.Page.Cursor
+	}
+	return datadog.Paginator[Log]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[Log], error) {
+			request := params
+			// The nested parameters are copied, not to modify the ones of the caller.
+			if request.Body == nil {
+				request.Body = NewLogsListRequest()
+			} else {
+				logsListRequest := *request.Body
+				request.Body = &logsListRequest
+			}
+			if request.Body.Page == nil {
+				request.Body.Page = NewLogsListRequestPage()
+			} else {
+				logsListRequestPage := *request.Body.Page
+				request.Body.Page = &logsListRequestPage
+			}
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.Body.Page.Limit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.Body.Page.Cursor = &cursor
+			}
+			resp, _, err := a.ListLogs(ctx, request)
+			if err != nil {
+				return datadog.Page[Log]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[Log]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// ListLogsStream provides a streaming version of ListLogs, passing each item to fn as it is decoded
+// instead of buffering the whole page. The items are not included in the returned response.
+func (a *LogsApi) ListLogsStream(ctx _context.Context, fn func(Log) error, o ...ListLogsOptionalParameters) (LogsListResponse, *_nethttp.Response, error) {
+	ctx = datadog.WithItemStream(ctx, "data", fn)
+	return a.ListLogs(ctx, o...)
+}
+
 // ListLogsGetOptionalParameters holds optional parameters for ListLogsGet.
 type ListLogsGetOptionalParameters struct {
 	FilterQuery       *string
@@ -356,6 +420,7 @@ func (a *LogsApi) ListLogsGet(ctx _context.Context, o ...ListLogsGetOptionalPara
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsApi.ListLogsGet")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsApi.ListLogsGet")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -414,13 +479,10 @@ func (a *LogsApi) ListLogsGet(ctx _context.Context, o ...ListLogsGetOptionalPara
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -434,6 +496,7 @@ func (a *LogsApi) ListLogsGet(ctx _context.Context, o ...ListLogsGetOptionalPara
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -499,6 +562,58 @@ func (a *LogsApi) ListLogsGetWithPagination(ctx _context.Context, o ...ListLogsG
 	return items, cancel
 }
 
+// ListLogsGetSeq provides a paginated version of ListLogsGet returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *LogsApi) ListLogsGetSeq(ctx _context.Context, o ...ListLogsGetOptionalParameters) iter.Seq2[Log, error] {
+	return a.listLogsGetPaginator(o...).Seq(ctx)
+}
+
+func (a *LogsApi) listLogsGetPaginator(o ...ListLogsGetOptionalParameters) datadog.Paginator[Log] {
+	var params ListLogsGetOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[Log]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[Log], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageLimit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListLogsGet(ctx, request)
+			if err != nil {
+				return datadog.Page[Log]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[Log]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// ListLogsGetStream provides a streaming version of ListLogsGet, passing each item to fn as it is decoded
+// instead of buffering the whole page. The items are not included in the returned response.
+func (a *LogsApi) ListLogsGetStream(ctx _context.Context, fn func(Log) error, o ...ListLogsGetOptionalParameters) (LogsListResponse, *_nethttp.Response, error) {
+	ctx = datadog.WithItemStream(ctx, "data", fn)
+	return a.ListLogsGet(ctx, o...)
+}
+
 // SubmitLogOptionalParameters holds optional parameters for SubmitLog.
 type SubmitLogOptionalParameters struct {
 	ContentEncoding *ContentEncoding
@@ -563,6 +678,7 @@ func (a *LogsApi) SubmitLog(ctx _context.Context, body []HTTPLogItem, o ...Submi
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsApi.SubmitLog")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsApi.SubmitLog")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -606,13 +722,10 @@ func (a *LogsApi) SubmitLog(ctx _context.Context, body []HTTPLogItem, o ...Submi
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 401 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 408 || localVarHTTPResponse.StatusCode == 413 || localVarHTTPResponse.StatusCode == 429 || localVarHTTPResponse.StatusCode == 500 || localVarHTTPResponse.StatusCode == 503 {
 			var v HTTPLogErrors
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -626,6 +739,7 @@ func (a *LogsApi) SubmitLog(ctx _context.Context, body []HTTPLogItem, o ...Submi
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -633,6 +747,22 @@ func (a *LogsApi) SubmitLog(ctx _context.Context, body []HTTPLogItem, o ...Submi
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// LogsApiInterface lists the operations of LogsApi, so that code depending on it can be tested with mocks.
+type LogsApiInterface interface {
+	AggregateLogs(ctx _context.Context, body LogsAggregateRequest) (LogsAggregateResponse, *_nethttp.Response, error)
+	ListLogs(ctx _context.Context, o ...ListLogsOptionalParameters) (LogsListResponse, *_nethttp.Response, error)
+	ListLogsWithPagination(ctx _context.Context, o ...ListLogsOptionalParameters) (<-chan datadog.PaginationResult[Log], func())
+	ListLogsSeq(ctx _context.Context, o ...ListLogsOptionalParameters) iter.Seq2[Log, error]
+	ListLogsStream(ctx _context.Context, fn func(Log) error, o ...ListLogsOptionalParameters) (LogsListResponse, *_nethttp.Response, error)
+	ListLogsGet(ctx _context.Context, o ...ListLogsGetOptionalParameters) (LogsListResponse, *_nethttp.Response, error)
+	ListLogsGetWithPagination(ctx _context.Context, o ...ListLogsGetOptionalParameters) (<-chan datadog.PaginationResult[Log], func())
+	ListLogsGetSeq(ctx _context.Context, o ...ListLogsGetOptionalParameters) iter.Seq2[Log, error]
+	ListLogsGetStream(ctx _context.Context, fn func(Log) error, o ...ListLogsGetOptionalParameters) (LogsListResponse, *_nethttp.Response, error)
+	SubmitLog(ctx _context.Context, body []HTTPLogItem, o ...SubmitLogOptionalParameters) (interface{}, *_nethttp.Response, error)
+}
+
+var _ LogsApiInterface = (*LogsApi)(nil)
+
 // NewLogsApi Returns NewLogsApi.
 func NewLogsApi(client *datadog.APIClient) *LogsApi {
 	return &LogsApi{
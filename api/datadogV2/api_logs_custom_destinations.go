@@ -25,6 +25,7 @@ func (a *LogsCustomDestinationsApi) CreateLogsCustomDestination(ctx _context.Con
 		localVarReturnValue CustomDestinationResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsCustomDestinationsApi.CreateLogsCustomDestination")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsCustomDestinationsApi.CreateLogsCustomDestination")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *LogsCustomDestinationsApi) CreateLogsCustomDestination(ctx _context.Con
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *LogsCustomDestinationsApi) CreateLogsCustomDestination(ctx _context.Con
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +96,7 @@ func (a *LogsCustomDestinationsApi) DeleteLogsCustomDestination(ctx _context.Con
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsCustomDestinationsApi.DeleteLogsCustomDestination")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsCustomDestinationsApi.DeleteLogsCustomDestination")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +132,10 @@ func (a *LogsCustomDestinationsApi) DeleteLogsCustomDestination(ctx _context.Con
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +156,7 @@ func (a *LogsCustomDestinationsApi) GetLogsCustomDestination(ctx _context.Contex
 		localVarReturnValue CustomDestinationResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsCustomDestinationsApi.GetLogsCustomDestination")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsCustomDestinationsApi.GetLogsCustomDestination")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +192,10 @@ func (a *LogsCustomDestinationsApi) GetLogsCustomDestination(ctx _context.Contex
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +209,7 @@ func (a *LogsCustomDestinationsApi) GetLogsCustomDestination(ctx _context.Contex
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -230,6 +226,7 @@ func (a *LogsCustomDestinationsApi) ListLogsCustomDestinations(ctx _context.Cont
 		localVarReturnValue CustomDestinationsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsCustomDestinationsApi.ListLogsCustomDestinations")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsCustomDestinationsApi.ListLogsCustomDestinations")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -264,13 +261,10 @@ func (a *LogsCustomDestinationsApi) ListLogsCustomDestinations(ctx _context.Cont
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -284,6 +278,7 @@ func (a *LogsCustomDestinationsApi) ListLogsCustomDestinations(ctx _context.Cont
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -300,6 +295,7 @@ func (a *LogsCustomDestinationsApi) UpdateLogsCustomDestination(ctx _context.Con
 		localVarReturnValue CustomDestinationResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsCustomDestinationsApi.UpdateLogsCustomDestination")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsCustomDestinationsApi.UpdateLogsCustomDestination")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -338,13 +334,10 @@ func (a *LogsCustomDestinationsApi) UpdateLogsCustomDestination(ctx _context.Con
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -358,6 +351,7 @@ func (a *LogsCustomDestinationsApi) UpdateLogsCustomDestination(ctx _context.Con
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -365,6 +359,17 @@ func (a *LogsCustomDestinationsApi) UpdateLogsCustomDestination(ctx _context.Con
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// LogsCustomDestinationsApiInterface lists the operations of LogsCustomDestinationsApi, so that code depending on it can be tested with mocks.
+type LogsCustomDestinationsApiInterface interface {
+	CreateLogsCustomDestination(ctx _context.Context, body CustomDestinationCreateRequest) (CustomDestinationResponse, *_nethttp.Response, error)
+	DeleteLogsCustomDestination(ctx _context.Context, customDestinationId string) (*_nethttp.Response, error)
+	GetLogsCustomDestination(ctx _context.Context, customDestinationId string) (CustomDestinationResponse, *_nethttp.Response, error)
+	ListLogsCustomDestinations(ctx _context.Context) (CustomDestinationsResponse, *_nethttp.Response, error)
+	UpdateLogsCustomDestination(ctx _context.Context, customDestinationId string, body CustomDestinationUpdateRequest) (CustomDestinationResponse, *_nethttp.Response, error)
+}
+
+var _ LogsCustomDestinationsApiInterface = (*LogsCustomDestinationsApi)(nil)
+
 // NewLogsCustomDestinationsApi Returns NewLogsCustomDestinationsApi.
 func NewLogsCustomDestinationsApi(client *datadog.APIClient) *LogsCustomDestinationsApi {
 	return &LogsCustomDestinationsApi{
@@ -26,6 +26,7 @@ func (a *LogsMetricsApi) CreateLogsMetric(ctx _context.Context, body LogsMetricC
 		localVarReturnValue LogsMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsMetricsApi.CreateLogsMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsMetricsApi.CreateLogsMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -63,13 +64,10 @@ func (a *LogsMetricsApi) CreateLogsMetric(ctx _context.Context, body LogsMetricC
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -83,6 +81,7 @@ func (a *LogsMetricsApi) CreateLogsMetric(ctx _context.Context, body LogsMetricC
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -98,6 +97,7 @@ func (a *LogsMetricsApi) DeleteLogsMetric(ctx _context.Context, metricId string)
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsMetricsApi.DeleteLogsMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsMetricsApi.DeleteLogsMetric")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -133,13 +133,10 @@ func (a *LogsMetricsApi) DeleteLogsMetric(ctx _context.Context, metricId string)
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -160,6 +157,7 @@ func (a *LogsMetricsApi) GetLogsMetric(ctx _context.Context, metricId string) (L
 		localVarReturnValue LogsMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsMetricsApi.GetLogsMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsMetricsApi.GetLogsMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -195,13 +193,10 @@ func (a *LogsMetricsApi) GetLogsMetric(ctx _context.Context, metricId string) (L
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -215,6 +210,7 @@ func (a *LogsMetricsApi) GetLogsMetric(ctx _context.Context, metricId string) (L
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -231,6 +227,7 @@ func (a *LogsMetricsApi) ListLogsMetrics(ctx _context.Context) (LogsMetricsRespo
 		localVarReturnValue LogsMetricsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsMetricsApi.ListLogsMetrics")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsMetricsApi.ListLogsMetrics")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -265,13 +262,10 @@ func (a *LogsMetricsApi) ListLogsMetrics(ctx _context.Context) (LogsMetricsRespo
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -285,6 +279,7 @@ func (a *LogsMetricsApi) ListLogsMetrics(ctx _context.Context) (LogsMetricsRespo
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -302,6 +297,7 @@ func (a *LogsMetricsApi) UpdateLogsMetric(ctx _context.Context, metricId string,
 		localVarReturnValue LogsMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.LogsMetricsApi.UpdateLogsMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.LogsMetricsApi.UpdateLogsMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -340,13 +336,10 @@ func (a *LogsMetricsApi) UpdateLogsMetric(ctx _context.Context, metricId string,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -360,6 +353,7 @@ func (a *LogsMetricsApi) UpdateLogsMetric(ctx _context.Context, metricId string,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -367,6 +361,17 @@ func (a *LogsMetricsApi) UpdateLogsMetric(ctx _context.Context, metricId string,
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// LogsMetricsApiInterface lists the operations of LogsMetricsApi, so that code depending on it can be tested with mocks.
+type LogsMetricsApiInterface interface {
+	CreateLogsMetric(ctx _context.Context, body LogsMetricCreateRequest) (LogsMetricResponse, *_nethttp.Response, error)
+	DeleteLogsMetric(ctx _context.Context, metricId string) (*_nethttp.Response, error)
+	GetLogsMetric(ctx _context.Context, metricId string) (LogsMetricResponse, *_nethttp.Response, error)
+	ListLogsMetrics(ctx _context.Context) (LogsMetricsResponse, *_nethttp.Response, error)
+	UpdateLogsMetric(ctx _context.Context, metricId string, body LogsMetricUpdateRequest) (LogsMetricResponse, *_nethttp.Response, error)
+}
+
+var _ LogsMetricsApiInterface = (*LogsMetricsApi)(nil)
+
 // NewLogsMetricsApi Returns NewLogsMetricsApi.
 func NewLogsMetricsApi(client *datadog.APIClient) *LogsMetricsApi {
 	return &LogsMetricsApi{
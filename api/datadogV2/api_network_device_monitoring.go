@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *NetworkDeviceMonitoringApi) GetDevice(ctx _context.Context, deviceId st
 		localVarReturnValue GetDeviceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.NetworkDeviceMonitoringApi.GetDevice")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.NetworkDeviceMonitoringApi.GetDevice")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -60,13 +62,10 @@ func (a *NetworkDeviceMonitoringApi) GetDevice(ctx _context.Context, deviceId st
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -80,6 +79,7 @@ func (a *NetworkDeviceMonitoringApi) GetDevice(ctx _context.Context, deviceId st
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -96,6 +96,7 @@ func (a *NetworkDeviceMonitoringApi) GetInterfaces(ctx _context.Context, deviceI
 		localVarReturnValue GetInterfacesResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.NetworkDeviceMonitoringApi.GetInterfaces")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.NetworkDeviceMonitoringApi.GetInterfaces")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -131,13 +132,10 @@ func (a *NetworkDeviceMonitoringApi) GetInterfaces(ctx _context.Context, deviceI
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -151,6 +149,7 @@ func (a *NetworkDeviceMonitoringApi) GetInterfaces(ctx _context.Context, deviceI
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -167,6 +166,7 @@ func (a *NetworkDeviceMonitoringApi) ListDeviceUserTags(ctx _context.Context, de
 		localVarReturnValue ListTagsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.NetworkDeviceMonitoringApi.ListDeviceUserTags")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.NetworkDeviceMonitoringApi.ListDeviceUserTags")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -202,13 +202,10 @@ func (a *NetworkDeviceMonitoringApi) ListDeviceUserTags(ctx _context.Context, de
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -222,6 +219,7 @@ func (a *NetworkDeviceMonitoringApi) ListDeviceUserTags(ctx _context.Context, de
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -284,6 +282,7 @@ func (a *NetworkDeviceMonitoringApi) ListDevices(ctx _context.Context, o ...List
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.NetworkDeviceMonitoringApi.ListDevices")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.NetworkDeviceMonitoringApi.ListDevices")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -330,13 +329,10 @@ func (a *NetworkDeviceMonitoringApi) ListDevices(ctx _context.Context, o ...List
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -350,6 +346,7 @@ func (a *NetworkDeviceMonitoringApi) ListDevices(ctx _context.Context, o ...List
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -357,6 +354,59 @@ func (a *NetworkDeviceMonitoringApi) ListDevices(ctx _context.Context, o ...List
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListDevicesWithPagination provides a paginated version of ListDevices returning a channel with all items.
+func (a *NetworkDeviceMonitoringApi) ListDevicesWithPagination(ctx _context.Context, o ...ListDevicesOptionalParameters) (<-chan datadog.PaginationResult[DevicesListData], func()) {
+	return a.listDevicesPaginator(o...).Channel(ctx)
+}
+
+// ListDevicesSeq provides a paginated version of ListDevices returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *NetworkDeviceMonitoringApi) ListDevicesSeq(ctx _context.Context, o ...ListDevicesOptionalParameters) iter.Seq2[DevicesListData, error] {
+	return a.listDevicesPaginator(o...).Seq(ctx)
+}
+
+// ListDevicesPrefetch provides a paginated version of ListDevices returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *NetworkDeviceMonitoringApi) ListDevicesPrefetch(ctx _context.Context, prefetch int, o ...ListDevicesOptionalParameters) iter.Seq2[DevicesListData, error] {
+	paginator := a.listDevicesPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *NetworkDeviceMonitoringApi) listDevicesPaginator(o ...ListDevicesOptionalParameters) datadog.Paginator[DevicesListData] {
+	var params ListDevicesOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[DevicesListData]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[DevicesListData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListDevices(ctx, request)
+			if err != nil {
+				return datadog.Page[DevicesListData]{}, err
+			}
+			return datadog.Page[DevicesListData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdateDeviceUserTags Update the tags for a device.
 // Update the tags for a device.
 func (a *NetworkDeviceMonitoringApi) UpdateDeviceUserTags(ctx _context.Context, deviceId string, body ListTagsResponse) (ListTagsResponse, *_nethttp.Response, error) {
@@ -366,6 +416,7 @@ func (a *NetworkDeviceMonitoringApi) UpdateDeviceUserTags(ctx _context.Context,
 		localVarReturnValue ListTagsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.NetworkDeviceMonitoringApi.UpdateDeviceUserTags")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.NetworkDeviceMonitoringApi.UpdateDeviceUserTags")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -404,13 +455,10 @@ func (a *NetworkDeviceMonitoringApi) UpdateDeviceUserTags(ctx _context.Context,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -424,6 +472,7 @@ func (a *NetworkDeviceMonitoringApi) UpdateDeviceUserTags(ctx _context.Context,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -431,6 +480,20 @@ func (a *NetworkDeviceMonitoringApi) UpdateDeviceUserTags(ctx _context.Context,
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// NetworkDeviceMonitoringApiInterface lists the operations of NetworkDeviceMonitoringApi, so that code depending on it can be tested with mocks.
+type NetworkDeviceMonitoringApiInterface interface {
+	GetDevice(ctx _context.Context, deviceId string) (GetDeviceResponse, *_nethttp.Response, error)
+	GetInterfaces(ctx _context.Context, deviceId string) (GetInterfacesResponse, *_nethttp.Response, error)
+	ListDeviceUserTags(ctx _context.Context, deviceId string) (ListTagsResponse, *_nethttp.Response, error)
+	ListDevices(ctx _context.Context, o ...ListDevicesOptionalParameters) (ListDevicesResponse, *_nethttp.Response, error)
+	ListDevicesWithPagination(ctx _context.Context, o ...ListDevicesOptionalParameters) (<-chan datadog.PaginationResult[DevicesListData], func())
+	ListDevicesSeq(ctx _context.Context, o ...ListDevicesOptionalParameters) iter.Seq2[DevicesListData, error]
+	ListDevicesPrefetch(ctx _context.Context, prefetch int, o ...ListDevicesOptionalParameters) iter.Seq2[DevicesListData, error]
+	UpdateDeviceUserTags(ctx _context.Context, deviceId string, body ListTagsResponse) (ListTagsResponse, *_nethttp.Response, error)
+}
+
+var _ NetworkDeviceMonitoringApiInterface = (*NetworkDeviceMonitoringApi)(nil)
+
 // NewNetworkDeviceMonitoringApi Returns NewNetworkDeviceMonitoringApi.
 func NewNetworkDeviceMonitoringApi(client *datadog.APIClient) *NetworkDeviceMonitoringApi {
 	return &NetworkDeviceMonitoringApi{
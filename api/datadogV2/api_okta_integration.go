@@ -25,6 +25,7 @@ func (a *OktaIntegrationApi) CreateOktaAccount(ctx _context.Context, body OktaAc
 		localVarReturnValue OktaAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OktaIntegrationApi.CreateOktaAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OktaIntegrationApi.CreateOktaAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *OktaIntegrationApi) CreateOktaAccount(ctx _context.Context, body OktaAc
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *OktaIntegrationApi) CreateOktaAccount(ctx _context.Context, body OktaAc
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +96,7 @@ func (a *OktaIntegrationApi) DeleteOktaAccount(ctx _context.Context, accountId s
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OktaIntegrationApi.DeleteOktaAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OktaIntegrationApi.DeleteOktaAccount")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +132,10 @@ func (a *OktaIntegrationApi) DeleteOktaAccount(ctx _context.Context, accountId s
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +156,7 @@ func (a *OktaIntegrationApi) GetOktaAccount(ctx _context.Context, accountId stri
 		localVarReturnValue OktaAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OktaIntegrationApi.GetOktaAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OktaIntegrationApi.GetOktaAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +192,10 @@ func (a *OktaIntegrationApi) GetOktaAccount(ctx _context.Context, accountId stri
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +209,7 @@ func (a *OktaIntegrationApi) GetOktaAccount(ctx _context.Context, accountId stri
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -230,6 +226,7 @@ func (a *OktaIntegrationApi) ListOktaAccounts(ctx _context.Context) (OktaAccount
 		localVarReturnValue OktaAccountsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OktaIntegrationApi.ListOktaAccounts")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OktaIntegrationApi.ListOktaAccounts")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -264,13 +261,10 @@ func (a *OktaIntegrationApi) ListOktaAccounts(ctx _context.Context) (OktaAccount
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -284,6 +278,7 @@ func (a *OktaIntegrationApi) ListOktaAccounts(ctx _context.Context) (OktaAccount
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -300,6 +295,7 @@ func (a *OktaIntegrationApi) UpdateOktaAccount(ctx _context.Context, accountId s
 		localVarReturnValue OktaAccountResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OktaIntegrationApi.UpdateOktaAccount")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OktaIntegrationApi.UpdateOktaAccount")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -338,13 +334,10 @@ func (a *OktaIntegrationApi) UpdateOktaAccount(ctx _context.Context, accountId s
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -358,6 +351,7 @@ func (a *OktaIntegrationApi) UpdateOktaAccount(ctx _context.Context, accountId s
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -365,6 +359,17 @@ func (a *OktaIntegrationApi) UpdateOktaAccount(ctx _context.Context, accountId s
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// OktaIntegrationApiInterface lists the operations of OktaIntegrationApi, so that code depending on it can be tested with mocks.
+type OktaIntegrationApiInterface interface {
+	CreateOktaAccount(ctx _context.Context, body OktaAccountRequest) (OktaAccountResponse, *_nethttp.Response, error)
+	DeleteOktaAccount(ctx _context.Context, accountId string) (*_nethttp.Response, error)
+	GetOktaAccount(ctx _context.Context, accountId string) (OktaAccountResponse, *_nethttp.Response, error)
+	ListOktaAccounts(ctx _context.Context) (OktaAccountsResponse, *_nethttp.Response, error)
+	UpdateOktaAccount(ctx _context.Context, accountId string, body OktaAccountUpdateRequest) (OktaAccountResponse, *_nethttp.Response, error)
+}
+
+var _ OktaIntegrationApiInterface = (*OktaIntegrationApi)(nil)
+
 // NewOktaIntegrationApi Returns NewOktaIntegrationApi.
 func NewOktaIntegrationApi(client *datadog.APIClient) *OktaIntegrationApi {
 	return &OktaIntegrationApi{
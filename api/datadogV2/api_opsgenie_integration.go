@@ -25,6 +25,7 @@ func (a *OpsgenieIntegrationApi) CreateOpsgenieService(ctx _context.Context, bod
 		localVarReturnValue OpsgenieServiceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OpsgenieIntegrationApi.CreateOpsgenieService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OpsgenieIntegrationApi.CreateOpsgenieService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +63,10 @@ func (a *OpsgenieIntegrationApi) CreateOpsgenieService(ctx _context.Context, bod
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +80,7 @@ func (a *OpsgenieIntegrationApi) CreateOpsgenieService(ctx _context.Context, bod
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +96,7 @@ func (a *OpsgenieIntegrationApi) DeleteOpsgenieService(ctx _context.Context, int
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OpsgenieIntegrationApi.DeleteOpsgenieService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OpsgenieIntegrationApi.DeleteOpsgenieService")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +132,10 @@ func (a *OpsgenieIntegrationApi) DeleteOpsgenieService(ctx _context.Context, int
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +156,7 @@ func (a *OpsgenieIntegrationApi) GetOpsgenieService(ctx _context.Context, integr
 		localVarReturnValue OpsgenieServiceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OpsgenieIntegrationApi.GetOpsgenieService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OpsgenieIntegrationApi.GetOpsgenieService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +192,10 @@ func (a *OpsgenieIntegrationApi) GetOpsgenieService(ctx _context.Context, integr
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +209,7 @@ func (a *OpsgenieIntegrationApi) GetOpsgenieService(ctx _context.Context, integr
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -230,6 +226,7 @@ func (a *OpsgenieIntegrationApi) ListOpsgenieServices(ctx _context.Context) (Ops
 		localVarReturnValue OpsgenieServicesResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OpsgenieIntegrationApi.ListOpsgenieServices")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OpsgenieIntegrationApi.ListOpsgenieServices")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -264,13 +261,10 @@ func (a *OpsgenieIntegrationApi) ListOpsgenieServices(ctx _context.Context) (Ops
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -284,6 +278,7 @@ func (a *OpsgenieIntegrationApi) ListOpsgenieServices(ctx _context.Context) (Ops
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -300,6 +295,7 @@ func (a *OpsgenieIntegrationApi) UpdateOpsgenieService(ctx _context.Context, int
 		localVarReturnValue OpsgenieServiceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.OpsgenieIntegrationApi.UpdateOpsgenieService")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.OpsgenieIntegrationApi.UpdateOpsgenieService")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -338,13 +334,10 @@ func (a *OpsgenieIntegrationApi) UpdateOpsgenieService(ctx _context.Context, int
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -358,6 +351,7 @@ func (a *OpsgenieIntegrationApi) UpdateOpsgenieService(ctx _context.Context, int
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -365,6 +359,17 @@ func (a *OpsgenieIntegrationApi) UpdateOpsgenieService(ctx _context.Context, int
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// OpsgenieIntegrationApiInterface lists the operations of OpsgenieIntegrationApi, so that code depending on it can be tested with mocks.
+type OpsgenieIntegrationApiInterface interface {
+	CreateOpsgenieService(ctx _context.Context, body OpsgenieServiceCreateRequest) (OpsgenieServiceResponse, *_nethttp.Response, error)
+	DeleteOpsgenieService(ctx _context.Context, integrationServiceId string) (*_nethttp.Response, error)
+	GetOpsgenieService(ctx _context.Context, integrationServiceId string) (OpsgenieServiceResponse, *_nethttp.Response, error)
+	ListOpsgenieServices(ctx _context.Context) (OpsgenieServicesResponse, *_nethttp.Response, error)
+	UpdateOpsgenieService(ctx _context.Context, integrationServiceId string, body OpsgenieServiceUpdateRequest) (OpsgenieServiceResponse, *_nethttp.Response, error)
+}
+
+var _ OpsgenieIntegrationApiInterface = (*OpsgenieIntegrationApi)(nil)
+
 // NewOpsgenieIntegrationApi Returns NewOpsgenieIntegrationApi.
 func NewOpsgenieIntegrationApi(client *datadog.APIClient) *OpsgenieIntegrationApi {
 	return &OpsgenieIntegrationApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *PowerpackApi) CreatePowerpack(ctx _context.Context, body Powerpack) (Po
 		localVarReturnValue PowerpackResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.PowerpackApi.CreatePowerpack")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.PowerpackApi.CreatePowerpack")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *PowerpackApi) CreatePowerpack(ctx _context.Context, body Powerpack) (Po
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *PowerpackApi) CreatePowerpack(ctx _context.Context, body Powerpack) (Po
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +97,7 @@ func (a *PowerpackApi) DeletePowerpack(ctx _context.Context, powerpackId string)
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.PowerpackApi.DeletePowerpack")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.PowerpackApi.DeletePowerpack")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +133,10 @@ func (a *PowerpackApi) DeletePowerpack(ctx _context.Context, powerpackId string)
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -159,6 +157,7 @@ func (a *PowerpackApi) GetPowerpack(ctx _context.Context, powerpackId string) (P
 		localVarReturnValue PowerpackResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.PowerpackApi.GetPowerpack")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.PowerpackApi.GetPowerpack")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -194,13 +193,10 @@ func (a *PowerpackApi) GetPowerpack(ctx _context.Context, powerpackId string) (P
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -214,6 +210,7 @@ func (a *PowerpackApi) GetPowerpack(ctx _context.Context, powerpackId string) (P
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -262,6 +259,7 @@ func (a *PowerpackApi) ListPowerpacks(ctx _context.Context, o ...ListPowerpacksO
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.PowerpackApi.ListPowerpacks")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.PowerpackApi.ListPowerpacks")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -302,13 +300,10 @@ func (a *PowerpackApi) ListPowerpacks(ctx _context.Context, o ...ListPowerpacksO
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -322,6 +317,7 @@ func (a *PowerpackApi) ListPowerpacks(ctx _context.Context, o ...ListPowerpacksO
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -379,6 +375,54 @@ func (a *PowerpackApi) ListPowerpacksWithPagination(ctx _context.Context, o ...L
 	return items, cancel
 }
 
+// ListPowerpacksSeq provides a paginated version of ListPowerpacks returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *PowerpackApi) ListPowerpacksSeq(ctx _context.Context, o ...ListPowerpacksOptionalParameters) iter.Seq2[PowerpackData, error] {
+	return a.listPowerpacksPaginator(o...).Seq(ctx)
+}
+
+// ListPowerpacksPrefetch provides a paginated version of ListPowerpacks returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *PowerpackApi) ListPowerpacksPrefetch(ctx _context.Context, prefetch int, o ...ListPowerpacksOptionalParameters) iter.Seq2[PowerpackData, error] {
+	paginator := a.listPowerpacksPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *PowerpackApi) listPowerpacksPaginator(o ...ListPowerpacksOptionalParameters) datadog.Paginator[PowerpackData] {
+	var params ListPowerpacksOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 25}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageOffset != nil {
+		first.Offset = int64(*params.PageOffset)
+	}
+	return datadog.Paginator[PowerpackData]{
+		Strategy: datadog.OffsetPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[PowerpackData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageLimit = &pageSize
+			}
+			pageOffset := int64(page.Offset)
+			request.PageOffset = &pageOffset
+			resp, _, err := a.ListPowerpacks(ctx, request)
+			if err != nil {
+				return datadog.Page[PowerpackData]{}, err
+			}
+			return datadog.Page[PowerpackData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpdatePowerpack Update a powerpack.
 // Update a powerpack.
 func (a *PowerpackApi) UpdatePowerpack(ctx _context.Context, powerpackId string, body Powerpack) (PowerpackResponse, *_nethttp.Response, error) {
@@ -388,6 +432,7 @@ func (a *PowerpackApi) UpdatePowerpack(ctx _context.Context, powerpackId string,
 		localVarReturnValue PowerpackResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.PowerpackApi.UpdatePowerpack")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.PowerpackApi.UpdatePowerpack")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -426,13 +471,10 @@ func (a *PowerpackApi) UpdatePowerpack(ctx _context.Context, powerpackId string,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -446,6 +488,7 @@ func (a *PowerpackApi) UpdatePowerpack(ctx _context.Context, powerpackId string,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -453,6 +496,20 @@ func (a *PowerpackApi) UpdatePowerpack(ctx _context.Context, powerpackId string,
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// PowerpackApiInterface lists the operations of PowerpackApi, so that code depending on it can be tested with mocks.
+type PowerpackApiInterface interface {
+	CreatePowerpack(ctx _context.Context, body Powerpack) (PowerpackResponse, *_nethttp.Response, error)
+	DeletePowerpack(ctx _context.Context, powerpackId string) (*_nethttp.Response, error)
+	GetPowerpack(ctx _context.Context, powerpackId string) (PowerpackResponse, *_nethttp.Response, error)
+	ListPowerpacks(ctx _context.Context, o ...ListPowerpacksOptionalParameters) (ListPowerpacksResponse, *_nethttp.Response, error)
+	ListPowerpacksWithPagination(ctx _context.Context, o ...ListPowerpacksOptionalParameters) (<-chan datadog.PaginationResult[PowerpackData], func())
+	ListPowerpacksSeq(ctx _context.Context, o ...ListPowerpacksOptionalParameters) iter.Seq2[PowerpackData, error]
+	ListPowerpacksPrefetch(ctx _context.Context, prefetch int, o ...ListPowerpacksOptionalParameters) iter.Seq2[PowerpackData, error]
+	UpdatePowerpack(ctx _context.Context, powerpackId string, body Powerpack) (PowerpackResponse, *_nethttp.Response, error)
+}
+
+var _ PowerpackApiInterface = (*PowerpackApi)(nil)
+
 // NewPowerpackApi Returns NewPowerpackApi.
 func NewPowerpackApi(client *datadog.APIClient) *PowerpackApi {
 	return &PowerpackApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 
@@ -84,6 +85,7 @@ func (a *ProcessesApi) ListProcesses(ctx _context.Context, o ...ListProcessesOpt
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.ProcessesApi.ListProcesses")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ProcessesApi.ListProcesses")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -136,13 +138,10 @@ func (a *ProcessesApi) ListProcesses(ctx _context.Context, o ...ListProcessesOpt
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -156,6 +155,7 @@ func (a *ProcessesApi) ListProcesses(ctx _context.Context, o ...ListProcessesOpt
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -221,6 +221,60 @@ func (a *ProcessesApi) ListProcessesWithPagination(ctx _context.Context, o ...Li
 	return items, cancel
 }
 
+// ListProcessesSeq provides a paginated version of ListProcesses returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *ProcessesApi) ListProcessesSeq(ctx _context.Context, o ...ListProcessesOptionalParameters) iter.Seq2[ProcessSummary, error] {
+	return a.listProcessesPaginator(o...).Seq(ctx)
+}
+
+func (a *ProcessesApi) listProcessesPaginator(o ...ListProcessesOptionalParameters) datadog.Paginator[ProcessSummary] {
+	var params ListProcessesOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 1000}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[ProcessSummary]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[ProcessSummary], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageLimit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListProcesses(ctx, request)
+			if err != nil {
+				return datadog.Page[ProcessSummary]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[ProcessSummary]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// ProcessesApiInterface lists the operations of ProcessesApi, so that code depending on it can be tested with mocks.
+type ProcessesApiInterface interface {
+	ListProcesses(ctx _context.Context, o ...ListProcessesOptionalParameters) (ProcessSummariesResponse, *_nethttp.Response, error)
+	ListProcessesWithPagination(ctx _context.Context, o ...ListProcessesOptionalParameters) (<-chan datadog.PaginationResult[ProcessSummary], func())
+	ListProcessesSeq(ctx _context.Context, o ...ListProcessesOptionalParameters) iter.Seq2[ProcessSummary, error]
+}
+
+var _ ProcessesApiInterface = (*ProcessesApi)(nil)
+
 // NewProcessesApi Returns NewProcessesApi.
 func NewProcessesApi(client *datadog.APIClient) *ProcessesApi {
 	return &ProcessesApi{
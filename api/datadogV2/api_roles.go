@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *RolesApi) AddPermissionToRole(ctx _context.Context, roleId string, body
 		localVarReturnValue PermissionsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.AddPermissionToRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.AddPermissionToRole")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -63,13 +65,10 @@ func (a *RolesApi) AddPermissionToRole(ctx _context.Context, roleId string, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -83,6 +82,7 @@ func (a *RolesApi) AddPermissionToRole(ctx _context.Context, roleId string, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -99,6 +99,7 @@ func (a *RolesApi) AddUserToRole(ctx _context.Context, roleId string, body Relat
 		localVarReturnValue UsersResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.AddUserToRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.AddUserToRole")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -137,13 +138,10 @@ func (a *RolesApi) AddUserToRole(ctx _context.Context, roleId string, body Relat
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -157,6 +155,7 @@ func (a *RolesApi) AddUserToRole(ctx _context.Context, roleId string, body Relat
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -173,6 +172,7 @@ func (a *RolesApi) CloneRole(ctx _context.Context, roleId string, body RoleClone
 		localVarReturnValue RoleResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.CloneRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.CloneRole")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -211,13 +211,10 @@ func (a *RolesApi) CloneRole(ctx _context.Context, roleId string, body RoleClone
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -231,6 +228,7 @@ func (a *RolesApi) CloneRole(ctx _context.Context, roleId string, body RoleClone
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -247,6 +245,7 @@ func (a *RolesApi) CreateRole(ctx _context.Context, body RoleCreateRequest) (Rol
 		localVarReturnValue RoleCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.CreateRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.CreateRole")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -284,13 +283,10 @@ func (a *RolesApi) CreateRole(ctx _context.Context, body RoleCreateRequest) (Rol
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -304,6 +300,7 @@ func (a *RolesApi) CreateRole(ctx _context.Context, body RoleCreateRequest) (Rol
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -319,6 +316,7 @@ func (a *RolesApi) DeleteRole(ctx _context.Context, roleId string) (*_nethttp.Re
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.DeleteRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.DeleteRole")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -354,13 +352,10 @@ func (a *RolesApi) DeleteRole(ctx _context.Context, roleId string) (*_nethttp.Re
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -381,6 +376,7 @@ func (a *RolesApi) GetRole(ctx _context.Context, roleId string) (RoleResponse, *
 		localVarReturnValue RoleResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.GetRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.GetRole")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -416,13 +412,10 @@ func (a *RolesApi) GetRole(ctx _context.Context, roleId string) (RoleResponse, *
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -436,6 +429,7 @@ func (a *RolesApi) GetRole(ctx _context.Context, roleId string) (RoleResponse, *
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -452,6 +446,7 @@ func (a *RolesApi) ListPermissions(ctx _context.Context) (PermissionsResponse, *
 		localVarReturnValue PermissionsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.ListPermissions")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.ListPermissions")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -486,13 +481,10 @@ func (a *RolesApi) ListPermissions(ctx _context.Context) (PermissionsResponse, *
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -506,6 +498,7 @@ func (a *RolesApi) ListPermissions(ctx _context.Context) (PermissionsResponse, *
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -522,6 +515,7 @@ func (a *RolesApi) ListRolePermissions(ctx _context.Context, roleId string) (Per
 		localVarReturnValue PermissionsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.ListRolePermissions")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.ListRolePermissions")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -557,13 +551,10 @@ func (a *RolesApi) ListRolePermissions(ctx _context.Context, roleId string) (Per
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -577,6 +568,7 @@ func (a *RolesApi) ListRolePermissions(ctx _context.Context, roleId string) (Per
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -639,6 +631,7 @@ func (a *RolesApi) ListRoleUsers(ctx _context.Context, roleId string, o ...ListR
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.ListRoleUsers")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.ListRoleUsers")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -686,13 +679,10 @@ func (a *RolesApi) ListRoleUsers(ctx _context.Context, roleId string, o ...ListR
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -706,6 +696,7 @@ func (a *RolesApi) ListRoleUsers(ctx _context.Context, roleId string, o ...ListR
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -713,6 +704,59 @@ func (a *RolesApi) ListRoleUsers(ctx _context.Context, roleId string, o ...ListR
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListRoleUsersWithPagination provides a paginated version of ListRoleUsers returning a channel with all items.
+func (a *RolesApi) ListRoleUsersWithPagination(ctx _context.Context, roleId string, o ...ListRoleUsersOptionalParameters) (<-chan datadog.PaginationResult[User], func()) {
+	return a.listRoleUsersPaginator(roleId, o...).Channel(ctx)
+}
+
+// ListRoleUsersSeq provides a paginated version of ListRoleUsers returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *RolesApi) ListRoleUsersSeq(ctx _context.Context, roleId string, o ...ListRoleUsersOptionalParameters) iter.Seq2[User, error] {
+	return a.listRoleUsersPaginator(roleId, o...).Seq(ctx)
+}
+
+// ListRoleUsersPrefetch provides a paginated version of ListRoleUsers returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *RolesApi) ListRoleUsersPrefetch(ctx _context.Context, roleId string, prefetch int, o ...ListRoleUsersOptionalParameters) iter.Seq2[User, error] {
+	paginator := a.listRoleUsersPaginator(roleId, o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *RolesApi) listRoleUsersPaginator(roleId string, o ...ListRoleUsersOptionalParameters) datadog.Paginator[User] {
+	var params ListRoleUsersOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[User]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[User], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListRoleUsers(ctx, roleId, request)
+			if err != nil {
+				return datadog.Page[User]{}, err
+			}
+			return datadog.Page[User]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // ListRolesOptionalParameters holds optional parameters for ListRoles.
 type ListRolesOptionalParameters struct {
 	PageSize   *int64
@@ -775,6 +819,7 @@ func (a *RolesApi) ListRoles(ctx _context.Context, o ...ListRolesOptionalParamet
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.ListRoles")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.ListRoles")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -824,13 +869,10 @@ func (a *RolesApi) ListRoles(ctx _context.Context, o ...ListRolesOptionalParamet
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -844,6 +886,7 @@ func (a *RolesApi) ListRoles(ctx _context.Context, o ...ListRolesOptionalParamet
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -851,6 +894,59 @@ func (a *RolesApi) ListRoles(ctx _context.Context, o ...ListRolesOptionalParamet
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListRolesWithPagination provides a paginated version of ListRoles returning a channel with all items.
+func (a *RolesApi) ListRolesWithPagination(ctx _context.Context, o ...ListRolesOptionalParameters) (<-chan datadog.PaginationResult[Role], func()) {
+	return a.listRolesPaginator(o...).Channel(ctx)
+}
+
+// ListRolesSeq provides a paginated version of ListRoles returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *RolesApi) ListRolesSeq(ctx _context.Context, o ...ListRolesOptionalParameters) iter.Seq2[Role, error] {
+	return a.listRolesPaginator(o...).Seq(ctx)
+}
+
+// ListRolesPrefetch provides a paginated version of ListRoles returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *RolesApi) ListRolesPrefetch(ctx _context.Context, prefetch int, o ...ListRolesOptionalParameters) iter.Seq2[Role, error] {
+	paginator := a.listRolesPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *RolesApi) listRolesPaginator(o ...ListRolesOptionalParameters) datadog.Paginator[Role] {
+	var params ListRolesOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[Role]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[Role], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListRoles(ctx, request)
+			if err != nil {
+				return datadog.Page[Role]{}, err
+			}
+			return datadog.Page[Role]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // RemovePermissionFromRole Revoke permission.
 // Removes a permission from a role.
 func (a *RolesApi) RemovePermissionFromRole(ctx _context.Context, roleId string, body RelationshipToPermission) (PermissionsResponse, *_nethttp.Response, error) {
@@ -860,6 +956,7 @@ func (a *RolesApi) RemovePermissionFromRole(ctx _context.Context, roleId string,
 		localVarReturnValue PermissionsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.RemovePermissionFromRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.RemovePermissionFromRole")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -898,13 +995,10 @@ func (a *RolesApi) RemovePermissionFromRole(ctx _context.Context, roleId string,
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -918,6 +1012,7 @@ func (a *RolesApi) RemovePermissionFromRole(ctx _context.Context, roleId string,
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -934,6 +1029,7 @@ func (a *RolesApi) RemoveUserFromRole(ctx _context.Context, roleId string, body
 		localVarReturnValue UsersResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.RemoveUserFromRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.RemoveUserFromRole")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -972,13 +1068,10 @@ func (a *RolesApi) RemoveUserFromRole(ctx _context.Context, roleId string, body
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -992,6 +1085,7 @@ func (a *RolesApi) RemoveUserFromRole(ctx _context.Context, roleId string, body
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1008,6 +1102,7 @@ func (a *RolesApi) UpdateRole(ctx _context.Context, roleId string, body RoleUpda
 		localVarReturnValue RoleUpdateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RolesApi.UpdateRole")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RolesApi.UpdateRole")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -1046,13 +1141,10 @@ func (a *RolesApi) UpdateRole(ctx _context.Context, roleId string, body RoleUpda
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 422 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -1066,6 +1158,7 @@ func (a *RolesApi) UpdateRole(ctx _context.Context, roleId string, body RoleUpda
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -1073,6 +1166,31 @@ func (a *RolesApi) UpdateRole(ctx _context.Context, roleId string, body RoleUpda
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// RolesApiInterface lists the operations of RolesApi, so that code depending on it can be tested with mocks.
+type RolesApiInterface interface {
+	AddPermissionToRole(ctx _context.Context, roleId string, body RelationshipToPermission) (PermissionsResponse, *_nethttp.Response, error)
+	AddUserToRole(ctx _context.Context, roleId string, body RelationshipToUser) (UsersResponse, *_nethttp.Response, error)
+	CloneRole(ctx _context.Context, roleId string, body RoleCloneRequest) (RoleResponse, *_nethttp.Response, error)
+	CreateRole(ctx _context.Context, body RoleCreateRequest) (RoleCreateResponse, *_nethttp.Response, error)
+	DeleteRole(ctx _context.Context, roleId string) (*_nethttp.Response, error)
+	GetRole(ctx _context.Context, roleId string) (RoleResponse, *_nethttp.Response, error)
+	ListPermissions(ctx _context.Context) (PermissionsResponse, *_nethttp.Response, error)
+	ListRolePermissions(ctx _context.Context, roleId string) (PermissionsResponse, *_nethttp.Response, error)
+	ListRoleUsers(ctx _context.Context, roleId string, o ...ListRoleUsersOptionalParameters) (UsersResponse, *_nethttp.Response, error)
+	ListRoleUsersWithPagination(ctx _context.Context, roleId string, o ...ListRoleUsersOptionalParameters) (<-chan datadog.PaginationResult[User], func())
+	ListRoleUsersSeq(ctx _context.Context, roleId string, o ...ListRoleUsersOptionalParameters) iter.Seq2[User, error]
+	ListRoleUsersPrefetch(ctx _context.Context, roleId string, prefetch int, o ...ListRoleUsersOptionalParameters) iter.Seq2[User, error]
+	ListRoles(ctx _context.Context, o ...ListRolesOptionalParameters) (RolesResponse, *_nethttp.Response, error)
+	ListRolesWithPagination(ctx _context.Context, o ...ListRolesOptionalParameters) (<-chan datadog.PaginationResult[Role], func())
+	ListRolesSeq(ctx _context.Context, o ...ListRolesOptionalParameters) iter.Seq2[Role, error]
+	ListRolesPrefetch(ctx _context.Context, prefetch int, o ...ListRolesOptionalParameters) iter.Seq2[Role, error]
+	RemovePermissionFromRole(ctx _context.Context, roleId string, body RelationshipToPermission) (PermissionsResponse, *_nethttp.Response, error)
+	RemoveUserFromRole(ctx _context.Context, roleId string, body RelationshipToUser) (UsersResponse, *_nethttp.Response, error)
+	UpdateRole(ctx _context.Context, roleId string, body RoleUpdateRequest) (RoleUpdateResponse, *_nethttp.Response, error)
+}
+
+var _ RolesApiInterface = (*RolesApi)(nil)
+
 // NewRolesApi Returns NewRolesApi.
 func NewRolesApi(client *datadog.APIClient) *RolesApi {
 	return &RolesApi{
@@ -26,6 +26,7 @@ func (a *RumMetricsApi) CreateRumMetric(ctx _context.Context, body RumMetricCrea
 		localVarReturnValue RumMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RumMetricsApi.CreateRumMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RumMetricsApi.CreateRumMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -63,13 +64,10 @@ func (a *RumMetricsApi) CreateRumMetric(ctx _context.Context, body RumMetricCrea
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -83,6 +81,7 @@ func (a *RumMetricsApi) CreateRumMetric(ctx _context.Context, body RumMetricCrea
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -98,6 +97,7 @@ func (a *RumMetricsApi) DeleteRumMetric(ctx _context.Context, metricId string) (
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RumMetricsApi.DeleteRumMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RumMetricsApi.DeleteRumMetric")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -133,13 +133,10 @@ func (a *RumMetricsApi) DeleteRumMetric(ctx _context.Context, metricId string) (
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -160,6 +157,7 @@ func (a *RumMetricsApi) GetRumMetric(ctx _context.Context, metricId string) (Rum
 		localVarReturnValue RumMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RumMetricsApi.GetRumMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RumMetricsApi.GetRumMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -195,13 +193,10 @@ func (a *RumMetricsApi) GetRumMetric(ctx _context.Context, metricId string) (Rum
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -215,6 +210,7 @@ func (a *RumMetricsApi) GetRumMetric(ctx _context.Context, metricId string) (Rum
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -231,6 +227,7 @@ func (a *RumMetricsApi) ListRumMetrics(ctx _context.Context) (RumMetricsResponse
 		localVarReturnValue RumMetricsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RumMetricsApi.ListRumMetrics")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RumMetricsApi.ListRumMetrics")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -265,13 +262,10 @@ func (a *RumMetricsApi) ListRumMetrics(ctx _context.Context) (RumMetricsResponse
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -285,6 +279,7 @@ func (a *RumMetricsApi) ListRumMetrics(ctx _context.Context) (RumMetricsResponse
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -302,6 +297,7 @@ func (a *RumMetricsApi) UpdateRumMetric(ctx _context.Context, metricId string, b
 		localVarReturnValue RumMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.RumMetricsApi.UpdateRumMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.RumMetricsApi.UpdateRumMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -340,13 +336,10 @@ func (a *RumMetricsApi) UpdateRumMetric(ctx _context.Context, metricId string, b
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -360,6 +353,7 @@ func (a *RumMetricsApi) UpdateRumMetric(ctx _context.Context, metricId string, b
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -367,6 +361,17 @@ func (a *RumMetricsApi) UpdateRumMetric(ctx _context.Context, metricId string, b
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// RumMetricsApiInterface lists the operations of RumMetricsApi, so that code depending on it can be tested with mocks.
+type RumMetricsApiInterface interface {
+	CreateRumMetric(ctx _context.Context, body RumMetricCreateRequest) (RumMetricResponse, *_nethttp.Response, error)
+	DeleteRumMetric(ctx _context.Context, metricId string) (*_nethttp.Response, error)
+	GetRumMetric(ctx _context.Context, metricId string) (RumMetricResponse, *_nethttp.Response, error)
+	ListRumMetrics(ctx _context.Context) (RumMetricsResponse, *_nethttp.Response, error)
+	UpdateRumMetric(ctx _context.Context, metricId string, body RumMetricUpdateRequest) (RumMetricResponse, *_nethttp.Response, error)
+}
+
+var _ RumMetricsApiInterface = (*RumMetricsApi)(nil)
+
 // NewRumMetricsApi Returns NewRumMetricsApi.
 func NewRumMetricsApi(client *datadog.APIClient) *RumMetricsApi {
 	return &RumMetricsApi{
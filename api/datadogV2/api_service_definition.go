@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *ServiceDefinitionApi) CreateOrUpdateServiceDefinitions(ctx _context.Con
 		localVarReturnValue ServiceDefinitionCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ServiceDefinitionApi.CreateOrUpdateServiceDefinitions")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ServiceDefinitionApi.CreateOrUpdateServiceDefinitions")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *ServiceDefinitionApi) CreateOrUpdateServiceDefinitions(ctx _context.Con
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *ServiceDefinitionApi) CreateOrUpdateServiceDefinitions(ctx _context.Con
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +97,7 @@ func (a *ServiceDefinitionApi) DeleteServiceDefinition(ctx _context.Context, ser
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.ServiceDefinitionApi.DeleteServiceDefinition")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ServiceDefinitionApi.DeleteServiceDefinition")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -132,13 +133,10 @@ func (a *ServiceDefinitionApi) DeleteServiceDefinition(ctx _context.Context, ser
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -184,6 +182,7 @@ func (a *ServiceDefinitionApi) GetServiceDefinition(ctx _context.Context, servic
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.ServiceDefinitionApi.GetServiceDefinition")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ServiceDefinitionApi.GetServiceDefinition")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -222,13 +221,10 @@ func (a *ServiceDefinitionApi) GetServiceDefinition(ctx _context.Context, servic
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -242,6 +238,7 @@ func (a *ServiceDefinitionApi) GetServiceDefinition(ctx _context.Context, servic
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -297,6 +294,7 @@ func (a *ServiceDefinitionApi) ListServiceDefinitions(ctx _context.Context, o ..
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.ServiceDefinitionApi.ListServiceDefinitions")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.ServiceDefinitionApi.ListServiceDefinitions")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -340,13 +338,10 @@ func (a *ServiceDefinitionApi) ListServiceDefinitions(ctx _context.Context, o ..
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -360,6 +355,7 @@ func (a *ServiceDefinitionApi) ListServiceDefinitions(ctx _context.Context, o ..
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -415,6 +411,67 @@ func (a *ServiceDefinitionApi) ListServiceDefinitionsWithPagination(ctx _context
 	return items, cancel
 }
 
+// ListServiceDefinitionsSeq provides a paginated version of ListServiceDefinitions returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *ServiceDefinitionApi) ListServiceDefinitionsSeq(ctx _context.Context, o ...ListServiceDefinitionsOptionalParameters) iter.Seq2[ServiceDefinitionData, error] {
+	return a.listServiceDefinitionsPaginator(o...).Seq(ctx)
+}
+
+// ListServiceDefinitionsPrefetch provides a paginated version of ListServiceDefinitions returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *ServiceDefinitionApi) ListServiceDefinitionsPrefetch(ctx _context.Context, prefetch int, o ...ListServiceDefinitionsOptionalParameters) iter.Seq2[ServiceDefinitionData, error] {
+	paginator := a.listServiceDefinitionsPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *ServiceDefinitionApi) listServiceDefinitionsPaginator(o ...ListServiceDefinitionsOptionalParameters) datadog.Paginator[ServiceDefinitionData] {
+	var params ListServiceDefinitionsOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[ServiceDefinitionData]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[ServiceDefinitionData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListServiceDefinitions(ctx, request)
+			if err != nil {
+				return datadog.Page[ServiceDefinitionData]{}, err
+			}
+			return datadog.Page[ServiceDefinitionData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
+// ServiceDefinitionApiInterface lists the operations of ServiceDefinitionApi, so that code depending on it can be tested with mocks.
+type ServiceDefinitionApiInterface interface {
+	CreateOrUpdateServiceDefinitions(ctx _context.Context, body ServiceDefinitionsCreateRequest) (ServiceDefinitionCreateResponse, *_nethttp.Response, error)
+	DeleteServiceDefinition(ctx _context.Context, serviceName string) (*_nethttp.Response, error)
+	GetServiceDefinition(ctx _context.Context, serviceName string, o ...GetServiceDefinitionOptionalParameters) (ServiceDefinitionGetResponse, *_nethttp.Response, error)
+	ListServiceDefinitions(ctx _context.Context, o ...ListServiceDefinitionsOptionalParameters) (ServiceDefinitionsListResponse, *_nethttp.Response, error)
+	ListServiceDefinitionsWithPagination(ctx _context.Context, o ...ListServiceDefinitionsOptionalParameters) (<-chan datadog.PaginationResult[ServiceDefinitionData], func())
+	ListServiceDefinitionsSeq(ctx _context.Context, o ...ListServiceDefinitionsOptionalParameters) iter.Seq2[ServiceDefinitionData, error]
+	ListServiceDefinitionsPrefetch(ctx _context.Context, prefetch int, o ...ListServiceDefinitionsOptionalParameters) iter.Seq2[ServiceDefinitionData, error]
+}
+
+var _ ServiceDefinitionApiInterface = (*ServiceDefinitionApi)(nil)
+
 // NewServiceDefinitionApi Returns NewServiceDefinitionApi.
 func NewServiceDefinitionApi(client *datadog.APIClient) *ServiceDefinitionApi {
 	return &ServiceDefinitionApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -24,6 +25,7 @@ func (a *SoftwareCatalogApi) DeleteCatalogEntity(ctx _context.Context, entityId
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SoftwareCatalogApi.DeleteCatalogEntity")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SoftwareCatalogApi.DeleteCatalogEntity")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -59,13 +61,10 @@ func (a *SoftwareCatalogApi) DeleteCatalogEntity(ctx _context.Context, entityId
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -174,6 +173,7 @@ func (a *SoftwareCatalogApi) ListCatalogEntity(ctx _context.Context, o ...ListCa
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.SoftwareCatalogApi.ListCatalogEntity")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SoftwareCatalogApi.ListCatalogEntity")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -238,13 +238,10 @@ func (a *SoftwareCatalogApi) ListCatalogEntity(ctx _context.Context, o ...ListCa
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -258,6 +255,7 @@ func (a *SoftwareCatalogApi) ListCatalogEntity(ctx _context.Context, o ...ListCa
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -313,6 +311,54 @@ func (a *SoftwareCatalogApi) ListCatalogEntityWithPagination(ctx _context.Contex
 	return items, cancel
 }
 
+// ListCatalogEntitySeq provides a paginated version of ListCatalogEntity returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *SoftwareCatalogApi) ListCatalogEntitySeq(ctx _context.Context, o ...ListCatalogEntityOptionalParameters) iter.Seq2[EntityData, error] {
+	return a.listCatalogEntityPaginator(o...).Seq(ctx)
+}
+
+// ListCatalogEntityPrefetch provides a paginated version of ListCatalogEntity returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *SoftwareCatalogApi) ListCatalogEntityPrefetch(ctx _context.Context, prefetch int, o ...ListCatalogEntityOptionalParameters) iter.Seq2[EntityData, error] {
+	paginator := a.listCatalogEntityPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *SoftwareCatalogApi) listCatalogEntityPaginator(o ...ListCatalogEntityOptionalParameters) datadog.Paginator[EntityData] {
+	var params ListCatalogEntityOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 100}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageOffset != nil {
+		first.Number = int64(*params.PageOffset)
+	}
+	return datadog.Paginator[EntityData]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[EntityData], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageLimit = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageOffset = &pageNumber
+			resp, _, err := a.ListCatalogEntity(ctx, request)
+			if err != nil {
+				return datadog.Page[EntityData]{}, err
+			}
+			return datadog.Page[EntityData]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // UpsertCatalogEntity Create or update entities.
 // Create or update entities in Software Catalog.
 func (a *SoftwareCatalogApi) UpsertCatalogEntity(ctx _context.Context, body UpsertCatalogEntityRequest) (UpsertCatalogEntityResponse, *_nethttp.Response, error) {
@@ -322,6 +368,7 @@ func (a *SoftwareCatalogApi) UpsertCatalogEntity(ctx _context.Context, body Upse
 		localVarReturnValue UpsertCatalogEntityResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SoftwareCatalogApi.UpsertCatalogEntity")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SoftwareCatalogApi.UpsertCatalogEntity")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -359,13 +406,10 @@ func (a *SoftwareCatalogApi) UpsertCatalogEntity(ctx _context.Context, body Upse
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -379,6 +423,7 @@ func (a *SoftwareCatalogApi) UpsertCatalogEntity(ctx _context.Context, body Upse
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -386,6 +431,18 @@ func (a *SoftwareCatalogApi) UpsertCatalogEntity(ctx _context.Context, body Upse
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// SoftwareCatalogApiInterface lists the operations of SoftwareCatalogApi, so that code depending on it can be tested with mocks.
+type SoftwareCatalogApiInterface interface {
+	DeleteCatalogEntity(ctx _context.Context, entityId string) (*_nethttp.Response, error)
+	ListCatalogEntity(ctx _context.Context, o ...ListCatalogEntityOptionalParameters) (ListEntityCatalogResponse, *_nethttp.Response, error)
+	ListCatalogEntityWithPagination(ctx _context.Context, o ...ListCatalogEntityOptionalParameters) (<-chan datadog.PaginationResult[EntityData], func())
+	ListCatalogEntitySeq(ctx _context.Context, o ...ListCatalogEntityOptionalParameters) iter.Seq2[EntityData, error]
+	ListCatalogEntityPrefetch(ctx _context.Context, prefetch int, o ...ListCatalogEntityOptionalParameters) iter.Seq2[EntityData, error]
+	UpsertCatalogEntity(ctx _context.Context, body UpsertCatalogEntityRequest) (UpsertCatalogEntityResponse, *_nethttp.Response, error)
+}
+
+var _ SoftwareCatalogApiInterface = (*SoftwareCatalogApi)(nil)
+
 // NewSoftwareCatalogApi Returns NewSoftwareCatalogApi.
 func NewSoftwareCatalogApi(client *datadog.APIClient) *SoftwareCatalogApi {
 	return &SoftwareCatalogApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 
@@ -25,6 +26,7 @@ func (a *SpansApi) AggregateSpans(ctx _context.Context, body SpansAggregateReque
 		localVarReturnValue SpansAggregateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SpansApi.AggregateSpans")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SpansApi.AggregateSpans")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *SpansApi) AggregateSpans(ctx _context.Context, body SpansAggregateReque
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *SpansApi) AggregateSpans(ctx _context.Context, body SpansAggregateReque
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -104,6 +104,7 @@ func (a *SpansApi) ListSpans(ctx _context.Context, body SpansListRequest) (Spans
 		localVarReturnValue SpansListResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SpansApi.ListSpans")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SpansApi.ListSpans")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -141,13 +142,10 @@ func (a *SpansApi) ListSpans(ctx _context.Context, body SpansListRequest) (Spans
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 422 || localVarHTTPResponse.StatusCode == 429 {
 			var v JSONAPIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -161,6 +159,7 @@ func (a *SpansApi) ListSpans(ctx _context.Context, body SpansListRequest) (Spans
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -234,6 +233,73 @@ func (a *SpansApi) ListSpansWithPagination(ctx _context.Context, body SpansListR
 	return items, cancel
 }
 
+// ListSpansSeq provides a paginated version of ListSpans returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *SpansApi) ListSpansSeq(ctx _context.Context, body SpansListRequest) iter.Seq2[Span, error] {
+	return a.listSpansPaginator(body).Seq(ctx)
+}
+
+func (a *SpansApi) listSpansPaginator(body SpansListRequest) datadog.Paginator[Span] {
+	first := datadog.PageRequest{Size: 10}
+	if body.Data != nil && body.Data.Attributes != nil && body.Data.Attributes.Page != nil && body.Data.Attributes.Page.Limit != nil {
+		first.Size = int64(*body.Data.Attributes.Page.Limit)
+	}
+	if body.Data != nil && body.Data.Attributes != nil && body.Data.Attributes.Page != nil && body.Data.Attributes.Page.Cursor != nil {
+		first.Cursor = *body.Data.Attributes.Page.Cursor
+	}
+	return datadog.Paginator[Span]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[Span], error) {
+			body := body
+			// The nested parameters are copied, not to modify the ones of the caller.
+			if body.Data == nil {
+				body.Data = NewSpansListRequestData()
+			} else {
+				spansListRequestData := *body.Data
+				body.Data = &spansListRequestData
+			}
+			if body.Data.Attributes == nil {
+				body.Data.Attributes = NewSpansListRequestAttributes()
+			} else {
+				spansListRequestAttributes := *body.Data.Attributes
+				body.Data.Attributes = &spansListRequestAttributes
+			}
+			if body.Data.Attributes.Page == nil {
+				body.Data.Attributes.Page = NewSpansListRequestPage()
+			} else {
+				spansListRequestPage := *body.Data.Attributes.Page
+				body.Data.Attributes.Page = &spansListRequestPage
+			}
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				body.Data.Attributes.Page.Limit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				body.Data.Attributes.Page.Cursor = &cursor
+			}
+			resp, _, err := a.ListSpans(ctx, body)
+			if err != nil {
+				return datadog.Page[Span]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[Span]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// ListSpansStream provides a streaming version of ListSpans, passing each item to fn as it is decoded
+// instead of buffering the whole page. The items are not included in the returned response.
+func (a *SpansApi) ListSpansStream(ctx _context.Context, body SpansListRequest, fn func(Span) error) (SpansListResponse, *_nethttp.Response, error) {
+	ctx = datadog.WithItemStream(ctx, "data", fn)
+	return a.ListSpans(ctx, body)
+}
+
 // ListSpansGetOptionalParameters holds optional parameters for ListSpansGet.
 type ListSpansGetOptionalParameters struct {
 	FilterQuery *string
@@ -309,6 +375,7 @@ func (a *SpansApi) ListSpansGet(ctx _context.Context, o ...ListSpansGetOptionalP
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.SpansApi.ListSpansGet")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SpansApi.ListSpansGet")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -361,13 +428,10 @@ func (a *SpansApi) ListSpansGet(ctx _context.Context, o ...ListSpansGetOptionalP
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 422 || localVarHTTPResponse.StatusCode == 429 {
 			var v JSONAPIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -381,6 +445,7 @@ func (a *SpansApi) ListSpansGet(ctx _context.Context, o ...ListSpansGetOptionalP
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -446,6 +511,73 @@ func (a *SpansApi) ListSpansGetWithPagination(ctx _context.Context, o ...ListSpa
 	return items, cancel
 }
 
+// ListSpansGetSeq provides a paginated version of ListSpansGet returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *SpansApi) ListSpansGetSeq(ctx _context.Context, o ...ListSpansGetOptionalParameters) iter.Seq2[Span, error] {
+	return a.listSpansGetPaginator(o...).Seq(ctx)
+}
+
+func (a *SpansApi) listSpansGetPaginator(o ...ListSpansGetOptionalParameters) datadog.Paginator[Span] {
+	var params ListSpansGetOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageLimit != nil {
+		first.Size = int64(*params.PageLimit)
+	}
+	if params.PageCursor != nil {
+		first.Cursor = *params.PageCursor
+	}
+	return datadog.Paginator[Span]{
+		Strategy: datadog.CursorPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[Span], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int32(page.Size)
+				request.PageLimit = &pageSize
+			}
+			if page.Cursor != "" {
+				cursor := page.Cursor
+				request.PageCursor = &cursor
+			}
+			resp, _, err := a.ListSpansGet(ctx, request)
+			if err != nil {
+				return datadog.Page[Span]{}, err
+			}
+			cursorMeta := resp.GetMeta()
+			cursorMetaPage := cursorMeta.GetPage()
+			return datadog.Page[Span]{
+				Items:  resp.GetData(),
+				Cursor: cursorMetaPage.GetAfter(),
+			}, nil
+		},
+	}
+}
+
+// ListSpansGetStream provides a streaming version of ListSpansGet, passing each item to fn as it is decoded
+// instead of buffering the whole page. The items are not included in the returned response.
+func (a *SpansApi) ListSpansGetStream(ctx _context.Context, fn func(Span) error, o ...ListSpansGetOptionalParameters) (SpansListResponse, *_nethttp.Response, error) {
+	ctx = datadog.WithItemStream(ctx, "data", fn)
+	return a.ListSpansGet(ctx, o...)
+}
+
+// SpansApiInterface lists the operations of SpansApi, so that code depending on it can be tested with mocks.
+type SpansApiInterface interface {
+	AggregateSpans(ctx _context.Context, body SpansAggregateRequest) (SpansAggregateResponse, *_nethttp.Response, error)
+	ListSpans(ctx _context.Context, body SpansListRequest) (SpansListResponse, *_nethttp.Response, error)
+	ListSpansWithPagination(ctx _context.Context, body SpansListRequest) (<-chan datadog.PaginationResult[Span], func())
+	ListSpansSeq(ctx _context.Context, body SpansListRequest) iter.Seq2[Span, error]
+	ListSpansStream(ctx _context.Context, body SpansListRequest, fn func(Span) error) (SpansListResponse, *_nethttp.Response, error)
+	ListSpansGet(ctx _context.Context, o ...ListSpansGetOptionalParameters) (SpansListResponse, *_nethttp.Response, error)
+	ListSpansGetWithPagination(ctx _context.Context, o ...ListSpansGetOptionalParameters) (<-chan datadog.PaginationResult[Span], func())
+	ListSpansGetSeq(ctx _context.Context, o ...ListSpansGetOptionalParameters) iter.Seq2[Span, error]
+	ListSpansGetStream(ctx _context.Context, fn func(Span) error, o ...ListSpansGetOptionalParameters) (SpansListResponse, *_nethttp.Response, error)
+}
+
+var _ SpansApiInterface = (*SpansApi)(nil)
+
 // NewSpansApi Returns NewSpansApi.
 func NewSpansApi(client *datadog.APIClient) *SpansApi {
 	return &SpansApi{
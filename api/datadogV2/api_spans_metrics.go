@@ -26,6 +26,7 @@ func (a *SpansMetricsApi) CreateSpansMetric(ctx _context.Context, body SpansMetr
 		localVarReturnValue SpansMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SpansMetricsApi.CreateSpansMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SpansMetricsApi.CreateSpansMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -63,13 +64,10 @@ func (a *SpansMetricsApi) CreateSpansMetric(ctx _context.Context, body SpansMetr
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 409 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -83,6 +81,7 @@ func (a *SpansMetricsApi) CreateSpansMetric(ctx _context.Context, body SpansMetr
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -98,6 +97,7 @@ func (a *SpansMetricsApi) DeleteSpansMetric(ctx _context.Context, metricId strin
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SpansMetricsApi.DeleteSpansMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SpansMetricsApi.DeleteSpansMetric")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -133,13 +133,10 @@ func (a *SpansMetricsApi) DeleteSpansMetric(ctx _context.Context, metricId strin
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -160,6 +157,7 @@ func (a *SpansMetricsApi) GetSpansMetric(ctx _context.Context, metricId string)
 		localVarReturnValue SpansMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SpansMetricsApi.GetSpansMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SpansMetricsApi.GetSpansMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -195,13 +193,10 @@ func (a *SpansMetricsApi) GetSpansMetric(ctx _context.Context, metricId string)
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -215,6 +210,7 @@ func (a *SpansMetricsApi) GetSpansMetric(ctx _context.Context, metricId string)
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -231,6 +227,7 @@ func (a *SpansMetricsApi) ListSpansMetrics(ctx _context.Context) (SpansMetricsRe
 		localVarReturnValue SpansMetricsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SpansMetricsApi.ListSpansMetrics")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SpansMetricsApi.ListSpansMetrics")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -265,13 +262,10 @@ func (a *SpansMetricsApi) ListSpansMetrics(ctx _context.Context) (SpansMetricsRe
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -285,6 +279,7 @@ func (a *SpansMetricsApi) ListSpansMetrics(ctx _context.Context) (SpansMetricsRe
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -302,6 +297,7 @@ func (a *SpansMetricsApi) UpdateSpansMetric(ctx _context.Context, metricId strin
 		localVarReturnValue SpansMetricResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.SpansMetricsApi.UpdateSpansMetric")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.SpansMetricsApi.UpdateSpansMetric")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -340,13 +336,10 @@ func (a *SpansMetricsApi) UpdateSpansMetric(ctx _context.Context, metricId strin
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -360,6 +353,7 @@ func (a *SpansMetricsApi) UpdateSpansMetric(ctx _context.Context, metricId strin
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -367,6 +361,17 @@ func (a *SpansMetricsApi) UpdateSpansMetric(ctx _context.Context, metricId strin
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// SpansMetricsApiInterface lists the operations of SpansMetricsApi, so that code depending on it can be tested with mocks.
+type SpansMetricsApiInterface interface {
+	CreateSpansMetric(ctx _context.Context, body SpansMetricCreateRequest) (SpansMetricResponse, *_nethttp.Response, error)
+	DeleteSpansMetric(ctx _context.Context, metricId string) (*_nethttp.Response, error)
+	GetSpansMetric(ctx _context.Context, metricId string) (SpansMetricResponse, *_nethttp.Response, error)
+	ListSpansMetrics(ctx _context.Context) (SpansMetricsResponse, *_nethttp.Response, error)
+	UpdateSpansMetric(ctx _context.Context, metricId string, body SpansMetricUpdateRequest) (SpansMetricResponse, *_nethttp.Response, error)
+}
+
+var _ SpansMetricsApiInterface = (*SpansMetricsApi)(nil)
+
 // NewSpansMetricsApi Returns NewSpansMetricsApi.
 func NewSpansMetricsApi(client *datadog.APIClient) *SpansMetricsApi {
 	return &SpansMetricsApi{
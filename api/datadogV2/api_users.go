@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *UsersApi) CreateUser(ctx _context.Context, body UserCreateRequest) (Use
 		localVarReturnValue UserResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.CreateUser")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.CreateUser")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -62,13 +64,10 @@ func (a *UsersApi) CreateUser(ctx _context.Context, body UserCreateRequest) (Use
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -82,6 +81,7 @@ func (a *UsersApi) CreateUser(ctx _context.Context, body UserCreateRequest) (Use
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -98,6 +98,7 @@ func (a *UsersApi) DisableUser(ctx _context.Context, userId string) (*_nethttp.R
 		localVarPostBody   interface{}
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.DisableUser")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.DisableUser")
 	if err != nil {
 		return nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -133,13 +134,10 @@ func (a *UsersApi) DisableUser(ctx _context.Context, userId string) (*_nethttp.R
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarHTTPResponse, newErr
 			}
@@ -160,6 +158,7 @@ func (a *UsersApi) GetInvitation(ctx _context.Context, userInvitationUuid string
 		localVarReturnValue UserInvitationResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.GetInvitation")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.GetInvitation")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -195,13 +194,10 @@ func (a *UsersApi) GetInvitation(ctx _context.Context, userInvitationUuid string
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -215,6 +211,7 @@ func (a *UsersApi) GetInvitation(ctx _context.Context, userInvitationUuid string
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -231,6 +228,7 @@ func (a *UsersApi) GetUser(ctx _context.Context, userId string) (UserResponse, *
 		localVarReturnValue UserResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.GetUser")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.GetUser")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -266,13 +264,10 @@ func (a *UsersApi) GetUser(ctx _context.Context, userId string) (UserResponse, *
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -286,6 +281,7 @@ func (a *UsersApi) GetUser(ctx _context.Context, userId string) (UserResponse, *
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -303,6 +299,7 @@ func (a *UsersApi) ListUserOrganizations(ctx _context.Context, userId string) (U
 		localVarReturnValue UserResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.ListUserOrganizations")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.ListUserOrganizations")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -338,13 +335,10 @@ func (a *UsersApi) ListUserOrganizations(ctx _context.Context, userId string) (U
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -358,6 +352,7 @@ func (a *UsersApi) ListUserOrganizations(ctx _context.Context, userId string) (U
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -375,6 +370,7 @@ func (a *UsersApi) ListUserPermissions(ctx _context.Context, userId string) (Per
 		localVarReturnValue PermissionsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.ListUserPermissions")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.ListUserPermissions")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -410,13 +406,10 @@ func (a *UsersApi) ListUserPermissions(ctx _context.Context, userId string) (Per
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -430,6 +423,7 @@ func (a *UsersApi) ListUserPermissions(ctx _context.Context, userId string) (Per
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -507,6 +501,7 @@ func (a *UsersApi) ListUsers(ctx _context.Context, o ...ListUsersOptionalParamet
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.ListUsers")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.ListUsers")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -559,13 +554,10 @@ func (a *UsersApi) ListUsers(ctx _context.Context, o ...ListUsersOptionalParamet
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -579,6 +571,7 @@ func (a *UsersApi) ListUsers(ctx _context.Context, o ...ListUsersOptionalParamet
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -634,6 +627,54 @@ func (a *UsersApi) ListUsersWithPagination(ctx _context.Context, o ...ListUsersO
 	return items, cancel
 }
 
+// ListUsersSeq provides a paginated version of ListUsers returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *UsersApi) ListUsersSeq(ctx _context.Context, o ...ListUsersOptionalParameters) iter.Seq2[User, error] {
+	return a.listUsersPaginator(o...).Seq(ctx)
+}
+
+// ListUsersPrefetch provides a paginated version of ListUsers returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *UsersApi) ListUsersPrefetch(ctx _context.Context, prefetch int, o ...ListUsersOptionalParameters) iter.Seq2[User, error] {
+	paginator := a.listUsersPaginator(o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *UsersApi) listUsersPaginator(o ...ListUsersOptionalParameters) datadog.Paginator[User] {
+	var params ListUsersOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[User]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[User], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListUsers(ctx, request)
+			if err != nil {
+				return datadog.Page[User]{}, err
+			}
+			return datadog.Page[User]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
 // SendInvitations Send invitation emails.
 // Sends emails to one or more users inviting them to join the organization.
 func (a *UsersApi) SendInvitations(ctx _context.Context, body UserInvitationsRequest) (UserInvitationsResponse, *_nethttp.Response, error) {
@@ -643,6 +684,7 @@ func (a *UsersApi) SendInvitations(ctx _context.Context, body UserInvitationsReq
 		localVarReturnValue UserInvitationsResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.SendInvitations")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.SendInvitations")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -680,13 +722,10 @@ func (a *UsersApi) SendInvitations(ctx _context.Context, body UserInvitationsReq
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -700,6 +739,7 @@ func (a *UsersApi) SendInvitations(ctx _context.Context, body UserInvitationsReq
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -717,6 +757,7 @@ func (a *UsersApi) UpdateUser(ctx _context.Context, userId string, body UserUpda
 		localVarReturnValue UserResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.UsersApi.UpdateUser")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.UsersApi.UpdateUser")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -755,13 +796,10 @@ func (a *UsersApi) UpdateUser(ctx _context.Context, userId string, body UserUpda
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 422 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -775,6 +813,7 @@ func (a *UsersApi) UpdateUser(ctx _context.Context, userId string, body UserUpda
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -782,6 +821,24 @@ func (a *UsersApi) UpdateUser(ctx _context.Context, userId string, body UserUpda
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// UsersApiInterface lists the operations of UsersApi, so that code depending on it can be tested with mocks.
+type UsersApiInterface interface {
+	CreateUser(ctx _context.Context, body UserCreateRequest) (UserResponse, *_nethttp.Response, error)
+	DisableUser(ctx _context.Context, userId string) (*_nethttp.Response, error)
+	GetInvitation(ctx _context.Context, userInvitationUuid string) (UserInvitationResponse, *_nethttp.Response, error)
+	GetUser(ctx _context.Context, userId string) (UserResponse, *_nethttp.Response, error)
+	ListUserOrganizations(ctx _context.Context, userId string) (UserResponse, *_nethttp.Response, error)
+	ListUserPermissions(ctx _context.Context, userId string) (PermissionsResponse, *_nethttp.Response, error)
+	ListUsers(ctx _context.Context, o ...ListUsersOptionalParameters) (UsersResponse, *_nethttp.Response, error)
+	ListUsersWithPagination(ctx _context.Context, o ...ListUsersOptionalParameters) (<-chan datadog.PaginationResult[User], func())
+	ListUsersSeq(ctx _context.Context, o ...ListUsersOptionalParameters) iter.Seq2[User, error]
+	ListUsersPrefetch(ctx _context.Context, prefetch int, o ...ListUsersOptionalParameters) iter.Seq2[User, error]
+	SendInvitations(ctx _context.Context, body UserInvitationsRequest) (UserInvitationsResponse, *_nethttp.Response, error)
+	UpdateUser(ctx _context.Context, userId string, body UserUpdateRequest) (UserResponse, *_nethttp.Response, error)
+}
+
+var _ UsersApiInterface = (*UsersApi)(nil)
+
 // NewUsersApi Returns NewUsersApi.
 func NewUsersApi(client *datadog.APIClient) *UsersApi {
 	return &UsersApi{
@@ -6,6 +6,7 @@ package datadogV2
 
 import (
 	_context "context"
+	"iter"
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
@@ -25,6 +26,7 @@ func (a *WorkflowAutomationApi) CancelWorkflowInstance(ctx _context.Context, wor
 		localVarReturnValue WorklflowCancelInstanceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.WorkflowAutomationApi.CancelWorkflowInstance")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.WorkflowAutomationApi.CancelWorkflowInstance")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -61,13 +63,10 @@ func (a *WorkflowAutomationApi) CancelWorkflowInstance(ctx _context.Context, wor
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -81,6 +80,7 @@ func (a *WorkflowAutomationApi) CancelWorkflowInstance(ctx _context.Context, wor
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -97,6 +97,7 @@ func (a *WorkflowAutomationApi) CreateWorkflowInstance(ctx _context.Context, wor
 		localVarReturnValue WorkflowInstanceCreateResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.WorkflowAutomationApi.CreateWorkflowInstance")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.WorkflowAutomationApi.CreateWorkflowInstance")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -135,13 +136,10 @@ func (a *WorkflowAutomationApi) CreateWorkflowInstance(ctx _context.Context, wor
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -155,6 +153,7 @@ func (a *WorkflowAutomationApi) CreateWorkflowInstance(ctx _context.Context, wor
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -171,6 +170,7 @@ func (a *WorkflowAutomationApi) GetWorkflowInstance(ctx _context.Context, workfl
 		localVarReturnValue WorklflowGetInstanceResponse
 	)
 
+	ctx = datadog.WithOperationID(ctx, "v2.WorkflowAutomationApi.GetWorkflowInstance")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.WorkflowAutomationApi.GetWorkflowInstance")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -207,13 +207,10 @@ func (a *WorkflowAutomationApi) GetWorkflowInstance(ctx _context.Context, workfl
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 404 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -227,6 +224,7 @@ func (a *WorkflowAutomationApi) GetWorkflowInstance(ctx _context.Context, workfl
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -275,6 +273,7 @@ func (a *WorkflowAutomationApi) ListWorkflowInstances(ctx _context.Context, work
 		optionalParams = o[0]
 	}
 
+	ctx = datadog.WithOperationID(ctx, "v2.WorkflowAutomationApi.ListWorkflowInstances")
 	localBasePath, err := a.Client.Cfg.ServerURLWithContext(ctx, "v2.WorkflowAutomationApi.ListWorkflowInstances")
 	if err != nil {
 		return localVarReturnValue, nil, datadog.GenericOpenAPIError{ErrorMessage: err.Error()}
@@ -316,13 +315,10 @@ func (a *WorkflowAutomationApi) ListWorkflowInstances(ctx _context.Context, work
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
-		newErr := datadog.GenericOpenAPIError{
-			ErrorBody:    localVarBody,
-			ErrorMessage: localVarHTTPResponse.Status,
-		}
+		newErr := datadog.NewGenericOpenAPIError(localVarHTTPResponse, localVarBody)
 		if localVarHTTPResponse.StatusCode == 400 || localVarHTTPResponse.StatusCode == 403 || localVarHTTPResponse.StatusCode == 429 {
 			var v APIErrorResponse
-			err = a.Client.Decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			err = a.Client.DecodeErrorModel(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
@@ -336,6 +332,7 @@ func (a *WorkflowAutomationApi) ListWorkflowInstances(ctx _context.Context, work
 		newErr := datadog.GenericOpenAPIError{
 			ErrorBody:    localVarBody,
 			ErrorMessage: err.Error(),
+			Err:          err,
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -343,6 +340,72 @@ func (a *WorkflowAutomationApi) ListWorkflowInstances(ctx _context.Context, work
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// ListWorkflowInstancesWithPagination provides a paginated version of ListWorkflowInstances returning a channel with all items.
+func (a *WorkflowAutomationApi) ListWorkflowInstancesWithPagination(ctx _context.Context, workflowId string, o ...ListWorkflowInstancesOptionalParameters) (<-chan datadog.PaginationResult[WorkflowInstanceListItem], func()) {
+	return a.listWorkflowInstancesPaginator(workflowId, o...).Channel(ctx)
+}
+
+// ListWorkflowInstancesSeq provides a paginated version of ListWorkflowInstances returning an iterator over all items.
+// Pages are requested as the iterator is consumed, and iteration stops after the first error.
+func (a *WorkflowAutomationApi) ListWorkflowInstancesSeq(ctx _context.Context, workflowId string, o ...ListWorkflowInstancesOptionalParameters) iter.Seq2[WorkflowInstanceListItem, error] {
+	return a.listWorkflowInstancesPaginator(workflowId, o...).Seq(ctx)
+}
+
+// ListWorkflowInstancesPrefetch provides a paginated version of ListWorkflowInstances returning an iterator over all items,
+// with up to prefetch pages requested concurrently. Items are returned in order, and iteration stops after the first error.
+func (a *WorkflowAutomationApi) ListWorkflowInstancesPrefetch(ctx _context.Context, workflowId string, prefetch int, o ...ListWorkflowInstancesOptionalParameters) iter.Seq2[WorkflowInstanceListItem, error] {
+	paginator := a.listWorkflowInstancesPaginator(workflowId, o...)
+	paginator.Prefetch = prefetch
+	return paginator.Seq(ctx)
+}
+
+func (a *WorkflowAutomationApi) listWorkflowInstancesPaginator(workflowId string, o ...ListWorkflowInstancesOptionalParameters) datadog.Paginator[WorkflowInstanceListItem] {
+	var params ListWorkflowInstancesOptionalParameters
+	if len(o) > 0 {
+		params = o[0]
+	}
+	first := datadog.PageRequest{Size: 10}
+	if params.PageSize != nil {
+		first.Size = int64(*params.PageSize)
+	}
+	if params.PageNumber != nil {
+		first.Number = int64(*params.PageNumber)
+	}
+	return datadog.Paginator[WorkflowInstanceListItem]{
+		Strategy: datadog.PageNumberPagination,
+		First:    first,
+		Fetch: func(ctx _context.Context, page datadog.PageRequest) (datadog.Page[WorkflowInstanceListItem], error) {
+			request := params
+			if page.Size != 0 {
+				pageSize := int64(page.Size)
+				request.PageSize = &pageSize
+			}
+			pageNumber := int64(page.Number)
+			request.PageNumber = &pageNumber
+			resp, _, err := a.ListWorkflowInstances(ctx, workflowId, request)
+			if err != nil {
+				return datadog.Page[WorkflowInstanceListItem]{}, err
+			}
+			return datadog.Page[WorkflowInstanceListItem]{
+				Items: resp.GetData(),
+			}, nil
+		},
+	}
+}
+
+// WorkflowAutomationApiInterface lists the operations of WorkflowAutomationApi, so that code depending on it can be tested with mocks.
+type WorkflowAutomationApiInterface interface {
+	CancelWorkflowInstance(ctx _context.Context, workflowId string, instanceId string) (WorklflowCancelInstanceResponse, *_nethttp.Response, error)
+	CreateWorkflowInstance(ctx _context.Context, workflowId string, body WorkflowInstanceCreateRequest) (WorkflowInstanceCreateResponse, *_nethttp.Response, error)
+	GetWorkflowInstance(ctx _context.Context, workflowId string, instanceId string) (WorklflowGetInstanceResponse, *_nethttp.Response, error)
+	ListWorkflowInstances(ctx _context.Context, workflowId string, o ...ListWorkflowInstancesOptionalParameters) (WorkflowListInstancesResponse, *_nethttp.Response, error)
+	ListWorkflowInstancesWithPagination(ctx _context.Context, workflowId string, o ...ListWorkflowInstancesOptionalParameters) (<-chan datadog.PaginationResult[WorkflowInstanceListItem], func())
+	ListWorkflowInstancesSeq(ctx _context.Context, workflowId string, o ...ListWorkflowInstancesOptionalParameters) iter.Seq2[WorkflowInstanceListItem, error]
+	ListWorkflowInstancesPrefetch(ctx _context.Context, workflowId string, prefetch int, o ...ListWorkflowInstancesOptionalParameters) iter.Seq2[WorkflowInstanceListItem, error]
+}
+
+var _ WorkflowAutomationApiInterface = (*WorkflowAutomationApi)(nil)
+
 // NewWorkflowAutomationApi Returns NewWorkflowAutomationApi.
 func NewWorkflowAutomationApi(client *datadog.APIClient) *WorkflowAutomationApi {
 	return &WorkflowAutomationApi{
@@ -141,3 +141,8 @@ func (o *ActiveBillingDimensionsAttributes) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ActiveBillingDimensionsAttributes) Validate() error {
+	return nil
+}
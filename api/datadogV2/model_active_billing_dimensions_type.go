@@ -58,6 +58,14 @@ func (v ActiveBillingDimensionsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ActiveBillingDimensionsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ActiveBillingDimensionsType: valid values are %v", v, allowedActiveBillingDimensionsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ActiveBillingDimensionsType value.
 func (v ActiveBillingDimensionsType) Ptr() *ActiveBillingDimensionsType {
 	return &v
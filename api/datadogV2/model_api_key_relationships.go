@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -155,3 +157,14 @@ func (o *APIKeyRelationships) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *APIKeyRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("created_by", o.CreatedBy, nil),
+		datadog.ValidateValue("modified_by", o.ModifiedBy, nil),
+	)
+}
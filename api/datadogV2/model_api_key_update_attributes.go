@@ -169,3 +169,8 @@ func (o *APIKeyUpdateAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *APIKeyUpdateAttributes) Validate() error {
+	return nil
+}
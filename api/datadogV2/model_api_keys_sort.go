@@ -72,6 +72,14 @@ func (v APIKeysSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v APIKeysSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for APIKeysSort: valid values are %v", v, allowedAPIKeysSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to APIKeysSort value.
 func (v APIKeysSort) Ptr() *APIKeysSort {
 	return &v
@@ -58,6 +58,14 @@ func (v APIKeysType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v APIKeysType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for APIKeysType: valid values are %v", v, allowedAPIKeysTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to APIKeysType value.
 func (v APIKeysType) Ptr() *APIKeysType {
 	return &v
@@ -58,6 +58,14 @@ func (v ApmRetentionFilterType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ApmRetentionFilterType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ApmRetentionFilterType: valid values are %v", v, allowedApmRetentionFilterTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ApmRetentionFilterType value.
 func (v ApmRetentionFilterType) Ptr() *ApmRetentionFilterType {
 	return &v
@@ -145,3 +145,8 @@ func (o *ApplicationKeyCreateAttributes) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ApplicationKeyCreateAttributes) Validate() error {
+	return nil
+}
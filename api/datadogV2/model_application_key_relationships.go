@@ -109,3 +109,11 @@ func (o *ApplicationKeyRelationships) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ApplicationKeyRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("owned_by", o.OwnedBy, nil)
+}
@@ -68,6 +68,14 @@ func (v ApplicationKeysSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ApplicationKeysSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ApplicationKeysSort: valid values are %v", v, allowedApplicationKeysSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ApplicationKeysSort value.
 func (v ApplicationKeysSort) Ptr() *ApplicationKeysSort {
 	return &v
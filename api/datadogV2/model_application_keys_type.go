@@ -58,6 +58,14 @@ func (v ApplicationKeysType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ApplicationKeysType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ApplicationKeysType: valid values are %v", v, allowedApplicationKeysTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ApplicationKeysType value.
 func (v ApplicationKeysType) Ptr() *ApplicationKeysType {
 	return &v
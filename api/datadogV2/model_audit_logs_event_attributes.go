@@ -248,3 +248,8 @@ func (o *AuditLogsEventAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuditLogsEventAttributes) Validate() error {
+	return nil
+}
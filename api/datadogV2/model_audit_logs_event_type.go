@@ -58,6 +58,14 @@ func (v AuditLogsEventType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AuditLogsEventType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AuditLogsEventType: valid values are %v", v, allowedAuditLogsEventTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AuditLogsEventType value.
 func (v AuditLogsEventType) Ptr() *AuditLogsEventType {
 	return &v
@@ -100,3 +100,8 @@ func (o *AuditLogsResponsePage) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuditLogsResponsePage) Validate() error {
+	return nil
+}
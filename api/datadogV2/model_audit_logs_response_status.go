@@ -60,6 +60,14 @@ func (v AuditLogsResponseStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AuditLogsResponseStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AuditLogsResponseStatus: valid values are %v", v, allowedAuditLogsResponseStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AuditLogsResponseStatus value.
 func (v AuditLogsResponseStatus) Ptr() *AuditLogsResponseStatus {
 	return &v
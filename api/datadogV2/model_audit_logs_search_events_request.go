@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -225,3 +227,16 @@ func (o *AuditLogsSearchEventsRequest) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuditLogsSearchEventsRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("filter", o.Filter, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("page", o.Page, nil),
+		datadog.ValidateValue("sort", o.Sort, nil),
+	)
+}
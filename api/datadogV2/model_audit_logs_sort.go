@@ -60,6 +60,14 @@ func (v AuditLogsSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AuditLogsSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AuditLogsSort: valid values are %v", v, allowedAuditLogsSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AuditLogsSort value.
 func (v AuditLogsSort) Ptr() *AuditLogsSort {
 	return &v
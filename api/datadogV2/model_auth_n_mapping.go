@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -219,3 +220,15 @@ func (o *AuthNMapping) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuthNMapping) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("attributes", o.Attributes, nil),
+		datadog.ValidateValue("relationships", o.Relationships, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
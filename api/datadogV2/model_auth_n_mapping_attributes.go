@@ -250,3 +250,8 @@ func (o *AuthNMappingAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuthNMappingAttributes) Validate() error {
+	return nil
+}
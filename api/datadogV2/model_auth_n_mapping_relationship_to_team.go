@@ -108,3 +108,11 @@ func (o *AuthNMappingRelationshipToTeam) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuthNMappingRelationshipToTeam) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("team", o.Team, nil)
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -185,3 +187,15 @@ func (o *AuthNMappingRelationships) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuthNMappingRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("role", o.Role, nil),
+		datadog.ValidateValue("saml_assertion_attribute", o.SamlAssertionAttribute, nil),
+		datadog.ValidateValue("team", o.Team, nil),
+	)
+}
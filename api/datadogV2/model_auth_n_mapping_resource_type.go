@@ -60,6 +60,14 @@ func (v AuthNMappingResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AuthNMappingResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AuthNMappingResourceType: valid values are %v", v, allowedAuthNMappingResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AuthNMappingResourceType value.
 func (v AuthNMappingResourceType) Ptr() *AuthNMappingResourceType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -343,3 +345,17 @@ func (o *AuthNMappingTeamAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuthNMappingTeamAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("handle", o.Handle, &datadog.Constraints{MaxLength: datadog.PtrInt64(195)}),
+		datadog.ValidateValue("link_count", o.LinkCount, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MaxLength: datadog.PtrInt64(200)}),
+		datadog.ValidateValue("summary", o.Summary, &datadog.Constraints{MaxLength: datadog.PtrInt64(120)}),
+		datadog.ValidateValue("user_count", o.UserCount, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
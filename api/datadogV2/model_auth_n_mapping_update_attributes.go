@@ -135,3 +135,8 @@ func (o *AuthNMappingUpdateAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AuthNMappingUpdateAttributes) Validate() error {
+	return nil
+}
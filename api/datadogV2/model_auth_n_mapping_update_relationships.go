@@ -103,3 +103,11 @@ func (obj *AuthNMappingUpdateRelationships) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *AuthNMappingUpdateRelationships) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
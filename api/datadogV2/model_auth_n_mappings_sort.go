@@ -80,6 +80,14 @@ func (v AuthNMappingsSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AuthNMappingsSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AuthNMappingsSort: valid values are %v", v, allowedAuthNMappingsSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AuthNMappingsSort value.
 func (v AuthNMappingsSort) Ptr() *AuthNMappingsSort {
 	return &v
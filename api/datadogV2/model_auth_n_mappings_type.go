@@ -58,6 +58,14 @@ func (v AuthNMappingsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AuthNMappingsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AuthNMappingsType: valid values are %v", v, allowedAuthNMappingsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AuthNMappingsType value.
 func (v AuthNMappingsType) Ptr() *AuthNMappingsType {
 	return &v
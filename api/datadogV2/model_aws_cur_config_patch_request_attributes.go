@@ -99,3 +99,8 @@ func (o *AwsCURConfigPatchRequestAttributes) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AwsCURConfigPatchRequestAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v AwsCURConfigPatchRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AwsCURConfigPatchRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AwsCURConfigPatchRequestType: valid values are %v", v, allowedAwsCURConfigPatchRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AwsCURConfigPatchRequestType value.
 func (v AwsCURConfigPatchRequestType) Ptr() *AwsCURConfigPatchRequestType {
 	return &v
@@ -300,3 +300,11 @@ func (o *AwsCURConfigPostRequestAttributes) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AwsCURConfigPostRequestAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("months", o.Months, &datadog.Constraints{Maximum: datadog.PtrFloat64(36)})
+}
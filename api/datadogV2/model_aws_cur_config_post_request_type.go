@@ -58,6 +58,14 @@ func (v AwsCURConfigPostRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AwsCURConfigPostRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AwsCURConfigPostRequestType: valid values are %v", v, allowedAwsCURConfigPostRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AwsCURConfigPostRequestType value.
 func (v AwsCURConfigPostRequestType) Ptr() *AwsCURConfigPostRequestType {
 	return &v
@@ -58,6 +58,14 @@ func (v AwsCURConfigType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AwsCURConfigType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AwsCURConfigType: valid values are %v", v, allowedAwsCURConfigTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AwsCURConfigType value.
 func (v AwsCURConfigType) Ptr() *AwsCURConfigType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -569,3 +570,16 @@ func (o *AzureUCConfig) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AzureUCConfig) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("created_at", o.CreatedAt, &datadog.Constraints{Pattern: `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}.\d{6}$`}),
+		datadog.ValidateValue("months", o.Months, &datadog.Constraints{Maximum: datadog.PtrFloat64(36)}),
+		datadog.ValidateValue("status_updated_at", o.StatusUpdatedAt, &datadog.Constraints{Pattern: `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}.\d{6}$`}),
+		datadog.ValidateValue("updated_at", o.UpdatedAt, &datadog.Constraints{Pattern: `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}.\d{6}$`}),
+	)
+}
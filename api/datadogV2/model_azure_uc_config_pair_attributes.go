@@ -134,3 +134,11 @@ func (o *AzureUCConfigPairAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AzureUCConfigPairAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("configs", o.Configs, nil)
+}
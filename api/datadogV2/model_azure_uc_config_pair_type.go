@@ -58,6 +58,14 @@ func (v AzureUCConfigPairType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AzureUCConfigPairType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AzureUCConfigPairType: valid values are %v", v, allowedAzureUCConfigPairTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AzureUCConfigPairType value.
 func (v AzureUCConfigPairType) Ptr() *AzureUCConfigPairType {
 	return &v
@@ -99,3 +99,8 @@ func (o *AzureUCConfigPatchRequestAttributes) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AzureUCConfigPatchRequestAttributes) Validate() error {
+	return nil
+}
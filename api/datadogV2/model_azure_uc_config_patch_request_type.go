@@ -58,6 +58,14 @@ func (v AzureUCConfigPatchRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AzureUCConfigPatchRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AzureUCConfigPatchRequestType: valid values are %v", v, allowedAzureUCConfigPatchRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AzureUCConfigPatchRequestType value.
 func (v AzureUCConfigPatchRequestType) Ptr() *AzureUCConfigPatchRequestType {
 	return &v
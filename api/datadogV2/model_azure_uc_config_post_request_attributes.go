@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -274,3 +275,14 @@ func (o *AzureUCConfigPostRequestAttributes) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *AzureUCConfigPostRequestAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("actual_bill_config", o.ActualBillConfig, nil),
+		datadog.ValidateValue("amortized_bill_config", o.AmortizedBillConfig, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v AzureUCConfigPostRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v AzureUCConfigPostRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for AzureUCConfigPostRequestType: valid values are %v", v, allowedAzureUCConfigPostRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to AzureUCConfigPostRequestType value.
 func (v AzureUCConfigPostRequestType) Ptr() *AzureUCConfigPostRequestType {
 	return &v
@@ -195,3 +195,8 @@ func (o *BillConfig) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *BillConfig) Validate() error {
+	return nil
+}
@@ -176,3 +176,11 @@ func (o *BillingDimensionsMappingBodyItemAttributes) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *BillingDimensionsMappingBodyItemAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("endpoints", o.Endpoints, nil)
+}
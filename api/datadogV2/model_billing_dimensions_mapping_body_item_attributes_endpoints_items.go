@@ -180,3 +180,11 @@ func (o *BillingDimensionsMappingBodyItemAttributesEndpointsItems) UnmarshalJSON
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *BillingDimensionsMappingBodyItemAttributesEndpointsItems) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("status", o.Status, nil)
+}
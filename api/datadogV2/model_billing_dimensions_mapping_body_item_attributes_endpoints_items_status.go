@@ -60,6 +60,14 @@ func (v BillingDimensionsMappingBodyItemAttributesEndpointsItemsStatus) IsValid(
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v BillingDimensionsMappingBodyItemAttributesEndpointsItemsStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for BillingDimensionsMappingBodyItemAttributesEndpointsItemsStatus: valid values are %v", v, allowedBillingDimensionsMappingBodyItemAttributesEndpointsItemsStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to BillingDimensionsMappingBodyItemAttributesEndpointsItemsStatus value.
 func (v BillingDimensionsMappingBodyItemAttributesEndpointsItemsStatus) Ptr() *BillingDimensionsMappingBodyItemAttributesEndpointsItemsStatus {
 	return &v
@@ -93,3 +93,11 @@ func (o *BulkMuteFindingsRequestAttributes) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *BulkMuteFindingsRequestAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("mute", o.Mute, nil)
+}
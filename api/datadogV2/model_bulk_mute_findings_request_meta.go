@@ -100,3 +100,11 @@ func (o *BulkMuteFindingsRequestMeta) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *BulkMuteFindingsRequestMeta) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("findings", o.Findings, nil)
+}
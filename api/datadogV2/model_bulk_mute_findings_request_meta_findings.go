@@ -100,3 +100,8 @@ func (o *BulkMuteFindingsRequestMetaFindings) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *BulkMuteFindingsRequestMetaFindings) Validate() error {
+	return nil
+}
@@ -198,3 +198,11 @@ func (o *BulkMuteFindingsRequestProperties) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *BulkMuteFindingsRequestProperties) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("reason", o.Reason, nil)
+}
@@ -62,6 +62,14 @@ func (v Case3rdPartyTicketStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v Case3rdPartyTicketStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for Case3rdPartyTicketStatus: valid values are %v", v, allowedCase3rdPartyTicketStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to Case3rdPartyTicketStatus value.
 func (v Case3rdPartyTicketStatus) Ptr() *Case3rdPartyTicketStatus {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -568,3 +569,17 @@ func (o *CaseAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CaseAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("jira_issue", o.JiraIssue, nil),
+		datadog.ValidateValue("priority", o.Priority, nil),
+		datadog.ValidateValue("service_now_ticket", o.ServiceNowTicket, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -68,6 +68,14 @@ func (v CasePriority) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CasePriority) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CasePriority: valid values are %v", v, allowedCasePriorityEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CasePriority value.
 func (v CasePriority) Ptr() *CasePriority {
 	return &v
@@ -58,6 +58,14 @@ func (v CaseResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CaseResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CaseResourceType: valid values are %v", v, allowedCaseResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CaseResourceType value.
 func (v CaseResourceType) Ptr() *CaseResourceType {
 	return &v
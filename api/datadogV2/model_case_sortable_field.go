@@ -62,6 +62,14 @@ func (v CaseSortableField) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CaseSortableField) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CaseSortableField: valid values are %v", v, allowedCaseSortableFieldEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CaseSortableField value.
 func (v CaseSortableField) Ptr() *CaseSortableField {
 	return &v
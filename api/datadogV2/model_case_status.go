@@ -62,6 +62,14 @@ func (v CaseStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CaseStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CaseStatus: valid values are %v", v, allowedCaseStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CaseStatus value.
 func (v CaseStatus) Ptr() *CaseStatus {
 	return &v
@@ -58,6 +58,14 @@ func (v CaseType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CaseType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CaseType: valid values are %v", v, allowedCaseTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CaseType value.
 func (v CaseType) Ptr() *CaseType {
 	return &v
@@ -111,3 +111,11 @@ func (o *CaseUpdatePriorityAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CaseUpdatePriorityAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("priority", o.Priority, nil)
+}
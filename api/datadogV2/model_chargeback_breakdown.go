@@ -170,3 +170,8 @@ func (o *ChargebackBreakdown) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ChargebackBreakdown) Validate() error {
+	return nil
+}
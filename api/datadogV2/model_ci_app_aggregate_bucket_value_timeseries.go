@@ -61,3 +61,11 @@ func (o *CIAppAggregateBucketValueTimeseries) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppAggregateBucketValueTimeseries) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", o.Items, nil)
+}
@@ -141,3 +141,8 @@ func (o *CIAppAggregateBucketValueTimeseriesPoint) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppAggregateBucketValueTimeseriesPoint) Validate() error {
+	return nil
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -227,3 +229,15 @@ func (o *CIAppAggregateSort) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppAggregateSort) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("aggregation", o.Aggregation, nil),
+		datadog.ValidateValue("order", o.Order, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -60,6 +60,14 @@ func (v CIAppAggregateSortType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppAggregateSortType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppAggregateSortType: valid values are %v", v, allowedCIAppAggregateSortTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppAggregateSortType value.
 func (v CIAppAggregateSortType) Ptr() *CIAppAggregateSortType {
 	return &v
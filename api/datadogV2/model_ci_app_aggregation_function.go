@@ -88,6 +88,14 @@ func (v CIAppAggregationFunction) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppAggregationFunction) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppAggregationFunction: valid values are %v", v, allowedCIAppAggregationFunctionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppAggregationFunction value.
 func (v CIAppAggregationFunction) Ptr() *CIAppAggregationFunction {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -249,6 +251,18 @@ func (o *CIAppCIError) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppCIError) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("domain", o.Domain, nil),
+		datadog.ValidateValue("message", o.Message, &datadog.Constraints{MaxLength: datadog.PtrInt64(5000)}),
+		datadog.ValidateValue("type", o.Type, &datadog.Constraints{MaxLength: datadog.PtrInt64(100)}),
+	)
+}
+
 // NullableCIAppCIError handles when a null is used for CIAppCIError.
 type NullableCIAppCIError struct {
 	value *CIAppCIError
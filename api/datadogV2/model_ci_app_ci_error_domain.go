@@ -62,6 +62,14 @@ func (v CIAppCIErrorDomain) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppCIErrorDomain) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppCIErrorDomain: valid values are %v", v, allowedCIAppCIErrorDomainEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppCIErrorDomain value.
 func (v CIAppCIErrorDomain) Ptr() *CIAppCIErrorDomain {
 	return &v
@@ -60,6 +60,14 @@ func (v CIAppComputeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppComputeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppComputeType: valid values are %v", v, allowedCIAppComputeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppComputeType value.
 func (v CIAppComputeType) Ptr() *CIAppComputeType {
 	return &v
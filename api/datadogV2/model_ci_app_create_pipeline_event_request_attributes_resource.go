@@ -167,3 +167,11 @@ func (obj *CIAppCreatePipelineEventRequestAttributesResource) GetActualInstance(
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *CIAppCreatePipelineEventRequestAttributesResource) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
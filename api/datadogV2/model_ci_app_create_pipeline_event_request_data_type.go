@@ -58,6 +58,14 @@ func (v CIAppCreatePipelineEventRequestDataType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppCreatePipelineEventRequestDataType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppCreatePipelineEventRequestDataType: valid values are %v", v, allowedCIAppCreatePipelineEventRequestDataTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppCreatePipelineEventRequestDataType value.
 func (v CIAppCreatePipelineEventRequestDataType) Ptr() *CIAppCreatePipelineEventRequestDataType {
 	return &v
@@ -579,6 +579,14 @@ func (o *CIAppGitInfo) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppGitInfo) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("sha", o.Sha, &datadog.Constraints{Pattern: `^[a-fA-F0-9]{40}$`})
+}
+
 // NullableCIAppGitInfo handles when a null is used for CIAppGitInfo.
 type NullableCIAppGitInfo struct {
 	value *CIAppGitInfo
@@ -206,6 +206,11 @@ func (o *CIAppHostInfo) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppHostInfo) Validate() error {
+	return nil
+}
+
 // NullableCIAppHostInfo handles when a null is used for CIAppHostInfo.
 type NullableCIAppHostInfo struct {
 	value *CIAppHostInfo
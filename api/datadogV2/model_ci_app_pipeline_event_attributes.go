@@ -180,3 +180,11 @@ func (o *CIAppPipelineEventAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelineEventAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("ci_level", o.CiLevel, nil)
+}
@@ -58,6 +58,14 @@ func (v CIAppPipelineEventJobLevel) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventJobLevel) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventJobLevel: valid values are %v", v, allowedCIAppPipelineEventJobLevelEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventJobLevel value.
 func (v CIAppPipelineEventJobLevel) Ptr() *CIAppPipelineEventJobLevel {
 	return &v
@@ -64,6 +64,14 @@ func (v CIAppPipelineEventJobStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventJobStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventJobStatus: valid values are %v", v, allowedCIAppPipelineEventJobStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventJobStatus value.
 func (v CIAppPipelineEventJobStatus) Ptr() *CIAppPipelineEventJobStatus {
 	return &v
@@ -135,6 +135,11 @@ func (o *CIAppPipelineEventParentPipeline) UnmarshalJSON(bytes []byte) (err erro
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelineEventParentPipeline) Validate() error {
+	return nil
+}
+
 // NullableCIAppPipelineEventParentPipeline handles when a null is used for CIAppPipelineEventParentPipeline.
 type NullableCIAppPipelineEventParentPipeline struct {
 	value *CIAppPipelineEventParentPipeline
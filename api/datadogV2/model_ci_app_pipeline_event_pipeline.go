@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -883,3 +884,20 @@ func (o *CIAppPipelineEventPipeline) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelineEventPipeline) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("error", o.Error, nil),
+		datadog.ValidateValue("git", o.Git, nil),
+		datadog.ValidateValue("level", o.Level, nil),
+		datadog.ValidateValue("node", o.Node, nil),
+		datadog.ValidateValue("parent_pipeline", o.ParentPipeline, nil),
+		datadog.ValidateValue("previous_attempt", o.PreviousAttempt, nil),
+		datadog.ValidateValue("queue_time", o.QueueTime, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("status", o.Status, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v CIAppPipelineEventPipelineLevel) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventPipelineLevel) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventPipelineLevel: valid values are %v", v, allowedCIAppPipelineEventPipelineLevelEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventPipelineLevel value.
 func (v CIAppPipelineEventPipelineLevel) Ptr() *CIAppPipelineEventPipelineLevel {
 	return &v
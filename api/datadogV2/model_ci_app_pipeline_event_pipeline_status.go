@@ -66,6 +66,14 @@ func (v CIAppPipelineEventPipelineStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventPipelineStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventPipelineStatus: valid values are %v", v, allowedCIAppPipelineEventPipelineStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventPipelineStatus value.
 func (v CIAppPipelineEventPipelineStatus) Ptr() *CIAppPipelineEventPipelineStatus {
 	return &v
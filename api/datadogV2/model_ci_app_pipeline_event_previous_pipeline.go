@@ -135,6 +135,11 @@ func (o *CIAppPipelineEventPreviousPipeline) UnmarshalJSON(bytes []byte) (err er
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelineEventPreviousPipeline) Validate() error {
+	return nil
+}
+
 // NullableCIAppPipelineEventPreviousPipeline handles when a null is used for CIAppPipelineEventPreviousPipeline.
 type NullableCIAppPipelineEventPreviousPipeline struct {
 	value *CIAppPipelineEventPreviousPipeline
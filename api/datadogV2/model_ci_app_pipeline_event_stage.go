@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -707,3 +708,18 @@ func (o *CIAppPipelineEventStage) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelineEventStage) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("error", o.Error, nil),
+		datadog.ValidateValue("git", o.Git, nil),
+		datadog.ValidateValue("level", o.Level, nil),
+		datadog.ValidateValue("node", o.Node, nil),
+		datadog.ValidateValue("queue_time", o.QueueTime, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("status", o.Status, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v CIAppPipelineEventStageLevel) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventStageLevel) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventStageLevel: valid values are %v", v, allowedCIAppPipelineEventStageLevelEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventStageLevel value.
 func (v CIAppPipelineEventStageLevel) Ptr() *CIAppPipelineEventStageLevel {
 	return &v
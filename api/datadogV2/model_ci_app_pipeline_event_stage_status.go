@@ -64,6 +64,14 @@ func (v CIAppPipelineEventStageStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventStageStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventStageStatus: valid values are %v", v, allowedCIAppPipelineEventStageStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventStageStatus value.
 func (v CIAppPipelineEventStageStatus) Ptr() *CIAppPipelineEventStageStatus {
 	return &v
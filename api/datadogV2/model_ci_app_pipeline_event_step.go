@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -845,3 +846,17 @@ func (o *CIAppPipelineEventStep) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelineEventStep) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("error", o.Error, nil),
+		datadog.ValidateValue("git", o.Git, nil),
+		datadog.ValidateValue("level", o.Level, nil),
+		datadog.ValidateValue("node", o.Node, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+	)
+}
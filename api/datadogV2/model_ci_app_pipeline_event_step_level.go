@@ -58,6 +58,14 @@ func (v CIAppPipelineEventStepLevel) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventStepLevel) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventStepLevel: valid values are %v", v, allowedCIAppPipelineEventStepLevelEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventStepLevel value.
 func (v CIAppPipelineEventStepLevel) Ptr() *CIAppPipelineEventStepLevel {
 	return &v
@@ -60,6 +60,14 @@ func (v CIAppPipelineEventStepStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventStepStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventStepStatus: valid values are %v", v, allowedCIAppPipelineEventStepStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventStepStatus value.
 func (v CIAppPipelineEventStepStatus) Ptr() *CIAppPipelineEventStepStatus {
 	return &v
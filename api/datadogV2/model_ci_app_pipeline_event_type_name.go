@@ -58,6 +58,14 @@ func (v CIAppPipelineEventTypeName) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineEventTypeName) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineEventTypeName: valid values are %v", v, allowedCIAppPipelineEventTypeNameEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineEventTypeName value.
 func (v CIAppPipelineEventTypeName) Ptr() *CIAppPipelineEventTypeName {
 	return &v
@@ -66,6 +66,14 @@ func (v CIAppPipelineLevel) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppPipelineLevel) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppPipelineLevel: valid values are %v", v, allowedCIAppPipelineLevelEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppPipelineLevel value.
 func (v CIAppPipelineLevel) Ptr() *CIAppPipelineLevel {
 	return &v
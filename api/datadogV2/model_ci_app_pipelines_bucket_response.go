@@ -135,3 +135,11 @@ func (o *CIAppPipelinesBucketResponse) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelinesBucketResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("computes", o.Computes, nil)
+}
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -291,3 +292,16 @@ func (o *CIAppPipelinesGroupBy) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelinesGroupBy) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("histogram", o.Histogram, nil),
+		datadog.ValidateValue("missing", o.Missing, nil),
+		datadog.ValidateValue("sort", o.Sort, nil),
+		datadog.ValidateValue("total", o.Total, nil),
+	)
+}
@@ -182,3 +182,8 @@ func (o *CIAppPipelinesQueryFilter) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppPipelinesQueryFilter) Validate() error {
+	return nil
+}
@@ -140,3 +140,8 @@ func (o *CIAppQueryOptions) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppQueryOptions) Validate() error {
+	return nil
+}
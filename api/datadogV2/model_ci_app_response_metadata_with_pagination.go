@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -254,3 +256,15 @@ func (o *CIAppResponseMetadataWithPagination) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppResponseMetadataWithPagination) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("page", o.Page, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+		datadog.ValidateValue("warnings", o.Warnings, nil),
+	)
+}
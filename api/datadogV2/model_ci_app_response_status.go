@@ -60,6 +60,14 @@ func (v CIAppResponseStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppResponseStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppResponseStatus: valid values are %v", v, allowedCIAppResponseStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppResponseStatus value.
 func (v CIAppResponseStatus) Ptr() *CIAppResponseStatus {
 	return &v
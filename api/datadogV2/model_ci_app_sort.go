@@ -60,6 +60,14 @@ func (v CIAppSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppSort: valid values are %v", v, allowedCIAppSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppSort value.
 func (v CIAppSort) Ptr() *CIAppSort {
 	return &v
@@ -60,6 +60,14 @@ func (v CIAppSortOrder) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppSortOrder) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppSortOrder: valid values are %v", v, allowedCIAppSortOrderEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppSortOrder value.
 func (v CIAppSortOrder) Ptr() *CIAppSortOrder {
 	return &v
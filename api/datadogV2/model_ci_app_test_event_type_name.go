@@ -58,6 +58,14 @@ func (v CIAppTestEventTypeName) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppTestEventTypeName) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppTestEventTypeName: valid values are %v", v, allowedCIAppTestEventTypeNameEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppTestEventTypeName value.
 func (v CIAppTestEventTypeName) Ptr() *CIAppTestEventTypeName {
 	return &v
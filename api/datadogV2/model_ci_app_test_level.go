@@ -64,6 +64,14 @@ func (v CIAppTestLevel) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CIAppTestLevel) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CIAppTestLevel: valid values are %v", v, allowedCIAppTestLevelEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CIAppTestLevel value.
 func (v CIAppTestLevel) Ptr() *CIAppTestLevel {
 	return &v
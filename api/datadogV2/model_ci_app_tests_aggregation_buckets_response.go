@@ -100,3 +100,11 @@ func (o *CIAppTestsAggregationBucketsResponse) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CIAppTestsAggregationBucketsResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("buckets", o.Buckets, nil)
+}
@@ -183,3 +183,11 @@ func (o *CloudConfigurationComplianceRuleOptions) UnmarshalJSON(bytes []byte) (e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudConfigurationComplianceRuleOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("regoRule", o.RegoRule, nil)
+}
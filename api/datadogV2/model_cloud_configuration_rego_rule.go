@@ -131,3 +131,8 @@ func (o *CloudConfigurationRegoRule) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudConfigurationRegoRule) Validate() error {
+	return nil
+}
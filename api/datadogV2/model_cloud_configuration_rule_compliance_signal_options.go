@@ -249,3 +249,8 @@ func (o *CloudConfigurationRuleComplianceSignalOptions) UnmarshalJSON(bytes []by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudConfigurationRuleComplianceSignalOptions) Validate() error {
+	return nil
+}
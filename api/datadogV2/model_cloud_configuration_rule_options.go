@@ -110,3 +110,11 @@ func (o *CloudConfigurationRuleOptions) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudConfigurationRuleOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("complianceRuleOptions", o.ComplianceRuleOptions, nil)
+}
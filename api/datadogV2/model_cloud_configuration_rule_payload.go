@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -381,3 +382,17 @@ func (o *CloudConfigurationRulePayload) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudConfigurationRulePayload) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("cases", o.Cases, nil),
+		datadog.ValidateValue("complianceSignalOptions", o.ComplianceSignalOptions, nil),
+		datadog.ValidateValue("filters", o.Filters, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
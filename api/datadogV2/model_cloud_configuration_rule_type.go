@@ -58,6 +58,14 @@ func (v CloudConfigurationRuleType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CloudConfigurationRuleType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CloudConfigurationRuleType: valid values are %v", v, allowedCloudConfigurationRuleTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CloudConfigurationRuleType value.
 func (v CloudConfigurationRuleType) Ptr() *CloudConfigurationRuleType {
 	return &v
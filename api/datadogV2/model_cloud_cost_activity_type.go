@@ -58,6 +58,14 @@ func (v CloudCostActivityType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CloudCostActivityType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CloudCostActivityType: valid values are %v", v, allowedCloudCostActivityTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CloudCostActivityType value.
 func (v CloudCostActivityType) Ptr() *CloudCostActivityType {
 	return &v
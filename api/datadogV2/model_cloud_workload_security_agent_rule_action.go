@@ -144,3 +144,11 @@ func (o *CloudWorkloadSecurityAgentRuleAction) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudWorkloadSecurityAgentRuleAction) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("kill", o.Kill, nil)
+}
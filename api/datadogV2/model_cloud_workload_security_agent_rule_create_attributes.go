@@ -236,3 +236,8 @@ func (o *CloudWorkloadSecurityAgentRuleCreateAttributes) UnmarshalJSON(bytes []b
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudWorkloadSecurityAgentRuleCreateAttributes) Validate() error {
+	return nil
+}
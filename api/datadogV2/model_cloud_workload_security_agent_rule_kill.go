@@ -100,3 +100,8 @@ func (o *CloudWorkloadSecurityAgentRuleKill) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudWorkloadSecurityAgentRuleKill) Validate() error {
+	return nil
+}
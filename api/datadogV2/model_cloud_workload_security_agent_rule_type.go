@@ -58,6 +58,14 @@ func (v CloudWorkloadSecurityAgentRuleType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CloudWorkloadSecurityAgentRuleType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CloudWorkloadSecurityAgentRuleType: valid values are %v", v, allowedCloudWorkloadSecurityAgentRuleTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CloudWorkloadSecurityAgentRuleType value.
 func (v CloudWorkloadSecurityAgentRuleType) Ptr() *CloudWorkloadSecurityAgentRuleType {
 	return &v
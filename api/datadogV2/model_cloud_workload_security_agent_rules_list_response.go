@@ -100,3 +100,11 @@ func (o *CloudWorkloadSecurityAgentRulesListResponse) UnmarshalJSON(bytes []byte
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudWorkloadSecurityAgentRulesListResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("data", o.Data, nil)
+}
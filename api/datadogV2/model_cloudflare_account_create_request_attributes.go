@@ -236,3 +236,8 @@ func (o *CloudflareAccountCreateRequestAttributes) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CloudflareAccountCreateRequestAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v CloudflareAccountType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CloudflareAccountType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CloudflareAccountType: valid values are %v", v, allowedCloudflareAccountTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CloudflareAccountType value.
 func (v CloudflareAccountType) Ptr() *CloudflareAccountType {
 	return &v
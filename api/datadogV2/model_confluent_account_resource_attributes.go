@@ -208,3 +208,8 @@ func (o *ConfluentAccountResourceAttributes) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ConfluentAccountResourceAttributes) Validate() error {
+	return nil
+}
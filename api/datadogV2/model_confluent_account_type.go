@@ -58,6 +58,14 @@ func (v ConfluentAccountType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ConfluentAccountType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ConfluentAccountType: valid values are %v", v, allowedConfluentAccountTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ConfluentAccountType value.
 func (v ConfluentAccountType) Ptr() *ConfluentAccountType {
 	return &v
@@ -173,3 +173,8 @@ func (o *ConfluentResourceRequestAttributes) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ConfluentResourceRequestAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v ConfluentResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ConfluentResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ConfluentResourceType: valid values are %v", v, allowedConfluentResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ConfluentResourceType value.
 func (v ConfluentResourceType) Ptr() *ConfluentResourceType {
 	return &v
@@ -437,3 +437,8 @@ func (o *ContainerAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ContainerAttributes) Validate() error {
+	return nil
+}
@@ -109,3 +109,11 @@ func (o *ContainerGroupRelationships) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ContainerGroupRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("containers", o.Containers, nil)
+}
@@ -100,3 +100,8 @@ func (o *ContainerGroupRelationshipsLinks) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ContainerGroupRelationshipsLinks) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v ContainerGroupType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ContainerGroupType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ContainerGroupType: valid values are %v", v, allowedContainerGroupTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ContainerGroupType value.
 func (v ContainerGroupType) Ptr() *ContainerGroupType {
 	return &v
@@ -240,3 +240,8 @@ func (o *ContainerImageFlavor) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ContainerImageFlavor) Validate() error {
+	return nil
+}
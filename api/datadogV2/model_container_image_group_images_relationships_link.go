@@ -144,3 +144,11 @@ func (o *ContainerImageGroupImagesRelationshipsLink) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ContainerImageGroupImagesRelationshipsLink) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("links", o.Links, nil)
+}
@@ -109,3 +109,11 @@ func (o *ContainerImageGroupRelationships) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ContainerImageGroupRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("container_images", o.ContainerImages, nil)
+}
@@ -58,6 +58,14 @@ func (v ContainerImageGroupType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ContainerImageGroupType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ContainerImageGroupType: valid values are %v", v, allowedContainerImageGroupTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ContainerImageGroupType value.
 func (v ContainerImageGroupType) Ptr() *ContainerImageGroupType {
 	return &v
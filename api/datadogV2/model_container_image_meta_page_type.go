@@ -58,6 +58,14 @@ func (v ContainerImageMetaPageType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ContainerImageMetaPageType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ContainerImageMetaPageType: valid values are %v", v, allowedContainerImageMetaPageTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ContainerImageMetaPageType value.
 func (v ContainerImageMetaPageType) Ptr() *ContainerImageMetaPageType {
 	return &v
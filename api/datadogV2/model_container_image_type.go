@@ -58,6 +58,14 @@ func (v ContainerImageType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ContainerImageType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ContainerImageType: valid values are %v", v, allowedContainerImageTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ContainerImageType value.
 func (v ContainerImageType) Ptr() *ContainerImageType {
 	return &v
@@ -310,3 +310,8 @@ func (o *ContainerImageVulnerabilities) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ContainerImageVulnerabilities) Validate() error {
+	return nil
+}
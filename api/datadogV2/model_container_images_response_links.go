@@ -273,3 +273,8 @@ func (o *ContainerImagesResponseLinks) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ContainerImagesResponseLinks) Validate() error {
+	return nil
+}
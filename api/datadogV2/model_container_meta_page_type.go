@@ -58,6 +58,14 @@ func (v ContainerMetaPageType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ContainerMetaPageType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ContainerMetaPageType: valid values are %v", v, allowedContainerMetaPageTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ContainerMetaPageType value.
 func (v ContainerMetaPageType) Ptr() *ContainerMetaPageType {
 	return &v
@@ -58,6 +58,14 @@ func (v ContainerType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ContainerType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ContainerType: valid values are %v", v, allowedContainerTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ContainerType value.
 func (v ContainerType) Ptr() *ContainerType {
 	return &v
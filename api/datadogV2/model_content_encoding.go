@@ -62,6 +62,14 @@ func (v ContentEncoding) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ContentEncoding) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ContentEncoding: valid values are %v", v, allowedContentEncodingEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ContentEncoding value.
 func (v ContentEncoding) Ptr() *ContentEncoding {
 	return &v
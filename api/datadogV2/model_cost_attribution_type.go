@@ -58,6 +58,14 @@ func (v CostAttributionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CostAttributionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CostAttributionType: valid values are %v", v, allowedCostAttributionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CostAttributionType value.
 func (v CostAttributionType) Ptr() *CostAttributionType {
 	return &v
@@ -58,6 +58,14 @@ func (v CostByOrgType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CostByOrgType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CostByOrgType: valid values are %v", v, allowedCostByOrgTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CostByOrgType value.
 func (v CostByOrgType) Ptr() *CostByOrgType {
 	return &v
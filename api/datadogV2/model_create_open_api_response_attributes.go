@@ -100,3 +100,11 @@ func (o *CreateOpenAPIResponseAttributes) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CreateOpenAPIResponseAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("failed_endpoints", o.FailedEndpoints, nil)
+}
@@ -146,3 +146,11 @@ func (o *CreateOpenAPIResponseData) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CreateOpenAPIResponseData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("attributes", o.Attributes, nil)
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -310,3 +312,14 @@ func (o *CustomCostsFileLineItem) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomCostsFileLineItem) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("ChargePeriodEnd", o.ChargePeriodEnd, &datadog.Constraints{Pattern: `^\d{4}-\d{2}-\d{2}$`}),
+		datadog.ValidateValue("ChargePeriodStart", o.ChargePeriodStart, &datadog.Constraints{Pattern: `^\d{4}-\d{2}-\d{2}$`}),
+	)
+}
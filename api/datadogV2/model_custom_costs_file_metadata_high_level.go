@@ -179,3 +179,11 @@ func (o *CustomCostsFileMetadataHighLevel) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomCostsFileMetadataHighLevel) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("attributes", o.Attributes, nil)
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -392,3 +394,15 @@ func (o *CustomCostsFileMetadataWithContent) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomCostsFileMetadataWithContent) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("charge_period", o.ChargePeriod, nil),
+		datadog.ValidateValue("content", o.Content, nil),
+		datadog.ValidateValue("uploaded_by", o.UploadedBy, nil),
+	)
+}
@@ -179,3 +179,11 @@ func (o *CustomCostsFileMetadataWithContentHighLevel) UnmarshalJSON(bytes []byte
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomCostsFileMetadataWithContentHighLevel) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("attributes", o.Attributes, nil)
+}
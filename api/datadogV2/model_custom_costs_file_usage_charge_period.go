@@ -135,3 +135,8 @@ func (o *CustomCostsFileUsageChargePeriod) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomCostsFileUsageChargePeriod) Validate() error {
+	return nil
+}
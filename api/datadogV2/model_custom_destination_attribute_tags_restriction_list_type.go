@@ -64,6 +64,14 @@ func (v CustomDestinationAttributeTagsRestrictionListType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationAttributeTagsRestrictionListType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationAttributeTagsRestrictionListType: valid values are %v", v, allowedCustomDestinationAttributeTagsRestrictionListTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationAttributeTagsRestrictionListType value.
 func (v CustomDestinationAttributeTagsRestrictionListType) Ptr() *CustomDestinationAttributeTagsRestrictionListType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -339,3 +340,15 @@ func (o *CustomDestinationCreateRequestAttributes) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationCreateRequestAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("forward_tags_restriction_list", o.ForwardTagsRestrictionList, &datadog.Constraints{MinItems: datadog.PtrInt64(0), MaxItems: datadog.PtrInt64(10)}),
+		datadog.ValidateValue("forward_tags_restriction_list_type", o.ForwardTagsRestrictionListType, nil),
+		datadog.ValidateValue("forwarder_destination", o.ForwarderDestination, nil),
+	)
+}
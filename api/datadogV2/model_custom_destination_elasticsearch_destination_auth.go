@@ -131,3 +131,8 @@ func (o *CustomDestinationElasticsearchDestinationAuth) UnmarshalJSON(bytes []by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationElasticsearchDestinationAuth) Validate() error {
+	return nil
+}
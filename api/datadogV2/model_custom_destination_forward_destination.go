@@ -135,3 +135,11 @@ func (obj *CustomDestinationForwardDestination) GetActualInstance() interface{}
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *CustomDestinationForwardDestination) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
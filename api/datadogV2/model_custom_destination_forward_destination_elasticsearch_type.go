@@ -58,6 +58,14 @@ func (v CustomDestinationForwardDestinationElasticsearchType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationForwardDestinationElasticsearchType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationForwardDestinationElasticsearchType: valid values are %v", v, allowedCustomDestinationForwardDestinationElasticsearchTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationForwardDestinationElasticsearchType value.
 func (v CustomDestinationForwardDestinationElasticsearchType) Ptr() *CustomDestinationForwardDestinationElasticsearchType {
 	return &v
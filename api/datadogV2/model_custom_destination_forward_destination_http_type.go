@@ -58,6 +58,14 @@ func (v CustomDestinationForwardDestinationHttpType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationForwardDestinationHttpType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationForwardDestinationHttpType: valid values are %v", v, allowedCustomDestinationForwardDestinationHttpTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationForwardDestinationHttpType value.
 func (v CustomDestinationForwardDestinationHttpType) Ptr() *CustomDestinationForwardDestinationHttpType {
 	return &v
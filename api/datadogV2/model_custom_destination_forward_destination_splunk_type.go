@@ -58,6 +58,14 @@ func (v CustomDestinationForwardDestinationSplunkType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationForwardDestinationSplunkType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationForwardDestinationSplunkType: valid values are %v", v, allowedCustomDestinationForwardDestinationSplunkTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationForwardDestinationSplunkType value.
 func (v CustomDestinationForwardDestinationSplunkType) Ptr() *CustomDestinationForwardDestinationSplunkType {
 	return &v
@@ -58,6 +58,14 @@ func (v CustomDestinationHttpDestinationAuthBasicType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationHttpDestinationAuthBasicType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationHttpDestinationAuthBasicType: valid values are %v", v, allowedCustomDestinationHttpDestinationAuthBasicTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationHttpDestinationAuthBasicType value.
 func (v CustomDestinationHttpDestinationAuthBasicType) Ptr() *CustomDestinationHttpDestinationAuthBasicType {
 	return &v
@@ -175,3 +175,11 @@ func (o *CustomDestinationHttpDestinationAuthCustomHeader) UnmarshalJSON(bytes [
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationHttpDestinationAuthCustomHeader) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
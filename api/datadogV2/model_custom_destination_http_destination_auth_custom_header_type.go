@@ -58,6 +58,14 @@ func (v CustomDestinationHttpDestinationAuthCustomHeaderType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationHttpDestinationAuthCustomHeaderType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationHttpDestinationAuthCustomHeaderType: valid values are %v", v, allowedCustomDestinationHttpDestinationAuthCustomHeaderTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationHttpDestinationAuthCustomHeaderType value.
 func (v CustomDestinationHttpDestinationAuthCustomHeaderType) Ptr() *CustomDestinationHttpDestinationAuthCustomHeaderType {
 	return &v
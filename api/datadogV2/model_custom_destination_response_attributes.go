@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -343,3 +345,15 @@ func (o *CustomDestinationResponseAttributes) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationResponseAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("forward_tags_restriction_list", o.ForwardTagsRestrictionList, &datadog.Constraints{MinItems: datadog.PtrInt64(0), MaxItems: datadog.PtrInt64(10)}),
+		datadog.ValidateValue("forward_tags_restriction_list_type", o.ForwardTagsRestrictionListType, nil),
+		datadog.ValidateValue("forwarder_destination", o.ForwarderDestination, nil),
+	)
+}
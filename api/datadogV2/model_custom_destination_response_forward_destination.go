@@ -135,3 +135,11 @@ func (obj *CustomDestinationResponseForwardDestination) GetActualInstance() inte
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *CustomDestinationResponseForwardDestination) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
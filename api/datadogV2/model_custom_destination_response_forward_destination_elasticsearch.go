@@ -252,3 +252,11 @@ func (o *CustomDestinationResponseForwardDestinationElasticsearch) UnmarshalJSON
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationResponseForwardDestinationElasticsearch) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
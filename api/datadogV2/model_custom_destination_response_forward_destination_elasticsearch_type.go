@@ -58,6 +58,14 @@ func (v CustomDestinationResponseForwardDestinationElasticsearchType) IsValid()
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationResponseForwardDestinationElasticsearchType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationResponseForwardDestinationElasticsearchType: valid values are %v", v, allowedCustomDestinationResponseForwardDestinationElasticsearchTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationResponseForwardDestinationElasticsearchType value.
 func (v CustomDestinationResponseForwardDestinationElasticsearchType) Ptr() *CustomDestinationResponseForwardDestinationElasticsearchType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -176,3 +177,14 @@ func (o *CustomDestinationResponseForwardDestinationHttp) UnmarshalJSON(bytes []
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationResponseForwardDestinationHttp) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("auth", o.Auth, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v CustomDestinationResponseForwardDestinationHttpType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationResponseForwardDestinationHttpType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationResponseForwardDestinationHttpType: valid values are %v", v, allowedCustomDestinationResponseForwardDestinationHttpTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationResponseForwardDestinationHttpType value.
 func (v CustomDestinationResponseForwardDestinationHttpType) Ptr() *CustomDestinationResponseForwardDestinationHttpType {
 	return &v
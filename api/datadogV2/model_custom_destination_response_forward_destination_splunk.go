@@ -144,3 +144,11 @@ func (o *CustomDestinationResponseForwardDestinationSplunk) UnmarshalJSON(bytes
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationResponseForwardDestinationSplunk) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
@@ -58,6 +58,14 @@ func (v CustomDestinationResponseForwardDestinationSplunkType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationResponseForwardDestinationSplunkType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationResponseForwardDestinationSplunkType: valid values are %v", v, allowedCustomDestinationResponseForwardDestinationSplunkTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationResponseForwardDestinationSplunkType value.
 func (v CustomDestinationResponseForwardDestinationSplunkType) Ptr() *CustomDestinationResponseForwardDestinationSplunkType {
 	return &v
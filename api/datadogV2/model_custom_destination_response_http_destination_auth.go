@@ -103,3 +103,11 @@ func (obj *CustomDestinationResponseHttpDestinationAuth) GetActualInstance() int
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *CustomDestinationResponseHttpDestinationAuth) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
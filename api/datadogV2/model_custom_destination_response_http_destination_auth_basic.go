@@ -111,3 +111,11 @@ func (o *CustomDestinationResponseHttpDestinationAuthBasic) UnmarshalJSON(bytes
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationResponseHttpDestinationAuthBasic) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
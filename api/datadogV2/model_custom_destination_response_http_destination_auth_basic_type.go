@@ -58,6 +58,14 @@ func (v CustomDestinationResponseHttpDestinationAuthBasicType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationResponseHttpDestinationAuthBasicType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationResponseHttpDestinationAuthBasicType: valid values are %v", v, allowedCustomDestinationResponseHttpDestinationAuthBasicTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationResponseHttpDestinationAuthBasicType value.
 func (v CustomDestinationResponseHttpDestinationAuthBasicType) Ptr() *CustomDestinationResponseHttpDestinationAuthBasicType {
 	return &v
@@ -143,3 +143,11 @@ func (o *CustomDestinationResponseHttpDestinationAuthCustomHeader) UnmarshalJSON
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationResponseHttpDestinationAuthCustomHeader) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
@@ -58,6 +58,14 @@ func (v CustomDestinationResponseHttpDestinationAuthCustomHeaderType) IsValid()
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationResponseHttpDestinationAuthCustomHeaderType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationResponseHttpDestinationAuthCustomHeaderType: valid values are %v", v, allowedCustomDestinationResponseHttpDestinationAuthCustomHeaderTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationResponseHttpDestinationAuthCustomHeaderType value.
 func (v CustomDestinationResponseHttpDestinationAuthCustomHeaderType) Ptr() *CustomDestinationResponseHttpDestinationAuthCustomHeaderType {
 	return &v
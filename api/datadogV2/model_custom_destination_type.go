@@ -58,6 +58,14 @@ func (v CustomDestinationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v CustomDestinationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for CustomDestinationType: valid values are %v", v, allowedCustomDestinationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to CustomDestinationType value.
 func (v CustomDestinationType) Ptr() *CustomDestinationType {
 	return &v
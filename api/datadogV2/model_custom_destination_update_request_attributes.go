@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -341,3 +343,15 @@ func (o *CustomDestinationUpdateRequestAttributes) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *CustomDestinationUpdateRequestAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("forward_tags_restriction_list", o.ForwardTagsRestrictionList, &datadog.Constraints{MinItems: datadog.PtrInt64(0), MaxItems: datadog.PtrInt64(10)}),
+		datadog.ValidateValue("forward_tags_restriction_list_type", o.ForwardTagsRestrictionListType, nil),
+		datadog.ValidateValue("forwarder_destination", o.ForwarderDestination, nil),
+	)
+}
@@ -100,3 +100,11 @@ func (o *DashboardListAddItemsResponse) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardListAddItemsResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("added_dashboards_to_list", o.AddedDashboardsToList, nil)
+}
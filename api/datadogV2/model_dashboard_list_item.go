@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -606,3 +607,16 @@ func (o *DashboardListItem) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardListItem) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("author", o.Author, nil),
+		datadog.ValidateValue("popularity", o.Popularity, &datadog.Constraints{Maximum: datadog.PtrFloat64(5)}),
+		datadog.ValidateValue("tags", o.Tags, &datadog.Constraints{MaxItems: datadog.PtrInt64(5)}),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
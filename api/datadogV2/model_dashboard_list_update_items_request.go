@@ -100,3 +100,11 @@ func (o *DashboardListUpdateItemsRequest) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DashboardListUpdateItemsRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("dashboards", o.Dashboards, nil)
+}
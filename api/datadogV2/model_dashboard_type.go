@@ -66,6 +66,14 @@ func (v DashboardType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DashboardType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DashboardType: valid values are %v", v, allowedDashboardTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DashboardType value.
 func (v DashboardType) Ptr() *DashboardType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -470,3 +472,18 @@ func (o *DetailedFindingAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DetailedFindingAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("evaluation", o.Evaluation, nil),
+		datadog.ValidateValue("evaluation_changed_at", o.EvaluationChangedAt, &datadog.Constraints{Minimum: datadog.PtrFloat64(1)}),
+		datadog.ValidateValue("mute", o.Mute, nil),
+		datadog.ValidateValue("resource_discovery_date", o.ResourceDiscoveryDate, &datadog.Constraints{Minimum: datadog.PtrFloat64(1)}),
+		datadog.ValidateValue("rule", o.Rule, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v DetailedFindingType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DetailedFindingType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DetailedFindingType: valid values are %v", v, allowedDetailedFindingTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DetailedFindingType value.
 func (v DetailedFindingType) Ptr() *DetailedFindingType {
 	return &v
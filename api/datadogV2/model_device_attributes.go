@@ -774,3 +774,11 @@ func (o *DeviceAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DeviceAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("interface_statuses", o.InterfaceStatuses, nil)
+}
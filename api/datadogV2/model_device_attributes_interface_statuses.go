@@ -205,3 +205,8 @@ func (o *DeviceAttributesInterfaceStatuses) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DeviceAttributesInterfaceStatuses) Validate() error {
+	return nil
+}
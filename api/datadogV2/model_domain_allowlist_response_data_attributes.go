@@ -135,3 +135,8 @@ func (o *DomainAllowlistResponseDataAttributes) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DomainAllowlistResponseDataAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v DomainAllowlistType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DomainAllowlistType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DomainAllowlistType: valid values are %v", v, allowedDomainAllowlistTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DomainAllowlistType value.
 func (v DomainAllowlistType) Ptr() *DomainAllowlistType {
 	return &v
@@ -58,6 +58,14 @@ func (v DORADeploymentType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DORADeploymentType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DORADeploymentType: valid values are %v", v, allowedDORADeploymentTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DORADeploymentType value.
 func (v DORADeploymentType) Ptr() *DORADeploymentType {
 	return &v
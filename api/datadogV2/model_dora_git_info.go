@@ -131,3 +131,11 @@ func (o *DORAGitInfo) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DORAGitInfo) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("commit_sha", o.CommitSha, &datadog.Constraints{Pattern: `^[a-fA-F0-9]{40,}$`})
+}
@@ -58,6 +58,14 @@ func (v DORAIncidentType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DORAIncidentType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DORAIncidentType: valid values are %v", v, allowedDORAIncidentTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DORAIncidentType value.
 func (v DORAIncidentType) Ptr() *DORAIncidentType {
 	return &v
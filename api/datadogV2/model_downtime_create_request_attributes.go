@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -369,3 +370,16 @@ func (o *DowntimeCreateRequestAttributes) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeCreateRequestAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("monitor_identifier", o.MonitorIdentifier, nil),
+		datadog.ValidateValue("notify_end_states", o.NotifyEndStates, nil),
+		datadog.ValidateValue("notify_end_types", o.NotifyEndTypes, nil),
+		datadog.ValidateValue("schedule", o.Schedule, nil),
+	)
+}
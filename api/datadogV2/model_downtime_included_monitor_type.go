@@ -58,6 +58,14 @@ func (v DowntimeIncludedMonitorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DowntimeIncludedMonitorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DowntimeIncludedMonitorType: valid values are %v", v, allowedDowntimeIncludedMonitorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DowntimeIncludedMonitorType value.
 func (v DowntimeIncludedMonitorType) Ptr() *DowntimeIncludedMonitorType {
 	return &v
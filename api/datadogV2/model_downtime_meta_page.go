@@ -100,3 +100,8 @@ func (o *DowntimeMetaPage) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeMetaPage) Validate() error {
+	return nil
+}
@@ -102,3 +102,11 @@ func (o *DowntimeMonitorIdentifierTags) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeMonitorIdentifierTags) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("monitor_tags", o.MonitorTags, &datadog.Constraints{MinItems: datadog.PtrInt64(1)})
+}
@@ -100,3 +100,8 @@ func (o *DowntimeMonitorIncludedAttributes) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeMonitorIncludedAttributes) Validate() error {
+	return nil
+}
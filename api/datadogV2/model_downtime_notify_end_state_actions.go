@@ -60,6 +60,14 @@ func (v DowntimeNotifyEndStateActions) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DowntimeNotifyEndStateActions) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DowntimeNotifyEndStateActions: valid values are %v", v, allowedDowntimeNotifyEndStateActionsEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DowntimeNotifyEndStateActions value.
 func (v DowntimeNotifyEndStateActions) Ptr() *DowntimeNotifyEndStateActions {
 	return &v
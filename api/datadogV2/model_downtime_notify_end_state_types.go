@@ -62,6 +62,14 @@ func (v DowntimeNotifyEndStateTypes) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DowntimeNotifyEndStateTypes) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DowntimeNotifyEndStateTypes: valid values are %v", v, allowedDowntimeNotifyEndStateTypesEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DowntimeNotifyEndStateTypes value.
 func (v DowntimeNotifyEndStateTypes) Ptr() *DowntimeNotifyEndStateTypes {
 	return &v
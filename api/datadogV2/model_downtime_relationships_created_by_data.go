@@ -150,6 +150,14 @@ func (o *DowntimeRelationshipsCreatedByData) UnmarshalJSON(bytes []byte) (err er
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeRelationshipsCreatedByData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
+
 // NullableDowntimeRelationshipsCreatedByData handles when a null is used for DowntimeRelationshipsCreatedByData.
 type NullableDowntimeRelationshipsCreatedByData struct {
 	value *DowntimeRelationshipsCreatedByData
@@ -150,6 +150,14 @@ func (o *DowntimeRelationshipsMonitorData) UnmarshalJSON(bytes []byte) (err erro
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeRelationshipsMonitorData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
+
 // NullableDowntimeRelationshipsMonitorData handles when a null is used for DowntimeRelationshipsMonitorData.
 type NullableDowntimeRelationshipsMonitorData struct {
 	value *DowntimeRelationshipsMonitorData
@@ -58,6 +58,14 @@ func (v DowntimeResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DowntimeResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DowntimeResourceType: valid values are %v", v, allowedDowntimeResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DowntimeResourceType value.
 func (v DowntimeResourceType) Ptr() *DowntimeResourceType {
 	return &v
@@ -154,3 +154,8 @@ func (o *DowntimeScheduleCurrentDowntimeResponse) UnmarshalJSON(bytes []byte) (e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeScheduleCurrentDowntimeResponse) Validate() error {
+	return nil
+}
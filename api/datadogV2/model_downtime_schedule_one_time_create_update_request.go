@@ -146,3 +146,8 @@ func (o *DowntimeScheduleOneTimeCreateUpdateRequest) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeScheduleOneTimeCreateUpdateRequest) Validate() error {
+	return nil
+}
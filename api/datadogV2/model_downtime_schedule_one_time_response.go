@@ -150,3 +150,8 @@ func (o *DowntimeScheduleOneTimeResponse) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeScheduleOneTimeResponse) Validate() error {
+	return nil
+}
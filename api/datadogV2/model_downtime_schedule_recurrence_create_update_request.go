@@ -183,3 +183,8 @@ func (o *DowntimeScheduleRecurrenceCreateUpdateRequest) UnmarshalJSON(bytes []by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeScheduleRecurrenceCreateUpdateRequest) Validate() error {
+	return nil
+}
@@ -176,3 +176,8 @@ func (o *DowntimeScheduleRecurrenceResponse) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeScheduleRecurrenceResponse) Validate() error {
+	return nil
+}
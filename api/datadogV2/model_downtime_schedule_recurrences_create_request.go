@@ -138,3 +138,11 @@ func (o *DowntimeScheduleRecurrencesCreateRequest) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeScheduleRecurrencesCreateRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("recurrences", o.Recurrences, nil)
+}
@@ -124,3 +124,11 @@ func (o *DowntimeScheduleRecurrencesUpdateRequest) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *DowntimeScheduleRecurrencesUpdateRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("recurrences", o.Recurrences, nil)
+}
@@ -64,6 +64,14 @@ func (v DowntimeStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v DowntimeStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for DowntimeStatus: valid values are %v", v, allowedDowntimeStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to DowntimeStatus value.
 func (v DowntimeStatus) Ptr() *DowntimeStatus {
 	return &v
@@ -345,3 +345,8 @@ func (o *EntityAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityAttributes) Validate() error {
+	return nil
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -261,3 +263,17 @@ func (o *EntityRelationships) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("incidents", o.Incidents, nil),
+		datadog.ValidateValue("oncall", o.Oncall, nil),
+		datadog.ValidateValue("rawSchema", o.RawSchema, nil),
+		datadog.ValidateValue("relatedEntities", o.RelatedEntities, nil),
+		datadog.ValidateValue("schema", o.Schema, nil),
+	)
+}
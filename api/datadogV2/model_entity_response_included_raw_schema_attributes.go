@@ -100,3 +100,8 @@ func (o *EntityResponseIncludedRawSchemaAttributes) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityResponseIncludedRawSchemaAttributes) Validate() error {
+	return nil
+}
@@ -205,3 +205,8 @@ func (o *EntityResponseIncludedRelatedEntityAttributes) UnmarshalJSON(bytes []by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityResponseIncludedRelatedEntityAttributes) Validate() error {
+	return nil
+}
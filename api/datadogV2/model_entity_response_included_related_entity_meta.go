@@ -215,3 +215,8 @@ func (o *EntityResponseIncludedRelatedEntityMeta) UnmarshalJSON(bytes []byte) (e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityResponseIncludedRelatedEntityMeta) Validate() error {
+	return nil
+}
@@ -246,3 +246,8 @@ func (o *EntityResponseIncludedRelatedIncidentAttributes) UnmarshalJSON(bytes []
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityResponseIncludedRelatedIncidentAttributes) Validate() error {
+	return nil
+}
@@ -135,3 +135,11 @@ func (o *EntityResponseIncludedRelatedOncallAttributes) UnmarshalJSON(bytes []by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityResponseIncludedRelatedOncallAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("escalations", o.Escalations, nil)
+}
@@ -170,3 +170,8 @@ func (o *EntityResponseIncludedRelatedOncallEscalationItem) UnmarshalJSON(bytes
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityResponseIncludedRelatedOncallEscalationItem) Validate() error {
+	return nil
+}
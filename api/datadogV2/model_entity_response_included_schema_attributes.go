@@ -100,3 +100,11 @@ func (o *EntityResponseIncludedSchemaAttributes) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityResponseIncludedSchemaAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("schema", o.Schema, nil)
+}
@@ -58,6 +58,14 @@ func (v EntityV3APIVersion) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EntityV3APIVersion) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EntityV3APIVersion: valid values are %v", v, allowedEntityV3APIVersionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EntityV3APIVersion value.
 func (v EntityV3APIVersion) Ptr() *EntityV3APIVersion {
 	return &v
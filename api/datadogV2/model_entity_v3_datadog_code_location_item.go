@@ -120,3 +120,11 @@ func (o *EntityV3DatadogCodeLocationItem) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3DatadogCodeLocationItem) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("repositoryURL", o.RepositoryUrl, &datadog.Constraints{Pattern: `url`})
+}
@@ -120,3 +120,8 @@ func (o *EntityV3DatadogEventItem) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3DatadogEventItem) Validate() error {
+	return nil
+}
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -119,3 +120,14 @@ func (o *EntityV3DatadogIntegrationOpsgenie) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3DatadogIntegrationOpsgenie) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("region", o.Region, &datadog.Constraints{MinLength: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("serviceURL", o.ServiceUrl, &datadog.Constraints{MinLength: datadog.PtrInt64(1)}),
+	)
+}
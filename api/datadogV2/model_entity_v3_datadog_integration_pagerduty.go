@@ -84,3 +84,11 @@ func (o *EntityV3DatadogIntegrationPagerduty) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3DatadogIntegrationPagerduty) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("serviceURL", o.ServiceUrl, &datadog.Constraints{MinLength: datadog.PtrInt64(1)})
+}
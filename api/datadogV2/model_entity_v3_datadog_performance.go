@@ -85,3 +85,8 @@ func (o *EntityV3DatadogPerformance) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3DatadogPerformance) Validate() error {
+	return nil
+}
@@ -85,3 +85,8 @@ func (o *EntityV3DatadogPipelines) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3DatadogPipelines) Validate() error {
+	return nil
+}
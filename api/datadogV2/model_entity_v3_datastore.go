@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -314,3 +315,18 @@ func (o *EntityV3Datastore) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3Datastore) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("apiVersion", o.ApiVersion, nil),
+		datadog.ValidateValue("datadog", o.Datadog, nil),
+		datadog.ValidateValue("integrations", o.Integrations, nil),
+		datadog.ValidateValue("kind", o.Kind, nil),
+		datadog.ValidateValue("metadata", o.Metadata, nil),
+		datadog.ValidateValue("spec", o.Spec, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v EntityV3DatastoreKind) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EntityV3DatastoreKind) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EntityV3DatastoreKind: valid values are %v", v, allowedEntityV3DatastoreKindEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EntityV3DatastoreKind value.
 func (v EntityV3DatastoreKind) Ptr() *EntityV3DatastoreKind {
 	return &v
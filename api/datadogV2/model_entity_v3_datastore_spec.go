@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -155,3 +157,14 @@ func (o *EntityV3DatastoreSpec) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3DatastoreSpec) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("lifecycle", o.Lifecycle, &datadog.Constraints{MinLength: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("tier", o.Tier, &datadog.Constraints{MinLength: datadog.PtrInt64(1)}),
+	)
+}
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -478,3 +479,19 @@ func (o *EntityV3Metadata) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3Metadata) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("additionalOwners", o.AdditionalOwners, nil),
+		datadog.ValidateValue("contacts", o.Contacts, nil),
+		datadog.ValidateValue("id", o.Id, &datadog.Constraints{MinLength: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("links", o.Links, nil),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MinLength: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("namespace", o.Namespace, &datadog.Constraints{MinLength: datadog.PtrInt64(1)}),
+		datadog.ValidateValue("owner", o.Owner, nil),
+	)
+}
@@ -134,3 +134,8 @@ func (o *EntityV3MetadataAdditionalOwnersItems) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3MetadataAdditionalOwnersItems) Validate() error {
+	return nil
+}
@@ -151,3 +151,11 @@ func (o *EntityV3MetadataContactsItems) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3MetadataContactsItems) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("name", o.Name, &datadog.Constraints{MinLength: datadog.PtrInt64(2)})
+}
@@ -185,3 +185,8 @@ func (o *EntityV3MetadataLinksItems) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3MetadataLinksItems) Validate() error {
+	return nil
+}
@@ -85,3 +85,8 @@ func (o *EntityV3MetadataOwner) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3MetadataOwner) Validate() error {
+	return nil
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -164,3 +166,15 @@ func (o *EntityV3QueueDatadog) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3QueueDatadog) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("events", o.Events, nil),
+		datadog.ValidateValue("logs", o.Logs, nil),
+		datadog.ValidateValue("performanceData", o.PerformanceData, nil),
+	)
+}
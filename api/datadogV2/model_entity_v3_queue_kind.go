@@ -58,6 +58,14 @@ func (v EntityV3QueueKind) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EntityV3QueueKind) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EntityV3QueueKind: valid values are %v", v, allowedEntityV3QueueKindEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EntityV3QueueKind value.
 func (v EntityV3QueueKind) Ptr() *EntityV3QueueKind {
 	return &v
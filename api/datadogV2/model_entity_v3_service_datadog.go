@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -237,3 +239,17 @@ func (o *EntityV3ServiceDatadog) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EntityV3ServiceDatadog) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("codeLocations", o.CodeLocations, nil),
+		datadog.ValidateValue("events", o.Events, nil),
+		datadog.ValidateValue("logs", o.Logs, nil),
+		datadog.ValidateValue("performanceData", o.PerformanceData, nil),
+		datadog.ValidateValue("pipelines", o.Pipelines, nil),
+	)
+}
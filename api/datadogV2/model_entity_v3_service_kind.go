@@ -58,6 +58,14 @@ func (v EntityV3ServiceKind) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EntityV3ServiceKind) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EntityV3ServiceKind: valid values are %v", v, allowedEntityV3ServiceKindEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EntityV3ServiceKind value.
 func (v EntityV3ServiceKind) Ptr() *EntityV3ServiceKind {
 	return &v
@@ -58,6 +58,14 @@ func (v EntityV3SystemKind) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EntityV3SystemKind) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EntityV3SystemKind: valid values are %v", v, allowedEntityV3SystemKindEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EntityV3SystemKind value.
 func (v EntityV3SystemKind) Ptr() *EntityV3SystemKind {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -797,3 +799,16 @@ func (o *EventAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EventAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("evt", o.Evt, nil),
+		datadog.ValidateValue("monitor", o.Monitor, nil),
+		datadog.ValidateValue("priority", o.Priority, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+	)
+}
@@ -74,6 +74,14 @@ func (v EventStatusType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventStatusType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventStatusType: valid values are %v", v, allowedEventStatusTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventStatusType value.
 func (v EventStatusType) Ptr() *EventStatusType {
 	return &v
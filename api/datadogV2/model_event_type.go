@@ -58,6 +58,14 @@ func (v EventType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventType: valid values are %v", v, allowedEventTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventType value.
 func (v EventType) Ptr() *EventType {
 	return &v
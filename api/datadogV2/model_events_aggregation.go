@@ -78,6 +78,14 @@ func (v EventsAggregation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventsAggregation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventsAggregation: valid values are %v", v, allowedEventsAggregationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventsAggregation value.
 func (v EventsAggregation) Ptr() *EventsAggregation {
 	return &v
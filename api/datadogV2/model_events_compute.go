@@ -181,3 +181,11 @@ func (o *EventsCompute) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EventsCompute) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("aggregation", o.Aggregation, nil)
+}
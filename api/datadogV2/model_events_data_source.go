@@ -60,6 +60,14 @@ func (v EventsDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventsDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventsDataSource: valid values are %v", v, allowedEventsDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventsDataSource value.
 func (v EventsDataSource) Ptr() *EventsDataSource {
 	return &v
@@ -140,3 +140,8 @@ func (o *EventsQueryOptions) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EventsQueryOptions) Validate() error {
+	return nil
+}
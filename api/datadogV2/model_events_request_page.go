@@ -139,3 +139,11 @@ func (o *EventsRequestPage) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *EventsRequestPage) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("limit", o.Limit, &datadog.Constraints{Maximum: datadog.PtrFloat64(1000)})
+}
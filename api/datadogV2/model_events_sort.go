@@ -60,6 +60,14 @@ func (v EventsSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventsSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventsSort: valid values are %v", v, allowedEventsSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventsSort value.
 func (v EventsSort) Ptr() *EventsSort {
 	return &v
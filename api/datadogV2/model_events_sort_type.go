@@ -60,6 +60,14 @@ func (v EventsSortType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v EventsSortType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for EventsSortType: valid values are %v", v, allowedEventsSortTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to EventsSortType value.
 func (v EventsSortType) Ptr() *EventsSortType {
 	return &v
@@ -134,3 +134,11 @@ func (o *FastlyAccounResponseAttributes) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FastlyAccounResponseAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("services", o.Services, nil)
+}
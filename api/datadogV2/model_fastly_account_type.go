@@ -58,6 +58,14 @@ func (v FastlyAccountType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FastlyAccountType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FastlyAccountType: valid values are %v", v, allowedFastlyAccountTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FastlyAccountType value.
 func (v FastlyAccountType) Ptr() *FastlyAccountType {
 	return &v
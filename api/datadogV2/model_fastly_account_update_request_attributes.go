@@ -135,3 +135,8 @@ func (o *FastlyAccountUpdateRequestAttributes) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FastlyAccountUpdateRequestAttributes) Validate() error {
+	return nil
+}
@@ -134,3 +134,8 @@ func (o *FastlyService) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FastlyService) Validate() error {
+	return nil
+}
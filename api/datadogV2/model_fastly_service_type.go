@@ -58,6 +58,14 @@ func (v FastlyServiceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FastlyServiceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FastlyServiceType: valid values are %v", v, allowedFastlyServiceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FastlyServiceType value.
 func (v FastlyServiceType) Ptr() *FastlyServiceType {
 	return &v
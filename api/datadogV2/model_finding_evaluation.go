@@ -60,6 +60,14 @@ func (v FindingEvaluation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FindingEvaluation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FindingEvaluation: valid values are %v", v, allowedFindingEvaluationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FindingEvaluation value.
 func (v FindingEvaluation) Ptr() *FindingEvaluation {
 	return &v
@@ -270,3 +270,11 @@ func (o *FindingMute) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FindingMute) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("reason", o.Reason, nil)
+}
@@ -70,6 +70,14 @@ func (v FindingMuteReason) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FindingMuteReason) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FindingMuteReason: valid values are %v", v, allowedFindingMuteReasonEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FindingMuteReason value.
 func (v FindingMuteReason) Ptr() *FindingMuteReason {
 	return &v
@@ -120,3 +120,8 @@ func (o *FindingRule) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FindingRule) Validate() error {
+	return nil
+}
@@ -66,6 +66,14 @@ func (v FindingStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FindingStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FindingStatus: valid values are %v", v, allowedFindingStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FindingStatus value.
 func (v FindingStatus) Ptr() *FindingStatus {
 	return &v
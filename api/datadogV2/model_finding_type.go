@@ -58,6 +58,14 @@ func (v FindingType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FindingType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FindingType: valid values are %v", v, allowedFindingTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FindingType value.
 func (v FindingType) Ptr() *FindingType {
 	return &v
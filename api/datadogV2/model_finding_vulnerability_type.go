@@ -64,6 +64,14 @@ func (v FindingVulnerabilityType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v FindingVulnerabilityType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for FindingVulnerabilityType: valid values are %v", v, allowedFindingVulnerabilityTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to FindingVulnerabilityType value.
 func (v FindingVulnerabilityType) Ptr() *FindingVulnerabilityType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -150,3 +152,14 @@ func (o *FormulaLimit) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FormulaLimit) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("count", o.Count, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+		datadog.ValidateValue("order", o.Order, nil),
+	)
+}
@@ -257,3 +257,11 @@ func (o *FullApplicationKeyAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *FullApplicationKeyAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("last4", o.Last4, &datadog.Constraints{MinLength: datadog.PtrInt64(4), MaxLength: datadog.PtrInt64(4)})
+}
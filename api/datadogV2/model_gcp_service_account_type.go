@@ -58,6 +58,14 @@ func (v GCPServiceAccountType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v GCPServiceAccountType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for GCPServiceAccountType: valid values are %v", v, allowedGCPServiceAccountTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to GCPServiceAccountType value.
 func (v GCPServiceAccountType) Ptr() *GCPServiceAccountType {
 	return &v
@@ -58,6 +58,14 @@ func (v GCPSTSDelegateAccountType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v GCPSTSDelegateAccountType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for GCPSTSDelegateAccountType: valid values are %v", v, allowedGCPSTSDelegateAccountTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to GCPSTSDelegateAccountType value.
 func (v GCPSTSDelegateAccountType) Ptr() *GCPSTSDelegateAccountType {
 	return &v
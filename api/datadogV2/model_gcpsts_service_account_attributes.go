@@ -389,3 +389,8 @@ func (o *GCPSTSServiceAccountAttributes) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *GCPSTSServiceAccountAttributes) Validate() error {
+	return nil
+}
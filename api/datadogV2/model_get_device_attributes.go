@@ -730,3 +730,8 @@ func (o *GetDeviceAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *GetDeviceAttributes) Validate() error {
+	return nil
+}
@@ -72,6 +72,14 @@ func (v GetTeamMembershipsSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v GetTeamMembershipsSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for GetTeamMembershipsSort: valid values are %v", v, allowedGetTeamMembershipsSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to GetTeamMembershipsSort value.
 func (v GetTeamMembershipsSort) Ptr() *GetTeamMembershipsSort {
 	return &v
@@ -111,3 +111,8 @@ func (o *HourlyUsagePagination) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HourlyUsagePagination) Validate() error {
+	return nil
+}
@@ -62,6 +62,14 @@ func (v HourlyUsageType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v HourlyUsageType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for HourlyUsageType: valid values are %v", v, allowedHourlyUsageTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to HourlyUsageType value.
 func (v HourlyUsageType) Ptr() *HourlyUsageType {
 	return &v
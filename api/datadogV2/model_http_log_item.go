@@ -245,3 +245,8 @@ func (o *HTTPLogItem) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *HTTPLogItem) Validate() error {
+	return nil
+}
@@ -60,6 +60,14 @@ func (v IncidentAttachmentAttachmentType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentAttachmentAttachmentType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentAttachmentAttachmentType: valid values are %v", v, allowedIncidentAttachmentAttachmentTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentAttachmentAttachmentType value.
 func (v IncidentAttachmentAttachmentType) Ptr() *IncidentAttachmentAttachmentType {
 	return &v
@@ -58,6 +58,14 @@ func (v IncidentAttachmentLinkAttachmentType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentAttachmentLinkAttachmentType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentAttachmentLinkAttachmentType: valid values are %v", v, allowedIncidentAttachmentLinkAttachmentTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentAttachmentLinkAttachmentType value.
 func (v IncidentAttachmentLinkAttachmentType) Ptr() *IncidentAttachmentLinkAttachmentType {
 	return &v
@@ -131,3 +131,8 @@ func (o *IncidentAttachmentLinkAttributesAttachmentObject) UnmarshalJSON(bytes [
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentAttachmentLinkAttributesAttachmentObject) Validate() error {
+	return nil
+}
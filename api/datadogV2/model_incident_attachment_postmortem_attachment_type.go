@@ -58,6 +58,14 @@ func (v IncidentAttachmentPostmortemAttachmentType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentAttachmentPostmortemAttachmentType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentAttachmentPostmortemAttachmentType: valid values are %v", v, allowedIncidentAttachmentPostmortemAttachmentTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentAttachmentPostmortemAttachmentType value.
 func (v IncidentAttachmentPostmortemAttachmentType) Ptr() *IncidentAttachmentPostmortemAttachmentType {
 	return &v
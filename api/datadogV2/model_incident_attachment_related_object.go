@@ -58,6 +58,14 @@ func (v IncidentAttachmentRelatedObject) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentAttachmentRelatedObject) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentAttachmentRelatedObject: valid values are %v", v, allowedIncidentAttachmentRelatedObjectEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentAttachmentRelatedObject value.
 func (v IncidentAttachmentRelatedObject) Ptr() *IncidentAttachmentRelatedObject {
 	return &v
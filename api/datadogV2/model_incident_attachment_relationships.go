@@ -109,3 +109,11 @@ func (o *IncidentAttachmentRelationships) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentAttachmentRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("last_modified_by_user", o.LastModifiedByUser, nil)
+}
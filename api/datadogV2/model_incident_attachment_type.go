@@ -58,6 +58,14 @@ func (v IncidentAttachmentType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentAttachmentType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentAttachmentType: valid values are %v", v, allowedIncidentAttachmentTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentAttachmentType value.
 func (v IncidentAttachmentType) Ptr() *IncidentAttachmentType {
 	return &v
@@ -131,3 +131,8 @@ func (o *IncidentAttachmentsPostmortemAttributesAttachmentObject) UnmarshalJSON(
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentAttachmentsPostmortemAttributesAttachmentObject) Validate() error {
+	return nil
+}
@@ -71,3 +71,11 @@ func (obj *IncidentAttachmentsResponseIncludedItem) GetActualInstance() interfac
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *IncidentAttachmentsResponseIncludedItem) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
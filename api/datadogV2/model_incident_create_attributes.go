@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -306,3 +307,15 @@ func (o *IncidentCreateAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentCreateAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("fields", o.Fields, nil),
+		datadog.ValidateValue("initial_cells", o.InitialCells, nil),
+		datadog.ValidateValue("notification_handles", o.NotificationHandles, nil),
+	)
+}
@@ -101,3 +101,11 @@ func (o *IncidentCreateRelationships) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentCreateRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("commander_user", o.CommanderUser, nil)
+}
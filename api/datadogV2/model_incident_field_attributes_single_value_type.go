@@ -60,6 +60,14 @@ func (v IncidentFieldAttributesSingleValueType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentFieldAttributesSingleValueType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentFieldAttributesSingleValueType: valid values are %v", v, allowedIncidentFieldAttributesSingleValueTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentFieldAttributesSingleValueType value.
 func (v IncidentFieldAttributesSingleValueType) Ptr() *IncidentFieldAttributesSingleValueType {
 	return &v
@@ -64,6 +64,14 @@ func (v IncidentFieldAttributesValueType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentFieldAttributesValueType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentFieldAttributesValueType: valid values are %v", v, allowedIncidentFieldAttributesValueTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentFieldAttributesValueType value.
 func (v IncidentFieldAttributesValueType) Ptr() *IncidentFieldAttributesValueType {
 	return &v
@@ -58,6 +58,14 @@ func (v IncidentImpactsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentImpactsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentImpactsType: valid values are %v", v, allowedIncidentImpactsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentImpactsType value.
 func (v IncidentImpactsType) Ptr() *IncidentImpactsType {
 	return &v
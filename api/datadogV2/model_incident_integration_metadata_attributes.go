@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -283,3 +284,15 @@ func (o *IncidentIntegrationMetadataAttributes) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentIntegrationMetadataAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("integration_type", o.IntegrationType, &datadog.Constraints{Maximum: datadog.PtrFloat64(9)}),
+		datadog.ValidateValue("metadata", o.Metadata, nil),
+		datadog.ValidateValue("status", o.Status, &datadog.Constraints{Maximum: datadog.PtrFloat64(5)}),
+	)
+}
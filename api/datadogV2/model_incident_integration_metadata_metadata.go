@@ -135,3 +135,11 @@ func (obj *IncidentIntegrationMetadataMetadata) GetActualInstance() interface{}
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *IncidentIntegrationMetadataMetadata) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
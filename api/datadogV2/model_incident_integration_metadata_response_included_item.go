@@ -71,3 +71,11 @@ func (obj *IncidentIntegrationMetadataResponseIncludedItem) GetActualInstance()
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *IncidentIntegrationMetadataResponseIncludedItem) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
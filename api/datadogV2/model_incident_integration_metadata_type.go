@@ -58,6 +58,14 @@ func (v IncidentIntegrationMetadataType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentIntegrationMetadataType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentIntegrationMetadataType: valid values are %v", v, allowedIncidentIntegrationMetadataTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentIntegrationMetadataType value.
 func (v IncidentIntegrationMetadataType) Ptr() *IncidentIntegrationMetadataType {
 	return &v
@@ -136,6 +136,11 @@ func (o *IncidentNonDatadogCreator) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentNonDatadogCreator) Validate() error {
+	return nil
+}
+
 // NullableIncidentNonDatadogCreator handles when a null is used for IncidentNonDatadogCreator.
 type NullableIncidentNonDatadogCreator struct {
 	value *IncidentNonDatadogCreator
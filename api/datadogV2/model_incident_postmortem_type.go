@@ -58,6 +58,14 @@ func (v IncidentPostmortemType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentPostmortemType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentPostmortemType: valid values are %v", v, allowedIncidentPostmortemTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentPostmortemType value.
 func (v IncidentPostmortemType) Ptr() *IncidentPostmortemType {
 	return &v
@@ -60,6 +60,14 @@ func (v IncidentRelatedObject) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentRelatedObject) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentRelatedObject: valid values are %v", v, allowedIncidentRelatedObjectEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentRelatedObject value.
 func (v IncidentRelatedObject) Ptr() *IncidentRelatedObject {
 	return &v
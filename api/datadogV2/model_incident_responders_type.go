@@ -58,6 +58,14 @@ func (v IncidentRespondersType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentRespondersType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentRespondersType: valid values are %v", v, allowedIncidentRespondersTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentRespondersType value.
 func (v IncidentRespondersType) Ptr() *IncidentRespondersType {
 	return &v
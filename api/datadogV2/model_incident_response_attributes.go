@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -1036,3 +1037,16 @@ func (o *IncidentResponseAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentResponseAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("fields", o.Fields, nil),
+		datadog.ValidateValue("non_datadog_creator", o.NonDatadogCreator, nil),
+		datadog.ValidateValue("notification_handles", o.NotificationHandles, nil),
+		datadog.ValidateValue("severity", o.Severity, nil),
+	)
+}
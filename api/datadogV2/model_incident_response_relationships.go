@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -383,3 +385,20 @@ func (o *IncidentResponseRelationships) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentResponseRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("attachments", o.Attachments, nil),
+		datadog.ValidateValue("commander_user", o.CommanderUser, nil),
+		datadog.ValidateValue("created_by_user", o.CreatedByUser, nil),
+		datadog.ValidateValue("impacts", o.Impacts, nil),
+		datadog.ValidateValue("integrations", o.Integrations, nil),
+		datadog.ValidateValue("last_modified_by_user", o.LastModifiedByUser, nil),
+		datadog.ValidateValue("responders", o.Responders, nil),
+		datadog.ValidateValue("user_defined_fields", o.UserDefinedFields, nil),
+	)
+}
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -172,3 +173,15 @@ func (o *IncidentSearchResponseAttributes) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentSearchResponseAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("facets", o.Facets, nil),
+		datadog.ValidateValue("incidents", o.Incidents, nil),
+		datadog.ValidateValue("total", o.Total, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
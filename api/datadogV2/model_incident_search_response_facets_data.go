@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -450,3 +452,23 @@ func (o *IncidentSearchResponseFacetsData) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentSearchResponseFacetsData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("commander", o.Commander, nil),
+		datadog.ValidateValue("created_by", o.CreatedBy, nil),
+		datadog.ValidateValue("fields", o.Fields, nil),
+		datadog.ValidateValue("impact", o.Impact, nil),
+		datadog.ValidateValue("last_modified_by", o.LastModifiedBy, nil),
+		datadog.ValidateValue("postmortem", o.Postmortem, nil),
+		datadog.ValidateValue("responder", o.Responder, nil),
+		datadog.ValidateValue("severity", o.Severity, nil),
+		datadog.ValidateValue("state", o.State, nil),
+		datadog.ValidateValue("time_to_repair", o.TimeToRepair, nil),
+		datadog.ValidateValue("time_to_resolve", o.TimeToResolve, nil),
+	)
+}
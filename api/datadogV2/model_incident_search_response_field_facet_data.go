@@ -135,3 +135,11 @@ func (o *IncidentSearchResponseFieldFacetData) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentSearchResponseFieldFacetData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("count", o.Count, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)})
+}
@@ -140,3 +140,11 @@ func (o *IncidentSearchResponseNumericFacetData) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentSearchResponseNumericFacetData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("aggregates", o.Aggregates, nil)
+}
@@ -240,3 +240,11 @@ func (o *IncidentSearchResponseUserFacetData) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentSearchResponseUserFacetData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("count", o.Count, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)})
+}
@@ -58,6 +58,14 @@ func (v IncidentSearchResultsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentSearchResultsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentSearchResultsType: valid values are %v", v, allowedIncidentSearchResultsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentSearchResultsType value.
 func (v IncidentSearchResultsType) Ptr() *IncidentSearchResultsType {
 	return &v
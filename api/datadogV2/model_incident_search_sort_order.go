@@ -60,6 +60,14 @@ func (v IncidentSearchSortOrder) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentSearchSortOrder) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentSearchSortOrder: valid values are %v", v, allowedIncidentSearchSortOrderEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentSearchSortOrder value.
 func (v IncidentSearchSortOrder) Ptr() *IncidentSearchSortOrder {
 	return &v
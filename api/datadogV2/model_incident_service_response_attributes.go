@@ -180,3 +180,8 @@ func (o *IncidentServiceResponseAttributes) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentServiceResponseAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v IncidentServiceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentServiceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentServiceType: valid values are %v", v, allowedIncidentServiceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentServiceType value.
 func (v IncidentServiceType) Ptr() *IncidentServiceType {
 	return &v
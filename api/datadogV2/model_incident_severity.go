@@ -68,6 +68,14 @@ func (v IncidentSeverity) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentSeverity) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentSeverity: valid values are %v", v, allowedIncidentSeverityEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentSeverity value.
 func (v IncidentSeverity) Ptr() *IncidentSeverity {
 	return &v
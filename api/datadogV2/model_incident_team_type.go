@@ -58,6 +58,14 @@ func (v IncidentTeamType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentTeamType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentTeamType: valid values are %v", v, allowedIncidentTeamTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentTeamType value.
 func (v IncidentTeamType) Ptr() *IncidentTeamType {
 	return &v
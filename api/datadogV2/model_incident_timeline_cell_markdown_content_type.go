@@ -58,6 +58,14 @@ func (v IncidentTimelineCellMarkdownContentType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentTimelineCellMarkdownContentType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentTimelineCellMarkdownContentType: valid values are %v", v, allowedIncidentTimelineCellMarkdownContentTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentTimelineCellMarkdownContentType value.
 func (v IncidentTimelineCellMarkdownContentType) Ptr() *IncidentTimelineCellMarkdownContentType {
 	return &v
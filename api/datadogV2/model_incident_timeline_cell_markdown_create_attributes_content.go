@@ -100,3 +100,8 @@ func (o *IncidentTimelineCellMarkdownCreateAttributesContent) UnmarshalJSON(byte
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentTimelineCellMarkdownCreateAttributesContent) Validate() error {
+	return nil
+}
@@ -207,3 +207,11 @@ func (o *IncidentTodoAnonymousAssignee) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentTodoAnonymousAssignee) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("source", o.Source, nil)
+}
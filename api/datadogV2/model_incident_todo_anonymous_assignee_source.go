@@ -60,6 +60,14 @@ func (v IncidentTodoAnonymousAssigneeSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentTodoAnonymousAssigneeSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentTodoAnonymousAssigneeSource: valid values are %v", v, allowedIncidentTodoAnonymousAssigneeSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentTodoAnonymousAssigneeSource value.
 func (v IncidentTodoAnonymousAssigneeSource) Ptr() *IncidentTodoAnonymousAssigneeSource {
 	return &v
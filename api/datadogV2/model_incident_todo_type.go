@@ -58,6 +58,14 @@ func (v IncidentTodoType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentTodoType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentTodoType: valid values are %v", v, allowedIncidentTodoTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentTodoType value.
 func (v IncidentTodoType) Ptr() *IncidentTodoType {
 	return &v
@@ -58,6 +58,14 @@ func (v IncidentType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentType: valid values are %v", v, allowedIncidentTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentType value.
 func (v IncidentType) Ptr() *IncidentType {
 	return &v
@@ -357,3 +357,8 @@ func (o *IncidentTypeAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentTypeAttributes) Validate() error {
+	return nil
+}
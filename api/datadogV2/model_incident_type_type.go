@@ -58,6 +58,14 @@ func (v IncidentTypeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentTypeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentTypeType: valid values are %v", v, allowedIncidentTypeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentTypeType value.
 func (v IncidentTypeType) Ptr() *IncidentTypeType {
 	return &v
@@ -251,3 +251,8 @@ func (o *IncidentUserAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IncidentUserAttributes) Validate() error {
+	return nil
+}
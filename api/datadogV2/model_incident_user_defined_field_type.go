@@ -58,6 +58,14 @@ func (v IncidentUserDefinedFieldType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncidentUserDefinedFieldType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncidentUserDefinedFieldType: valid values are %v", v, allowedIncidentUserDefinedFieldTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncidentUserDefinedFieldType value.
 func (v IncidentUserDefinedFieldType) Ptr() *IncidentUserDefinedFieldType {
 	return &v
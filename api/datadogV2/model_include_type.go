@@ -66,6 +66,14 @@ func (v IncludeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IncludeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IncludeType: valid values are %v", v, allowedIncludeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IncludeType value.
 func (v IncludeType) Ptr() *IncludeType {
 	return &v
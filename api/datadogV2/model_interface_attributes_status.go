@@ -64,6 +64,14 @@ func (v InterfaceAttributesStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v InterfaceAttributesStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for InterfaceAttributesStatus: valid values are %v", v, allowedInterfaceAttributesStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to InterfaceAttributesStatus value.
 func (v InterfaceAttributesStatus) Ptr() *InterfaceAttributesStatus {
 	return &v
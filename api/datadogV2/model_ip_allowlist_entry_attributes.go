@@ -215,3 +215,8 @@ func (o *IPAllowlistEntryAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *IPAllowlistEntryAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v IPAllowlistEntryType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IPAllowlistEntryType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IPAllowlistEntryType: valid values are %v", v, allowedIPAllowlistEntryTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IPAllowlistEntryType value.
 func (v IPAllowlistEntryType) Ptr() *IPAllowlistEntryType {
 	return &v
@@ -58,6 +58,14 @@ func (v IPAllowlistType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v IPAllowlistType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for IPAllowlistType: valid values are %v", v, allowedIPAllowlistTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to IPAllowlistType value.
 func (v IPAllowlistType) Ptr() *IPAllowlistType {
 	return &v
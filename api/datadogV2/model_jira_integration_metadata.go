@@ -99,3 +99,11 @@ func (o *JiraIntegrationMetadata) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *JiraIntegrationMetadata) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("issues", o.Issues, nil)
+}
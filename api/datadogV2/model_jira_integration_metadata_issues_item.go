@@ -236,3 +236,8 @@ func (o *JiraIntegrationMetadataIssuesItem) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *JiraIntegrationMetadataIssuesItem) Validate() error {
+	return nil
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -149,6 +151,17 @@ func (o *JiraIssue) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *JiraIssue) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("result", o.Result, nil),
+		datadog.ValidateValue("status", o.Status, nil),
+	)
+}
+
 // NullableJiraIssue handles when a null is used for JiraIssue.
 type NullableJiraIssue struct {
 	value *JiraIssue
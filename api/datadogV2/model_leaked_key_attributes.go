@@ -139,3 +139,8 @@ func (o *LeakedKeyAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LeakedKeyAttributes) Validate() error {
+	return nil
+}
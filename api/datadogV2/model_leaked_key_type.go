@@ -58,6 +58,14 @@ func (v LeakedKeyType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LeakedKeyType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LeakedKeyType: valid values are %v", v, allowedLeakedKeyTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LeakedKeyType value.
 func (v LeakedKeyType) Ptr() *LeakedKeyType {
 	return &v
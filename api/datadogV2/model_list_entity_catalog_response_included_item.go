@@ -199,3 +199,11 @@ func (obj *ListEntityCatalogResponseIncludedItem) GetActualInstance() interface{
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *ListEntityCatalogResponseIncludedItem) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -129,3 +131,14 @@ func (o *ListFindingsMeta) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ListFindingsMeta) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("page", o.Page, nil),
+		datadog.ValidateValue("snapshot_timestamp", o.SnapshotTimestamp, &datadog.Constraints{Minimum: datadog.PtrFloat64(1)}),
+	)
+}
@@ -120,3 +120,8 @@ func (o *ListFindingsPage) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ListFindingsPage) Validate() error {
+	return nil
+}
@@ -100,3 +100,8 @@ func (o *ListRulesResponseLinks) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ListRulesResponseLinks) Validate() error {
+	return nil
+}
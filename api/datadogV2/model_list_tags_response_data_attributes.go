@@ -100,3 +100,8 @@ func (o *ListTagsResponseDataAttributes) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ListTagsResponseDataAttributes) Validate() error {
+	return nil
+}
@@ -60,6 +60,14 @@ func (v ListTeamsInclude) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ListTeamsInclude) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ListTeamsInclude: valid values are %v", v, allowedListTeamsIncludeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ListTeamsInclude value.
 func (v ListTeamsInclude) Ptr() *ListTeamsInclude {
 	return &v
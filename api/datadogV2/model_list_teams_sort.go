@@ -64,6 +64,14 @@ func (v ListTeamsSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ListTeamsSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ListTeamsSort: valid values are %v", v, allowedListTeamsSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ListTeamsSort value.
 func (v ListTeamsSort) Ptr() *ListTeamsSort {
 	return &v
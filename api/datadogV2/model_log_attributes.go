@@ -320,3 +320,8 @@ func (o *LogAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogAttributes) Validate() error {
+	return nil
+}
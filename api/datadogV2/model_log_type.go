@@ -58,6 +58,14 @@ func (v LogType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogType: valid values are %v", v, allowedLogTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogType value.
 func (v LogType) Ptr() *LogType {
 	return &v
@@ -135,3 +135,8 @@ func (o *LogsAggregateBucketValueTimeseriesPoint) UnmarshalJSON(bytes []byte) (e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsAggregateBucketValueTimeseriesPoint) Validate() error {
+	return nil
+}
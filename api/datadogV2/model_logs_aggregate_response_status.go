@@ -60,6 +60,14 @@ func (v LogsAggregateResponseStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsAggregateResponseStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsAggregateResponseStatus: valid values are %v", v, allowedLogsAggregateResponseStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsAggregateResponseStatus value.
 func (v LogsAggregateResponseStatus) Ptr() *LogsAggregateResponseStatus {
 	return &v
@@ -60,6 +60,14 @@ func (v LogsAggregateSortType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsAggregateSortType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsAggregateSortType: valid values are %v", v, allowedLogsAggregateSortTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsAggregateSortType value.
 func (v LogsAggregateSortType) Ptr() *LogsAggregateSortType {
 	return &v
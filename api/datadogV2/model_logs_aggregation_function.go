@@ -80,6 +80,14 @@ func (v LogsAggregationFunction) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsAggregationFunction) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsAggregationFunction: valid values are %v", v, allowedLogsAggregationFunctionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsAggregationFunction value.
 func (v LogsAggregationFunction) Ptr() *LogsAggregationFunction {
 	return &v
@@ -136,6 +136,14 @@ func (obj *LogsArchiveDestination) GetActualInstance() interface{} {
 	return nil
 }
 
+// Validate validates the actual instance.
+func (obj *LogsArchiveDestination) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
+
 // NullableLogsArchiveDestination handles when a null is used for LogsArchiveDestination.
 type NullableLogsArchiveDestination struct {
 	value *LogsArchiveDestination
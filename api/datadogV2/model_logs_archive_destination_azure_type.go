@@ -58,6 +58,14 @@ func (v LogsArchiveDestinationAzureType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsArchiveDestinationAzureType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsArchiveDestinationAzureType: valid values are %v", v, allowedLogsArchiveDestinationAzureTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsArchiveDestinationAzureType value.
 func (v LogsArchiveDestinationAzureType) Ptr() *LogsArchiveDestinationAzureType {
 	return &v
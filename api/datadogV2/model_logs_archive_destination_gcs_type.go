@@ -58,6 +58,14 @@ func (v LogsArchiveDestinationGCSType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsArchiveDestinationGCSType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsArchiveDestinationGCSType: valid values are %v", v, allowedLogsArchiveDestinationGCSTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsArchiveDestinationGCSType value.
 func (v LogsArchiveDestinationGCSType) Ptr() *LogsArchiveDestinationGCSType {
 	return &v
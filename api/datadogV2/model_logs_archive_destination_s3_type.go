@@ -58,6 +58,14 @@ func (v LogsArchiveDestinationS3Type) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsArchiveDestinationS3Type) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsArchiveDestinationS3Type: valid values are %v", v, allowedLogsArchiveDestinationS3TypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsArchiveDestinationS3Type value.
 func (v LogsArchiveDestinationS3Type) Ptr() *LogsArchiveDestinationS3Type {
 	return &v
@@ -58,6 +58,14 @@ func (v LogsArchiveOrderDefinitionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsArchiveOrderDefinitionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsArchiveOrderDefinitionType: valid values are %v", v, allowedLogsArchiveOrderDefinitionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsArchiveOrderDefinitionType value.
 func (v LogsArchiveOrderDefinitionType) Ptr() *LogsArchiveOrderDefinitionType {
 	return &v
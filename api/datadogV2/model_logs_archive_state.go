@@ -64,6 +64,14 @@ func (v LogsArchiveState) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsArchiveState) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsArchiveState: valid values are %v", v, allowedLogsArchiveStateEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsArchiveState value.
 func (v LogsArchiveState) Ptr() *LogsArchiveState {
 	return &v
@@ -60,6 +60,14 @@ func (v LogsComputeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsComputeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsComputeType: valid values are %v", v, allowedLogsComputeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsComputeType value.
 func (v LogsComputeType) Ptr() *LogsComputeType {
 	return &v
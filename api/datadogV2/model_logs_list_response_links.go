@@ -101,3 +101,8 @@ func (o *LogsListResponseLinks) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsListResponseLinks) Validate() error {
+	return nil
+}
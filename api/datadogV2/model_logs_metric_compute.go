@@ -180,3 +180,11 @@ func (o *LogsMetricCompute) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsMetricCompute) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("aggregation_type", o.AggregationType, nil)
+}
@@ -60,6 +60,14 @@ func (v LogsMetricComputeAggregationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsMetricComputeAggregationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsMetricComputeAggregationType: valid values are %v", v, allowedLogsMetricComputeAggregationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsMetricComputeAggregationType value.
 func (v LogsMetricComputeAggregationType) Ptr() *LogsMetricComputeAggregationType {
 	return &v
@@ -134,3 +134,8 @@ func (o *LogsMetricGroupBy) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsMetricGroupBy) Validate() error {
+	return nil
+}
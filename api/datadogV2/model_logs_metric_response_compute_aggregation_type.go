@@ -60,6 +60,14 @@ func (v LogsMetricResponseComputeAggregationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsMetricResponseComputeAggregationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsMetricResponseComputeAggregationType: valid values are %v", v, allowedLogsMetricResponseComputeAggregationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsMetricResponseComputeAggregationType value.
 func (v LogsMetricResponseComputeAggregationType) Ptr() *LogsMetricResponseComputeAggregationType {
 	return &v
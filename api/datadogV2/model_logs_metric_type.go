@@ -58,6 +58,14 @@ func (v LogsMetricType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsMetricType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsMetricType: valid values are %v", v, allowedLogsMetricTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsMetricType value.
 func (v LogsMetricType) Ptr() *LogsMetricType {
 	return &v
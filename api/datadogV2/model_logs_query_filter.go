@@ -266,3 +266,11 @@ func (o *LogsQueryFilter) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsQueryFilter) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("storage_tier", o.StorageTier, nil)
+}
@@ -140,3 +140,8 @@ func (o *LogsQueryOptions) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsQueryOptions) Validate() error {
+	return nil
+}
@@ -101,3 +101,8 @@ func (o *LogsResponseMetadataPage) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *LogsResponseMetadataPage) Validate() error {
+	return nil
+}
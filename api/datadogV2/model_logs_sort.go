@@ -60,6 +60,14 @@ func (v LogsSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsSort: valid values are %v", v, allowedLogsSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsSort value.
 func (v LogsSort) Ptr() *LogsSort {
 	return &v
@@ -60,6 +60,14 @@ func (v LogsSortOrder) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsSortOrder) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsSortOrder: valid values are %v", v, allowedLogsSortOrderEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsSortOrder value.
 func (v LogsSortOrder) Ptr() *LogsSortOrder {
 	return &v
@@ -62,6 +62,14 @@ func (v LogsStorageTier) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v LogsStorageTier) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for LogsStorageTier: valid values are %v", v, allowedLogsStorageTierEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to LogsStorageTier value.
 func (v LogsStorageTier) Ptr() *LogsStorageTier {
 	return &v
@@ -58,6 +58,14 @@ func (v MetricActiveConfigurationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricActiveConfigurationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricActiveConfigurationType: valid values are %v", v, allowedMetricActiveConfigurationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricActiveConfigurationType value.
 func (v MetricActiveConfigurationType) Ptr() *MetricActiveConfigurationType {
 	return &v
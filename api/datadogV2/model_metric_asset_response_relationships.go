@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -223,3 +225,16 @@ func (o *MetricAssetResponseRelationships) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricAssetResponseRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("dashboards", o.Dashboards, nil),
+		datadog.ValidateValue("monitors", o.Monitors, nil),
+		datadog.ValidateValue("notebooks", o.Notebooks, nil),
+		datadog.ValidateValue("slos", o.Slos, nil),
+	)
+}
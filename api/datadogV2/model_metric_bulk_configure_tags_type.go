@@ -58,6 +58,14 @@ func (v MetricBulkConfigureTagsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricBulkConfigureTagsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricBulkConfigureTagsType: valid values are %v", v, allowedMetricBulkConfigureTagsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricBulkConfigureTagsType value.
 func (v MetricBulkConfigureTagsType) Ptr() *MetricBulkConfigureTagsType {
 	return &v
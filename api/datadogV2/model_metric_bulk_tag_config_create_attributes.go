@@ -172,3 +172,11 @@ func (o *MetricBulkTagConfigCreateAttributes) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricBulkTagConfigCreateAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("tags", o.Tags, &datadog.Constraints{Items: &datadog.Constraints{MaxLength: datadog.PtrInt64(200), Pattern: `^[A-Za-z][A-Za-z0-9\.\-\_:\/]*$`}})
+}
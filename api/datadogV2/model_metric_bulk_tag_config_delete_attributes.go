@@ -100,3 +100,8 @@ func (o *MetricBulkTagConfigDeleteAttributes) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricBulkTagConfigDeleteAttributes) Validate() error {
+	return nil
+}
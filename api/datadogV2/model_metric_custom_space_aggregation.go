@@ -64,6 +64,14 @@ func (v MetricCustomSpaceAggregation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricCustomSpaceAggregation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricCustomSpaceAggregation: valid values are %v", v, allowedMetricCustomSpaceAggregationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricCustomSpaceAggregation value.
 func (v MetricCustomSpaceAggregation) Ptr() *MetricCustomSpaceAggregation {
 	return &v
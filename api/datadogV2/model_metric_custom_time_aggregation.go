@@ -66,6 +66,14 @@ func (v MetricCustomTimeAggregation) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricCustomTimeAggregation) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricCustomTimeAggregation: valid values are %v", v, allowedMetricCustomTimeAggregationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricCustomTimeAggregation value.
 func (v MetricCustomTimeAggregation) Ptr() *MetricCustomTimeAggregation {
 	return &v
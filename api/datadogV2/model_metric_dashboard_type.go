@@ -58,6 +58,14 @@ func (v MetricDashboardType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricDashboardType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricDashboardType: valid values are %v", v, allowedMetricDashboardTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricDashboardType value.
 func (v MetricDashboardType) Ptr() *MetricDashboardType {
 	return &v
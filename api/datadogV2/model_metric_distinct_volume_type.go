@@ -58,6 +58,14 @@ func (v MetricDistinctVolumeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricDistinctVolumeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricDistinctVolumeType: valid values are %v", v, allowedMetricDistinctVolumeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricDistinctVolumeType value.
 func (v MetricDistinctVolumeType) Ptr() *MetricDistinctVolumeType {
 	return &v
@@ -58,6 +58,14 @@ func (v MetricEstimateResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricEstimateResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricEstimateResourceType: valid values are %v", v, allowedMetricEstimateResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricEstimateResourceType value.
 func (v MetricEstimateResourceType) Ptr() *MetricEstimateResourceType {
 	return &v
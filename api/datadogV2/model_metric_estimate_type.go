@@ -62,6 +62,14 @@ func (v MetricEstimateType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricEstimateType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricEstimateType: valid values are %v", v, allowedMetricEstimateTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricEstimateType value.
 func (v MetricEstimateType) Ptr() *MetricEstimateType {
 	return &v
@@ -135,3 +135,8 @@ func (o *MetricIngestedIndexedVolumeAttributes) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricIngestedIndexedVolumeAttributes) Validate() error {
+	return nil
+}
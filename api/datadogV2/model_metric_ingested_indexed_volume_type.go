@@ -58,6 +58,14 @@ func (v MetricIngestedIndexedVolumeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricIngestedIndexedVolumeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricIngestedIndexedVolumeType: valid values are %v", v, allowedMetricIngestedIndexedVolumeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricIngestedIndexedVolumeType value.
 func (v MetricIngestedIndexedVolumeType) Ptr() *MetricIngestedIndexedVolumeType {
 	return &v
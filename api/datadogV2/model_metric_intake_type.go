@@ -64,6 +64,14 @@ func (v MetricIntakeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricIntakeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricIntakeType: valid values are %v", v, allowedMetricIntakeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricIntakeType value.
 func (v MetricIntakeType) Ptr() *MetricIntakeType {
 	return &v
@@ -58,6 +58,14 @@ func (v MetricMonitorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricMonitorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricMonitorType: valid values are %v", v, allowedMetricMonitorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricMonitorType value.
 func (v MetricMonitorType) Ptr() *MetricMonitorType {
 	return &v
@@ -58,6 +58,14 @@ func (v MetricNotebookType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricNotebookType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricNotebookType: valid values are %v", v, allowedMetricNotebookTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricNotebookType value.
 func (v MetricNotebookType) Ptr() *MetricNotebookType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -182,3 +184,15 @@ func (o *MetricOrigin) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricOrigin) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("metric_type", o.MetricType, &datadog.Constraints{Maximum: datadog.PtrFloat64(1000)}),
+		datadog.ValidateValue("product", o.Product, &datadog.Constraints{Maximum: datadog.PtrFloat64(1000)}),
+		datadog.ValidateValue("service", o.Service, &datadog.Constraints{Maximum: datadog.PtrFloat64(1000)}),
+	)
+}
@@ -99,3 +99,11 @@ func (o *MetricPayload) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricPayload) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("series", o.Series, nil)
+}
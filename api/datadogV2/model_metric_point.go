@@ -136,3 +136,8 @@ func (o *MetricPoint) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MetricPoint) Validate() error {
+	return nil
+}
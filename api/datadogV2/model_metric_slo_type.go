@@ -58,6 +58,14 @@ func (v MetricSLOType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricSLOType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricSLOType: valid values are %v", v, allowedMetricSLOTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricSLOType value.
 func (v MetricSLOType) Ptr() *MetricSLOType {
 	return &v
@@ -60,6 +60,14 @@ func (v MetricTagConfigurationMetricTypeCategory) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricTagConfigurationMetricTypeCategory) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricTagConfigurationMetricTypeCategory: valid values are %v", v, allowedMetricTagConfigurationMetricTypeCategoryEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricTagConfigurationMetricTypeCategory value.
 func (v MetricTagConfigurationMetricTypeCategory) Ptr() *MetricTagConfigurationMetricTypeCategory {
 	return &v
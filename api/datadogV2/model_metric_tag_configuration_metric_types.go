@@ -64,6 +64,14 @@ func (v MetricTagConfigurationMetricTypes) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricTagConfigurationMetricTypes) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricTagConfigurationMetricTypes: valid values are %v", v, allowedMetricTagConfigurationMetricTypesEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricTagConfigurationMetricTypes value.
 func (v MetricTagConfigurationMetricTypes) Ptr() *MetricTagConfigurationMetricTypes {
 	return &v
@@ -58,6 +58,14 @@ func (v MetricTagConfigurationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricTagConfigurationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricTagConfigurationType: valid values are %v", v, allowedMetricTagConfigurationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricTagConfigurationType value.
 func (v MetricTagConfigurationType) Ptr() *MetricTagConfigurationType {
 	return &v
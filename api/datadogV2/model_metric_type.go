@@ -58,6 +58,14 @@ func (v MetricType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricType: valid values are %v", v, allowedMetricTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricType value.
 func (v MetricType) Ptr() *MetricType {
 	return &v
@@ -74,6 +74,14 @@ func (v MetricsAggregator) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricsAggregator) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricsAggregator: valid values are %v", v, allowedMetricsAggregatorEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricsAggregator value.
 func (v MetricsAggregator) Ptr() *MetricsAggregator {
 	return &v
@@ -60,6 +60,14 @@ func (v MetricsDataSource) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MetricsDataSource) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MetricsDataSource: valid values are %v", v, allowedMetricsDataSourceEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MetricsDataSource value.
 func (v MetricsDataSource) Ptr() *MetricsDataSource {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -170,3 +172,14 @@ func (o *MicrosoftTeamsChannelInfoResponseAttributes) UnmarshalJSON(bytes []byte
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MicrosoftTeamsChannelInfoResponseAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("team_id", o.TeamId, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("tenant_id", o.TenantId, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+	)
+}
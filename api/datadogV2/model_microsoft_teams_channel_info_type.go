@@ -58,6 +58,14 @@ func (v MicrosoftTeamsChannelInfoType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MicrosoftTeamsChannelInfoType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MicrosoftTeamsChannelInfoType: valid values are %v", v, allowedMicrosoftTeamsChannelInfoTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MicrosoftTeamsChannelInfoType value.
 func (v MicrosoftTeamsChannelInfoType) Ptr() *MicrosoftTeamsChannelInfoType {
 	return &v
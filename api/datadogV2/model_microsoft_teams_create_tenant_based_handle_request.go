@@ -108,3 +108,11 @@ func (o *MicrosoftTeamsCreateTenantBasedHandleRequest) UnmarshalJSON(bytes []byt
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MicrosoftTeamsCreateTenantBasedHandleRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("data", o.Data, nil)
+}
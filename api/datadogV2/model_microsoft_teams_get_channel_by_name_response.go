@@ -109,3 +109,11 @@ func (o *MicrosoftTeamsGetChannelByNameResponse) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MicrosoftTeamsGetChannelByNameResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("data", o.Data, nil)
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -310,3 +312,19 @@ func (o *MicrosoftTeamsTenantBasedHandleInfoResponseAttributes) UnmarshalJSON(by
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MicrosoftTeamsTenantBasedHandleInfoResponseAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("channel_id", o.ChannelId, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("channel_name", o.ChannelName, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("team_id", o.TeamId, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("team_name", o.TeamName, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("tenant_id", o.TenantId, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("tenant_name", o.TenantName, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+	)
+}
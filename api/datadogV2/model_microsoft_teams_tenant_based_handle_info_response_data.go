@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -187,3 +189,15 @@ func (o *MicrosoftTeamsTenantBasedHandleInfoResponseData) UnmarshalJSON(bytes []
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MicrosoftTeamsTenantBasedHandleInfoResponseData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("attributes", o.Attributes, nil),
+		datadog.ValidateValue("id", o.Id, &datadog.Constraints{MinLength: datadog.PtrInt64(1), MaxLength: datadog.PtrInt64(100)}),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v MicrosoftTeamsTenantBasedHandleInfoType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MicrosoftTeamsTenantBasedHandleInfoType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MicrosoftTeamsTenantBasedHandleInfoType: valid values are %v", v, allowedMicrosoftTeamsTenantBasedHandleInfoTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MicrosoftTeamsTenantBasedHandleInfoType value.
 func (v MicrosoftTeamsTenantBasedHandleInfoType) Ptr() *MicrosoftTeamsTenantBasedHandleInfoType {
 	return &v
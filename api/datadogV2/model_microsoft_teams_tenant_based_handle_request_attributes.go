@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -195,3 +196,16 @@ func (o *MicrosoftTeamsTenantBasedHandleRequestAttributes) UnmarshalJSON(bytes [
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MicrosoftTeamsTenantBasedHandleRequestAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("channel_id", o.ChannelId, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("team_id", o.TeamId, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("tenant_id", o.TenantId, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+	)
+}
@@ -58,6 +58,14 @@ func (v MicrosoftTeamsTenantBasedHandleType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MicrosoftTeamsTenantBasedHandleType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MicrosoftTeamsTenantBasedHandleType: valid values are %v", v, allowedMicrosoftTeamsTenantBasedHandleTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MicrosoftTeamsTenantBasedHandleType value.
 func (v MicrosoftTeamsTenantBasedHandleType) Ptr() *MicrosoftTeamsTenantBasedHandleType {
 	return &v
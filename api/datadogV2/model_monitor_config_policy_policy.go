@@ -71,3 +71,11 @@ func (obj *MonitorConfigPolicyPolicy) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *MonitorConfigPolicyPolicy) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
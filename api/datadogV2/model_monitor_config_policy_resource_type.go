@@ -58,6 +58,14 @@ func (v MonitorConfigPolicyResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorConfigPolicyResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorConfigPolicyResourceType: valid values are %v", v, allowedMonitorConfigPolicyResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorConfigPolicyResourceType value.
 func (v MonitorConfigPolicyResourceType) Ptr() *MonitorConfigPolicyResourceType {
 	return &v
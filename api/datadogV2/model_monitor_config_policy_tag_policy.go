@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -170,3 +172,14 @@ func (o *MonitorConfigPolicyTagPolicy) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorConfigPolicyTagPolicy) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("tag_key", o.TagKey, &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}),
+		datadog.ValidateValue("valid_tag_values", o.ValidTagValues, &datadog.Constraints{Items: &datadog.Constraints{MaxLength: datadog.PtrInt64(255)}}),
+	)
+}
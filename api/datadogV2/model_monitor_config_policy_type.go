@@ -58,6 +58,14 @@ func (v MonitorConfigPolicyType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorConfigPolicyType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorConfigPolicyType: valid values are %v", v, allowedMonitorConfigPolicyTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorConfigPolicyType value.
 func (v MonitorConfigPolicyType) Ptr() *MonitorConfigPolicyType {
 	return &v
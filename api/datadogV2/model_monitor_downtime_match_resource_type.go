@@ -58,6 +58,14 @@ func (v MonitorDowntimeMatchResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v MonitorDowntimeMatchResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for MonitorDowntimeMatchResourceType: valid values are %v", v, allowedMonitorDowntimeMatchResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to MonitorDowntimeMatchResourceType value.
 func (v MonitorDowntimeMatchResourceType) Ptr() *MonitorDowntimeMatchResourceType {
 	return &v
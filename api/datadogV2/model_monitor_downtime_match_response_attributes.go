@@ -222,3 +222,8 @@ func (o *MonitorDowntimeMatchResponseAttributes) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorDowntimeMatchResponseAttributes) Validate() error {
+	return nil
+}
@@ -451,6 +451,14 @@ func (o *MonitorType) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonitorType) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("group_status", o.GroupStatus, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)})
+}
+
 // NullableMonitorType handles when a null is used for MonitorType.
 type NullableMonitorType struct {
 	value *MonitorType
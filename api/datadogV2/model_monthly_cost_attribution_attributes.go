@@ -320,3 +320,8 @@ func (o *MonthlyCostAttributionAttributes) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MonthlyCostAttributionAttributes) Validate() error {
+	return nil
+}
@@ -195,3 +195,8 @@ func (o *MSTeamsIntegrationMetadataTeamsItem) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *MSTeamsIntegrationMetadataTeamsItem) Validate() error {
+	return nil
+}
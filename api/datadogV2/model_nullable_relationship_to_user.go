@@ -102,6 +102,14 @@ func (o *NullableRelationshipToUser) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NullableRelationshipToUser) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("data", o.Data, nil)
+}
+
 // NullableNullableRelationshipToUser handles when a null is used for NullableRelationshipToUser.
 type NullableNullableRelationshipToUser struct {
 	value *NullableRelationshipToUser
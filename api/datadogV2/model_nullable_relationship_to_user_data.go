@@ -144,6 +144,14 @@ func (o *NullableRelationshipToUserData) UnmarshalJSON(bytes []byte) (err error)
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NullableRelationshipToUserData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
+
 // NullableNullableRelationshipToUserData handles when a null is used for NullableRelationshipToUserData.
 type NullableNullableRelationshipToUserData struct {
 	value *NullableRelationshipToUserData
@@ -102,6 +102,14 @@ func (o *NullableUserRelationship) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NullableUserRelationship) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("data", o.Data, nil)
+}
+
 // NullableNullableUserRelationship handles when a null is used for NullableUserRelationship.
 type NullableNullableUserRelationship struct {
 	value *NullableUserRelationship
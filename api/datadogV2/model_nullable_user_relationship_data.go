@@ -144,6 +144,14 @@ func (o *NullableUserRelationshipData) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *NullableUserRelationshipData) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("type", o.Type, nil)
+}
+
 // NullableNullableUserRelationshipData handles when a null is used for NullableUserRelationshipData.
 type NullableNullableUserRelationshipData struct {
 	value *NullableUserRelationshipData
@@ -268,3 +268,8 @@ func (o *OktaAccountAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OktaAccountAttributes) Validate() error {
+	return nil
+}
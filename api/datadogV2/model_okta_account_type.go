@@ -58,6 +58,14 @@ func (v OktaAccountType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OktaAccountType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OktaAccountType: valid values are %v", v, allowedOktaAccountTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OktaAccountType value.
 func (v OktaAccountType) Ptr() *OktaAccountType {
 	return &v
@@ -236,3 +236,8 @@ func (o *OktaAccountUpdateRequestAttributes) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OktaAccountUpdateRequestAttributes) Validate() error {
+	return nil
+}
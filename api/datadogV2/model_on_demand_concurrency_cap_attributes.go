@@ -100,3 +100,8 @@ func (o *OnDemandConcurrencyCapAttributes) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OnDemandConcurrencyCapAttributes) Validate() error {
+	return nil
+}
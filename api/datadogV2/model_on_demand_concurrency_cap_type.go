@@ -58,6 +58,14 @@ func (v OnDemandConcurrencyCapType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OnDemandConcurrencyCapType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OnDemandConcurrencyCapType: valid values are %v", v, allowedOnDemandConcurrencyCapTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OnDemandConcurrencyCapType value.
 func (v OnDemandConcurrencyCapType) Ptr() *OnDemandConcurrencyCapType {
 	return &v
@@ -102,3 +102,8 @@ func (o *OpenAPIFile) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OpenAPIFile) Validate() error {
+	return nil
+}
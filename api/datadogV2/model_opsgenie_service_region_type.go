@@ -62,6 +62,14 @@ func (v OpsgenieServiceRegionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OpsgenieServiceRegionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OpsgenieServiceRegionType: valid values are %v", v, allowedOpsgenieServiceRegionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OpsgenieServiceRegionType value.
 func (v OpsgenieServiceRegionType) Ptr() *OpsgenieServiceRegionType {
 	return &v
@@ -58,6 +58,14 @@ func (v OpsgenieServiceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OpsgenieServiceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OpsgenieServiceType: valid values are %v", v, allowedOpsgenieServiceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OpsgenieServiceType value.
 func (v OpsgenieServiceType) Ptr() *OpsgenieServiceType {
 	return &v
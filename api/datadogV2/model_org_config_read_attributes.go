@@ -242,3 +242,8 @@ func (o *OrgConfigReadAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrgConfigReadAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v OrgConfigType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OrgConfigType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OrgConfigType: valid values are %v", v, allowedOrgConfigTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OrgConfigType value.
 func (v OrgConfigType) Ptr() *OrgConfigType {
 	return &v
@@ -99,3 +99,8 @@ func (o *OrgConfigWriteAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrgConfigWriteAttributes) Validate() error {
+	return nil
+}
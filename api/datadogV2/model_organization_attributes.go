@@ -355,3 +355,8 @@ func (o *OrganizationAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OrganizationAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v OrganizationsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OrganizationsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OrganizationsType: valid values are %v", v, allowedOrganizationsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OrganizationsType value.
 func (v OrganizationsType) Ptr() *OrganizationsType {
 	return &v
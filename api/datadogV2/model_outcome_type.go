@@ -58,6 +58,14 @@ func (v OutcomeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OutcomeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OutcomeType: valid values are %v", v, allowedOutcomeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OutcomeType value.
 func (v OutcomeType) Ptr() *OutcomeType {
 	return &v
@@ -208,3 +208,11 @@ func (o *OutcomesBatchRequestItem) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OutcomesBatchRequestItem) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("state", o.State, nil)
+}
@@ -58,6 +58,14 @@ func (v OutcomesBatchType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v OutcomesBatchType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for OutcomesBatchType: valid values are %v", v, allowedOutcomesBatchTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to OutcomesBatchType value.
 func (v OutcomesBatchType) Ptr() *OutcomesBatchType {
 	return &v
@@ -135,3 +135,8 @@ func (o *OutcomesResponseIncludedRuleAttributes) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *OutcomesResponseIncludedRuleAttributes) Validate() error {
+	return nil
+}
@@ -316,3 +316,8 @@ func (o *PermissionAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *PermissionAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v PermissionsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v PermissionsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for PermissionsType: valid values are %v", v, allowedPermissionsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to PermissionsType value.
 func (v PermissionsType) Ptr() *PermissionsType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -245,3 +246,15 @@ func (o *PowerpackAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *PowerpackAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("group_widget", o.GroupWidget, nil),
+		datadog.ValidateValue("tags", o.Tags, &datadog.Constraints{MaxItems: datadog.PtrInt64(8), Items: &datadog.Constraints{MaxLength: datadog.PtrInt64(80)}}),
+		datadog.ValidateValue("template_variables", o.TemplateVariables, nil),
+	)
+}
@@ -233,3 +233,11 @@ func (o *PowerpackGroupWidgetDefinition) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *PowerpackGroupWidgetDefinition) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("widgets", o.Widgets, nil)
+}
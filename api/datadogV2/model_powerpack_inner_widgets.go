@@ -143,3 +143,11 @@ func (o *PowerpackInnerWidgets) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *PowerpackInnerWidgets) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("layout", o.Layout, nil)
+}
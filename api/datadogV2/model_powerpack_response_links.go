@@ -262,3 +262,8 @@ func (o *PowerpackResponseLinks) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *PowerpackResponseLinks) Validate() error {
+	return nil
+}
@@ -356,3 +356,8 @@ func (o *PowerpacksResponseMetaPagination) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *PowerpacksResponseMetaPagination) Validate() error {
+	return nil
+}
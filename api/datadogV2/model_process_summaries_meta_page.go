@@ -136,3 +136,11 @@ func (o *ProcessSummariesMetaPage) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ProcessSummariesMetaPage) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("size", o.Size, &datadog.Constraints{Minimum: datadog.PtrFloat64(0), Maximum: datadog.PtrFloat64(10000)})
+}
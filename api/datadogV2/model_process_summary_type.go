@@ -58,6 +58,14 @@ func (v ProcessSummaryType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ProcessSummaryType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ProcessSummaryType: valid values are %v", v, allowedProcessSummaryTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ProcessSummaryType value.
 func (v ProcessSummaryType) Ptr() *ProcessSummaryType {
 	return &v
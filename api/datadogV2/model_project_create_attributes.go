@@ -131,3 +131,8 @@ func (o *ProjectCreateAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ProjectCreateAttributes) Validate() error {
+	return nil
+}
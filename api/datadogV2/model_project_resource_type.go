@@ -58,6 +58,14 @@ func (v ProjectResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ProjectResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ProjectResourceType: valid values are %v", v, allowedProjectResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ProjectResourceType value.
 func (v ProjectResourceType) Ptr() *ProjectResourceType {
 	return &v
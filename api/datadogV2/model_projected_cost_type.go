@@ -58,6 +58,14 @@ func (v ProjectedCostType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ProjectedCostType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ProjectedCostType: valid values are %v", v, allowedProjectedCostTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ProjectedCostType value.
 func (v ProjectedCostType) Ptr() *ProjectedCostType {
 	return &v
@@ -76,6 +76,14 @@ func (v RelationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RelationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RelationType: valid values are %v", v, allowedRelationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RelationType value.
 func (v RelationType) Ptr() *RelationType {
 	return &v
@@ -58,6 +58,14 @@ func (v RestrictionPolicyType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RestrictionPolicyType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RestrictionPolicyType: valid values are %v", v, allowedRestrictionPolicyTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RestrictionPolicyType value.
 func (v RestrictionPolicyType) Ptr() *RestrictionPolicyType {
 	return &v
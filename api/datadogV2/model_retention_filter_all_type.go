@@ -62,6 +62,14 @@ func (v RetentionFilterAllType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RetentionFilterAllType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RetentionFilterAllType: valid values are %v", v, allowedRetentionFilterAllTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RetentionFilterAllType value.
 func (v RetentionFilterAllType) Ptr() *RetentionFilterAllType {
 	return &v
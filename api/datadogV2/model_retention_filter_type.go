@@ -58,6 +58,14 @@ func (v RetentionFilterType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RetentionFilterType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RetentionFilterType: valid values are %v", v, allowedRetentionFilterTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RetentionFilterType value.
 func (v RetentionFilterType) Ptr() *RetentionFilterType {
 	return &v
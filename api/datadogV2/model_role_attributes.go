@@ -215,3 +215,8 @@ func (o *RoleAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RoleAttributes) Validate() error {
+	return nil
+}
@@ -178,3 +178,8 @@ func (o *RoleCreateAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RoleCreateAttributes) Validate() error {
+	return nil
+}
@@ -215,3 +215,11 @@ func (o *RoleUpdateAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RoleUpdateAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("user_count", o.UserCount, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)})
+}
@@ -68,6 +68,14 @@ func (v RolesSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RolesSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RolesSort: valid values are %v", v, allowedRolesSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RolesSort value.
 func (v RolesSort) Ptr() *RolesSort {
 	return &v
@@ -58,6 +58,14 @@ func (v RolesType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RolesType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RolesType: valid values are %v", v, allowedRolesTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RolesType value.
 func (v RolesType) Ptr() *RolesType {
 	return &v
@@ -394,3 +394,8 @@ func (o *RuleAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RuleAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v RuleType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RuleType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RuleType: valid values are %v", v, allowedRuleTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RuleType value.
 func (v RuleType) Ptr() *RuleType {
 	return &v
@@ -61,3 +61,11 @@ func (o *RUMAggregateBucketValueTimeseries) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RUMAggregateBucketValueTimeseries) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", o.Items, nil)
+}
@@ -141,3 +141,8 @@ func (o *RUMAggregateBucketValueTimeseriesPoint) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RUMAggregateBucketValueTimeseriesPoint) Validate() error {
+	return nil
+}
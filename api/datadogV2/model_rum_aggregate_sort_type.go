@@ -60,6 +60,14 @@ func (v RUMAggregateSortType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMAggregateSortType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMAggregateSortType: valid values are %v", v, allowedRUMAggregateSortTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMAggregateSortType value.
 func (v RUMAggregateSortType) Ptr() *RUMAggregateSortType {
 	return &v
@@ -80,6 +80,14 @@ func (v RUMAggregationFunction) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMAggregationFunction) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMAggregationFunction: valid values are %v", v, allowedRUMAggregationFunctionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMAggregationFunction value.
 func (v RUMAggregationFunction) Ptr() *RUMAggregationFunction {
 	return &v
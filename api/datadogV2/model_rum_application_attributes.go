@@ -425,3 +425,11 @@ func (o *RUMApplicationAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RUMApplicationAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("org_id", o.OrgId, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)})
+}
@@ -58,6 +58,14 @@ func (v RUMApplicationCreateType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMApplicationCreateType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMApplicationCreateType: valid values are %v", v, allowedRUMApplicationCreateTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMApplicationCreateType value.
 func (v RUMApplicationCreateType) Ptr() *RUMApplicationCreateType {
 	return &v
@@ -58,6 +58,14 @@ func (v RUMApplicationListType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMApplicationListType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMApplicationListType: valid values are %v", v, allowedRUMApplicationListTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMApplicationListType value.
 func (v RUMApplicationListType) Ptr() *RUMApplicationListType {
 	return &v
@@ -58,6 +58,14 @@ func (v RUMApplicationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMApplicationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMApplicationType: valid values are %v", v, allowedRUMApplicationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMApplicationType value.
 func (v RUMApplicationType) Ptr() *RUMApplicationType {
 	return &v
@@ -58,6 +58,14 @@ func (v RUMApplicationUpdateType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMApplicationUpdateType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMApplicationUpdateType: valid values are %v", v, allowedRUMApplicationUpdateTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMApplicationUpdateType value.
 func (v RUMApplicationUpdateType) Ptr() *RUMApplicationUpdateType {
 	return &v
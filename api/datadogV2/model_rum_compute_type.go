@@ -60,6 +60,14 @@ func (v RUMComputeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMComputeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMComputeType: valid values are %v", v, allowedRUMComputeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMComputeType value.
 func (v RUMComputeType) Ptr() *RUMComputeType {
 	return &v
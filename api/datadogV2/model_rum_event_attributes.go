@@ -213,3 +213,8 @@ func (o *RUMEventAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RUMEventAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v RUMEventType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMEventType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMEventType: valid values are %v", v, allowedRUMEventTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMEventType value.
 func (v RUMEventType) Ptr() *RUMEventType {
 	return &v
@@ -60,6 +60,14 @@ func (v RumMetricComputeAggregationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RumMetricComputeAggregationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RumMetricComputeAggregationType: valid values are %v", v, allowedRumMetricComputeAggregationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RumMetricComputeAggregationType value.
 func (v RumMetricComputeAggregationType) Ptr() *RumMetricComputeAggregationType {
 	return &v
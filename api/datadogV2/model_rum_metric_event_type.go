@@ -70,6 +70,14 @@ func (v RumMetricEventType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RumMetricEventType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RumMetricEventType: valid values are %v", v, allowedRumMetricEventTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RumMetricEventType value.
 func (v RumMetricEventType) Ptr() *RumMetricEventType {
 	return &v
@@ -110,3 +110,11 @@ func (o *RumMetricResponseUniqueness) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RumMetricResponseUniqueness) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("when", o.When, nil)
+}
@@ -58,6 +58,14 @@ func (v RumMetricType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RumMetricType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RumMetricType: valid values are %v", v, allowedRumMetricTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RumMetricType value.
 func (v RumMetricType) Ptr() *RumMetricType {
 	return &v
@@ -60,6 +60,14 @@ func (v RumMetricUniquenessWhen) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RumMetricUniquenessWhen) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RumMetricUniquenessWhen: valid values are %v", v, allowedRumMetricUniquenessWhenEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RumMetricUniquenessWhen value.
 func (v RumMetricUniquenessWhen) Ptr() *RumMetricUniquenessWhen {
 	return &v
@@ -182,3 +182,8 @@ func (o *RUMQueryFilter) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RUMQueryFilter) Validate() error {
+	return nil
+}
@@ -140,3 +140,8 @@ func (o *RUMQueryOptions) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *RUMQueryOptions) Validate() error {
+	return nil
+}
@@ -60,6 +60,14 @@ func (v RUMResponseStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMResponseStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMResponseStatus: valid values are %v", v, allowedRUMResponseStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMResponseStatus value.
 func (v RUMResponseStatus) Ptr() *RUMResponseStatus {
 	return &v
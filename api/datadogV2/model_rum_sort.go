@@ -60,6 +60,14 @@ func (v RUMSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMSort: valid values are %v", v, allowedRUMSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMSort value.
 func (v RUMSort) Ptr() *RUMSort {
 	return &v
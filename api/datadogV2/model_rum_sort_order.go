@@ -60,6 +60,14 @@ func (v RUMSortOrder) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v RUMSortOrder) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for RUMSortOrder: valid values are %v", v, allowedRUMSortOrderEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to RUMSortOrder value.
 func (v RUMSortOrder) Ptr() *RUMSortOrder {
 	return &v
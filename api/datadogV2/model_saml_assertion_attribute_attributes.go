@@ -135,3 +135,8 @@ func (o *SAMLAssertionAttributeAttributes) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SAMLAssertionAttributeAttributes) Validate() error {
+	return nil
+}
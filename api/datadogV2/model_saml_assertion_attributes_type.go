@@ -58,6 +58,14 @@ func (v SAMLAssertionAttributesType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SAMLAssertionAttributesType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SAMLAssertionAttributesType: valid values are %v", v, allowedSAMLAssertionAttributesTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SAMLAssertionAttributesType value.
 func (v SAMLAssertionAttributesType) Ptr() *SAMLAssertionAttributesType {
 	return &v
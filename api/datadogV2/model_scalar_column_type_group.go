@@ -58,6 +58,14 @@ func (v ScalarColumnTypeGroup) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ScalarColumnTypeGroup) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ScalarColumnTypeGroup: valid values are %v", v, allowedScalarColumnTypeGroupEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ScalarColumnTypeGroup value.
 func (v ScalarColumnTypeGroup) Ptr() *ScalarColumnTypeGroup {
 	return &v
@@ -58,6 +58,14 @@ func (v ScalarColumnTypeNumber) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ScalarColumnTypeNumber) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ScalarColumnTypeNumber: valid values are %v", v, allowedScalarColumnTypeNumberEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ScalarColumnTypeNumber value.
 func (v ScalarColumnTypeNumber) Ptr() *ScalarColumnTypeNumber {
 	return &v
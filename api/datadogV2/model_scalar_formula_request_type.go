@@ -58,6 +58,14 @@ func (v ScalarFormulaRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ScalarFormulaRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ScalarFormulaRequestType: valid values are %v", v, allowedScalarFormulaRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ScalarFormulaRequestType value.
 func (v ScalarFormulaRequestType) Ptr() *ScalarFormulaRequestType {
 	return &v
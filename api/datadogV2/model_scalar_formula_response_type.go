@@ -58,6 +58,14 @@ func (v ScalarFormulaResponseType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ScalarFormulaResponseType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ScalarFormulaResponseType: valid values are %v", v, allowedScalarFormulaResponseTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ScalarFormulaResponseType value.
 func (v ScalarFormulaResponseType) Ptr() *ScalarFormulaResponseType {
 	return &v
@@ -104,3 +104,11 @@ func (o *ScalarMeta) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ScalarMeta) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("unit", o.Unit, nil)
+}
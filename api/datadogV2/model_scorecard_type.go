@@ -58,6 +58,14 @@ func (v ScorecardType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ScorecardType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ScorecardType: valid values are %v", v, allowedScorecardTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ScorecardType value.
 func (v ScorecardType) Ptr() *ScorecardType {
 	return &v
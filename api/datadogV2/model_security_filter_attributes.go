@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -320,3 +322,15 @@ func (o *SecurityFilterAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityFilterAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("exclusion_filters", o.ExclusionFilters, nil),
+		datadog.ValidateValue("filtered_data_type", o.FilteredDataType, nil),
+		datadog.ValidateValue("version", o.Version, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
@@ -58,6 +58,14 @@ func (v SecurityFilterFilteredDataType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityFilterFilteredDataType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityFilterFilteredDataType: valid values are %v", v, allowedSecurityFilterFilteredDataTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityFilterFilteredDataType value.
 func (v SecurityFilterFilteredDataType) Ptr() *SecurityFilterFilteredDataType {
 	return &v
@@ -58,6 +58,14 @@ func (v SecurityFilterType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityFilterType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityFilterType: valid values are %v", v, allowedSecurityFilterTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityFilterType value.
 func (v SecurityFilterType) Ptr() *SecurityFilterType {
 	return &v
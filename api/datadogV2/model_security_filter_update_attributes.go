@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -285,3 +287,15 @@ func (o *SecurityFilterUpdateAttributes) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityFilterUpdateAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("exclusion_filters", o.ExclusionFilters, nil),
+		datadog.ValidateValue("filtered_data_type", o.FilteredDataType, nil),
+		datadog.ValidateValue("version", o.Version, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
@@ -145,3 +145,11 @@ func (o *SecurityMonitoringFilter) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringFilter) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("action", o.Action, nil)
+}
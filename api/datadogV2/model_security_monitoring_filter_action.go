@@ -60,6 +60,14 @@ func (v SecurityMonitoringFilterAction) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringFilterAction) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringFilterAction: valid values are %v", v, allowedSecurityMonitoringFilterActionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringFilterAction value.
 func (v SecurityMonitoringFilterAction) Ptr() *SecurityMonitoringFilterAction {
 	return &v
@@ -240,3 +240,8 @@ func (o *SecurityMonitoringReferenceTable) UnmarshalJSON(bytes []byte) (err erro
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringReferenceTable) Validate() error {
+	return nil
+}
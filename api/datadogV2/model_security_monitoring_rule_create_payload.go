@@ -135,3 +135,11 @@ func (obj *SecurityMonitoringRuleCreatePayload) GetActualInstance() interface{}
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *SecurityMonitoringRuleCreatePayload) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
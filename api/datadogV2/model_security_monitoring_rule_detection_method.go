@@ -70,6 +70,14 @@ func (v SecurityMonitoringRuleDetectionMethod) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringRuleDetectionMethod) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringRuleDetectionMethod: valid values are %v", v, allowedSecurityMonitoringRuleDetectionMethodEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringRuleDetectionMethod value.
 func (v SecurityMonitoringRuleDetectionMethod) Ptr() *SecurityMonitoringRuleDetectionMethod {
 	return &v
@@ -73,6 +73,14 @@ func (v SecurityMonitoringRuleEvaluationWindow) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringRuleEvaluationWindow) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringRuleEvaluationWindow: valid values are %v", v, allowedSecurityMonitoringRuleEvaluationWindowEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringRuleEvaluationWindow value.
 func (v SecurityMonitoringRuleEvaluationWindow) Ptr() *SecurityMonitoringRuleEvaluationWindow {
 	return &v
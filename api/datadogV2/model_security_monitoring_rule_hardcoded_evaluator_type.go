@@ -58,6 +58,14 @@ func (v SecurityMonitoringRuleHardcodedEvaluatorType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringRuleHardcodedEvaluatorType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringRuleHardcodedEvaluatorType: valid values are %v", v, allowedSecurityMonitoringRuleHardcodedEvaluatorTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringRuleHardcodedEvaluatorType value.
 func (v SecurityMonitoringRuleHardcodedEvaluatorType) Ptr() *SecurityMonitoringRuleHardcodedEvaluatorType {
 	return &v
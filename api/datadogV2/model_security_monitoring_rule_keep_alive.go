@@ -77,6 +77,14 @@ func (v SecurityMonitoringRuleKeepAlive) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringRuleKeepAlive) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringRuleKeepAlive: valid values are %v", v, allowedSecurityMonitoringRuleKeepAliveEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringRuleKeepAlive value.
 func (v SecurityMonitoringRuleKeepAlive) Ptr() *SecurityMonitoringRuleKeepAlive {
 	return &v
@@ -81,6 +81,14 @@ func (v SecurityMonitoringRuleMaxSignalDuration) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringRuleMaxSignalDuration) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringRuleMaxSignalDuration: valid values are %v", v, allowedSecurityMonitoringRuleMaxSignalDurationEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringRuleMaxSignalDuration value.
 func (v SecurityMonitoringRuleMaxSignalDuration) Ptr() *SecurityMonitoringRuleMaxSignalDuration {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -240,3 +242,16 @@ func (o *SecurityMonitoringRuleNewValueOptions) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringRuleNewValueOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("forgetAfter", o.ForgetAfter, nil),
+		datadog.ValidateValue("learningDuration", o.LearningDuration, nil),
+		datadog.ValidateValue("learningMethod", o.LearningMethod, nil),
+		datadog.ValidateValue("learningThreshold", o.LearningThreshold, nil),
+	)
+}
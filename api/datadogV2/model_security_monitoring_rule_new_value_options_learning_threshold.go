@@ -60,6 +60,14 @@ func (v SecurityMonitoringRuleNewValueOptionsLearningThreshold) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringRuleNewValueOptionsLearningThreshold) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringRuleNewValueOptionsLearningThreshold: valid values are %v", v, allowedSecurityMonitoringRuleNewValueOptionsLearningThresholdEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringRuleNewValueOptionsLearningThreshold value.
 func (v SecurityMonitoringRuleNewValueOptionsLearningThreshold) Ptr() *SecurityMonitoringRuleNewValueOptionsLearningThreshold {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -460,3 +462,21 @@ func (o *SecurityMonitoringRuleOptions) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringRuleOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("complianceRuleOptions", o.ComplianceRuleOptions, nil),
+		datadog.ValidateValue("detectionMethod", o.DetectionMethod, nil),
+		datadog.ValidateValue("evaluationWindow", o.EvaluationWindow, nil),
+		datadog.ValidateValue("hardcodedEvaluatorType", o.HardcodedEvaluatorType, nil),
+		datadog.ValidateValue("impossibleTravelOptions", o.ImpossibleTravelOptions, nil),
+		datadog.ValidateValue("keepAlive", o.KeepAlive, nil),
+		datadog.ValidateValue("maxSignalDuration", o.MaxSignalDuration, nil),
+		datadog.ValidateValue("newValueOptions", o.NewValueOptions, nil),
+		datadog.ValidateValue("thirdPartyRuleOptions", o.ThirdPartyRuleOptions, nil),
+	)
+}
@@ -240,3 +240,8 @@ func (o *SecurityMonitoringRuleQueryPayloadData) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringRuleQueryPayloadData) Validate() error {
+	return nil
+}
@@ -66,6 +66,14 @@ func (v SecurityMonitoringRuleSeverity) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringRuleSeverity) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringRuleSeverity: valid values are %v", v, allowedSecurityMonitoringRuleSeverityEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringRuleSeverity value.
 func (v SecurityMonitoringRuleSeverity) Ptr() *SecurityMonitoringRuleSeverity {
 	return &v
@@ -71,3 +71,11 @@ func (obj *SecurityMonitoringRuleTestPayload) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *SecurityMonitoringRuleTestPayload) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -135,3 +137,14 @@ func (o *SecurityMonitoringRuleTestRequest) UnmarshalJSON(bytes []byte) (err err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringRuleTestRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("rule", o.Rule, nil),
+		datadog.ValidateValue("ruleQueryPayloads", o.RuleQueryPayloads, nil),
+	)
+}
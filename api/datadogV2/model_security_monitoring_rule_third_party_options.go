@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -215,3 +217,14 @@ func (o *SecurityMonitoringRuleThirdPartyOptions) UnmarshalJSON(bytes []byte) (e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringRuleThirdPartyOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("defaultStatus", o.DefaultStatus, nil),
+		datadog.ValidateValue("rootQueries", o.RootQueries, nil),
+	)
+}
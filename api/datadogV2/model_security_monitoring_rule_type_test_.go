@@ -58,6 +58,14 @@ func (v SecurityMonitoringRuleTypeTest) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringRuleTypeTest) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringRuleTypeTest: valid values are %v", v, allowedSecurityMonitoringRuleTypeTestEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringRuleTypeTest value.
 func (v SecurityMonitoringRuleTypeTest) Ptr() *SecurityMonitoringRuleTypeTest {
 	return &v
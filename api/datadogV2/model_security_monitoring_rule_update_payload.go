@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -532,3 +534,20 @@ func (o *SecurityMonitoringRuleUpdatePayload) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringRuleUpdatePayload) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("cases", o.Cases, nil),
+		datadog.ValidateValue("complianceSignalOptions", o.ComplianceSignalOptions, nil),
+		datadog.ValidateValue("filters", o.Filters, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("queries", o.Queries, nil),
+		datadog.ValidateValue("referenceTables", o.ReferenceTables, nil),
+		datadog.ValidateValue("thirdPartyCases", o.ThirdPartyCases, nil),
+		datadog.ValidateValue("version", o.Version, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
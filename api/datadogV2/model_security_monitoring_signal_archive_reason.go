@@ -66,6 +66,14 @@ func (v SecurityMonitoringSignalArchiveReason) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringSignalArchiveReason) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringSignalArchiveReason: valid values are %v", v, allowedSecurityMonitoringSignalArchiveReasonEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringSignalArchiveReason value.
 func (v SecurityMonitoringSignalArchiveReason) Ptr() *SecurityMonitoringSignalArchiveReason {
 	return &v
@@ -180,3 +180,8 @@ func (o *SecurityMonitoringSignalListRequestFilter) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringSignalListRequestFilter) Validate() error {
+	return nil
+}
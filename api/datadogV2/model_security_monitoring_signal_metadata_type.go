@@ -58,6 +58,14 @@ func (v SecurityMonitoringSignalMetadataType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringSignalMetadataType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringSignalMetadataType: valid values are %v", v, allowedSecurityMonitoringSignalMetadataTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringSignalMetadataType value.
 func (v SecurityMonitoringSignalMetadataType) Ptr() *SecurityMonitoringSignalMetadataType {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -412,3 +413,17 @@ func (o *SecurityMonitoringSignalRuleCreatePayload) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringSignalRuleCreatePayload) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("cases", o.Cases, nil),
+		datadog.ValidateValue("filters", o.Filters, nil),
+		datadog.ValidateValue("options", o.Options, nil),
+		datadog.ValidateValue("queries", o.Queries, nil),
+		datadog.ValidateValue("type", o.Type, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v SecurityMonitoringSignalRuleType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringSignalRuleType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringSignalRuleType: valid values are %v", v, allowedSecurityMonitoringSignalRuleTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringSignalRuleType value.
 func (v SecurityMonitoringSignalRuleType) Ptr() *SecurityMonitoringSignalRuleType {
 	return &v
@@ -62,6 +62,14 @@ func (v SecurityMonitoringSignalState) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringSignalState) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringSignalState: valid values are %v", v, allowedSecurityMonitoringSignalStateEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringSignalState value.
 func (v SecurityMonitoringSignalState) Ptr() *SecurityMonitoringSignalState {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -396,3 +397,19 @@ func (o *SecurityMonitoringSignalTriageAttributes) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringSignalTriageAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("archive_comment_timestamp", o.ArchiveCommentTimestamp, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("archive_comment_user", o.ArchiveCommentUser, nil),
+		datadog.ValidateValue("archive_reason", o.ArchiveReason, nil),
+		datadog.ValidateValue("assignee", o.Assignee, nil),
+		datadog.ValidateValue("state", o.State, nil),
+		datadog.ValidateValue("state_update_timestamp", o.StateUpdateTimestamp, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)}),
+		datadog.ValidateValue("state_update_user", o.StateUpdateUser, nil),
+	)
+}
@@ -58,6 +58,14 @@ func (v SecurityMonitoringSignalType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringSignalType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringSignalType: valid values are %v", v, allowedSecurityMonitoringSignalTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringSignalType value.
 func (v SecurityMonitoringSignalType) Ptr() *SecurityMonitoringSignalType {
 	return &v
@@ -58,6 +58,14 @@ func (v SecurityMonitoringSuppressionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SecurityMonitoringSuppressionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SecurityMonitoringSuppressionType: valid values are %v", v, allowedSecurityMonitoringSuppressionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SecurityMonitoringSuppressionType value.
 func (v SecurityMonitoringSuppressionType) Ptr() *SecurityMonitoringSuppressionType {
 	return &v
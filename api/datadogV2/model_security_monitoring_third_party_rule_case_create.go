@@ -214,3 +214,11 @@ func (o *SecurityMonitoringThirdPartyRuleCaseCreate) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringThirdPartyRuleCaseCreate) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("status", o.Status, nil)
+}
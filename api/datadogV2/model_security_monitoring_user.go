@@ -146,3 +146,8 @@ func (o *SecurityMonitoringUser) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SecurityMonitoringUser) Validate() error {
+	return nil
+}
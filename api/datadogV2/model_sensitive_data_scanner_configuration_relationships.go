@@ -109,3 +109,11 @@ func (o *SensitiveDataScannerConfigurationRelationships) UnmarshalJSON(bytes []b
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SensitiveDataScannerConfigurationRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("groups", o.Groups, nil)
+}
@@ -58,6 +58,14 @@ func (v SensitiveDataScannerConfigurationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SensitiveDataScannerConfigurationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SensitiveDataScannerConfigurationType: valid values are %v", v, allowedSensitiveDataScannerConfigurationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SensitiveDataScannerConfigurationType value.
 func (v SensitiveDataScannerConfigurationType) Ptr() *SensitiveDataScannerConfigurationType {
 	return &v
@@ -103,3 +103,11 @@ func (obj *SensitiveDataScannerGetConfigIncludedItem) GetActualInstance() interf
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *SensitiveDataScannerGetConfigIncludedItem) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
@@ -108,3 +108,11 @@ func (o *SensitiveDataScannerGroupDeleteRequest) UnmarshalJSON(bytes []byte) (er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SensitiveDataScannerGroupDeleteRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("meta", o.Meta, nil)
+}
@@ -109,3 +109,11 @@ func (o *SensitiveDataScannerGroupDeleteResponse) UnmarshalJSON(bytes []byte) (e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SensitiveDataScannerGroupDeleteResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("meta", o.Meta, nil)
+}
@@ -58,6 +58,14 @@ func (v SensitiveDataScannerGroupType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SensitiveDataScannerGroupType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SensitiveDataScannerGroupType: valid values are %v", v, allowedSensitiveDataScannerGroupTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SensitiveDataScannerGroupType value.
 func (v SensitiveDataScannerGroupType) Ptr() *SensitiveDataScannerGroupType {
 	return &v
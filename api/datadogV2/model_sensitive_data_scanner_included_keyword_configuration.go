@@ -172,3 +172,11 @@ func (o *SensitiveDataScannerIncludedKeywordConfiguration) UnmarshalJSON(bytes [
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SensitiveDataScannerIncludedKeywordConfiguration) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("character_count", o.CharacterCount, &datadog.Constraints{Minimum: datadog.PtrFloat64(1), Maximum: datadog.PtrFloat64(50)})
+}
@@ -287,3 +287,11 @@ func (o *SensitiveDataScannerMeta) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SensitiveDataScannerMeta) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("version", o.Version, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)})
+}
@@ -100,3 +100,11 @@ func (o *SensitiveDataScannerMetaVersionOnly) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SensitiveDataScannerMetaVersionOnly) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("version", o.Version, &datadog.Constraints{Minimum: datadog.PtrFloat64(0)})
+}
@@ -64,6 +64,14 @@ func (v SensitiveDataScannerProduct) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SensitiveDataScannerProduct) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SensitiveDataScannerProduct: valid values are %v", v, allowedSensitiveDataScannerProductEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SensitiveDataScannerProduct value.
 func (v SensitiveDataScannerProduct) Ptr() *SensitiveDataScannerProduct {
 	return &v
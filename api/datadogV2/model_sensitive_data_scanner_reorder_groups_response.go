@@ -109,3 +109,11 @@ func (o *SensitiveDataScannerReorderGroupsResponse) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SensitiveDataScannerReorderGroupsResponse) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("meta", o.Meta, nil)
+}
@@ -108,3 +108,11 @@ func (o *SensitiveDataScannerRuleDeleteRequest) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SensitiveDataScannerRuleDeleteRequest) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("meta", o.Meta, nil)
+}
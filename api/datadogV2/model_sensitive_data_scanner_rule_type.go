@@ -58,6 +58,14 @@ func (v SensitiveDataScannerRuleType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SensitiveDataScannerRuleType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SensitiveDataScannerRuleType: valid values are %v", v, allowedSensitiveDataScannerRuleTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SensitiveDataScannerRuleType value.
 func (v SensitiveDataScannerRuleType) Ptr() *SensitiveDataScannerRuleType {
 	return &v
@@ -58,6 +58,14 @@ func (v SensitiveDataScannerStandardPatternType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SensitiveDataScannerStandardPatternType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SensitiveDataScannerStandardPatternType: valid values are %v", v, allowedSensitiveDataScannerStandardPatternTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SensitiveDataScannerStandardPatternType value.
 func (v SensitiveDataScannerStandardPatternType) Ptr() *SensitiveDataScannerStandardPatternType {
 	return &v
@@ -71,6 +71,14 @@ func (v SensitiveDataScannerTextReplacementType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SensitiveDataScannerTextReplacementType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SensitiveDataScannerTextReplacementType: valid values are %v", v, allowedSensitiveDataScannerTextReplacementTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SensitiveDataScannerTextReplacementType value.
 func (v SensitiveDataScannerTextReplacementType) Ptr() *SensitiveDataScannerTextReplacementType {
 	return &v
@@ -310,3 +310,11 @@ func (o *ServiceDefinitionMeta) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceDefinitionMeta) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("warnings", o.Warnings, nil)
+}
@@ -64,6 +64,14 @@ func (v ServiceDefinitionSchemaVersions) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionSchemaVersions) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionSchemaVersions: valid values are %v", v, allowedServiceDefinitionSchemaVersionsEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionSchemaVersions value.
 func (v ServiceDefinitionSchemaVersions) Ptr() *ServiceDefinitionSchemaVersions {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -367,3 +368,18 @@ func (o *ServiceDefinitionV1) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceDefinitionV1) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("contact", o.Contact, nil),
+		datadog.ValidateValue("external-resources", o.ExternalResources, nil),
+		datadog.ValidateValue("info", o.Info, nil),
+		datadog.ValidateValue("integrations", o.Integrations, nil),
+		datadog.ValidateValue("org", o.Org, nil),
+		datadog.ValidateValue("schema-version", o.SchemaVersion, nil),
+	)
+}
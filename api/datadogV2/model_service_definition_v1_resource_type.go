@@ -74,6 +74,14 @@ func (v ServiceDefinitionV1ResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV1ResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV1ResourceType: valid values are %v", v, allowedServiceDefinitionV1ResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV1ResourceType value.
 func (v ServiceDefinitionV1ResourceType) Ptr() *ServiceDefinitionV1ResourceType {
 	return &v
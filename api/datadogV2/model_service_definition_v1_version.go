@@ -58,6 +58,14 @@ func (v ServiceDefinitionV1Version) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV1Version) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV1Version: valid values are %v", v, allowedServiceDefinitionV1VersionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV1Version value.
 func (v ServiceDefinitionV1Version) Ptr() *ServiceDefinitionV1Version {
 	return &v
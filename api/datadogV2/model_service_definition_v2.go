@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -461,3 +462,18 @@ func (o *ServiceDefinitionV2) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceDefinitionV2) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("contacts", o.Contacts, nil),
+		datadog.ValidateValue("docs", o.Docs, nil),
+		datadog.ValidateValue("integrations", o.Integrations, nil),
+		datadog.ValidateValue("links", o.Links, nil),
+		datadog.ValidateValue("repos", o.Repos, nil),
+		datadog.ValidateValue("schema-version", o.SchemaVersion, nil),
+	)
+}
@@ -166,3 +166,8 @@ func (o *ServiceDefinitionV2Doc) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceDefinitionV2Doc) Validate() error {
+	return nil
+}
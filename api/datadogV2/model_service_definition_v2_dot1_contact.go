@@ -135,3 +135,11 @@ func (obj *ServiceDefinitionV2Dot1Contact) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *ServiceDefinitionV2Dot1Contact) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2Dot1EmailType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot1EmailType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot1EmailType: valid values are %v", v, allowedServiceDefinitionV2Dot1EmailTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot1EmailType value.
 func (v ServiceDefinitionV2Dot1EmailType) Ptr() *ServiceDefinitionV2Dot1EmailType {
 	return &v
@@ -66,6 +66,14 @@ func (v ServiceDefinitionV2Dot1LinkType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot1LinkType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot1LinkType: valid values are %v", v, allowedServiceDefinitionV2Dot1LinkTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot1LinkType value.
 func (v ServiceDefinitionV2Dot1LinkType) Ptr() *ServiceDefinitionV2Dot1LinkType {
 	return &v
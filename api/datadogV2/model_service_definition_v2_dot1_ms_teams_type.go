@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2Dot1MSTeamsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot1MSTeamsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot1MSTeamsType: valid values are %v", v, allowedServiceDefinitionV2Dot1MSTeamsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot1MSTeamsType value.
 func (v ServiceDefinitionV2Dot1MSTeamsType) Ptr() *ServiceDefinitionV2Dot1MSTeamsType {
 	return &v
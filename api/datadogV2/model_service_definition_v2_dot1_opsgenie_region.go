@@ -60,6 +60,14 @@ func (v ServiceDefinitionV2Dot1OpsgenieRegion) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot1OpsgenieRegion) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot1OpsgenieRegion: valid values are %v", v, allowedServiceDefinitionV2Dot1OpsgenieRegionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot1OpsgenieRegion value.
 func (v ServiceDefinitionV2Dot1OpsgenieRegion) Ptr() *ServiceDefinitionV2Dot1OpsgenieRegion {
 	return &v
@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2Dot1SlackType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot1SlackType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot1SlackType: valid values are %v", v, allowedServiceDefinitionV2Dot1SlackTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot1SlackType value.
 func (v ServiceDefinitionV2Dot1SlackType) Ptr() *ServiceDefinitionV2Dot1SlackType {
 	return &v
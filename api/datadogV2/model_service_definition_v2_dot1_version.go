@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2Dot1Version) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot1Version) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot1Version: valid values are %v", v, allowedServiceDefinitionV2Dot1VersionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot1Version value.
 func (v ServiceDefinitionV2Dot1Version) Ptr() *ServiceDefinitionV2Dot1Version {
 	return &v
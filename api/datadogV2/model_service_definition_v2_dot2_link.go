@@ -198,3 +198,8 @@ func (o *ServiceDefinitionV2Dot2Link) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceDefinitionV2Dot2Link) Validate() error {
+	return nil
+}
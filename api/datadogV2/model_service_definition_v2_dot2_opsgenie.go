@@ -144,3 +144,11 @@ func (o *ServiceDefinitionV2Dot2Opsgenie) UnmarshalJSON(bytes []byte) (err error
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *ServiceDefinitionV2Dot2Opsgenie) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("region", o.Region, nil)
+}
@@ -60,6 +60,14 @@ func (v ServiceDefinitionV2Dot2OpsgenieRegion) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot2OpsgenieRegion) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot2OpsgenieRegion: valid values are %v", v, allowedServiceDefinitionV2Dot2OpsgenieRegionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot2OpsgenieRegion value.
 func (v ServiceDefinitionV2Dot2OpsgenieRegion) Ptr() *ServiceDefinitionV2Dot2OpsgenieRegion {
 	return &v
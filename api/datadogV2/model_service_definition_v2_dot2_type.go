@@ -70,6 +70,14 @@ func (v ServiceDefinitionV2Dot2Type) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot2Type) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot2Type: valid values are %v", v, allowedServiceDefinitionV2Dot2TypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot2Type value.
 func (v ServiceDefinitionV2Dot2Type) Ptr() *ServiceDefinitionV2Dot2Type {
 	return &v
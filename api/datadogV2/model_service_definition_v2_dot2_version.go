@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2Dot2Version) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Dot2Version) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Dot2Version: valid values are %v", v, allowedServiceDefinitionV2Dot2VersionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Dot2Version value.
 func (v ServiceDefinitionV2Dot2Version) Ptr() *ServiceDefinitionV2Dot2Version {
 	return &v
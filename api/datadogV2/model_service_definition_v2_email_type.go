@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2EmailType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2EmailType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2EmailType: valid values are %v", v, allowedServiceDefinitionV2EmailTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2EmailType value.
 func (v ServiceDefinitionV2EmailType) Ptr() *ServiceDefinitionV2EmailType {
 	return &v
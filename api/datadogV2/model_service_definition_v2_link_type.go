@@ -74,6 +74,14 @@ func (v ServiceDefinitionV2LinkType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2LinkType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2LinkType: valid values are %v", v, allowedServiceDefinitionV2LinkTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2LinkType value.
 func (v ServiceDefinitionV2LinkType) Ptr() *ServiceDefinitionV2LinkType {
 	return &v
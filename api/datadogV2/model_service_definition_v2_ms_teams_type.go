@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2MSTeamsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2MSTeamsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2MSTeamsType: valid values are %v", v, allowedServiceDefinitionV2MSTeamsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2MSTeamsType value.
 func (v ServiceDefinitionV2MSTeamsType) Ptr() *ServiceDefinitionV2MSTeamsType {
 	return &v
@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2SlackType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2SlackType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2SlackType: valid values are %v", v, allowedServiceDefinitionV2SlackTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2SlackType value.
 func (v ServiceDefinitionV2SlackType) Ptr() *ServiceDefinitionV2SlackType {
 	return &v
@@ -58,6 +58,14 @@ func (v ServiceDefinitionV2Version) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v ServiceDefinitionV2Version) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for ServiceDefinitionV2Version: valid values are %v", v, allowedServiceDefinitionV2VersionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to ServiceDefinitionV2Version value.
 func (v ServiceDefinitionV2Version) Ptr() *ServiceDefinitionV2Version {
 	return &v
@@ -167,3 +167,11 @@ func (obj *ServiceDefinitionsCreateRequest) GetActualInstance() interface{} {
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *ServiceDefinitionsCreateRequest) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
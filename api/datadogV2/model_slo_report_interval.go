@@ -60,6 +60,14 @@ func (v SLOReportInterval) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOReportInterval) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOReportInterval: valid values are %v", v, allowedSLOReportIntervalEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOReportInterval value.
 func (v SLOReportInterval) Ptr() *SLOReportInterval {
 	return &v
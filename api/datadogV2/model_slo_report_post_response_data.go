@@ -135,3 +135,8 @@ func (o *SLOReportPostResponseData) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SLOReportPostResponseData) Validate() error {
+	return nil
+}
@@ -64,6 +64,14 @@ func (v SLOReportStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SLOReportStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SLOReportStatus: valid values are %v", v, allowedSLOReportStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SLOReportStatus value.
 func (v SLOReportStatus) Ptr() *SLOReportStatus {
 	return &v
@@ -60,6 +60,14 @@ func (v SortDirection) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SortDirection) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SortDirection: valid values are %v", v, allowedSortDirectionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SortDirection value.
 func (v SortDirection) Ptr() *SortDirection {
 	return &v
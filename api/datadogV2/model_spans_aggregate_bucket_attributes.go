@@ -170,3 +170,11 @@ func (o *SpansAggregateBucketAttributes) UnmarshalJSON(bytes []byte) (err error)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SpansAggregateBucketAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return datadog.ValidateValue("computes", o.Computes, nil)
+}
@@ -58,6 +58,14 @@ func (v SpansAggregateBucketType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansAggregateBucketType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansAggregateBucketType: valid values are %v", v, allowedSpansAggregateBucketTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansAggregateBucketType value.
 func (v SpansAggregateBucketType) Ptr() *SpansAggregateBucketType {
 	return &v
@@ -135,3 +135,8 @@ func (o *SpansAggregateBucketValueTimeseriesPoint) UnmarshalJSON(bytes []byte) (
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SpansAggregateBucketValueTimeseriesPoint) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v SpansAggregateRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansAggregateRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansAggregateRequestType: valid values are %v", v, allowedSpansAggregateRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansAggregateRequestType value.
 func (v SpansAggregateRequestType) Ptr() *SpansAggregateRequestType {
 	return &v
@@ -60,6 +60,14 @@ func (v SpansAggregateResponseStatus) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansAggregateResponseStatus) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansAggregateResponseStatus: valid values are %v", v, allowedSpansAggregateResponseStatusEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansAggregateResponseStatus value.
 func (v SpansAggregateResponseStatus) Ptr() *SpansAggregateResponseStatus {
 	return &v
@@ -60,6 +60,14 @@ func (v SpansAggregateSortType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansAggregateSortType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansAggregateSortType: valid values are %v", v, allowedSpansAggregateSortTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansAggregateSortType value.
 func (v SpansAggregateSortType) Ptr() *SpansAggregateSortType {
 	return &v
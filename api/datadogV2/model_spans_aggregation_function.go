@@ -80,6 +80,14 @@ func (v SpansAggregationFunction) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansAggregationFunction) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansAggregationFunction: valid values are %v", v, allowedSpansAggregationFunctionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansAggregationFunction value.
 func (v SpansAggregationFunction) Ptr() *SpansAggregationFunction {
 	return &v
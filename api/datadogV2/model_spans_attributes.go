@@ -672,3 +672,8 @@ func (o *SpansAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SpansAttributes) Validate() error {
+	return nil
+}
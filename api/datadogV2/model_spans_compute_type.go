@@ -60,6 +60,14 @@ func (v SpansComputeType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansComputeType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansComputeType: valid values are %v", v, allowedSpansComputeTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansComputeType value.
 func (v SpansComputeType) Ptr() *SpansComputeType {
 	return &v
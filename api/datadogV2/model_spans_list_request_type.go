@@ -58,6 +58,14 @@ func (v SpansListRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansListRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansListRequestType: valid values are %v", v, allowedSpansListRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansListRequestType value.
 func (v SpansListRequestType) Ptr() *SpansListRequestType {
 	return &v
@@ -60,6 +60,14 @@ func (v SpansMetricComputeAggregationType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansMetricComputeAggregationType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansMetricComputeAggregationType: valid values are %v", v, allowedSpansMetricComputeAggregationTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansMetricComputeAggregationType value.
 func (v SpansMetricComputeAggregationType) Ptr() *SpansMetricComputeAggregationType {
 	return &v
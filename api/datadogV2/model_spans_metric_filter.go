@@ -104,3 +104,8 @@ func (o *SpansMetricFilter) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SpansMetricFilter) Validate() error {
+	return nil
+}
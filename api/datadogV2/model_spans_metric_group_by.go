@@ -134,3 +134,8 @@ func (o *SpansMetricGroupBy) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SpansMetricGroupBy) Validate() error {
+	return nil
+}
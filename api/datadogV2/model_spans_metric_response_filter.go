@@ -100,3 +100,8 @@ func (o *SpansMetricResponseFilter) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SpansMetricResponseFilter) Validate() error {
+	return nil
+}
@@ -135,3 +135,8 @@ func (o *SpansMetricResponseGroupBy) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *SpansMetricResponseGroupBy) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v SpansMetricType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansMetricType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansMetricType: valid values are %v", v, allowedSpansMetricTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansMetricType value.
 func (v SpansMetricType) Ptr() *SpansMetricType {
 	return &v
@@ -60,6 +60,14 @@ func (v SpansSort) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansSort) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansSort: valid values are %v", v, allowedSpansSortEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansSort value.
 func (v SpansSort) Ptr() *SpansSort {
 	return &v
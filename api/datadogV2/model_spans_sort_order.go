@@ -60,6 +60,14 @@ func (v SpansSortOrder) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansSortOrder) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansSortOrder: valid values are %v", v, allowedSpansSortOrderEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansSortOrder value.
 func (v SpansSortOrder) Ptr() *SpansSortOrder {
 	return &v
@@ -58,6 +58,14 @@ func (v SpansType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v SpansType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for SpansType: valid values are %v", v, allowedSpansTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to SpansType value.
 func (v SpansType) Ptr() *SpansType {
 	return &v
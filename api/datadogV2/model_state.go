@@ -62,6 +62,14 @@ func (v State) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v State) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for State: valid values are %v", v, allowedStateEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to State value.
 func (v State) Ptr() *State {
 	return &v
@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -534,3 +535,17 @@ func (o *TeamAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *TeamAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("handle", o.Handle, &datadog.Constraints{MaxLength: datadog.PtrInt64(195)}),
+		datadog.ValidateValue("link_count", o.LinkCount, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+		datadog.ValidateValue("name", o.Name, &datadog.Constraints{MaxLength: datadog.PtrInt64(200)}),
+		datadog.ValidateValue("summary", o.Summary, &datadog.Constraints{MaxLength: datadog.PtrInt64(120)}),
+		datadog.ValidateValue("user_count", o.UserCount, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
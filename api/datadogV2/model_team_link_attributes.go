@@ -5,6 +5,7 @@
 package datadogV2
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -201,3 +202,14 @@ func (o *TeamLinkAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *TeamLinkAttributes) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("label", o.Label, &datadog.Constraints{MaxLength: datadog.PtrInt64(256)}),
+		datadog.ValidateValue("position", o.Position, &datadog.Constraints{Maximum: datadog.PtrFloat64(2147483647)}),
+	)
+}
@@ -58,6 +58,14 @@ func (v TeamLinkType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TeamLinkType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TeamLinkType: valid values are %v", v, allowedTeamLinkTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TeamLinkType value.
 func (v TeamLinkType) Ptr() *TeamLinkType {
 	return &v
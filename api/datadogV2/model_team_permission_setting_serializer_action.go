@@ -60,6 +60,14 @@ func (v TeamPermissionSettingSerializerAction) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TeamPermissionSettingSerializerAction) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TeamPermissionSettingSerializerAction: valid values are %v", v, allowedTeamPermissionSettingSerializerActionEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TeamPermissionSettingSerializerAction value.
 func (v TeamPermissionSettingSerializerAction) Ptr() *TeamPermissionSettingSerializerAction {
 	return &v
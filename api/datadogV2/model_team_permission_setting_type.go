@@ -58,6 +58,14 @@ func (v TeamPermissionSettingType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TeamPermissionSettingType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TeamPermissionSettingType: valid values are %v", v, allowedTeamPermissionSettingTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TeamPermissionSettingType value.
 func (v TeamPermissionSettingType) Ptr() *TeamPermissionSettingType {
 	return &v
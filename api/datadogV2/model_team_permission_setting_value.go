@@ -66,6 +66,14 @@ func (v TeamPermissionSettingValue) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TeamPermissionSettingValue) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TeamPermissionSettingValue: valid values are %v", v, allowedTeamPermissionSettingValueEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TeamPermissionSettingValue value.
 func (v TeamPermissionSettingValue) Ptr() *TeamPermissionSettingValue {
 	return &v
@@ -58,6 +58,14 @@ func (v TeamType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TeamType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TeamType: valid values are %v", v, allowedTeamTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TeamType value.
 func (v TeamType) Ptr() *TeamType {
 	return &v
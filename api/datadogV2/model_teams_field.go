@@ -86,6 +86,14 @@ func (v TeamsField) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TeamsField) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TeamsField: valid values are %v", v, allowedTeamsFieldEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TeamsField value.
 func (v TeamsField) Ptr() *TeamsField {
 	return &v
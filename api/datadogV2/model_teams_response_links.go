@@ -262,3 +262,8 @@ func (o *TeamsResponseLinks) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *TeamsResponseLinks) Validate() error {
+	return nil
+}
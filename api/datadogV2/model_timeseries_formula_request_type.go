@@ -58,6 +58,14 @@ func (v TimeseriesFormulaRequestType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TimeseriesFormulaRequestType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TimeseriesFormulaRequestType: valid values are %v", v, allowedTimeseriesFormulaRequestTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TimeseriesFormulaRequestType value.
 func (v TimeseriesFormulaRequestType) Ptr() *TimeseriesFormulaRequestType {
 	return &v
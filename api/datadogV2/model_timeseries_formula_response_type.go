@@ -58,6 +58,14 @@ func (v TimeseriesFormulaResponseType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v TimeseriesFormulaResponseType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for TimeseriesFormulaResponseType: valid values are %v", v, allowedTimeseriesFormulaResponseTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to TimeseriesFormulaResponseType value.
 func (v TimeseriesFormulaResponseType) Ptr() *TimeseriesFormulaResponseType {
 	return &v
@@ -241,6 +241,11 @@ func (o *Unit) UnmarshalJSON(bytes []byte) (err error) {
 	return nil
 }
 
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *Unit) Validate() error {
+	return nil
+}
+
 // NullableUnit handles when a null is used for Unit.
 type NullableUnit struct {
 	value *Unit
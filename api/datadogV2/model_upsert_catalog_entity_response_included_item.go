@@ -71,3 +71,11 @@ func (obj *UpsertCatalogEntityResponseIncludedItem) GetActualInstance() interfac
 	// all schemas are nil
 	return nil
 }
+
+// Validate validates the actual instance.
+func (obj *UpsertCatalogEntityResponseIncludedItem) Validate() error {
+	if obj == nil {
+		return nil
+	}
+	return datadog.ValidateValue("", obj.GetActualInstance(), nil)
+}
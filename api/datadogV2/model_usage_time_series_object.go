@@ -152,3 +152,8 @@ func (o *UsageTimeSeriesObject) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UsageTimeSeriesObject) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v UsageTimeSeriesType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UsageTimeSeriesType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UsageTimeSeriesType: valid values are %v", v, allowedUsageTimeSeriesTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UsageTimeSeriesType value.
 func (v UsageTimeSeriesType) Ptr() *UsageTimeSeriesType {
 	return &v
@@ -517,3 +517,8 @@ func (o *UserAttributes) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UserAttributes) Validate() error {
+	return nil
+}
@@ -58,6 +58,14 @@ func (v UserInvitationsType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UserInvitationsType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UserInvitationsType: valid values are %v", v, allowedUserInvitationsTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UserInvitationsType value.
 func (v UserInvitationsType) Ptr() *UserInvitationsType {
 	return &v
@@ -58,6 +58,14 @@ func (v UserResourceType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UserResourceType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UserResourceType: valid values are %v", v, allowedUserResourceTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UserResourceType value.
 func (v UserResourceType) Ptr() *UserResourceType {
 	return &v
@@ -5,6 +5,8 @@
 package datadogV2
 
 import (
+	"errors"
+
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
@@ -223,3 +225,16 @@ func (o *UserResponseRelationships) UnmarshalJSON(bytes []byte) (err error) {
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *UserResponseRelationships) Validate() error {
+	if o == nil {
+		return nil
+	}
+	return errors.Join(
+		datadog.ValidateValue("org", o.Org, nil),
+		datadog.ValidateValue("other_orgs", o.OtherOrgs, nil),
+		datadog.ValidateValue("other_users", o.OtherUsers, nil),
+		datadog.ValidateValue("roles", o.Roles, nil),
+	)
+}
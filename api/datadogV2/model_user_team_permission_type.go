@@ -58,6 +58,14 @@ func (v UserTeamPermissionType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UserTeamPermissionType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UserTeamPermissionType: valid values are %v", v, allowedUserTeamPermissionTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UserTeamPermissionType value.
 func (v UserTeamPermissionType) Ptr() *UserTeamPermissionType {
 	return &v
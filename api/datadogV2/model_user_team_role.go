@@ -58,6 +58,14 @@ func (v UserTeamRole) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UserTeamRole) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UserTeamRole: valid values are %v", v, allowedUserTeamRoleEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UserTeamRole value.
 func (v UserTeamRole) Ptr() *UserTeamRole {
 	return &v
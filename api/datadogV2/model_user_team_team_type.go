@@ -58,6 +58,14 @@ func (v UserTeamTeamType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UserTeamTeamType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UserTeamTeamType: valid values are %v", v, allowedUserTeamTeamTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UserTeamTeamType value.
 func (v UserTeamTeamType) Ptr() *UserTeamTeamType {
 	return &v
@@ -58,6 +58,14 @@ func (v UserTeamType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UserTeamType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UserTeamType: valid values are %v", v, allowedUserTeamTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UserTeamType value.
 func (v UserTeamType) Ptr() *UserTeamType {
 	return &v
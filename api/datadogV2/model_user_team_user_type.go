@@ -58,6 +58,14 @@ func (v UserTeamUserType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UserTeamUserType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UserTeamUserType: valid values are %v", v, allowedUserTeamUserTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UserTeamUserType value.
 func (v UserTeamUserType) Ptr() *UserTeamUserType {
 	return &v
@@ -58,6 +58,14 @@ func (v UsersType) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v UsersType) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for UsersType: valid values are %v", v, allowedUsersTypeEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to UsersType value.
 func (v UsersType) Ptr() *UsersType {
 	return &v
@@ -86,6 +86,14 @@ func (v WidgetLiveSpan) IsValid() bool {
 	return false
 }
 
+// Validate returns an error if the value is not allowed by the enum.
+func (v WidgetLiveSpan) Validate() error {
+	if !v.IsValid() {
+		return &datadog.ValidationError{Message: fmt.Sprintf("invalid value '%v' for WidgetLiveSpan: valid values are %v", v, allowedWidgetLiveSpanEnumValues)}
+	}
+	return nil
+}
+
 // Ptr returns reference to WidgetLiveSpan value.
 func (v WidgetLiveSpan) Ptr() *WidgetLiveSpan {
 	return &v
@@ -100,3 +100,8 @@ func (o *WorkflowInstanceCreateResponseData) UnmarshalJSON(bytes []byte) (err er
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WorkflowInstanceCreateResponseData) Validate() error {
+	return nil
+}
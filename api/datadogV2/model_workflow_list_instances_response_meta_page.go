@@ -100,3 +100,8 @@ func (o *WorkflowListInstancesResponseMetaPage) UnmarshalJSON(bytes []byte) (err
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WorkflowListInstancesResponseMetaPage) Validate() error {
+	return nil
+}
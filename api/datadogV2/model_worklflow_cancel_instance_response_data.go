@@ -100,3 +100,8 @@ func (o *WorklflowCancelInstanceResponseData) UnmarshalJSON(bytes []byte) (err e
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WorklflowCancelInstanceResponseData) Validate() error {
+	return nil
+}
@@ -100,3 +100,8 @@ func (o *WorklflowGetInstanceResponseDataAttributes) UnmarshalJSON(bytes []byte)
 
 	return nil
 }
+
+// Validate checks the values against the constraints of the spec, including the ones of nested objects.
+func (o *WorklflowGetInstanceResponseDataAttributes) Validate() error {
+	return nil
+}
@@ -0,0 +1,260 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0 License.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2019-Present Datadog, Inc.
+
+// Package datadogotel instruments the Datadog API client with OpenTelemetry. It is a module of its own, so
+// that the client does not depend on OpenTelemetry unless it is used, released together with the client.
+//
+// A Telemetry is hooked into a configuration as an interceptor, registered after the other ones so that it
+// sees the requests as they are sent:
+//
+//	telemetry := datadogotel.NewTelemetry()
+//	configuration.Interceptors = append(configuration.Interceptors, telemetry.Interceptor())
+package datadogotel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	client "github.com/DataDog/datadog-api-client-go/v2"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+)
+
+const (
+	telemetryScope = "github.com/DataDog/datadog-api-client-go/v2/contrib/datadogotel"
+)
+
+// Attributes of the spans and metrics emitted by Telemetry.
+const (
+	AttributeOperationID        = attribute.Key("datadog.operation.id")
+	AttributeRequestID          = attribute.Key("datadog.request.id")
+	AttributeRateLimitName      = attribute.Key("datadog.ratelimit.name")
+	AttributeRateLimitRemaining = attribute.Key("datadog.ratelimit.remaining")
+)
+
+// Telemetry emits an OpenTelemetry span and metrics for every request sent by the client, retries included,
+// and propagates the trace context to Datadog. A request ends once its response body is closed, so that the
+// download of the response is included, or when an interceptor aborts the call.
+//
+// The following metrics are recorded, with the operation ID, HTTP method and status code as attributes:
+// datadog.client.request.duration, datadog.client.request.body.size, datadog.client.response.body.size,
+// datadog.client.retries and datadog.client.ratelimit.remaining.
+type Telemetry struct {
+	// TracerProvider creates the spans. It defaults to the global tracer provider.
+	TracerProvider trace.TracerProvider
+	// MeterProvider creates the metrics. It defaults to the global meter provider.
+	MeterProvider metric.MeterProvider
+	// Propagator injects the trace context in the request headers. It defaults to W3C trace context.
+	Propagator propagation.TextMapPropagator
+
+	once        sync.Once
+	tracer      trace.Tracer
+	instruments telemetryInstruments
+	// attempts holds the attempt of every request sent and not answered yet.
+	attempts sync.Map
+}
+
+type telemetryInstruments struct {
+	duration           metric.Float64Histogram
+	requestSize        metric.Int64Histogram
+	responseSize       metric.Int64Histogram
+	retries            metric.Int64Counter
+	rateLimitRemaining metric.Int64Gauge
+}
+
+// NewTelemetry returns a Telemetry using the global OpenTelemetry providers.
+// The providers must be set before the first API call.
+func NewTelemetry() *Telemetry {
+	return &Telemetry{}
+}
+
+// Interceptor returns the interceptor emitting the telemetry of the requests of a configuration.
+func (t *Telemetry) Interceptor() datadog.Interceptor {
+	return datadog.Interceptor{
+		Request:  t.start,
+		Response: t.end,
+	}
+}
+
+func (t *Telemetry) init() {
+	t.once.Do(func() {
+		tracerProvider := t.TracerProvider
+		if tracerProvider == nil {
+			tracerProvider = otel.GetTracerProvider()
+		}
+		t.tracer = tracerProvider.Tracer(telemetryScope, trace.WithInstrumentationVersion(client.Version))
+
+		meterProvider := t.MeterProvider
+		if meterProvider == nil {
+			meterProvider = otel.GetMeterProvider()
+		}
+		meter := meterProvider.Meter(telemetryScope, metric.WithInstrumentationVersion(client.Version))
+		instruments, err := newTelemetryInstruments(meter)
+		if err != nil {
+			otel.Handle(err)
+			instruments, _ = newTelemetryInstruments(noop.Meter{})
+		}
+		t.instruments = instruments
+
+		if t.Propagator == nil {
+			t.Propagator = propagation.TraceContext{}
+		}
+	})
+}
+
+func newTelemetryInstruments(meter metric.Meter) (telemetryInstruments, error) {
+	var instruments telemetryInstruments
+	var err error
+	if instruments.duration, err = meter.Float64Histogram("datadog.client.request.duration",
+		metric.WithDescription("Duration of the requests, including the download of the response."),
+		metric.WithUnit("s")); err != nil {
+		return instruments, err
+	}
+	if instruments.requestSize, err = meter.Int64Histogram("datadog.client.request.body.size",
+		metric.WithDescription("Size of the request bodies."),
+		metric.WithUnit("By")); err != nil {
+		return instruments, err
+	}
+	if instruments.responseSize, err = meter.Int64Histogram("datadog.client.response.body.size",
+		metric.WithDescription("Size of the response bodies."),
+		metric.WithUnit("By")); err != nil {
+		return instruments, err
+	}
+	if instruments.retries, err = meter.Int64Counter("datadog.client.retries",
+		metric.WithDescription("Number of requests sent again after a failed attempt."),
+		metric.WithUnit("{retry}")); err != nil {
+		return instruments, err
+	}
+	if instruments.rateLimitRemaining, err = meter.Int64Gauge("datadog.client.ratelimit.remaining",
+		metric.WithDescription("Requests left in the rate limit window of the operation."),
+		metric.WithUnit("{request}")); err != nil {
+		return instruments, err
+	}
+	return instruments, nil
+}
+
+// attempt is the span and measurements of a request.
+type attempt struct {
+	telemetry  *Telemetry
+	ctx        context.Context
+	span       trace.Span
+	start      time.Time
+	attributes []attribute.KeyValue
+	endOnce    sync.Once
+}
+
+// start starts the span of a request and injects its trace context in the request headers.
+func (t *Telemetry) start(req *http.Request, info datadog.InterceptorInfo) error {
+	t.init()
+	name := info.OperationID
+	if name == "" {
+		name = req.Method
+	}
+	a := &attempt{
+		telemetry: t,
+		start:     time.Now(),
+		attributes: []attribute.KeyValue{
+			AttributeOperationID.String(info.OperationID),
+			attribute.String("http.request.method", req.Method),
+		},
+	}
+	ctx, span := t.tracer.Start(req.Context(), name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(a.attributes...),
+		trace.WithAttributes(
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("url.full", req.URL.Scheme+"://"+req.URL.Host+req.URL.Path),
+			attribute.Int64("http.request.body.size", req.ContentLength),
+		),
+	)
+	a.ctx, a.span = ctx, span
+	if info.Attempt > 1 {
+		span.SetAttributes(attribute.Int("http.request.resend_count", info.Attempt-1))
+		t.instruments.retries.Add(ctx, 1, metric.WithAttributes(a.attributes...))
+	}
+	t.Propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	t.instruments.requestSize.Record(ctx, req.ContentLength, metric.WithAttributes(a.attributes...))
+	t.attempts.Store(req, a)
+	return nil
+}
+
+// end records the outcome of a request. When there is a response, the request ends once its body is closed.
+func (t *Telemetry) end(req *http.Request, resp *http.Response, err error, info datadog.InterceptorInfo) error {
+	value, ok := t.attempts.LoadAndDelete(req)
+	if !ok {
+		return nil
+	}
+	a := value.(*attempt)
+	if err != nil || resp == nil {
+		if err != nil {
+			a.span.RecordError(err)
+			a.span.SetStatus(codes.Error, err.Error())
+		}
+		a.finish(0)
+		return nil
+	}
+
+	meta := datadog.AttemptResponseMeta(resp, info)
+	a.attributes = append(a.attributes, attribute.Int("http.response.status_code", resp.StatusCode))
+	a.span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if meta.RequestID != "" {
+		a.span.SetAttributes(AttributeRequestID.String(meta.RequestID))
+	}
+	if meta.RateLimit != nil {
+		a.span.SetAttributes(
+			AttributeRateLimitName.String(meta.RateLimit.Name),
+			AttributeRateLimitRemaining.Int(meta.RateLimit.Remaining),
+		)
+		t.instruments.rateLimitRemaining.Record(a.ctx, int64(meta.RateLimit.Remaining), metric.WithAttributes(
+			AttributeOperationID.String(info.OperationID),
+			AttributeRateLimitName.String(meta.RateLimit.Name),
+		))
+	}
+	if resp.StatusCode >= 400 {
+		a.span.SetStatus(codes.Error, resp.Status)
+	}
+	resp.Body = &telemetryBody{ReadCloser: resp.Body, attempt: a}
+	return nil
+}
+
+// finish ends the span and records the duration and response size of the request.
+func (a *attempt) finish(responseSize int64) {
+	a.endOnce.Do(func() {
+		ctx := a.ctx
+		options := metric.WithAttributes(a.attributes...)
+		a.telemetry.instruments.duration.Record(ctx, time.Since(a.start).Seconds(), options)
+		a.telemetry.instruments.responseSize.Record(ctx, responseSize, options)
+		a.span.SetAttributes(attribute.Int64("http.response.body.size", responseSize))
+		a.span.End()
+	})
+}
+
+// telemetryBody counts the bytes of a response body, and ends the request when it is closed.
+type telemetryBody struct {
+	io.ReadCloser
+	attempt *attempt
+	size    int64
+}
+
+func (b *telemetryBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	return n, err
+}
+
+func (b *telemetryBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.attempt.finish(b.size)
+	return err
+}
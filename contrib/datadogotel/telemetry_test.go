@@ -0,0 +1,137 @@
+package datadogotel_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/contrib/datadogotel"
+)
+
+func TestTelemetry(t *testing.T) {
+	traceparent := regexp.MustCompile("^00-[0-9a-f]{32}-[0-9a-f]{16}-01$")
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		w.Header().Set("Content-Type", "application/json")
+		if len(traceparents) == 1 {
+			w.Header().Set("X-Ratelimit-Reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"errors": ["Too many requests"]}`))
+			return
+		}
+		w.Header().Set("X-Ratelimit-Limit", "100")
+		w.Header().Set("X-Ratelimit-Remaining", "42")
+		w.Header().Set("X-Ratelimit-Period", "60")
+		w.Header().Set("X-Ratelimit-Name", "dashboard_lists")
+		w.Header().Set("X-Datadog-Request-Id", "abc123")
+		w.Write([]byte(`{"dashboards": [], "total": 0}`))
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	metrics := sdkmetric.NewManualReader()
+	telemetry := datadogotel.NewTelemetry()
+	telemetry.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	telemetry.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(metrics))
+
+	configuration := datadog.NewConfiguration()
+	configuration.RetryConfiguration.EnableRetry = true
+	configuration.OperationServers["v2.DashboardListsApi.GetDashboardListItems"] = datadog.ServerConfigurations{{URL: server.URL}}
+	configuration.Interceptors = append(configuration.Interceptors, telemetry.Interceptor())
+	api := datadogV2.NewDashboardListsApi(datadog.NewAPIClient(configuration))
+	ctx := context.WithValue(context.Background(), datadog.ContextAPIKeys, map[string]datadog.APIKey{
+		"apiKeyAuth": {Key: "api-key"},
+		"appKeyAuth": {Key: "app-key"},
+	})
+	_, _, err := api.GetDashboardListItems(ctx, 1234)
+	assert.NoError(t, err)
+
+	assert.Len(t, traceparents, 2)
+	ended := spans.Ended()
+	assert.Len(t, ended, 2)
+	for i, span := range ended {
+		assert.Regexp(t, traceparent, traceparents[i])
+		assert.Equal(t, span.SpanContext().SpanID().String(), traceparents[i][36:52])
+		assert.Equal(t, "v2.DashboardListsApi.GetDashboardListItems", span.Name())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	}
+	values := map[attribute.Key]attribute.Value{}
+	for _, kv := range ended[1].Attributes() {
+		values[kv.Key] = kv.Value
+	}
+	assert.Equal(t, "GET", values["http.request.method"].AsString())
+	assert.Equal(t, int64(200), values["http.response.status_code"].AsInt64())
+	assert.Equal(t, int64(1), values["http.request.resend_count"].AsInt64())
+	assert.Equal(t, int64(42), values[datadogotel.AttributeRateLimitRemaining].AsInt64())
+	assert.Equal(t, "abc123", values[datadogotel.AttributeRequestID].AsString())
+	assert.Greater(t, values["http.response.body.size"].AsInt64(), int64(0))
+
+	var collected metricdata.ResourceMetrics
+	assert.NoError(t, metrics.Collect(context.Background(), &collected))
+	names := map[string]bool{}
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			names[m.Name] = true
+			if m.Name == "datadog.client.retries" {
+				sum := m.Data.(metricdata.Sum[int64])
+				assert.Equal(t, int64(1), sum.DataPoints[0].Value)
+			}
+		}
+	}
+	for _, name := range []string{
+		"datadog.client.request.duration",
+		"datadog.client.request.body.size",
+		"datadog.client.response.body.size",
+		"datadog.client.retries",
+		"datadog.client.ratelimit.remaining",
+	} {
+		assert.True(t, names[name], name)
+	}
+}
+
+func TestTelemetryAbortedCalls(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"dashboards": [], "total": 0}`))
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	telemetry := datadogotel.NewTelemetry()
+	telemetry.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+
+	rejected := errors.New("rejected")
+	for _, interceptor := range []datadog.Interceptor{
+		{Request: func(*http.Request, datadog.InterceptorInfo) error { return rejected }},
+		{Response: func(*http.Request, *http.Response, error, datadog.InterceptorInfo) error { return rejected }},
+	} {
+		configuration := datadog.NewConfiguration()
+		configuration.OperationServers["v2.DashboardListsApi.GetDashboardListItems"] = datadog.ServerConfigurations{{URL: server.URL}}
+		configuration.Interceptors = append(configuration.Interceptors, telemetry.Interceptor(), interceptor)
+		api := datadogV2.NewDashboardListsApi(datadog.NewAPIClient(configuration))
+		_, _, err := api.GetDashboardListItems(context.Background(), 1234)
+		assert.ErrorIs(t, err, rejected)
+	}
+
+	ended := spans.Ended()
+	assert.Len(t, ended, 2)
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+	assert.Equal(t, "rejected", ended[0].Status().Description)
+	assert.Equal(t, codes.Unset, ended[1].Status().Code)
+	assert.Len(t, spans.Started(), 2)
+}
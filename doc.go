@@ -4,7 +4,7 @@
 //
 // Requirements
 //
-// • Go 1.22+
+// • Go 1.23+
 //
 // Layout
 //
@@ -88,6 +88,22 @@
 //
 // where <OperationName> is the name of the method used to interact with that endpoint. For example: GetLogsIndex, or UpdateLogsIndex
 //
+// Handle errors
+//
+// Unsuccessful responses are returned as datadog.GenericOpenAPIError, which carries the status code,
+// the operation ID, the Datadog request ID, the rate limit headers and the decoded error model.
+// Use errors.Is with the sentinel errors, or the helpers built on top of them, to check the kind of error:
+//
+//   _, _, err := api.GetMonitor(ctx, monitorID)
+//   if datadog.IsNotFound(err) {
+//   	// The monitor was deleted.
+//   } else if apiErr, ok := datadog.AsAPIError(err); ok {
+//   	log.Printf("%s failed with %d (request %s)", apiErr.OperationID, apiErr.StatusCode, apiErr.RequestID)
+//   	if model, ok := datadog.ErrorModelAs[datadogV1.APIErrorResponse](err); ok {
+//   		log.Println(model.Errors)
+//   	}
+//   }
+//
 // Changing Server
 //
 // When talking to a different server, like the eu instance, change the ContextServerVariables:
@@ -98,6 +114,68 @@
 //               "site": "datadoghq.eu",
 //       })
 //
+// Credentials and multiple organizations
+//
+// Instead of passing the keys with ContextAPIKeys, set a credential provider on the configuration. It is used
+// by the requests whose context has no API key. datadog.StaticCredentials, datadog.EnvCredentials,
+// datadog.FileCredentials and datadog.RefreshingCredentials cover fixed keys, environment variables,
+// JSON files rewritten on rotation and keys fetched from a secret store until they expire:
+//
+//   configuration := datadog.NewConfiguration()
+//   configuration.Credentials = datadog.FileCredentials("/var/run/secrets/datadog.json")
+//
+// To work with several organizations, register their site and credentials in configuration.Orgs and pick one
+// per call with datadog.WithOrg. datadog.FanOut calls an operation for all of them, with bounded concurrency,
+// and gathers the results:
+//
+//   configuration.Orgs = datadog.NewOrgRegistry(
+//   	datadog.Org{Name: "child-us", Credentials: datadog.StaticCredentials(usAPIKey, usAppKey)},
+//   	datadog.Org{Name: "child-eu", Site: "datadoghq.eu", Credentials: datadog.StaticCredentials(euAPIKey, euAppKey)},
+//   )
+//   monitorsApi := datadogV1.NewMonitorsApi(datadog.NewAPIClient(configuration))
+//
+//   monitors, _, err := monitorsApi.ListMonitors(datadog.WithOrg(ctx, "child-eu"))
+//
+//   results := datadog.FanOut(ctx, configuration.Orgs, 8, func(ctx context.Context, org string) ([]datadogV1.Monitor, error) {
+//   	monitors, _, err := monitorsApi.ListMonitors(ctx)
+//   	return monitors, err
+//   })
+//   if err := results.Err(); err != nil {
+//   	log.Println(err)
+//   }
+//   for org, monitors := range results.Values() {
+//   	fmt.Println(org, len(monitors))
+//   }
+//
+// Load the configuration from a file
+//
+// datadog.LoadConfiguration reads a profile of a YAML, JSON or TOML configuration file, ~/.datadog/config by
+// default or the file set in DD_CONFIG_FILE, and returns the configuration and context to use. A profile can set
+// the site, the keys or the paths of files holding them, a proxy, timeouts, retries, default headers, compression
+// and the unstable operations to enable:
+//
+//   default_profile: prod
+//   profiles:
+//     prod:
+//       site: datadoghq.eu
+//       api_key_file: /var/run/secrets/datadog/api-key
+//       app_key_file: /var/run/secrets/datadog/app-key
+//       proxy: http://proxy.internal:3128
+//       retry:
+//         enabled: true
+//         max_retries: 5
+//       unstable_operations: [v2.ListIncidents]
+//       headers:
+//         X-Team: platform
+//
+//   configuration, ctx, err := datadog.LoadConfiguration(context.Background(), "", "")
+//   if err != nil {
+//   	log.Fatal(err)
+//   }
+//   apiClient := datadog.NewAPIClient(configuration)
+//
+// An empty profile name selects the profile set in DD_PROFILE, then the default_profile of the file.
+//
 // Disable compressed payloads
 //
 // If you want to disable GZIP compressed responses, set the compress flag
@@ -106,12 +184,64 @@
 //
 //       configuration.Compress = false
 //
+// Validate requests before sending them
+//
+// Every model has a Validate() method checking its values against the constraints of the OpenAPI spec, such as the
+// length and pattern of strings, the bounds of numbers, the number of items of arrays and the values of enums, including
+// the ones of nested objects. The broken constraints are returned as *datadog.ValidationError joined with errors.Join,
+// with the path of each value, e.g. data.attributes.handle.
+//
+// Set ValidateRequests to validate the request bodies in the client, and get these errors instead of 400 responses:
+//
+//   configuration := datadog.NewConfiguration()
+//   configuration.ValidateRequests = true
+//
+// Strict decoding
+//
+// Values of a response that do not fit the models, such as a widget of a type added to the API after the client was
+// generated, are kept in the UnparsedObject fields of the models, and unknown fields in AdditionalProperties.
+// datadog.FindUnparsed lists them with their JSON path, e.g. $.widgets[1].definition, to audit decoded values:
+//
+//   for _, unparsed := range datadog.FindUnparsed(dashboard) {
+//       log.Printf("%s did not fit the models", unparsed.Path)
+//   }
+//
+// Set StrictDecoding to fail instead: the API calls then return the decoded value with an error wrapping a
+// *datadog.UnparsedError, which can be retrieved with errors.As.
+//
+//   configuration := datadog.NewConfiguration()
+//   configuration.StrictDecoding = true
+//
 // Enable requests logging
 //
 // If you want to enable requests logging, set the debug flag on your configuration object:
 //
 //       configuration.Debug = true
 //
+// Requests and responses are logged at debug level, with API and application keys, authorization
+// headers, bearer tokens and secret JSON fields such as password redacted, and bodies truncated to 16 KiB.
+// To send the logs to your own logger, set Logger, for example to a log/slog logger. Additional headers
+// and JSON fields can be redacted with LogRedaction, and fields added to the entries of a request with WithLogFields:
+//
+//       configuration.Logger = datadog.NewSlogLogger(slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})))
+//       configuration.LogRedaction = datadog.LogRedaction{JSONFields: []string{"email"}, MaxBodySize: 4096}
+//       ctx = datadog.WithLogFields(ctx, "job", "sync-monitors")
+//
+// Response metadata
+//
+// The metadata of a call, such as the request ID to give to the support, the rate limit of the operation, the number of
+// retries, the latency and the Server-Timing metrics, is available from the returned *http.Response:
+//
+//   resp, r, err := api.GetTeam(ctx, teamID)
+//   if meta, ok := datadog.ResponseMetaFromResponse(r); ok {
+//       slog.Info("Got team", "datadog", meta)
+//   }
+//
+// When the call fails before a response is returned, it can be read from a context set with WithResponseMeta instead:
+//
+//   var meta datadog.ResponseMeta
+//   resp, r, err := api.GetTeam(datadog.WithResponseMeta(ctx, &meta), teamID)
+//
 // Enable retry
 //
 // If you want to enable retry when getting status code 429 rate-limited, set EnableRetry to true
@@ -122,6 +252,32 @@
 //
 //       configuration.RetryConfiguration.MaxRetries = 3
 //
+// Configure the retry policy
+//
+// If you want more control over retries, set a RetryPolicy on your configuration object.
+// The DefaultRetryPolicy adds full jitter to the back-off, honors the Retry-After header,
+// retries transient network errors, and only retries server errors for idempotent operations,
+// so that calls like CreateMonitor are never duplicated:
+//
+//       configuration.RetryConfiguration.EnableRetry = true
+//       configuration.RetryPolicy = datadog.NewDefaultRetryPolicy(configuration.RetryConfiguration)
+//
+// Use SetIdempotentOperation to override whether an operation is safe to retry:
+//
+//       configuration.SetIdempotentOperation("v1.EventsApi.CreateEvent", true)
+//
+// Enable the circuit breaker
+//
+// If you want to stop sending requests to an operation that keeps failing, set a CircuitBreaker
+// on your configuration object. After FailureThreshold consecutive failures, calls fail fast with
+// a datadog.ErrCircuitOpen error until OpenTimeout elapses and a probe request succeeds:
+//
+//       breaker := datadog.NewCircuitBreaker()
+//       breaker.OnStateChange = func(key datadog.CircuitKey, from, to datadog.CircuitState) {
+//           log.Printf("circuit for %s is now %s", key.OperationID, to)
+//       }
+//       configuration.CircuitBreaker = breaker
+//
 // Configure proxy
 //
 // If you want to configure proxy, set env var HTTP_PROXY, and HTTPS_PROXY or set custom
@@ -132,6 +288,158 @@
 //           Transport: &http.Transport{Proxy: http.ProxyURL(proxyUrl)}
 //       }
 //
+// Add interceptors
+//
+// If you want to run custom logic on every request, such as tracing, metrics or request signing,
+// register an interceptor on your configuration object. Request hooks run in registration order
+// before each attempt, and response hooks run in reverse order after each attempt:
+//
+//       configuration.AddInterceptor(datadog.Interceptor{
+//           Request: func(req *http.Request, info datadog.InterceptorInfo) error {
+//               log.Printf("calling %s (attempt %d)", info.OperationID, info.Attempt)
+//               return nil
+//           },
+//       })
+//
+// Enable OpenTelemetry
+//
+// The datadogotel module, github.com/DataDog/datadog-api-client-go/v2/contrib/datadogotel, emits an
+// OpenTelemetry span and metrics for every request sent by the client, retries included, and propagates the W3C trace
+// context to Datadog. It is hooked in as an interceptor, registered after the other ones. Spans carry the operation ID,
+// HTTP method, status code, resend count, body sizes, request ID and remaining rate limit. The metrics are
+// datadog.client.request.duration, datadog.client.request.body.size, datadog.client.response.body.size,
+// datadog.client.retries and datadog.client.ratelimit.remaining. The global providers are used unless others
+// are set:
+//
+//   telemetry := datadogotel.NewTelemetry()
+//   telemetry.TracerProvider = tracerProvider
+//   telemetry.MeterProvider = meterProvider
+//   configuration.Interceptors = append(configuration.Interceptors, telemetry.Interceptor())
+//
+// The datadogotel module is released together with the client, with the same version, and requires the
+// version of the client it is released with.
+//
+// Client-side rate limiting
+//
+// If you want the client to wait before a rate limit bucket is exhausted, instead of
+// retrying after a 429 response, set a shared RateLimiter on your configuration object.
+// It tracks the X-RateLimit-* headers of every response per bucket:
+//
+//       limiter := datadog.NewRateLimiter()
+//       configuration.RateLimiter = limiter
+//
+// The current state of each bucket is available with limiter.Buckets().
+//
+// Batch operations
+//
+// datadog.Batch runs an operation for each input of a slice with bounded concurrency, and returns the value, response
+// and error of each of them with a summary. When an operation gets a 429 response, the batch pauses until the rate limit
+// is reset and runs it again, and the operations are also held by the RateLimiter of the client, if any:
+//
+//   result := datadog.Batch(ctx, monitors, func(ctx context.Context, monitor datadogV1.Monitor) (datadogV1.Monitor, *http.Response, error) {
+//       return api.UpdateMonitor(ctx, monitor.GetId(), update)
+//   }, datadog.BatchOptions{Concurrency: 16})
+//   log.Print(result) // 3000 items: 2998 succeeded, 2 failed, 0 skipped in 1m2s
+//   if err := result.Err(); err != nil {
+//       log.Print(err)
+//   }
+//
+// Submit metrics in the background
+//
+// The intake package provides a MetricsSubmitter, which aggregates gauges, counts and rates client-side and sends
+// them with MetricsApi.SubmitMetrics every flush interval. Payloads are compressed, split to stay under the size limit
+// of the intake, and sent again with a backoff when the intake times out, rate limits them or fails with a server error.
+// Close the submitter to send the last metrics:
+//
+//       submitter := intake.NewMetricsSubmitter(ctx, datadogV2.NewMetricsApi(apiClient), intake.MetricsSubmitterOptions{
+//           FlushInterval: 10 * time.Second,
+//           Tags:          []string{"env:prod"},
+//           OnError:       func(err error) { log.Print(err) },
+//       })
+//       defer submitter.Close()
+//       submitter.Gauge("queue.size", float64(len(queue)), "queue:default")
+//       submitter.Count("jobs.processed", 1, "queue:default")
+//
+// Submit distributions aggregated client-side
+//
+// MetricsApi.SubmitDistributionPoints takes the values of the distributions. The DistributionSubmitter of the
+// intake package adds the values of each series to a DDSketch, which bounds the memory used between two flushes, and
+// sends every flush interval the values representing the sketch, within a configurable relative accuracy. These
+// repeated values make small compressed payloads, and the smallest and largest values are sent exactly:
+//
+//       submitter, err := intake.NewDistributionSubmitter(ctx, datadogV1.NewMetricsApi(apiClient), intake.DistributionSubmitterOptions{
+//           RelativeAccuracy: 0.01,
+//           MaxBins:          1024,
+//           MaxSeries:        10000,
+//       })
+//       if err != nil {
+//           log.Fatal(err)
+//       }
+//       defer submitter.Close()
+//       submitter.Distribution("request.latency", time.Since(start).Seconds(), "endpoint:/users")
+//
+// Ship logs in the background
+//
+// The LogShipper of the intake package queues logs in a bounded queue and sends them with LogsApi.SubmitLog in
+// compressed batches, which respect the limits of the intake of 1000 logs and 5MB per payload, or are sent once their
+// oldest log waited MaxBatchAge. When the queue is full, Ship blocks by default, or drops the newest or oldest log
+// with the OverflowDropNewest and OverflowDropOldest policies. Payloads are sent again when the intake times out,
+// rate limits them or fails with a server error, and Close sends the queued logs:
+//
+//       shipper := intake.NewLogShipper(ctx, datadogV2.NewLogsApi(apiClient), intake.LogShipperOptions{
+//           QueueSize:   10000,
+//           Overflow:    intake.OverflowDropOldest,
+//           MaxBatchAge: 5 * time.Second,
+//           OnError:     func(err error) { log.Print(err) },
+//       })
+//       defer shipper.Close()
+//       item := datadogV2.NewHTTPLogItem("payment processed")
+//       item.SetService("payments")
+//       if err := shipper.Ship(ctx, *item); err != nil {
+//           log.Print(err)
+//       }
+//
+// Send slog records to Datadog
+//
+// The LogHandler of the intake package is a slog.Handler sending the records through a LogShipper. The message,
+// level and time of a record are the message, status and timestamp of the log, and its attributes and groups are the
+// attributes of the log, as nested objects. The top-level service, ddsource, hostname and ddtags attributes
+// set the fields of the log, over the defaults of the options:
+//
+//       logger := slog.New(intake.NewLogHandler(shipper, intake.LogHandlerOptions{
+//           Service: "payments",
+//           Tags:    []string{"env:prod"},
+//           Level:   slog.LevelDebug,
+//       }))
+//       logger.Info("payment processed", slog.Group("http", slog.Int("status_code", 200)))
+//
+// Spool payloads to disk
+//
+// A Spool of the intake package writes payloads to a local directory before they are sent, and removes them once
+// the intake accepted them. Payloads failing because the network is down, or with a retryable error, are sent again
+// until they are accepted, and the payloads left when the process stops are sent, in order, when the spool is opened
+// again. MaxBytes caps the disk usage, evicting the oldest payloads or rejecting the new ones, and OnDrop reports
+// the payloads dropped:
+//
+//       spool, err := intake.OpenSpool(ctx, "/var/lib/app/spool", intake.SpoolOptions{
+//           Senders: map[string]intake.SpoolSender{
+//               "v1.EventsApi.CreateEvent":               intake.CreateEventSender(datadogV1.NewEventsApi(apiClient)),
+//               "v1.ServiceChecksApi.SubmitServiceCheck": intake.SubmitServiceCheckSender(datadogV1.NewServiceChecksApi(apiClient)),
+//               "v2.MetricsApi.SubmitMetrics":            intake.SubmitMetricsSender(datadogV2.NewMetricsApi(apiClient)),
+//           },
+//           MaxBytes: 100 << 20,
+//           OnDrop: func(drop intake.SpoolDrop) {
+//               log.Printf("dropped %s", drop)
+//           },
+//       })
+//       if err != nil {
+//           log.Fatal(err)
+//       }
+//       defer spool.Close()
+//       err = spool.Enqueue("v1.EventsApi.CreateEvent", event)
+//
+// The Spool option of MetricsSubmitter and LogShipper enqueues their payloads to a spool instead of sending them.
+//
 // Pagination
 //
 // Several listing operations have a pagination method to help consume all the items available.
@@ -167,6 +475,84 @@
 //
 //   }
 //
+// Each pagination method also has a Seq variant returning an iter.Seq2, which requests pages as the loop
+// consumes them and does not leak a goroutine when the loop exits early:
+//
+//   for incident, err := range incidentsApi.ListIncidentsSeq(ctx) {
+//   	if err != nil {
+//   		fmt.Fprintf(os.Stderr, "Error when calling IncidentsApi.ListIncidentsSeq: %v\n", err)
+//   		break
+//   	}
+//   	fmt.Println(incident.Id)
+//   }
+//
+// Operations paging with query parameters, like RolesApi.ListRoles or UsageMeteringApi.GetHourlyUsage,
+// get the same methods, built on datadog.Paginator. It supports cursor, offset, page number and next record ID
+// pagination and can also be used directly to page through any other operation.
+//
+// Operations paginated by offset or page number also have a Prefetch variant, which keeps the given number of
+// page requests in flight while the items are consumed. Items are still returned in order:
+//
+//   for incident, err := range incidentsApi.ListIncidentsPrefetch(ctx, 4) {
+//   	if err != nil {
+//   		fmt.Fprintf(os.Stderr, "Error when calling `IncidentsApi.ListIncidentsPrefetch`: %v\n", err)
+//   		break
+//   	}
+//   	fmt.Println(incident.GetId())
+//   }
+//
+// Streaming large responses
+//
+// List and search operations returning large pages, like ListLogs, ListSpans, ListAuditLogs or GetHourlyUsage,
+// have a Stream variant decoding the items of the response one at a time instead of buffering the whole page.
+// The returned response holds everything but the items, such as the pagination cursor:
+//
+//   resp, _, err := api.ListLogsStream(ctx, func(log datadogV2.Log) error {
+//   	return export(log)
+//   }, *datadogV2.NewListLogsOptionalParameters().WithBody(body))
+//   if err != nil {
+//   	// err is the first error returned by the callback, or the error of the request.
+//   }
+//   cursor := resp.Meta.Page.GetAfter()
+//
+// Mocking the APIs
+//
+// Every API has an interface listing its operations, such as datadogV1.MonitorsApiInterface for datadogV1.MonitorsApi.
+// Depend on the interface in your code to replace the API with a mock in tests, written by hand or generated with tools
+// such as mockgen or mockery:
+//
+//       type monitorChecker struct {
+//           monitors datadogV1.MonitorsApiInterface
+//       }
+//
+//       checker := monitorChecker{monitors: datadogV1.NewMonitorsApi(apiClient)}
+//
+// Testing without a Datadog account
+//
+// The datadogtest package provides a Recorder that records the requests sent by the client and their responses
+// in a YAML cassette, with API and application keys scrubbed, and replays them so that tests run offline. Requests
+// are matched by operation ID, method, URL and body. Set the mode to datadogtest.ModeRecord to record the cassette
+// again against the API, and datadogtest.ModeAuto to record it only when the file does not exist:
+//
+//       recorder, err := datadogtest.NewRecorder("testdata/get_team.yaml", datadogtest.ModeReplay)
+//       if err != nil {
+//           t.Fatal(err)
+//       }
+//       defer recorder.Close()
+//       configuration := datadog.NewConfiguration()
+//       configuration.HTTPClient = recorder.HTTPClient()
+//
+// The datadogtest package also provides a fake server, keeping monitors, dashboards, SLOs, synthetics tests,
+// downtimes, users, teams and incidents in memory. Request bodies are validated with the models, and errors are
+// returned like the API does:
+//
+//       server := datadogtest.NewServer()
+//       defer server.Close()
+//       configuration := datadog.NewConfiguration()
+//       configuration.HTTPClient = server.HTTPClient()
+//       apiClient := datadog.NewAPIClient(configuration)
+//       monitor, _, err := datadogV1.NewMonitorsApi(apiClient).CreateMonitor(ctx, body)
+//
 // Encoder/Decoder
 //
 // By default, datadog-api-client-go uses the Go standard library enconding/json (https://pkg.go.dev/encoding/json) to encode and decode data. As an alternative users can opt in to use goccy/go-json (https://github.com/goccy/go-json) by specifying the go build tag goccy_gojson.
@@ -1,6 +1,6 @@
 module github.com/DataDog/datadog-api-client-go/v2
 
-go 1.22
+go 1.23
 
 retract (
 	// Version used to retract v2.0.0 and v2.0.1. DO NOT USE.
@@ -10,10 +10,12 @@ retract (
 )
 
 require (
+	github.com/BurntSushi/toml v1.4.0
 	github.com/DataDog/zstd v1.5.2
 	github.com/goccy/go-json v0.10.2
 	github.com/google/uuid v1.5.0
 	golang.org/x/oauth2 v0.10.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -3,7 +3,7 @@ set -e
 
 echo "Ensuring all dependencies are present in LICENSE-3rdparty.csv ..."
 go mod tidy
-ALL_DEPS=$(cat go.sum tests/go.sum | awk '{print $1}' | uniq | sort | sed "s|^\(.*\)|go.sum,\1,|")
+ALL_DEPS=$(cat go.sum tests/go.sum contrib/datadogotel/go.sum | awk '{print $1}' | uniq | sort | sed "s|^\(.*\)|go.sum,\1,|")
 DEPS_NOT_FOUND=""
 set +e
 for one_dep in $ALL_DEPS; do
@@ -32,6 +32,13 @@ staticcheck -checks -SA1009 ./api/...
 go mod tidy
 go clean -testcache
 
+# The OpenTelemetry instrumentation is a module of its own
+cd contrib/datadogotel
+staticcheck ./...
+go mod tidy
+go test ./...
+cd -
+
 # Run the same in tests submodule
 cd tests
 staticcheck -checks inherit,-SA1019 ./...
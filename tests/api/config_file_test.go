@@ -0,0 +1,231 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+const yamlConfig = `
+default_profile: staging
+profiles:
+  staging:
+    site: datadoghq.eu
+    api_key: staging-api-key
+    app_key: staging-app-key
+    proxy: http://proxy.internal:3128
+    timeout: 45s
+    compress: false
+    retry:
+      enabled: true
+      max_retries: 5
+      backoff_base: 3
+      timeout: 2m
+    unstable_operations: [v2.ListIncidents]
+    headers:
+      X-Team: platform
+  prod:
+    site: us5.datadoghq.com
+`
+
+const jsonConfig = `{
+	"profiles": {
+		"default": {"site": "us3.datadoghq.com", "api_key": "json-api-key", "retry": {"enabled": true}}
+	}
+}`
+
+const keyFileConfig = `
+# Shared by all the internal tools.
+default_profile: prod
+profiles:
+  prod:
+    site: ap1.datadoghq.com
+    api_key_file: %s
+    app_key: literal-app-key
+    unstable_operations:
+      - v2.ListIncidents
+      - v2.GetIncident
+    retry:
+      max_retries: 10
+      backoff_multiplier: 1.5
+`
+
+const tomlConfig = `
+# Shared by all the internal tools.
+default_profile = "prod"
+
+[profiles.prod]
+site = "ap1.datadoghq.com"
+api_key = "toml-api-key"
+app_key = 'literal-app-key' # inline comment
+unstable_operations = [
+	"v2.ListIncidents",
+	"v2.GetIncident",
+]
+headers = { "X-Team" = "sre", X-Env = "prod" }
+
+[profiles.prod.retry]
+enabled = true
+max_retries = 1_0
+backoff_multiplier = 1.5
+timeout = "1m"
+`
+
+func writeConfig(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigurationYAML(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	t.Setenv("DD_PROFILE", "")
+	cfg, ctx, err := datadog.LoadConfiguration(context.Background(), writeConfig(t, "config.yaml", yamlConfig), "")
+	assert.NoError(err)
+
+	URL, err := cfg.ServerURLWithContext(ctx, "v2.IncidentsApi.ListIncidents")
+	assert.NoError(err)
+	assert.Equal("https://api.datadoghq.eu", URL)
+	credentials, err := cfg.Credentials.Credentials(ctx)
+	assert.NoError(err)
+	assert.Equal(datadog.Credentials{APIKey: "staging-api-key", AppKey: "staging-app-key"}, credentials)
+
+	request, _ := http.NewRequest(http.MethodGet, "https://api.datadoghq.eu", nil)
+	proxy, err := cfg.HTTPClient.Transport.(*http.Transport).Proxy(request)
+	assert.NoError(err)
+	assert.Equal("proxy.internal:3128", proxy.Host)
+	assert.Equal(45*time.Second, cfg.HTTPClient.Timeout)
+	assert.False(cfg.Compress)
+	assert.True(cfg.RetryConfiguration.EnableRetry)
+	assert.Equal(5, cfg.RetryConfiguration.MaxRetries)
+	assert.Equal(3.0, cfg.RetryConfiguration.BackOffBase)
+	assert.Equal(2.0, cfg.RetryConfiguration.BackOffMultiplier)
+	assert.Equal(2*time.Minute, cfg.RetryConfiguration.HTTPRetryTimeout)
+	assert.True(cfg.IsUnstableOperationEnabled("v2.ListIncidents"))
+	assert.Equal("platform", cfg.DefaultHeader["X-Team"])
+
+	cfg, ctx, err = datadog.LoadConfiguration(context.Background(), writeConfig(t, "config.yml", yamlConfig), "prod")
+	assert.NoError(err)
+	URL, err = cfg.ServerURLWithContext(ctx, "v2.IncidentsApi.ListIncidents")
+	assert.NoError(err)
+	assert.Equal("https://api.us5.datadoghq.com", URL)
+	assert.Nil(cfg.Credentials)
+	assert.True(cfg.Compress)
+}
+
+func TestLoadConfigurationJSON(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	t.Setenv("DD_PROFILE", "")
+	// Without extension, the format is guessed from the content.
+	t.Setenv("DD_CONFIG_FILE", writeConfig(t, "config", jsonConfig))
+	cfg, ctx, err := datadog.LoadConfiguration(context.Background(), "", "")
+	assert.NoError(err)
+	URL, err := cfg.ServerURLWithContext(ctx, "v1.MonitorsApi.ListMonitors")
+	assert.NoError(err)
+	assert.Equal("https://api.us3.datadoghq.com", URL)
+	credentials, err := cfg.Credentials.Credentials(ctx)
+	assert.NoError(err)
+	assert.Equal(datadog.Credentials{APIKey: "json-api-key"}, credentials)
+	assert.True(cfg.RetryConfiguration.EnableRetry)
+	assert.Equal(3, cfg.RetryConfiguration.MaxRetries)
+
+	_, _, err = datadog.LoadConfiguration(context.Background(), "", "missing")
+	assert.Error(err)
+	assert.Contains(err.Error(), `profile "missing" not found`)
+}
+
+func TestLoadConfigurationKeyFile(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	t.Setenv("DD_PROFILE", "")
+	keyFile := writeConfig(t, "api-key", "file-api-key\n")
+	path := writeConfig(t, "config.yaml", fmt.Sprintf(keyFileConfig, keyFile))
+	cfg, ctx, err := datadog.LoadConfiguration(context.Background(), path, "")
+	assert.NoError(err)
+
+	URL, err := cfg.ServerURLWithContext(ctx, "v2.IncidentsApi.ListIncidents")
+	assert.NoError(err)
+	assert.Equal("https://api.ap1.datadoghq.com", URL)
+	credentials, err := cfg.Credentials.Credentials(ctx)
+	assert.NoError(err)
+	assert.Equal(datadog.Credentials{APIKey: "file-api-key", AppKey: "literal-app-key"}, credentials)
+	assert.True(cfg.IsUnstableOperationEnabled("v2.ListIncidents"))
+	assert.True(cfg.IsUnstableOperationEnabled("v2.GetIncident"))
+	assert.Equal(10, cfg.RetryConfiguration.MaxRetries)
+	assert.Equal(1.5, cfg.RetryConfiguration.BackOffMultiplier)
+
+	// The key file is only read again when its modification time changes.
+	info, err := os.Stat(keyFile)
+	assert.NoError(err)
+	assert.NoError(os.WriteFile(keyFile, []byte("unread-api-key"), 0600))
+	assert.NoError(os.Chtimes(keyFile, info.ModTime(), info.ModTime()))
+	credentials, err = cfg.Credentials.Credentials(ctx)
+	assert.NoError(err)
+	assert.Equal("file-api-key", credentials.APIKey)
+	assert.NoError(os.WriteFile(keyFile, []byte("rotated-api-key"), 0600))
+	assert.NoError(os.Chtimes(keyFile, info.ModTime().Add(time.Second), info.ModTime().Add(time.Second)))
+	credentials, err = cfg.Credentials.Credentials(ctx)
+	assert.NoError(err)
+	assert.Equal("rotated-api-key", credentials.APIKey)
+
+	// Retry settings without enabled keep whether retries are enabled.
+	file, err := datadog.ReadConfigFile(path)
+	assert.NoError(err)
+	profile, err := file.Profile("prod")
+	assert.NoError(err)
+	cfg = datadog.NewConfiguration()
+	cfg.RetryConfiguration.EnableRetry = true
+	_, err = profile.Apply(context.Background(), cfg)
+	assert.NoError(err)
+	assert.True(cfg.RetryConfiguration.EnableRetry)
+}
+
+func TestLoadConfigurationTOML(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	t.Setenv("DD_PROFILE", "")
+	// Like ~/.datadog/config, the file has no extension and its format is guessed from its content.
+	for _, name := range []string{"config", "config.toml"} {
+		cfg, ctx, err := datadog.LoadConfiguration(context.Background(), writeConfig(t, name, tomlConfig), "")
+		assert.NoError(err, name)
+
+		URL, err := cfg.ServerURLWithContext(ctx, "v1.DashboardsApi.ListDashboards")
+		assert.NoError(err)
+		assert.Equal("https://api.ap1.datadoghq.com", URL)
+		credentials, err := cfg.Credentials.Credentials(ctx)
+		assert.NoError(err)
+		assert.Equal(datadog.Credentials{APIKey: "toml-api-key", AppKey: "literal-app-key"}, credentials)
+		assert.True(cfg.IsUnstableOperationEnabled("v2.ListIncidents"))
+		assert.True(cfg.IsUnstableOperationEnabled("v2.GetIncident"))
+		assert.Equal("sre", cfg.DefaultHeader["X-Team"])
+		assert.Equal("prod", cfg.DefaultHeader["X-Env"])
+		assert.True(cfg.RetryConfiguration.EnableRetry)
+		assert.Equal(10, cfg.RetryConfiguration.MaxRetries)
+		assert.Equal(1.5, cfg.RetryConfiguration.BackOffMultiplier)
+		assert.Equal(time.Minute, cfg.RetryConfiguration.HTTPRetryTimeout)
+	}
+}
+
+func TestLoadConfigurationErrors(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	for name, content := range map[string]string{
+		"unknown.yaml":  "profiles:\n  default:\n    api_keys: typo\n",
+		"unstable.json": `{"profiles": {"default": {"unstable_operations": ["v1.ListMonitors"]}}}`,
+		"timeout.yaml":  "profiles:\n  default:\n    timeout: soon\n",
+		"syntax.json":   `{"profiles": {"default": {"site": "datadoghq.eu}}}`,
+		"profiles.yaml": "profiles:\n  - default\n",
+		"timeout.toml":  "[profiles.default]\ntimeout = \"soon\"\n",
+		"syntax.toml":   "[profiles.default]\nsite = \"datadoghq.eu\n",
+		"tables.toml":   "[[profiles]]\n",
+	} {
+		_, _, err := datadog.LoadConfiguration(context.Background(), writeConfig(t, name, content), "default")
+		assert.Error(err, name)
+	}
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestEnvCredentials(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	t.Setenv("DD_API_KEY", "api-key")
+	t.Setenv("DD_APP_KEY", "app-key")
+	credentials, err := datadog.EnvCredentials().Credentials(context.Background())
+	assert.NoError(err)
+	assert.Equal(datadog.Credentials{APIKey: "api-key", AppKey: "app-key"}, credentials)
+
+	os.Unsetenv("DD_API_KEY")
+	_, err = datadog.EnvCredentials().Credentials(context.Background())
+	assert.True(errors.Is(err, datadog.ErrNoCredentials))
+}
+
+func TestFileCredentials(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	provider := datadog.FileCredentials(path)
+
+	_, err := provider.Credentials(context.Background())
+	assert.Error(err)
+
+	assert.NoError(os.WriteFile(path, []byte(`{"api_key": "old-api-key", "app_key": "old-app-key"}`), 0600))
+	credentials, err := provider.Credentials(context.Background())
+	assert.NoError(err)
+	assert.Equal("old-api-key", credentials.APIKey)
+
+	// Rotated keys are read again.
+	assert.NoError(os.WriteFile(path, []byte(`{"api_key": "new-api-key", "app_key": "new-app-key"}`), 0600))
+	assert.NoError(os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	credentials, err = provider.Credentials(context.Background())
+	assert.NoError(err)
+	assert.Equal(datadog.Credentials{APIKey: "new-api-key", AppKey: "new-app-key"}, credentials)
+
+	assert.NoError(os.WriteFile(path, []byte(`{"app_key": "app-key"}`), 0600))
+	assert.NoError(os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	_, err = provider.Credentials(context.Background())
+	assert.True(errors.Is(err, datadog.ErrNoCredentials))
+}
+
+func TestRefreshingCredentials(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	var fetches int
+	var fetchErr error
+	expiration := time.Now().Add(time.Hour)
+	provider := datadog.RefreshingCredentials(func(ctx context.Context) (datadog.Credentials, time.Time, error) {
+		fetches++
+		return datadog.Credentials{APIKey: "key-" + string(rune('0'+fetches))}, expiration, fetchErr
+	}, 10*time.Minute)
+
+	credentials, err := provider.Credentials(context.Background())
+	assert.NoError(err)
+	assert.Equal("key-1", credentials.APIKey)
+	credentials, err = provider.Credentials(context.Background())
+	assert.NoError(err)
+	assert.Equal("key-1", credentials.APIKey)
+	assert.Equal(1, fetches)
+
+	// Refreshed ahead of the expiration.
+	expiration = time.Now().Add(5 * time.Minute)
+	provider = datadog.RefreshingCredentials(func(ctx context.Context) (datadog.Credentials, time.Time, error) {
+		fetches++
+		return datadog.Credentials{APIKey: "key-" + string(rune('0'+fetches))}, expiration, fetchErr
+	}, 10*time.Minute)
+	fetches = 0
+	_, err = provider.Credentials(context.Background())
+	assert.NoError(err)
+	credentials, err = provider.Credentials(context.Background())
+	assert.NoError(err)
+	assert.Equal("key-2", credentials.APIKey)
+
+	// Valid credentials are kept when refreshing fails.
+	fetchErr = errors.New("vault unavailable")
+	credentials, err = provider.Credentials(context.Background())
+	assert.NoError(err)
+	assert.Equal("key-2", credentials.APIKey)
+}
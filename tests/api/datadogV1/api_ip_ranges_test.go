@@ -8,6 +8,7 @@ package test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -83,3 +84,46 @@ func TestIPRangesMocked(t *testing.T) {
 	assert.Equal([]string{"ipv6"}, ipRanges.Synthetics.GetPrefixesIpv6())
 	assert.Equal([]string{"ipv6"}, ipRanges.Webhooks.GetPrefixesIpv6())
 }
+
+func TestCredentialsOfAuthSchemes(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(ctx)
+	defer gock.Off()
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().Credentials = datadog.StaticCredentials("api-key", "app-key")
+
+	headers := map[string]http.Header{}
+	record := func(operationID string) gock.MatchFunc {
+		return func(req *http.Request, _ *gock.Request) (bool, error) {
+			headers[operationID] = req.Header.Clone()
+			return true, nil
+		}
+	}
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v1.IPRangesApi.GetIPRanges")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/").
+		AddMatcher(record("GetIPRanges")).
+		Reply(200).
+		JSON(map[string]interface{}{})
+	URL, err = client.GetConfig().ServerURLWithContext(ctx, "v1.EventsApi.CreateEvent")
+	assert.NoError(err)
+	gock.New(URL).
+		Post("/api/v1/events").
+		AddMatcher(record("CreateEvent")).
+		Reply(202).
+		JSON(map[string]interface{}{})
+
+	_, _, err = datadogV1.NewIPRangesApi(client).GetIPRanges(ctx)
+	assert.NoError(err)
+	_, _, err = datadogV1.NewEventsApi(client).CreateEvent(ctx, *datadogV1.NewEventCreateRequest("text", "title"))
+	assert.NoError(err)
+	assert.True(gock.IsDone())
+
+	assert.Empty(headers["GetIPRanges"].Get("DD-API-KEY"))
+	assert.Empty(headers["GetIPRanges"].Get("DD-APPLICATION-KEY"))
+	assert.Equal("api-key", headers["CreateEvent"].Get("DD-API-KEY"))
+	assert.Empty(headers["CreateEvent"].Get("DD-APPLICATION-KEY"))
+}
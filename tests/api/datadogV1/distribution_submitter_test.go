@@ -0,0 +1,207 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog/intake"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestSketch(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+
+	_, err := intake.NewSketch(1.5, 0)
+	assert.Error(err)
+
+	sketch, err := intake.NewSketch(0.02, 0)
+	assert.NoError(err)
+	assert.True(math.IsNaN(sketch.Quantile(0.5)))
+	random := rand.New(rand.NewSource(1))
+	values := make([]float64, 10000)
+	for i := range values {
+		values[i] = math.Exp(random.NormFloat64()*2) - 0.5
+		sketch.Add(values[i])
+	}
+	sort.Float64s(values)
+
+	assert.Equal(uint64(len(values)), sketch.Count())
+	assert.Equal(values[0], sketch.Min())
+	assert.Equal(values[len(values)-1], sketch.Max())
+	assert.Equal(values[0], sketch.Quantile(0))
+	assert.Equal(values[len(values)-1], sketch.Quantile(1))
+	for _, q := range []float64{0.1, 0.25, 0.5, 0.75, 0.9, 0.99} {
+		expected := values[int(q*float64(len(values)-1))]
+		assert.InDelta(expected, sketch.Quantile(q), math.Abs(expected)*0.02+1e-9, "quantile %v", q)
+	}
+
+	bounded, err := intake.NewSketch(0.01, 50)
+	assert.NoError(err)
+	for i := 1; i <= 100000; i++ {
+		bounded.Add(float64(i))
+	}
+	assert.LessOrEqual(bounded.Bins(), 50)
+	assert.InDelta(99000, bounded.Quantile(0.99), 99000*0.01)
+}
+
+func TestDistributionSubmitter(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v1.MetricsApi.SubmitDistributionPoints")
+	assert.NoError(err)
+	received := &tests.CapturePayloads[map[string]interface{}]{}
+	gock.New(URL).
+		Post("/api/v1/distribution_points").
+		AddMatcher(received.Match).
+		Reply(202).
+		JSON(map[string]interface{}{"status": "ok"})
+	defer gock.Off()
+
+	submitter, err := intake.NewDistributionSubmitter(ctx, datadogV1.NewMetricsApi(client), intake.DistributionSubmitterOptions{
+		FlushInterval: time.Hour,
+		MaxSeries:     2,
+		Tags:          []string{"env:test"},
+		Host:          "web-1",
+	})
+	assert.NoError(err)
+	for i := 1; i <= 1000; i++ {
+		submitter.Distribution("request.latency", 1+float64(i)/1000, "endpoint:a")
+	}
+	submitter.Distribution("request.latency", 0.123456789, "endpoint:b")
+	submitter.Distribution("request.latency", 1, "endpoint:c")
+	err = submitter.Close()
+	assert.ErrorIs(err, intake.ErrTooManySeries)
+	assert.Contains(err.Error(), "too many series: 1 values dropped")
+
+	assert.True(gock.IsDone())
+	payloads := received.Payloads()
+	assert.Len(payloads, 1)
+	series := payloads[0]["series"].([]interface{})
+	assert.Len(series, 2)
+
+	first := series[0].(map[string]interface{})
+	assert.Equal("request.latency", first["metric"])
+	assert.Equal("distribution", first["type"])
+	assert.Equal("web-1", first["host"])
+	assert.Equal([]interface{}{"endpoint:a", "env:test"}, first["tags"])
+	point := first["points"].([]interface{})[0].([]interface{})
+	values := point[1].([]interface{})
+	assert.Len(values, 1000)
+	assert.Equal(1.001, values[0])
+	assert.Equal(2.0, values[999])
+	distinct := make(map[float64]bool)
+	for i, value := range values {
+		expected := 1 + float64(i+1)/1000
+		assert.InDelta(expected, value.(float64), expected*0.0101)
+		distinct[value.(float64)] = true
+		assert.LessOrEqual(len(fmt.Sprint(value)), 6)
+	}
+	assert.Less(len(distinct), 50)
+
+	second := series[1].(map[string]interface{})
+	assert.Equal([]interface{}{"endpoint:b", "env:test"}, second["tags"])
+	assert.Equal([]interface{}{0.123456789}, second["points"].([]interface{})[0].([]interface{})[1])
+}
+
+func TestDistributionSubmitterSendsEveryValue(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v1.MetricsApi.SubmitDistributionPoints")
+	assert.NoError(err)
+	received := &tests.CapturePayloads[map[string]interface{}]{}
+	gock.New(URL).
+		Post("/api/v1/distribution_points").
+		AddMatcher(received.Match).
+		Times(10).
+		Reply(202).
+		JSON(map[string]interface{}{"status": "ok"})
+	defer gock.Off()
+
+	submitter, err := intake.NewDistributionSubmitter(ctx, datadogV1.NewMetricsApi(client), intake.DistributionSubmitterOptions{
+		FlushInterval: time.Hour,
+	})
+	assert.NoError(err)
+	count := 100000
+	for i := 1; i <= count; i++ {
+		submitter.Distribution("request.latency", float64(i)/float64(count))
+	}
+	assert.NoError(submitter.Close())
+
+	var sizes []int
+	for _, payload := range received.Payloads() {
+		for _, serie := range payload["series"].([]interface{}) {
+			point := serie.(map[string]interface{})["points"].([]interface{})[0].([]interface{})
+			sizes = append(sizes, len(point[1].([]interface{})))
+		}
+	}
+	assert.Equal([]int{40000, 40000, 20000}, sizes)
+}
+
+func TestDistributionSubmitterMaxValues(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v1.MetricsApi.SubmitDistributionPoints")
+	assert.NoError(err)
+	received := &tests.CapturePayloads[map[string]interface{}]{}
+	gock.New(URL).
+		Post("/api/v1/distribution_points").
+		AddMatcher(received.Match).
+		Times(2).
+		Reply(202).
+		JSON(map[string]interface{}{"status": "ok"})
+	defer gock.Off()
+
+	submitter, err := intake.NewDistributionSubmitter(ctx, datadogV1.NewMetricsApi(client), intake.DistributionSubmitterOptions{
+		FlushInterval: time.Hour,
+		MaxValues:     1000,
+	})
+	assert.NoError(err)
+	var sizes []int
+	for _, count := range []int{100000, 1000000} {
+		for i := 1; i <= count; i++ {
+			submitter.Distribution("request.latency", float64(i)/float64(count))
+		}
+		assert.NoError(submitter.Flush(ctx))
+
+		payloads := received.Payloads()
+		payload := payloads[len(payloads)-1]
+		encoded, err := json.Marshal(payload)
+		assert.NoError(err)
+		sizes = append(sizes, len(encoded))
+		point := payload["series"].([]interface{})[0].(map[string]interface{})["points"].([]interface{})[0].([]interface{})
+		values := point[1].([]interface{})
+		assert.Len(values, 1000)
+		assert.Equal(1/float64(count), values[0])
+		assert.Equal(1.0, values[999])
+		for _, q := range []float64{0.1, 0.5, 0.9, 0.99} {
+			value := values[int(q*999)].(float64)
+			assert.InDelta(q, value, q*0.01+0.002, "quantile %v", q)
+		}
+	}
+	assert.NoError(submitter.Close())
+	assert.True(gock.IsDone())
+	assert.InDelta(sizes[0], sizes[1], float64(sizes[0])*0.1)
+}
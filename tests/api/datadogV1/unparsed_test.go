@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func mockDashboardWithUnknownWidget(ctx context.Context, t *testing.T) {
+	URL, err := Client(ctx).GetConfig().ServerURLWithContext(ctx, "v1.DashboardsApi.GetDashboard")
+	tests.Assert(ctx, t).NoError(err)
+	gock.New(URL).
+		Get("/api/v1/dashboard/abc-def-ghi").
+		Persist().
+		Reply(200).
+		JSON(map[string]interface{}{
+			"id":          "abc-def-ghi",
+			"title":       "Dashboard",
+			"layout_type": "ordered",
+			"new_field":   "value",
+			"widgets": []interface{}{
+				map[string]interface{}{"definition": map[string]interface{}{"type": "note", "content": "Note"}},
+				map[string]interface{}{"definition": map[string]interface{}{"type": "future_widget", "query": "avg:system.load.1{*}"}},
+			},
+		})
+}
+
+func TestFindUnparsed(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+
+	mockDashboardWithUnknownWidget(ctx, t)
+	defer gock.Off()
+
+	dashboard, _, err := datadogV1.NewDashboardsApi(Client(ctx)).GetDashboard(ctx, "abc-def-ghi")
+	assert.NoError(err)
+	assert.Equal([]datadog.Unparsed{
+		{
+			Path:  "$.widgets[1].definition",
+			Value: map[string]interface{}{"type": "future_widget", "query": "avg:system.load.1{*}"},
+		},
+		{Path: "$.new_field", Value: "value", AdditionalProperty: true},
+	}, datadog.FindUnparsed(dashboard))
+	assert.Empty(datadog.FindUnparsed(dashboard.Widgets[0]))
+	assert.Empty(datadog.FindUnparsed(nil))
+}
+
+func TestStrictDecoding(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().StrictDecoding = true
+
+	mockDashboardWithUnknownWidget(ctx, t)
+	defer gock.Off()
+
+	dashboard, httpresp, err := datadogV1.NewDashboardsApi(client).GetDashboard(ctx, "abc-def-ghi")
+	assert.Error(err)
+	assert.Equal(200, httpresp.StatusCode)
+	assert.Equal("Dashboard", dashboard.GetTitle())
+	assert.Equal("strict decoding: 2 values did not fit the models: $.widgets[1].definition, $.new_field", err.Error())
+	var unparsedErr *datadog.UnparsedError
+	assert.True(errors.As(err, &unparsedErr))
+	assert.Len(unparsedErr.Unparsed, 2)
+}
+
+func TestStrictDecodingErrorModel(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().StrictDecoding = true
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v1.DashboardsApi.GetDashboard")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v1/dashboard/abc-def-ghi").
+		Reply(404).
+		JSON(map[string]interface{}{"errors": []string{"Dashboard not found"}, "request_id": "abc123"})
+	defer gock.Off()
+
+	_, httpresp, err := datadogV1.NewDashboardsApi(client).GetDashboard(ctx, "abc-def-ghi")
+	assert.Equal(404, httpresp.StatusCode)
+	var apiErr datadog.GenericOpenAPIError
+	assert.True(errors.As(err, &apiErr))
+	var unparsedErr *datadog.UnparsedError
+	assert.False(errors.As(err, &unparsedErr))
+	model, ok := apiErr.Model().(datadogV1.APIErrorResponse)
+	assert.True(ok)
+	assert.Equal([]string{"Dashboard not found"}, model.Errors)
+	assert.Equal("abc123", model.AdditionalProperties["request_id"])
+}
@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestBatch(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+
+	inputs := make([]int, 50)
+	for i := range inputs {
+		inputs[i] = i
+	}
+	var running, maxRunning int32
+	result := datadog.Batch(ctx, inputs, func(ctx context.Context, input int) (int, *http.Response, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		if input%10 == 0 {
+			return 0, nil, fmt.Errorf("failed %d", input)
+		}
+		return input * 2, nil, nil
+	}, datadog.BatchOptions{Concurrency: 4})
+
+	assert.LessOrEqual(maxRunning, int32(4))
+	assert.Equal(45, result.Succeeded)
+	assert.Equal(5, result.Failed)
+	assert.Equal(0, result.Skipped)
+	assert.Len(result.Items, 50)
+	for i, item := range result.Items {
+		assert.Equal(i, item.Index)
+		assert.Equal(1, item.Attempts)
+		if i%10 != 0 {
+			assert.Equal(i*2, item.Value)
+		}
+	}
+	var batchErr *datadog.BatchError
+	assert.True(errors.As(result.Err(), &batchErr))
+	assert.Equal(0, batchErr.Index)
+	assert.Contains(result.Err().Error(), "item 40: failed 40")
+	assert.Contains(result.String(), "50 items: 45 succeeded, 5 failed, 0 skipped in ")
+}
+
+func TestBatchStopOnError(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+
+	result := datadog.Batch(ctx, []string{"a", "b", "c", "d", "e"}, func(ctx context.Context, input string) (string, *http.Response, error) {
+		if input == "b" {
+			return "", nil, errors.New("failed")
+		}
+		return input, nil, nil
+	}, datadog.BatchOptions{Concurrency: 1, StopOnError: true})
+
+	assert.Equal(1, result.Succeeded)
+	assert.Equal(1, result.Failed)
+	assert.Equal(3, result.Skipped)
+	assert.True(result.Items[4].Skipped())
+	assert.ErrorIs(result.Items[4].Err, datadog.ErrBatchStopped)
+	assert.ErrorIs(result.Err(), datadog.ErrBatchStopped)
+}
+
+func TestBatchRateLimited(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().RetryConfiguration.EnableRetry = false
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.TeamsApi.GetTeam")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v2/team/1").
+		Reply(429).
+		SetHeader("X-RateLimit-Limit", "10").
+		SetHeader("X-RateLimit-Remaining", "0").
+		SetHeader("X-RateLimit-Reset", "1").
+		JSON(map[string]interface{}{"errors": []string{"Too many requests"}})
+	for _, id := range []string{"1", "2", "3"} {
+		gock.New(URL).
+			Get("/api/v2/team/" + id).
+			Reply(200).
+			JSON(map[string]interface{}{"data": map[string]interface{}{"id": id, "type": "team"}})
+	}
+	defer gock.Off()
+
+	api := datadogV2.NewTeamsApi(client)
+	start := time.Now()
+	result := datadog.Batch(ctx, []string{"1", "2", "3"}, func(ctx context.Context, id string) (datadogV2.TeamResponse, *http.Response, error) {
+		return api.GetTeam(ctx, id)
+	}, datadog.BatchOptions{Concurrency: 1})
+
+	assert.NoError(result.Err())
+	assert.Equal(3, result.Succeeded)
+	assert.Equal(2, result.Items[0].Attempts)
+	assert.Equal(200, result.Items[0].Response.StatusCode)
+	assert.GreaterOrEqual(time.Since(start), 900*time.Millisecond)
+	assert.True(gock.IsDone())
+}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestCircuitBreakerOpensAndProbes(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().RetryConfiguration.EnableRetry = false
+
+	var transitions []string
+	breaker := datadog.NewCircuitBreaker()
+	breaker.FailureThreshold = 2
+	breaker.OpenTimeout = 10 * time.Millisecond
+	breaker.OnStateChange = func(key datadog.CircuitKey, from datadog.CircuitState, to datadog.CircuitState) {
+		transitions = append(transitions, key.OperationID+": "+from.String()+" -> "+to.String())
+	}
+	client.GetConfig().CircuitBreaker = breaker
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.DashboardListsApi.GetDashboardListItems")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("dashboard/lists/manual/1234/dashboards").
+		Times(2).
+		Reply(503)
+	defer gock.Off()
+
+	api := datadogV2.NewDashboardListsApi(client)
+	for i := 0; i < 2; i++ {
+		_, httpresp, err := api.GetDashboardListItems(ctx, 1234)
+		assert.Error(err)
+		assert.Equal(503, httpresp.StatusCode)
+	}
+
+	// The circuit is open: the request is not sent.
+	_, httpresp, err := api.GetDashboardListItems(ctx, 1234)
+	assert.Nil(httpresp)
+	var circuitErr datadog.ErrCircuitOpen
+	assert.True(errors.As(err, &circuitErr))
+	assert.Equal("v2.DashboardListsApi.GetDashboardListItems", circuitErr.Key.OperationID)
+	assert.Equal(URL, circuitErr.Key.ServerURL)
+	assert.Equal(datadog.CircuitOpen, breaker.State(circuitErr.Key))
+
+	// Once the timeout elapsed, a successful probe closes the circuit.
+	time.Sleep(20 * time.Millisecond)
+	gock.New(URL).
+		Get("dashboard/lists/manual/1234/dashboards").
+		Reply(200).
+		JSON(map[string]interface{}{"dashboards": []interface{}{}})
+	_, httpresp, err = api.GetDashboardListItems(ctx, 1234)
+	assert.NoError(err)
+	assert.Equal(200, httpresp.StatusCode)
+	assert.Equal(datadog.CircuitClosed, breaker.State(circuitErr.Key))
+	assert.Equal([]string{
+		"v2.DashboardListsApi.GetDashboardListItems: closed -> open",
+		"v2.DashboardListsApi.GetDashboardListItems: open -> half-open",
+		"v2.DashboardListsApi.GetDashboardListItems: half-open -> closed",
+	}, transitions)
+}
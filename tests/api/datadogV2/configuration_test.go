@@ -3,9 +3,12 @@ package test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
 	"github.com/DataDog/datadog-api-client-go/v2/tests"
 	"gotest.tools/assert"
 )
@@ -78,3 +81,41 @@ func TestRetryBackoffBaseNotSmallerThanTwo(t *testing.T) {
 
 	assert.Equal(t, client2.Cfg.RetryConfiguration.BackOffBase, 3.0)
 }
+
+func TestRetryServerErrorsOfIdempotentOperations(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.Method]++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"errors": ["Service unavailable"]}`))
+	}))
+	defer server.Close()
+
+	configuration := datadog.NewConfiguration()
+	configuration.SetUnstableOperationEnabled("v2.CreateIncident", true)
+	configuration.SetUnstableOperationEnabled("v2.GetIncident", true)
+	configuration.RetryConfiguration.EnableRetry = true
+	configuration.RetryConfiguration.MaxRetries = 2
+	// The back-off is bounded by the timeout of the HTTP client, so that the retries are immediate.
+	configuration.HTTPClient = &http.Client{}
+	configuration.Servers = datadog.ServerConfigurations{{URL: server.URL}}
+	configuration.OperationServers = map[string]datadog.ServerConfigurations{}
+	api := datadogV2.NewIncidentsApi(datadog.NewAPIClient(configuration))
+	ctx := context.WithValue(context.Background(), datadog.ContextAPIKeys, map[string]datadog.APIKey{
+		"apiKeyAuth": {Key: "api-key"},
+		"appKeyAuth": {Key: "app-key"},
+	})
+
+	body := datadogV2.NewIncidentCreateRequest(*datadogV2.NewIncidentCreateData(
+		*datadogV2.NewIncidentCreateAttributes(false, "Incident"),
+		datadogV2.INCIDENTTYPE_INCIDENTS,
+	))
+	_, httpresp, _ := api.CreateIncident(ctx, *body)
+	assert.Equal(t, http.StatusServiceUnavailable, httpresp.StatusCode)
+	assert.Equal(t, 1, calls[http.MethodPost])
+
+	_, httpresp, _ = api.GetIncident(ctx, "incident-id")
+	assert.Equal(t, http.StatusServiceUnavailable, httpresp.StatusCode)
+	assert.Equal(t, 3, calls[http.MethodGet])
+}
@@ -0,0 +1,60 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestTypedErrors(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.DashboardListsApi.GetDashboardListItems")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("dashboard/lists/manual/1234/dashboards").
+		Reply(404).
+		SetHeader("X-Ratelimit-Limit", "100").
+		SetHeader("X-Ratelimit-Remaining", "99").
+		SetHeader("X-Ratelimit-Period", "60").
+		SetHeader("X-Ratelimit-Name", "dashboard_lists").
+		SetHeader("X-Datadog-Request-Id", "abc123").
+		JSON(map[string]interface{}{"errors": []string{"Dashboard list not found"}})
+	defer gock.Off()
+
+	api := datadogV2.NewDashboardListsApi(client)
+	_, _, err = api.GetDashboardListItems(ctx, 1234)
+	wrapped := fmt.Errorf("listing dashboards: %w", err)
+
+	assert.True(datadog.IsNotFound(wrapped))
+	assert.ErrorIs(wrapped, datadog.ErrNotFound)
+	assert.False(datadog.IsForbidden(wrapped))
+	assert.False(datadog.IsRateLimited(wrapped))
+	assert.False(datadog.IsServerError(wrapped))
+
+	apiErr, ok := datadog.AsAPIError(wrapped)
+	assert.True(ok)
+	assert.Equal(404, apiErr.StatusCode)
+	assert.Equal("v2.DashboardListsApi.GetDashboardListItems", apiErr.OperationID)
+	assert.Equal("abc123", apiErr.RequestID)
+	assert.NotNil(apiErr.RateLimit)
+	assert.Equal("dashboard_lists", apiErr.RateLimit.Name)
+	assert.Equal(99, apiErr.RateLimit.Remaining)
+
+	model, ok := datadog.ErrorModelAs[datadogV2.APIErrorResponse](wrapped)
+	assert.True(ok)
+	assert.Equal([]string{"Dashboard list not found"}, model.Errors)
+
+	_, ok = datadog.AsAPIError(fmt.Errorf("unrelated"))
+	assert.False(ok)
+}
@@ -0,0 +1,214 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog/datadogtest"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func withFakeServer(ctx context.Context, t *testing.T) (context.Context, *datadog.APIClient) {
+	server := datadogtest.NewServer()
+	t.Cleanup(server.Close)
+	configuration := datadog.NewConfiguration()
+	configuration.HTTPClient = server.HTTPClient()
+	return WithFakeAuth(ctx), datadog.NewAPIClient(configuration)
+}
+
+func TestFakeServerMonitors(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx, client := withFakeServer(ctx, t)
+	assert := tests.Assert(ctx, t)
+	api := datadogV1.NewMonitorsApi(client)
+
+	body := datadogV1.NewMonitor("avg(last_5m):avg:system.cpu.user{*} > 90", datadogV1.MONITORTYPE_METRIC_ALERT)
+	body.SetName("CPU")
+	body.SetTags([]string{"team:frog"})
+	created, _, err := api.CreateMonitor(ctx, *body)
+	assert.NoError(err)
+	assert.NotZero(created.GetId())
+	assert.Equal(datadogV1.MONITOROVERALLSTATES_NO_DATA, created.GetOverallState())
+	assert.False(created.GetCreated().IsZero())
+
+	monitor, _, err := api.GetMonitor(ctx, created.GetId())
+	assert.NoError(err)
+	assert.Equal("CPU", monitor.GetName())
+	assert.Equal([]string{"team:frog"}, monitor.GetTags())
+
+	update := datadogV1.MonitorUpdateRequest{}
+	update.SetName("CPU usage")
+	monitor, _, err = api.UpdateMonitor(ctx, created.GetId(), update)
+	assert.NoError(err)
+	assert.Equal("CPU usage", monitor.GetName())
+	assert.Equal(body.Query, monitor.Query)
+
+	_, _, err = api.CreateMonitor(ctx, *datadogV1.NewMonitor("avg(last_5m):avg:system.load.1{*} > 2", datadogV1.MONITORTYPE_QUERY_ALERT))
+	assert.NoError(err)
+	monitors, _, err := api.ListMonitors(ctx)
+	assert.NoError(err)
+	assert.Len(monitors, 2)
+	monitors, _, err = api.ListMonitors(ctx, *datadogV1.NewListMonitorsOptionalParameters().WithPage(1).WithPageSize(1))
+	assert.NoError(err)
+	assert.Len(monitors, 1)
+	assert.Equal("avg(last_5m):avg:system.load.1{*} > 2", monitors[0].Query)
+
+	deleted, _, err := api.DeleteMonitor(ctx, created.GetId())
+	assert.NoError(err)
+	assert.Equal(created.GetId(), deleted.GetDeletedMonitorId())
+	_, httpresp, err := api.GetMonitor(ctx, created.GetId())
+	assert.Error(err)
+	assert.Equal(http.StatusNotFound, httpresp.StatusCode)
+	assert.Equal(`{"errors":["Monitor not found"]}`+"\n", string(err.(datadog.GenericOpenAPIError).Body()))
+
+	// Request bodies are validated with the models.
+	_, httpresp, err = api.CreateMonitor(ctx, datadogV1.Monitor{Type: "not a type", Query: "query"})
+	assert.Error(err)
+	assert.Equal(http.StatusBadRequest, httpresp.StatusCode)
+}
+
+func TestFakeServerDashboardsSLOsAndSynthetics(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx, client := withFakeServer(ctx, t)
+	assert := tests.Assert(ctx, t)
+
+	dashboards := datadogV1.NewDashboardsApi(client)
+	widget := datadogV1.NewWidget(datadogV1.NoteWidgetDefinitionAsWidgetDefinition(
+		datadogV1.NewNoteWidgetDefinition("Hello", datadogV1.NOTEWIDGETDEFINITIONTYPE_NOTE)))
+	dashboard, _, err := dashboards.CreateDashboard(ctx, *datadogV1.NewDashboard(datadogV1.DASHBOARDLAYOUTTYPE_ORDERED, "My Dashboard", []datadogV1.Widget{*widget}))
+	assert.NoError(err)
+	assert.Regexp(`^[a-z0-9]{3}-[a-z0-9]{3}-[a-z0-9]{3}$`, dashboard.GetId())
+	assert.Equal("/dashboard/"+dashboard.GetId()+"/my-dashboard", dashboard.GetUrl())
+	assert.NotZero(dashboard.Widgets[0].GetId())
+	dashboard, _, err = dashboards.GetDashboard(ctx, dashboard.GetId())
+	assert.NoError(err)
+	assert.Equal("Hello", dashboard.Widgets[0].Definition.NoteWidgetDefinition.Content)
+	summaries, _, err := dashboards.ListDashboards(ctx)
+	assert.NoError(err)
+	assert.Len(summaries.GetDashboards(), 1)
+	_, _, err = dashboards.DeleteDashboard(ctx, dashboard.GetId())
+	assert.NoError(err)
+
+	slos := datadogV1.NewServiceLevelObjectivesApi(client)
+	sloRequest := datadogV1.NewServiceLevelObjectiveRequest("Availability",
+		[]datadogV1.SLOThreshold{*datadogV1.NewSLOThreshold(99.9, datadogV1.SLOTIMEFRAME_SEVEN_DAYS)},
+		datadogV1.SLOTYPE_METRIC)
+	sloRequest.SetQuery(*datadogV1.NewServiceLevelObjectiveQuery("sum:requests{*}.as_count()", "sum:requests.ok{*}.as_count()"))
+	createdSLOs, _, err := slos.CreateSLO(ctx, *sloRequest)
+	assert.NoError(err)
+	assert.Len(createdSLOs.GetData(), 1)
+	sloID := createdSLOs.GetData()[0].GetId()
+	slo, _, err := slos.GetSLO(ctx, sloID)
+	assert.NoError(err)
+	sloData := slo.GetData()
+	assert.Equal("Availability", sloData.GetName())
+	_, _, err = slos.DeleteSLO(ctx, sloID)
+	assert.NoError(err)
+	_, httpresp, err := slos.GetSLO(ctx, sloID)
+	assert.Error(err)
+	assert.Equal(http.StatusNotFound, httpresp.StatusCode)
+
+	synthetics := datadogV1.NewSyntheticsApi(client)
+	test, _, err := synthetics.CreateSyntheticsAPITest(ctx, *datadogV1.NewSyntheticsAPITest(
+		*datadogV1.NewSyntheticsAPITestConfig(), []string{"aws:us-east-2"}, "Check", "Homepage",
+		*datadogV1.NewSyntheticsTestOptions(), datadogV1.SYNTHETICSAPITESTTYPE_API))
+	assert.NoError(err)
+	assert.NotEmpty(test.GetPublicId())
+	assert.NotZero(test.GetMonitorId())
+	_, _, err = synthetics.GetAPITest(ctx, test.GetPublicId())
+	assert.NoError(err)
+	_, httpresp, err = synthetics.GetBrowserTest(ctx, test.GetPublicId())
+	assert.Error(err)
+	assert.Equal(http.StatusNotFound, httpresp.StatusCode)
+	deletedTests, _, err := synthetics.DeleteTests(ctx, datadogV1.SyntheticsDeleteTestsPayload{PublicIds: []string{test.GetPublicId()}})
+	assert.NoError(err)
+	assert.Len(deletedTests.GetDeletedTests(), 1)
+	list, _, err := synthetics.ListTests(ctx)
+	assert.NoError(err)
+	assert.Empty(list.GetTests())
+}
+
+func TestFakeServerV2Resources(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx, client := withFakeServer(ctx, t)
+	assert := tests.Assert(ctx, t)
+
+	teams := datadogV2.NewTeamsApi(client)
+	for _, handle := range []string{"frogs", "toads", "newts"} {
+		_, httpresp, err := teams.CreateTeam(ctx, *datadogV2.NewTeamCreateRequest(*datadogV2.NewTeamCreate(
+			*datadogV2.NewTeamCreateAttributes(handle, handle), datadogV2.TEAMTYPE_TEAM)))
+		assert.NoError(err)
+		assert.Equal(http.StatusCreated, httpresp.StatusCode)
+	}
+	_, httpresp, err := teams.CreateTeam(ctx, *datadogV2.NewTeamCreateRequest(*datadogV2.NewTeamCreate(
+		*datadogV2.NewTeamCreateAttributes("frogs", "Frogs again"), datadogV2.TEAMTYPE_TEAM)))
+	assert.Error(err)
+	assert.Equal(http.StatusConflict, httpresp.StatusCode)
+	page, _, err := teams.ListTeams(ctx, *datadogV2.NewListTeamsOptionalParameters().WithPageSize(2).WithPageNumber(1))
+	assert.NoError(err)
+	assert.Len(page.GetData(), 1)
+	teamID := page.GetData()[0].Id
+	team, _, err := teams.UpdateTeam(ctx, teamID, *datadogV2.NewTeamUpdateRequest(*datadogV2.NewTeamUpdate(
+		*datadogV2.NewTeamUpdateAttributes("newts", "Newts"), datadogV2.TEAMTYPE_TEAM)))
+	assert.NoError(err)
+	teamData := team.GetData()
+	teamAttributes := teamData.GetAttributes()
+	assert.Equal("Newts", teamAttributes.GetName())
+	_, err = teams.DeleteTeam(ctx, teamID)
+	assert.NoError(err)
+	_, httpresp, err = teams.GetTeam(ctx, teamID)
+	assert.Error(err)
+	assert.Equal(http.StatusNotFound, httpresp.StatusCode)
+
+	users := datadogV2.NewUsersApi(client)
+	user, _, err := users.CreateUser(ctx, *datadogV2.NewUserCreateRequest(*datadogV2.NewUserCreateData(
+		*datadogV2.NewUserCreateAttributes("frog@example.com"), datadogV2.USERSTYPE_USERS)))
+	assert.NoError(err)
+	userData := user.GetData()
+	_, err = users.DisableUser(ctx, userData.GetId())
+	assert.NoError(err)
+	user, _, err = users.GetUser(ctx, userData.GetId())
+	assert.NoError(err)
+	userData = user.GetData()
+	userAttributes := userData.GetAttributes()
+	assert.True(userAttributes.GetDisabled())
+	assert.Equal("frog@example.com", userAttributes.GetHandle())
+
+	downtimes := datadogV2.NewDowntimesApi(client)
+	downtime, _, err := downtimes.CreateDowntime(ctx, *datadogV2.NewDowntimeCreateRequest(*datadogV2.NewDowntimeCreateRequestData(
+		*datadogV2.NewDowntimeCreateRequestAttributes(datadogV2.DowntimeMonitorIdentifierIdAsDowntimeMonitorIdentifier(
+			datadogV2.NewDowntimeMonitorIdentifierId(12345)), "env:prod"),
+		datadogV2.DOWNTIMERESOURCETYPE_DOWNTIME)))
+	assert.NoError(err)
+	downtimeData := downtime.GetData()
+	_, err = downtimes.CancelDowntime(ctx, downtimeData.GetId())
+	assert.NoError(err)
+	downtime, _, err = downtimes.GetDowntime(ctx, downtimeData.GetId())
+	assert.NoError(err)
+	downtimeData = downtime.GetData()
+	downtimeAttributes := downtimeData.GetAttributes()
+	assert.Equal(datadogV2.DOWNTIMESTATUS_CANCELED, downtimeAttributes.GetStatus())
+
+	client.GetConfig().SetUnstableOperationEnabled("v2.CreateIncident", true)
+	client.GetConfig().SetUnstableOperationEnabled("v2.GetIncident", true)
+	incidents := datadogV2.NewIncidentsApi(client)
+	incident, _, err := incidents.CreateIncident(ctx, *datadogV2.NewIncidentCreateRequest(*datadogV2.NewIncidentCreateData(
+		*datadogV2.NewIncidentCreateAttributes(false, "Frogs escaped"), datadogV2.INCIDENTTYPE_INCIDENTS)))
+	assert.NoError(err)
+	incident, _, err = incidents.GetIncident(ctx, incident.Data.Id)
+	assert.NoError(err)
+	assert.Equal("Frogs escaped", incident.Data.Attributes.Title)
+	assert.NotZero(incident.Data.Attributes.GetPublicId())
+
+	// Requests without keys are rejected.
+	_, httpresp, err = incidents.GetIncident(context.Background(), incident.Data.Id)
+	assert.Error(err)
+	assert.Equal(http.StatusForbidden, httpresp.StatusCode)
+}
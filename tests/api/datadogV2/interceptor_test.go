@@ -0,0 +1,162 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestInterceptorsOrderAndInfo(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	var calls []string
+	var infos []datadog.InterceptorInfo
+	client.GetConfig().AddInterceptor(datadog.Interceptor{
+		Request: func(req *http.Request, info datadog.InterceptorInfo) error {
+			calls = append(calls, "first request")
+			infos = append(infos, info)
+			req.Header.Set("X-Signature", "signed")
+			return nil
+		},
+		Response: func(req *http.Request, resp *http.Response, err error, info datadog.InterceptorInfo) error {
+			calls = append(calls, "first response")
+			return nil
+		},
+	})
+	client.GetConfig().AddInterceptor(datadog.Interceptor{
+		Response: func(req *http.Request, resp *http.Response, err error, info datadog.InterceptorInfo) error {
+			calls = append(calls, "second response")
+			return nil
+		},
+	})
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.DashboardListsApi.GetDashboardListItems")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("dashboard/lists/manual/1234/dashboards").
+		MatchHeader("X-Signature", "signed").
+		Reply(500)
+	gock.New(URL).
+		Get("dashboard/lists/manual/1234/dashboards").
+		MatchHeader("X-Signature", "signed").
+		Reply(200).
+		JSON(map[string]interface{}{"dashboards": []interface{}{}})
+	defer gock.Off()
+
+	api := datadogV2.NewDashboardListsApi(client)
+	_, httpresp, err := api.GetDashboardListItems(ctx, 1234)
+	assert.Nil(err)
+	assert.Equal(200, httpresp.StatusCode)
+	assert.Equal([]string{"first request", "second response", "first response", "first request", "second response", "first response"}, calls)
+	assert.Len(infos, 2)
+	assert.Equal("v2.DashboardListsApi.GetDashboardListItems", infos[0].OperationID)
+	assert.Equal(1, infos[0].Attempt)
+	assert.Equal(2, infos[1].Attempt)
+	assert.Equal("00000000000000000000000000000000", infos[0].APIKeys["apiKeyAuth"].Key)
+}
+
+func TestInterceptorAbortsRequest(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	abort := errors.New("aborted by interceptor")
+	client.GetConfig().AddInterceptor(datadog.Interceptor{
+		Request: func(req *http.Request, info datadog.InterceptorInfo) error {
+			return abort
+		},
+	})
+
+	api := datadogV2.NewDashboardListsApi(client)
+	_, _, err := api.GetDashboardListItems(ctx, 1234)
+	assert.ErrorIs(err, abort)
+}
+
+func TestInterceptorAbortCallsResponseHooks(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	abort := errors.New("aborted by interceptor")
+	var calls []string
+	var errs []error
+	for _, name := range []string{"first", "second"} {
+		name := name
+		client.GetConfig().AddInterceptor(datadog.Interceptor{
+			Request: func(req *http.Request, info datadog.InterceptorInfo) error {
+				calls = append(calls, name+" request")
+				return nil
+			},
+			Response: func(req *http.Request, resp *http.Response, err error, info datadog.InterceptorInfo) error {
+				calls = append(calls, name+" response")
+				errs = append(errs, err)
+				return nil
+			},
+		})
+	}
+	client.GetConfig().AddInterceptor(datadog.Interceptor{
+		Request: func(req *http.Request, info datadog.InterceptorInfo) error {
+			calls = append(calls, "third request")
+			return abort
+		},
+		Response: func(req *http.Request, resp *http.Response, err error, info datadog.InterceptorInfo) error {
+			calls = append(calls, "third response")
+			return nil
+		},
+	})
+
+	api := datadogV2.NewDashboardListsApi(client)
+	_, _, err := api.GetDashboardListItems(ctx, 1234)
+	assert.Equal(abort, err)
+	assert.Equal([]string{"first request", "second request", "third request", "second response", "first response"}, calls)
+	assert.Equal([]error{abort, abort}, errs)
+}
+
+func TestInterceptorResponseErrorsAreJoined(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().RetryConfiguration.EnableRetry = false
+
+	firstErr := errors.New("first response hook failed")
+	secondErr := errors.New("second response hook failed")
+	for _, hookErr := range []error{firstErr, secondErr} {
+		hookErr := hookErr
+		client.GetConfig().AddInterceptor(datadog.Interceptor{
+			Response: func(req *http.Request, resp *http.Response, err error, info datadog.InterceptorInfo) error {
+				return hookErr
+			},
+		})
+	}
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.DashboardListsApi.GetDashboardListItems")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("dashboard/lists/manual/1234/dashboards").
+		Reply(200).
+		JSON(map[string]interface{}{"dashboards": []interface{}{}})
+	defer gock.Off()
+
+	api := datadogV2.NewDashboardListsApi(client)
+	_, _, err = api.GetDashboardListItems(ctx, 1234)
+	assert.ErrorIs(err, firstErr)
+	assert.ErrorIs(err, secondErr)
+	assert.Equal("second response hook failed\nfirst response hook failed", err.Error())
+}
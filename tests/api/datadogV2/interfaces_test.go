@@ -0,0 +1,44 @@
+package test
+
+import (
+	"context"
+	_nethttp "net/http"
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+// mockTeamsApi implements GetTeam, and panics on the other operations.
+type mockTeamsApi struct {
+	datadogV2.TeamsApiInterface
+	names map[string]string
+}
+
+func (m mockTeamsApi) GetTeam(ctx context.Context, teamId string) (datadogV2.TeamResponse, *_nethttp.Response, error) {
+	team := datadogV2.NewTeam(*datadogV2.NewTeamAttributes(teamId, m.names[teamId]), teamId, datadogV2.TEAMTYPE_TEAM)
+	return datadogV2.TeamResponse{Data: team}, &_nethttp.Response{StatusCode: 200}, nil
+}
+
+// teamName depends on the interface, so that it can be tested without HTTP.
+func teamName(ctx context.Context, api datadogV2.TeamsApiInterface, teamId string) (string, error) {
+	resp, _, err := api.GetTeam(ctx, teamId)
+	if err != nil {
+		return "", err
+	}
+	return resp.Data.Attributes.GetName(), nil
+}
+
+func TestApiInterfaces(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+
+	var api datadogV2.TeamsApiInterface = mockTeamsApi{names: map[string]string{"frogs": "Frogs"}}
+	name, err := teamName(ctx, api, "frogs")
+	assert.NoError(err)
+	assert.Equal("Frogs", name)
+
+	api = datadogV2.NewTeamsApi(Client(WithClient(ctx)))
+	assert.NotNil(api)
+}
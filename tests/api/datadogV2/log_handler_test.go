@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"testing/slogtest"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog/intake"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+// shippedLogs returns the logs received by the mock of SubmitLog as the JSON objects sent.
+func shippedLogs(ctx context.Context, t *testing.T, shipper *intake.LogShipper, received *tests.CapturePayloads[[]datadogV2.HTTPLogItem]) []map[string]interface{} {
+	assert := tests.Assert(ctx, t)
+	assert.NoError(shipper.Flush(ctx))
+	var logs []map[string]interface{}
+	for _, payload := range received.Payloads() {
+		for _, item := range payload {
+			encoded, err := json.Marshal(item)
+			assert.NoError(err)
+			var log map[string]interface{}
+			assert.NoError(json.Unmarshal(encoded, &log))
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+func TestLogHandler(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	received := mockSubmitLog(ctx, t, 1)
+	defer gock.Off()
+
+	shipper := intake.NewLogShipper(ctx, datadogV2.NewLogsApi(Client(ctx)), intake.LogShipperOptions{MaxBatchAge: time.Hour})
+	defer shipper.Close()
+	logger := slog.New(intake.NewLogHandler(shipper, intake.LogHandlerOptions{
+		Service:  "payments",
+		Hostname: "web-1",
+		Tags:     []string{"env:test"},
+		Level:    slog.LevelDebug,
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if attr.Key == "card" {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}))
+
+	logger.Debug("debugging")
+	logger.With("ddtags", "team:a", "service", "billing").
+		WithGroup("http").
+		Warn("slow request",
+			slog.Int("status_code", 200),
+			slog.Duration("duration", 1500*time.Millisecond),
+			slog.Group("url", slog.String("path", "/pay")),
+			slog.Group("empty"),
+			slog.String("card", "4242"),
+		)
+	logger.Error("payment failed", slog.Any("error", errors.New("declined")), slog.String("message", "ignored"))
+
+	logs := shippedLogs(ctx, t, shipper, received)
+	assert.Len(logs, 3)
+	assert.Equal("debugging", logs[0]["message"])
+	assert.Equal("debug", logs[0]["status"])
+	assert.Equal("payments", logs[0]["service"])
+	assert.Equal("go", logs[0]["ddsource"])
+	assert.Equal("web-1", logs[0]["hostname"])
+	assert.Equal("env:test", logs[0]["ddtags"])
+	assert.InDelta(time.Now().UnixMilli(), logs[0]["timestamp"], 60000)
+
+	assert.Equal("slow request", logs[1]["message"])
+	assert.Equal("warn", logs[1]["status"])
+	assert.Equal("billing", logs[1]["service"])
+	assert.Equal("env:test,team:a", logs[1]["ddtags"])
+	assert.Equal(map[string]interface{}{
+		"status_code": 200.0,
+		"duration":    1.5e9,
+		"url":         map[string]interface{}{"path": "/pay"},
+	}, logs[1]["http"])
+
+	assert.Equal("payment failed", logs[2]["message"])
+	assert.Equal("error", logs[2]["status"])
+	assert.Equal(map[string]interface{}{"message": "declined", "kind": "*errors.errorString"}, logs[2]["error"])
+}
+
+func TestLogHandlerSlogtest(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	received := mockSubmitLog(ctx, t, 1)
+	defer gock.Off()
+
+	shipper := intake.NewLogShipper(ctx, datadogV2.NewLogsApi(Client(ctx)), intake.LogShipperOptions{MaxBatchAge: time.Hour})
+	defer shipper.Close()
+	handler := intake.NewLogHandler(shipper, intake.LogHandlerOptions{})
+
+	err := slogtest.TestHandler(handler, func() []map[string]any {
+		logs := shippedLogs(ctx, t, shipper, received)
+		for _, log := range logs {
+			// slogtest expects the keys of the built-in attributes of slog.
+			log[slog.MessageKey] = log["message"]
+			log[slog.LevelKey] = log["status"]
+			if timestamp, ok := log["timestamp"]; ok {
+				log[slog.TimeKey] = timestamp
+			}
+			for _, key := range []string{"message", "status", "timestamp", "ddsource"} {
+				delete(log, key)
+			}
+		}
+		return logs
+	})
+	tests.Assert(ctx, t).NoError(err)
+}
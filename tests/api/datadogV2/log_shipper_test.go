@@ -0,0 +1,225 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog/intake"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func mockSubmitLog(ctx context.Context, t *testing.T, times int) *tests.CapturePayloads[[]datadogV2.HTTPLogItem] {
+	URL, err := Client(ctx).GetConfig().ServerURLWithContext(ctx, "v2.LogsApi.SubmitLog")
+	tests.Assert(ctx, t).NoError(err)
+	received := &tests.CapturePayloads[[]datadogV2.HTTPLogItem]{}
+	gock.New(URL).
+		Post("/api/v2/logs").
+		Times(times).
+		AddMatcher(received.Match).
+		Reply(202).
+		JSON(map[string]interface{}{})
+	return received
+}
+
+func TestLogShipperBatches(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	received := mockSubmitLog(ctx, t, 3)
+	defer gock.Off()
+
+	shipper := intake.NewLogShipper(ctx, datadogV2.NewLogsApi(Client(ctx)), intake.LogShipperOptions{
+		MaxBatchSize: 10,
+		MaxBatchAge:  time.Hour,
+		Tags:         []string{"env:test", "team:a"},
+	})
+	for i := 0; i < 25; i++ {
+		assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem(fmt.Sprintf("log %d", i))))
+	}
+	assert.NoError(shipper.Close())
+	assert.ErrorIs(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem("late")), intake.ErrShipperClosed)
+
+	assert.True(gock.IsDone())
+	captured := received.Captured()
+	assert.Len(captured, 3)
+	assert.Len(captured[0].Body, 10)
+	assert.Len(captured[1].Body, 10)
+	assert.Len(captured[2].Body, 5)
+	assert.Equal("log 0", captured[0].Body[0].Message)
+	assert.Equal("log 24", captured[2].Body[4].Message)
+	assert.Equal("env:test,team:a", captured[0].Query.Get("ddtags"))
+	assert.Equal(intake.LogShipperStats{Sent: 25}, shipper.Stats())
+}
+
+func TestLogShipperBatchesByBytesAndAge(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	received := mockSubmitLog(ctx, t, 100)
+	defer gock.Off()
+
+	shipper := intake.NewLogShipper(ctx, datadogV2.NewLogsApi(Client(ctx)), intake.LogShipperOptions{
+		MaxBatchBytes:   1000,
+		MaxBatchAge:     50 * time.Millisecond,
+		ContentEncoding: datadogV2.CONTENTENCODING_IDENTITY,
+	})
+	message := strings.Repeat("m", 280)
+	for i := 0; i < 10; i++ {
+		assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem(message)))
+	}
+	assert.Eventually(func() bool { return received.Len() == 4 }, 5*time.Second, 10*time.Millisecond)
+	for _, captured := range received.Captured() {
+		assert.LessOrEqual(captured.Size, 1000)
+	}
+
+	assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem("alone")))
+	assert.Eventually(func() bool { return received.Len() == 5 }, 5*time.Second, 10*time.Millisecond)
+	assert.Len(received.Payloads()[4], 1)
+	assert.NoError(shipper.Flush(ctx))
+	assert.NoError(shipper.Close())
+	assert.Equal(uint64(11), shipper.Stats().Sent)
+}
+
+func TestLogShipperOverflow(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.LogsApi.SubmitLog")
+	assert.NoError(err)
+	gock.New(URL).
+		Post("/api/v2/logs").
+		Persist().
+		Reply(202).
+		Delay(200 * time.Millisecond).
+		JSON(map[string]interface{}{})
+	defer gock.Off()
+
+	for _, policy := range []intake.OverflowPolicy{intake.OverflowBlock, intake.OverflowDropNewest, intake.OverflowDropOldest} {
+		shipper := intake.NewLogShipper(ctx, datadogV2.NewLogsApi(client), intake.LogShipperOptions{
+			QueueSize:    2,
+			Overflow:     policy,
+			MaxBatchSize: 1,
+		})
+		// The first log is being sent while the next ones fill the queue.
+		assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem("sending")))
+		assert.Eventually(func() bool { return shipper.Stats().Queued == 0 }, time.Second, time.Millisecond)
+		assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem("queued 1")))
+		assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem("queued 2")))
+
+		shipCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		err := shipper.Ship(shipCtx, *datadogV2.NewHTTPLogItem("overflow"))
+		cancel()
+		switch policy {
+		case intake.OverflowBlock:
+			assert.ErrorIs(err, context.DeadlineExceeded)
+			assert.Equal(uint64(0), shipper.Stats().Dropped)
+		case intake.OverflowDropNewest:
+			assert.ErrorIs(err, intake.ErrQueueFull)
+			assert.Equal(uint64(1), shipper.Stats().Dropped)
+		case intake.OverflowDropOldest:
+			assert.NoError(err)
+			assert.Equal(uint64(1), shipper.Stats().Dropped)
+		}
+		assert.NoError(shipper.Close())
+		assert.Equal(uint64(3), shipper.Stats().Sent)
+	}
+}
+
+func TestLogShipperRetries(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().RetryConfiguration.EnableRetry = false
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.LogsApi.SubmitLog")
+	assert.NoError(err)
+	gock.New(URL).
+		Post("/api/v2/logs").
+		Reply(429).
+		JSON(map[string]interface{}{"errors": []string{"Too many requests"}})
+	gock.New(URL).
+		Post("/api/v2/logs").
+		Reply(408).
+		JSON(map[string]interface{}{"errors": []string{"Request timeout"}})
+	gock.New(URL).
+		Post("/api/v2/logs").
+		Reply(202).
+		JSON(map[string]interface{}{})
+	gock.New(URL).
+		Post("/api/v2/logs").
+		Reply(413).
+		JSON(map[string]interface{}{"errors": []string{"Payload too large"}})
+	defer gock.Off()
+
+	shipper := intake.NewLogShipper(ctx, datadogV2.NewLogsApi(client), intake.LogShipperOptions{
+		Retry: intake.RetryOptions{Backoff: 10 * time.Millisecond},
+	})
+	assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem("retried")))
+	assert.NoError(shipper.Flush(ctx))
+
+	assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem("rejected")))
+	err = shipper.Close()
+	var submitErr *intake.SubmitError
+	assert.ErrorAs(err, &submitErr)
+	assert.Equal("v2.LogsApi.SubmitLog", submitErr.OperationID)
+	assert.Equal(1, submitErr.Attempts)
+	apiErr, ok := datadog.AsAPIError(err)
+	assert.True(ok)
+	assert.Equal(413, apiErr.StatusCode)
+	assert.Equal(intake.LogShipperStats{Sent: 1, Failed: 1}, shipper.Stats())
+	assert.True(gock.IsDone())
+}
+
+func TestLogShipperSpoolTags(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	received := mockSubmitLog(ctx, t, 1)
+	defer gock.Off()
+
+	api := datadogV2.NewLogsApi(Client(ctx))
+	spool, err := intake.OpenSpool(ctx, t.TempDir(), intake.SpoolOptions{
+		Senders: map[string]intake.SpoolSender{
+			"v2.LogsApi.SubmitLog": intake.SubmitLogSender(api),
+		},
+	})
+	assert.NoError(err)
+	defer spool.Close()
+	shipper := intake.NewLogShipper(ctx, api, intake.LogShipperOptions{
+		MaxBatchAge: time.Hour,
+		Tags:        []string{"env:test", "team:a"},
+		Spool:       spool,
+	})
+	defer shipper.Close()
+
+	tagged := datadogV2.NewHTTPLogItem("tagged")
+	tagged.SetDdtags("version:1")
+	assert.NoError(shipper.Ship(ctx, *datadogV2.NewHTTPLogItem("untagged")))
+	assert.NoError(shipper.Ship(ctx, *tagged))
+	assert.NoError(shipper.Flush(ctx))
+	assert.NoError(spool.Drain(ctx))
+
+	assert.True(gock.IsDone())
+	captured := received.Captured()
+	assert.Len(captured, 1)
+	assert.Empty(captured[0].Query.Get("ddtags"))
+	assert.Len(captured[0].Body, 2)
+	assert.Equal("env:test,team:a", captured[0].Body[0].GetDdtags())
+	assert.Equal("version:1,env:test,team:a", captured[0].Body[1].GetDdtags())
+	assert.Equal("version:1", tagged.GetDdtags())
+}
@@ -0,0 +1,205 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestSlogLogger(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	var output bytes.Buffer
+	client.GetConfig().Logger = datadog.NewSlogLogger(slog.New(slog.NewJSONHandler(&output, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	client.GetConfig().LogRedaction = datadog.LogRedaction{
+		Headers:     []string{"X-Internal-Token"},
+		JSONFields:  []string{"handle"},
+		MaxBodySize: 300,
+	}
+	client.GetConfig().SetUnstableOperationEnabled("v2.ListIncidents", true)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.TeamsApi.GetTeam")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v2/team/1").
+		Reply(200).
+		SetHeader("X-Internal-Token", "internal-secret").
+		SetHeader("X-Datadog-Request-Id", "abc123").
+		JSON(map[string]interface{}{"data": map[string]interface{}{
+			"id":   "1",
+			"type": "team",
+			"attributes": map[string]interface{}{
+				"handle":      "team-handle",
+				"name":        "Team",
+				"description": "Call with Bearer abcdef0123 or password " + strings.Repeat("x", 400),
+				"api_key":     "body-api-key",
+			},
+		}})
+	gock.New(URL).
+		Get("/api/v2/incidents").
+		Reply(200).
+		JSON(map[string]interface{}{"data": []interface{}{}})
+	defer gock.Off()
+
+	api := datadogV2.NewTeamsApi(client)
+	_, _, err = api.GetTeam(datadog.WithLogFields(ctx, "job", "sync-teams"), "1")
+	assert.NoError(err)
+	_, _, err = datadogV2.NewIncidentsApi(client).ListIncidents(ctx)
+	assert.NoError(err)
+
+	logs := output.String()
+	assert.Contains(logs, `"msg":"Sending request"`)
+	assert.Contains(logs, `"msg":"Received response"`)
+	assert.Contains(logs, `"job":"sync-teams"`)
+	assert.Contains(logs, `"operation_id":"v2.TeamsApi.GetTeam"`)
+	assert.Contains(logs, `"request_id":"abc123"`)
+	assert.Contains(logs, `"level":"WARN","msg":"Using unstable operation 'v2.ListIncidents'"`)
+	assert.Contains(logs, `Dd-Api-Key: REDACTED`)
+	assert.Contains(logs, `X-Internal-Token: REDACTED`)
+	assert.Contains(logs, `Bearer REDACTED`)
+	assert.Contains(logs, `bytes truncated`)
+	for _, secret := range []string{"00000000000000000000000000000000", "internal-secret", "abcdef0123", "team-handle", "body-api-key"} {
+		assert.NotContains(logs, secret)
+	}
+}
+
+func TestLoggerDebugDisabled(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	logger := datadog.NewStdLogger(nil, false)
+	assert.False(logger.Enabled(ctx, datadog.LevelDebug))
+	assert.True(logger.Enabled(ctx, datadog.LevelWarn))
+	assert.True(datadog.NewStdLogger(nil, true).Enabled(ctx, datadog.LevelDebug))
+
+	// Requests are not dumped when debug entries are disabled.
+	var output bytes.Buffer
+	client.GetConfig().Logger = datadog.NewSlogLogger(slog.New(slog.NewTextHandler(&output, nil)))
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.TeamsApi.GetTeam")
+	assert.NoError(err)
+	gock.New(URL).Get("/api/v2/team/1").Reply(404).JSON(map[string]interface{}{"errors": []string{"Not found"}})
+	defer gock.Off()
+	_, _, err = datadogV2.NewTeamsApi(client).GetTeam(ctx, "1")
+	assert.Error(err)
+	assert.Empty(output.String())
+}
+
+func TestStdLoggerUnstableOperation(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	var output bytes.Buffer
+	client.GetConfig().Logger = datadog.NewStdLogger(log.New(&output, "", 0), false)
+	client.GetConfig().SetUnstableOperationEnabled("v2.ListIncidents", true)
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.IncidentsApi.ListIncidents")
+	assert.NoError(err)
+	gock.New(URL).Get("/api/v2/incidents").Reply(200).JSON(map[string]interface{}{"data": []interface{}{}})
+	defer gock.Off()
+
+	_, _, err = datadogV2.NewIncidentsApi(client).ListIncidents(ctx)
+	assert.NoError(err)
+	assert.Equal("WARNING: Using unstable operation 'v2.ListIncidents'\n", output.String())
+}
+
+// countingTransport counts the bytes of the response bodies read by the client.
+type countingTransport struct {
+	read atomic.Int64
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if resp != nil {
+		resp.Body = countingBody{ReadCloser: resp.Body, read: &t.read}
+	}
+	return resp, err
+}
+
+type countingBody struct {
+	io.ReadCloser
+	read *atomic.Int64
+}
+
+func (b countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.read.Add(int64(n))
+	return n, err
+}
+
+// readLogger records the number of bytes of the response read when it is logged.
+type readLogger struct {
+	transport *countingTransport
+	read      int64
+	body      string
+}
+
+func (l *readLogger) Enabled(context.Context, datadog.LogLevel) bool {
+	return true
+}
+
+func (l *readLogger) Log(_ context.Context, _ datadog.LogLevel, msg string, args ...interface{}) {
+	if msg != "Received response" {
+		return
+	}
+	l.read = l.transport.read.Load()
+	for i := 0; i+1 < len(args); i += 2 {
+		if args[i] == "body" {
+			l.body = args[i+1].(string)
+		}
+	}
+}
+
+func TestLoggerResponseBodyLimit(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	transport := &countingTransport{}
+	logger := &readLogger{transport: transport}
+	client.GetConfig().HTTPClient = &http.Client{Transport: transport}
+	client.GetConfig().Logger = logger
+	client.GetConfig().LogRedaction = datadog.LogRedaction{MaxBodySize: 100}
+
+	description := strings.Repeat("x", 1<<20)
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.TeamsApi.GetTeam")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v2/team/1").
+		Reply(200).
+		JSON(map[string]interface{}{"data": map[string]interface{}{
+			"id":         "1",
+			"type":       "team",
+			"attributes": map[string]interface{}{"handle": "team", "name": "Team", "description": description},
+		}})
+	defer gock.Off()
+
+	team, _, err := datadogV2.NewTeamsApi(client).GetTeam(ctx, "1")
+	assert.NoError(err)
+	assert.Equal(description, team.Data.Attributes.GetDescription())
+	assert.Less(logger.read, int64(64<<10))
+	assert.True(strings.HasSuffix(logger.body, "... (truncated)"), logger.body)
+	assert.LessOrEqual(len(logger.body), 100+len("... (truncated)"))
+}
@@ -0,0 +1,179 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog/intake"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestMetricsSubmitter(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.MetricsApi.SubmitMetrics")
+	assert.NoError(err)
+	received := &tests.CapturePayloads[datadogV2.MetricPayload]{}
+	gock.New(URL).
+		Post("/api/v2/series").
+		AddMatcher(received.Match).
+		Reply(202).
+		JSON(map[string]interface{}{"errors": []string{}})
+	defer gock.Off()
+
+	submitter := intake.NewMetricsSubmitter(ctx, datadogV2.NewMetricsApi(client), intake.MetricsSubmitterOptions{
+		FlushInterval: time.Hour,
+		Tags:          []string{"env:test"},
+		Host:          "web-1",
+	})
+	submitter.Gauge("queue.size", 3, "queue:a")
+	submitter.Gauge("queue.size", 5, "queue:a")
+	submitter.Count("jobs.processed", 2, "queue:a", "worker:1")
+	submitter.Count("jobs.processed", 3, "worker:1", "queue:a")
+	submitter.Count("jobs.processed", 1, "queue:b")
+	submitter.Rate("bytes.read", 10)
+	assert.NoError(submitter.Close())
+	submitter.Count("jobs.processed", 1)
+	assert.NoError(submitter.Close())
+
+	assert.True(gock.IsDone())
+	captured := received.Captured()
+	assert.Len(captured, 1)
+	assert.Equal("gzip", captured[0].Encoding)
+	series := captured[0].Body.Series
+	assert.Len(series, 4)
+	assert.Equal("bytes.read", series[0].Metric)
+	assert.Equal(datadogV2.METRICINTAKETYPE_RATE, series[0].GetType())
+	assert.Equal(int64(1), series[0].GetInterval())
+	assert.Equal(10.0, series[0].Points[0].GetValue())
+	assert.Equal([]string{"env:test"}, series[0].Tags)
+
+	assert.Equal("jobs.processed", series[1].Metric)
+	assert.Equal(datadogV2.METRICINTAKETYPE_COUNT, series[1].GetType())
+	assert.Equal([]string{"queue:a", "worker:1", "env:test"}, series[1].Tags)
+	assert.Equal(5.0, series[1].Points[0].GetValue())
+	assert.Equal([]string{"queue:b", "env:test"}, series[2].Tags)
+	assert.Equal(1.0, series[2].Points[0].GetValue())
+
+	assert.Equal("queue.size", series[3].Metric)
+	assert.Equal(datadogV2.METRICINTAKETYPE_GAUGE, series[3].GetType())
+	assert.Equal(5.0, series[3].Points[0].GetValue())
+	assert.False(series[3].HasInterval())
+	assert.Equal("web-1", series[3].Resources[0].GetName())
+	assert.Equal("host", series[3].Resources[0].GetType())
+}
+
+func TestMetricsSubmitterSplitsPayloads(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.MetricsApi.SubmitMetrics")
+	assert.NoError(err)
+	received := &tests.CapturePayloads[datadogV2.MetricPayload]{}
+	gock.New(URL).
+		Post("/api/v2/series").
+		Times(100).
+		AddMatcher(received.Match).
+		Reply(202).
+		JSON(map[string]interface{}{"errors": []string{}})
+	defer gock.Off()
+
+	for _, options := range []intake.MetricsSubmitterOptions{
+		{FlushInterval: time.Hour, MaxPayloadSize: 2000, DisableCompression: true},
+		{FlushInterval: time.Hour, MaxPayloadSize: 1000},
+	} {
+		received.Reset()
+		submitter := intake.NewMetricsSubmitter(ctx, datadogV2.NewMetricsApi(client), options)
+		for i := 0; i < 200; i++ {
+			submitter.Gauge("requests.latency", float64(i), fmt.Sprintf("endpoint:%d-%x", i, i*7919))
+		}
+		assert.NoError(submitter.Flush(ctx))
+		assert.NoError(submitter.Close())
+
+		payloads := received.Payloads()
+		assert.Greater(len(payloads), 1)
+		total := 0
+		for _, payload := range payloads {
+			encoded, err := datadog.Marshal(payload)
+			assert.NoError(err)
+			if options.DisableCompression {
+				assert.LessOrEqual(len(encoded), options.MaxPayloadSize)
+			}
+			total += len(payload.Series)
+		}
+		assert.Equal(200, total)
+	}
+}
+
+func TestMetricsSubmitterRetries(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().RetryConfiguration.EnableRetry = false
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.MetricsApi.SubmitMetrics")
+	assert.NoError(err)
+	gock.New(URL).
+		Post("/api/v2/series").
+		Reply(503).
+		JSON(map[string]interface{}{"errors": []string{"Unavailable"}})
+	gock.New(URL).
+		Post("/api/v2/series").
+		Reply(202).
+		JSON(map[string]interface{}{"errors": []string{}})
+	gock.New(URL).
+		Post("/api/v2/series").
+		Reply(400).
+		JSON(map[string]interface{}{"errors": []string{"Bad request"}})
+	defer gock.Off()
+
+	var mu sync.Mutex
+	var dropped []error
+	submitter := intake.NewMetricsSubmitter(ctx, datadogV2.NewMetricsApi(client), intake.MetricsSubmitterOptions{
+		FlushInterval: 50 * time.Millisecond,
+		Retry:         intake.RetryOptions{Backoff: 10 * time.Millisecond},
+		OnError: func(err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			dropped = append(dropped, err)
+		},
+	})
+	submitter.Count("jobs.processed", 1)
+	assert.NoError(submitter.Flush(ctx))
+	assert.False(gock.HasUnmatchedRequest())
+
+	submitter.Count("jobs.processed", 1)
+	submitter.Count("jobs.failed", 1)
+	assert.Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(dropped) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(submitter.Close())
+
+	var submitErr *intake.SubmitError
+	assert.True(errors.As(dropped[0], &submitErr))
+	assert.Equal("v2.MetricsApi.SubmitMetrics", submitErr.OperationID)
+	assert.Equal(2, submitErr.Items)
+	assert.Equal(1, submitErr.Attempts)
+	assert.ErrorIs(dropped[0], datadog.ErrBadRequest)
+	assert.False(intake.Retryable(dropped[0]))
+	assert.True(gock.IsDone())
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestWithOrg(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().Orgs = datadog.NewOrgRegistry(
+		datadog.Org{Name: "us", Credentials: datadog.StaticCredentials("us-api-key", "us-app-key")},
+		datadog.Org{Name: "eu", Site: "datadoghq.eu", Credentials: datadog.StaticCredentials("eu-api-key", "eu-app-key")},
+	)
+
+	gock.New("https://api.datadoghq.com").
+		Get("/api/v2/team/1").
+		MatchHeader("DD-API-KEY", "^us-api-key$").
+		MatchHeader("DD-APPLICATION-KEY", "^us-app-key$").
+		Reply(200).
+		JSON(map[string]interface{}{"data": map[string]interface{}{"id": "1", "type": "team"}})
+	gock.New("https://api.datadoghq.eu").
+		Get("/api/v2/team/1").
+		MatchHeader("DD-API-KEY", "^eu-api-key$").
+		MatchHeader("DD-APPLICATION-KEY", "^eu-app-key$").
+		Reply(200).
+		JSON(map[string]interface{}{"data": map[string]interface{}{"id": "1", "type": "team"}})
+	defer gock.Off()
+
+	api := datadogV2.NewTeamsApi(client)
+	_, _, err := api.GetTeam(datadog.WithOrg(ctx, "us"), "1")
+	assert.NoError(err)
+	_, _, err = api.GetTeam(datadog.WithOrg(ctx, "eu"), "1")
+	assert.NoError(err)
+	assert.True(gock.IsDone())
+
+	_, _, err = api.GetTeam(datadog.WithOrg(ctx, "unknown"), "1")
+	assert.Contains(err.Error(), `unknown organization "unknown"`)
+}
+
+func TestFanOut(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(ctx)
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().Orgs = datadog.NewOrgRegistry(
+		datadog.Org{Name: "child-1", Credentials: datadog.StaticCredentials("key-1", "app-1")},
+		datadog.Org{Name: "child-2", Credentials: datadog.StaticCredentials("key-2", "app-2")},
+		datadog.Org{Name: "child-3", Credentials: datadog.CredentialProviderFunc(func(context.Context) (datadog.Credentials, error) {
+			return datadog.Credentials{}, datadog.ErrNoCredentials
+		})},
+	)
+
+	for _, key := range []string{"key-1", "key-2"} {
+		gock.New("https://api.datadoghq.com").
+			Get("/api/v2/team").
+			MatchHeader("DD-API-KEY", "^"+key+"$").
+			Reply(200).
+			JSON(map[string]interface{}{"data": []interface{}{map[string]interface{}{"id": key, "type": "team", "attributes": map[string]interface{}{"handle": key, "name": key}}}})
+	}
+	defer gock.Off()
+
+	api := datadogV2.NewTeamsApi(client)
+	results := datadog.FanOut(ctx, client.GetConfig().Orgs, 2, func(ctx context.Context, org string) (int, error) {
+		teams, _, err := api.ListTeams(ctx)
+		return len(teams.Data), err
+	})
+	assert.Len(results, 3)
+	assert.Equal(map[string]int{"child-1": 1, "child-2": 1}, results.Values())
+	assert.Equal("child-3", results[2].Org)
+	assert.True(errors.Is(results.Err(), datadog.ErrNoCredentials))
+	assert.Contains(results.Err().Error(), "org child-3")
+	assert.True(gock.IsDone())
+}
@@ -0,0 +1,243 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func incidentsPage(ids ...string) map[string]interface{} {
+	data := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		data = append(data, map[string]interface{}{"id": id, "type": "incidents"})
+	}
+	return map[string]interface{}{"data": data}
+}
+
+func TestListIncidentsSeq(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().SetUnstableOperationEnabled("v2.ListIncidents", true)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.IncidentsApi.ListIncidents")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v2/incidents").
+		MatchParam("page[size]", "2").
+		MatchParam("page[offset]", "2").
+		Reply(200).
+		JSON(incidentsPage("3"))
+	gock.New(URL).
+		Get("/api/v2/incidents").
+		MatchParam("page[size]", "2").
+		Reply(200).
+		JSON(incidentsPage("1", "2"))
+	defer gock.Off()
+
+	api := datadogV2.NewIncidentsApi(client)
+	var ids []string
+	for incident, err := range api.ListIncidentsSeq(ctx, *datadogV2.NewListIncidentsOptionalParameters().WithPageSize(2)) {
+		assert.NoError(err)
+		ids = append(ids, incident.Id)
+	}
+	assert.Equal([]string{"1", "2", "3"}, ids)
+	assert.True(gock.IsDone())
+
+	// Breaking out of the loop does not request the next page.
+	gock.New(URL).
+		Get("/api/v2/incidents").
+		Reply(200).
+		JSON(incidentsPage("1", "2"))
+	ids = nil
+	for incident := range api.ListIncidentsSeq(ctx, *datadogV2.NewListIncidentsOptionalParameters().WithPageSize(2)) {
+		ids = append(ids, incident.Id)
+		break
+	}
+	assert.Equal([]string{"1"}, ids)
+	assert.True(gock.IsDone())
+
+	// Errors are yielded once and end the iteration.
+	gock.New(URL).
+		Get("/api/v2/incidents").
+		Reply(404).
+		JSON(map[string]interface{}{"errors": []string{"Not found"}})
+	var errs []error
+	for _, err := range api.ListIncidentsSeq(ctx) {
+		errs = append(errs, err)
+	}
+	assert.Len(errs, 1)
+	assert.True(errors.Is(errs[0], datadog.ErrNotFound))
+}
+
+func TestListRolesSeq(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	rolesPage := func(ids ...string) map[string]interface{} {
+		data := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			data = append(data, map[string]interface{}{"id": id, "type": "roles"})
+		}
+		return map[string]interface{}{"data": data}
+	}
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.RolesApi.ListRoles")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v2/roles").
+		MatchParam("page[size]", "^2$").
+		MatchParam("page[number]", "^0$").
+		MatchParam("filter", "^admin$").
+		Reply(200).
+		JSON(rolesPage("1", "2"))
+	gock.New(URL).
+		Get("/api/v2/roles").
+		MatchParam("page[size]", "^2$").
+		MatchParam("page[number]", "^1$").
+		MatchParam("filter", "^admin$").
+		Reply(200).
+		JSON(rolesPage("3"))
+	defer gock.Off()
+
+	api := datadogV2.NewRolesApi(client)
+	params := *datadogV2.NewListRolesOptionalParameters().WithPageSize(2).WithFilter("admin")
+	var ids []string
+	for role, err := range api.ListRolesSeq(ctx, params) {
+		assert.NoError(err)
+		ids = append(ids, role.GetId())
+	}
+	assert.Equal([]string{"1", "2", "3"}, ids)
+	assert.True(gock.IsDone())
+	// The parameters of the caller are left untouched.
+	assert.Nil(params.PageNumber)
+}
+
+func TestListRolesPrefetch(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	rolesPage := func(ids ...string) map[string]interface{} {
+		data := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			data = append(data, map[string]interface{}{"id": id, "type": "roles"})
+		}
+		return map[string]interface{}{"data": data}
+	}
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.RolesApi.ListRoles")
+	assert.NoError(err)
+	pages := [][]string{{"1", "2"}, {"3", "4"}, {"5"}}
+	for number, ids := range pages {
+		gock.New(URL).
+			Get("/api/v2/roles").
+			MatchParam("page[size]", "^2$").
+			MatchParam("page[number]", "^"+strconv.Itoa(number)+"$").
+			Reply(200).
+			JSON(rolesPage(ids...))
+	}
+	// The page after the last one may be requested ahead of time.
+	gock.New(URL).
+		Get("/api/v2/roles").
+		MatchParam("page[number]", "^3$").
+		Persist().
+		Reply(200).
+		JSON(rolesPage())
+	defer gock.Off()
+
+	api := datadogV2.NewRolesApi(client)
+	var ids []string
+	for role, err := range api.ListRolesPrefetch(ctx, 2, *datadogV2.NewListRolesOptionalParameters().WithPageSize(2)) {
+		assert.NoError(err)
+		ids = append(ids, role.GetId())
+	}
+	assert.Equal([]string{"1", "2", "3", "4", "5"}, ids)
+}
+
+func TestListLogsSeqRangesAgain(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	// The pages are served by cursor, whatever the number of requests.
+	var mu sync.Mutex
+	var cursors []string
+	matchCursor := func(cursor string) gock.MatchFunc {
+		return func(req *http.Request, _ *gock.Request) (bool, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return false, err
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			var request datadogV2.LogsListRequest
+			if err := json.Unmarshal(body, &request); err != nil {
+				return false, err
+			}
+			if request.Page.GetCursor() != cursor || request.Page.GetLimit() != 2 {
+				return false, nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			cursors = append(cursors, cursor)
+			return true, nil
+		}
+	}
+	logsPage := func(after string, ids ...string) map[string]interface{} {
+		data := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			data = append(data, map[string]interface{}{"id": id, "type": "log"})
+		}
+		return map[string]interface{}{"data": data, "meta": map[string]interface{}{"page": map[string]interface{}{"after": after}}}
+	}
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.LogsApi.ListLogs")
+	assert.NoError(err)
+	gock.New(URL).
+		Post("/api/v2/logs/events/search").
+		Persist().
+		AddMatcher(matchCursor("")).
+		Reply(200).
+		JSON(logsPage("next", "1", "2"))
+	gock.New(URL).
+		Post("/api/v2/logs/events/search").
+		Persist().
+		AddMatcher(matchCursor("next")).
+		Reply(200).
+		JSON(logsPage("", "3"))
+	defer gock.Off()
+
+	api := datadogV2.NewLogsApi(client)
+	body := datadogV2.LogsListRequest{Page: &datadogV2.LogsListRequestPage{Limit: datadog.PtrInt32(2)}}
+	seq := api.ListLogsSeq(ctx, *datadogV2.NewListLogsOptionalParameters().WithBody(body))
+	for i := 0; i < 2; i++ {
+		var ids []string
+		for log, err := range seq {
+			assert.NoError(err)
+			ids = append(ids, log.GetId())
+		}
+		// Each iteration starts from the first page.
+		assert.Equal([]string{"1", "2", "3"}, ids)
+	}
+	assert.Equal([]string{"", "next", "", "next"}, cursors)
+	// The body of the caller is left untouched.
+	assert.Nil(body.Page.Cursor)
+}
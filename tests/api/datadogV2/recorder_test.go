@@ -0,0 +1,149 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog/datadogtest"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	path := filepath.Join(t.TempDir(), "cassettes", "teams.yaml")
+
+	recorder, err := datadogtest.NewRecorder(path, datadogtest.ModeAuto)
+	assert.NoError(err)
+	assert.Equal(datadogtest.ModeRecord, recorder.Mode())
+	recorder.Scrub = func(interaction *datadogtest.Interaction) {
+		interaction.Response.Headers.Del("X-Internal-Token")
+	}
+	client := Client(ctx)
+	client.GetConfig().HTTPClient = recorder.HTTPClient()
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.TeamsApi.GetTeam")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v2/team/1").
+		Reply(200).
+		SetHeader("X-Internal-Token", "internal-secret").
+		JSON(map[string]interface{}{"data": map[string]interface{}{
+			"id":         "1",
+			"type":       "team",
+			"attributes": map[string]interface{}{"handle": "first", "name": "First"},
+		}})
+	gock.New(URL).
+		Get("/api/v2/team/1").
+		Reply(200).
+		JSON(map[string]interface{}{"data": map[string]interface{}{
+			"id":         "1",
+			"type":       "team",
+			"attributes": map[string]interface{}{"handle": "renamed", "name": "Renamed"},
+		}})
+	api := datadogV2.NewTeamsApi(client)
+	_, _, err = api.GetTeam(ctx, "1")
+	assert.NoError(err)
+	_, _, err = api.GetTeam(ctx, "1")
+	assert.NoError(err)
+	gock.Off()
+	assert.NoError(recorder.Close())
+
+	content, err := os.ReadFile(path)
+	assert.NoError(err)
+	assert.Contains(string(content), "operation_id: v2.TeamsApi.GetTeam")
+	for _, secret := range []string{"00000000000000000000000000000000", "internal-secret"} {
+		assert.NotContains(string(content), secret)
+	}
+
+	// The cassette now exists, so the requests are replayed in order without being sent.
+	recorder, err = datadogtest.NewRecorder(path, datadogtest.ModeAuto)
+	assert.NoError(err)
+	assert.Equal(datadogtest.ModeReplay, recorder.Mode())
+	client.GetConfig().HTTPClient = recorder.HTTPClient()
+	client.GetConfig().RetryConfiguration.EnableRetry = false
+	team, _, err := api.GetTeam(ctx, "1")
+	assert.NoError(err)
+	assert.Equal("First", team.Data.Attributes.GetName())
+	team, _, err = api.GetTeam(ctx, "1")
+	assert.NoError(err)
+	assert.Equal("Renamed", team.Data.Attributes.GetName())
+
+	_, _, err = api.GetTeam(ctx, "1")
+	assert.Error(err)
+	assert.Contains(err.Error(), "no interaction found in cassette: v2.TeamsApi.GetTeam")
+	_, _, err = api.GetTeam(ctx, "2")
+	assert.Error(err)
+	assert.True(errors.Is(err, datadogtest.ErrInteractionNotFound))
+}
+
+func TestRecorderReplayScenarioCassette(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+
+	recorder, err := datadogtest.NewRecorder("../../scenarios/cassettes/TestScenarios/v2/Feature_Roles/Scenario_List_permissions_returns_OK_response.yaml", datadogtest.ModeReplay)
+	assert.NoError(err)
+	client := Client(ctx)
+	client.GetConfig().HTTPClient = recorder.HTTPClient()
+
+	permissions, _, err := datadogV2.NewRolesApi(client).ListPermissions(ctx)
+	assert.NoError(err)
+	assert.NotEmpty(permissions.GetData())
+
+	_, err = datadogtest.NewRecorder(filepath.Join(t.TempDir(), "missing.yaml"), datadogtest.ModeReplay)
+	assert.Error(err)
+}
+
+func TestRecorderKeepsRequestBody(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+	path := filepath.Join(t.TempDir(), "body.yaml")
+
+	recorder, err := datadogtest.NewRecorder(path, datadogtest.ModeRecord)
+	assert.NoError(err)
+	gock.New("https://api.datadoghq.com").
+		Post("/api/v2/series").
+		Times(2).
+		Reply(202).
+		JSON(map[string]interface{}{"errors": []string{}})
+	defer gock.Off()
+
+	// The body of a request with GetBody is read from it, and left unread.
+	request, err := http.NewRequest(http.MethodPost, "https://api.datadoghq.com/api/v2/series", bytes.NewReader([]byte(`{"series":[]}`)))
+	assert.NoError(err)
+	body := request.Body
+	_, err = recorder.RoundTrip(request)
+	assert.NoError(err)
+	assert.Equal(body, request.Body)
+	content, err := io.ReadAll(request.Body)
+	assert.NoError(err)
+	assert.Equal(`{"series":[]}`, string(content))
+
+	// The body of a request without GetBody is read, and not replaced.
+	request, err = http.NewRequest(http.MethodPost, "https://api.datadoghq.com/api/v2/series", io.NopCloser(bytes.NewReader([]byte(`{"series":[]}`))))
+	assert.NoError(err)
+	assert.Nil(request.GetBody)
+	body = request.Body
+	_, err = recorder.RoundTrip(request)
+	assert.NoError(err)
+	assert.Equal(body, request.Body)
+	assert.NoError(recorder.Close())
+
+	cassette, err := os.ReadFile(path)
+	assert.NoError(err)
+	assert.Equal(2, bytes.Count(cassette, []byte(`{"series":[]}`)))
+}
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestResponseMeta(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().RetryConfiguration.EnableRetry = true
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.TeamsApi.GetTeam")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v2/team/1").
+		Reply(429).
+		SetHeader("X-RateLimit-Limit", "100").
+		SetHeader("X-RateLimit-Remaining", "0").
+		SetHeader("X-RateLimit-Reset", "0")
+	gock.New(URL).
+		Get("/api/v2/team/1").
+		Reply(200).
+		SetHeader("X-Datadog-Request-Id", "abc123").
+		SetHeader("X-RateLimit-Name", "teams").
+		SetHeader("X-RateLimit-Limit", "100").
+		SetHeader("X-RateLimit-Remaining", "99").
+		SetHeader("X-RateLimit-Period", "60").
+		SetHeader("Server-Timing", `db;dur=12.5, app;dur=40;desc="Application"`).
+		JSON(map[string]interface{}{"data": map[string]interface{}{"id": "1", "type": "team"}})
+	defer gock.Off()
+
+	var meta datadog.ResponseMeta
+	_, httpresp, err := datadogV2.NewTeamsApi(client).GetTeam(datadog.WithResponseMeta(ctx, &meta), "1")
+	assert.NoError(err)
+
+	assert.Equal("v2.TeamsApi.GetTeam", meta.OperationID)
+	assert.Equal("abc123", meta.RequestID)
+	assert.Equal(200, meta.StatusCode)
+	assert.Equal(1, meta.Retries)
+	assert.Greater(meta.Latency, time.Duration(0))
+	assert.NotNil(meta.RateLimit)
+	assert.Equal("teams", meta.RateLimit.Name)
+	assert.Equal(99, meta.RateLimit.Remaining)
+	assert.Equal(time.Minute, meta.RateLimit.Period)
+	assert.Equal([]datadog.ServerTiming{
+		{Name: "db", Duration: 12500 * time.Microsecond},
+		{Name: "app", Duration: 40 * time.Millisecond, Description: "Application"},
+	}, meta.ServerTiming)
+
+	fromResponse, ok := datadog.ResponseMetaFromResponse(httpresp)
+	assert.True(ok)
+	assert.Equal(meta, fromResponse)
+}
+
+func TestResponseMetaOnError(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.TeamsApi.GetTeam")
+	assert.NoError(err)
+	gock.New(URL).
+		Get("/api/v2/team/2").
+		Reply(404).
+		SetHeader("X-Datadog-Request-Id", "def456").
+		JSON(map[string]interface{}{"errors": []string{"Not found"}})
+	defer gock.Off()
+
+	var meta datadog.ResponseMeta
+	_, httpresp, err := datadogV2.NewTeamsApi(client).GetTeam(datadog.WithResponseMeta(ctx, &meta), "2")
+	assert.Error(err)
+	assert.Equal("def456", meta.RequestID)
+	assert.Equal(404, meta.StatusCode)
+	assert.Equal(0, meta.Retries)
+	assert.Nil(meta.RateLimit)
+
+	_, ok := datadog.ResponseMetaFromResponse(httpresp)
+	assert.True(ok)
+	_, ok = datadog.ResponseMetaFromResponse(nil)
+	assert.False(ok)
+}
@@ -0,0 +1,330 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog/intake"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+const spoolTestOperationID = "v2.Test.Send"
+
+// spoolRecorder records the payloads sent and dropped by a Spool.
+type spoolRecorder struct {
+	mu    sync.Mutex
+	sent  []string
+	drops []intake.SpoolDrop
+}
+
+func (r *spoolRecorder) send(_ context.Context, body string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sent = append(r.sent, body)
+	return nil
+}
+
+func (r *spoolRecorder) drop(drop intake.SpoolDrop) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.drops = append(r.drops, drop)
+}
+
+func (r *spoolRecorder) options() intake.SpoolOptions {
+	return intake.SpoolOptions{
+		Senders: map[string]intake.SpoolSender{spoolTestOperationID: intake.NewSpoolSender(r.send)},
+		OnDrop:  r.drop,
+	}
+}
+
+// unreachable is the error of a request sent while the network is down.
+var unreachable = &url.Error{Op: "Post", URL: "https://api.datadoghq.com", Err: errors.New("network is unreachable")}
+
+// openClosedSpool returns a closed spool of dir, whose payloads are enqueued to disk without being sent.
+func openClosedSpool(ctx context.Context, t *testing.T, dir string, options intake.SpoolOptions) *intake.Spool {
+	senders := map[string]intake.SpoolSender{}
+	for operationID := range options.Senders {
+		senders[operationID] = func(context.Context, []byte) error { return unreachable }
+	}
+	options.Senders = senders
+	spool, err := intake.OpenSpool(ctx, dir, options)
+	tests.Assert(ctx, t).NoError(err)
+	tests.Assert(ctx, t).NoError(spool.Close())
+	return spool
+}
+
+func TestSpoolReplaysAfterRestart(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+	dir := t.TempDir()
+
+	var attempts sync.WaitGroup
+	attempts.Add(1)
+	var once sync.Once
+	recorder := &spoolRecorder{}
+	options := recorder.options()
+	options.Retry = intake.RetryOptions{Backoff: time.Hour}
+	options.Senders[spoolTestOperationID] = func(context.Context, []byte) error {
+		once.Do(attempts.Done)
+		return unreachable
+	}
+	spool, err := intake.OpenSpool(ctx, dir, options)
+	assert.NoError(err)
+	for i := 0; i < 3; i++ {
+		assert.NoError(spool.Enqueue(spoolTestOperationID, fmt.Sprintf("payload %d", i)))
+	}
+	attempts.Wait()
+	assert.NoError(spool.Close())
+	stats := spool.Stats()
+	assert.Equal(3, stats.Pending)
+	assert.Equal(uint64(0), stats.Sent)
+
+	_, err = os.Stat(filepath.Join(dir, "00000000000000000003.spool"))
+	assert.NoError(err)
+	assert.NoError(os.WriteFile(filepath.Join(dir, "00000000000000000004.spool"), []byte("truncated"), 0o600))
+	assert.NoError(os.WriteFile(filepath.Join(dir, "00000000000000000005.tmp"), []byte("partial"), 0o600))
+
+	spool, err = intake.OpenSpool(ctx, dir, recorder.options())
+	assert.NoError(err)
+	assert.NoError(spool.Enqueue(spoolTestOperationID, "payload 3"))
+	assert.NoError(spool.Drain(ctx))
+	assert.NoError(spool.Close())
+
+	assert.Equal([]string{"payload 0", "payload 1", "payload 2", "payload 3"}, recorder.sent)
+	assert.Equal(intake.SpoolStats{Sent: 4, Dropped: 1}, spool.Stats())
+	assert.Len(recorder.drops, 1)
+	assert.Equal(intake.SpoolDropCorrupted, recorder.drops[0].Reason)
+	assert.Equal(9, recorder.drops[0].Size)
+	files, err := os.ReadDir(dir)
+	assert.NoError(err)
+	assert.Empty(files)
+}
+
+func TestSpoolEviction(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+	body := strings.Repeat("x", 100)
+
+	for _, policy := range []intake.EvictionPolicy{intake.EvictOldest, intake.RejectNewest} {
+		dir := t.TempDir()
+		recorder := &spoolRecorder{}
+		options := recorder.options()
+		spool := openClosedSpool(ctx, t, dir, options)
+		assert.NoError(spool.Enqueue(spoolTestOperationID, body))
+		size := spool.Stats().PendingBytes
+
+		recorder = &spoolRecorder{}
+		options = recorder.options()
+		options.MaxBytes = 3*size + size/2
+		options.Eviction = policy
+		spool = openClosedSpool(ctx, t, dir, options)
+		for i := 1; i < 5; i++ {
+			err := spool.Enqueue(spoolTestOperationID, fmt.Sprintf("%s %d", body[:98], i))
+			if policy == intake.RejectNewest && i > 2 {
+				assert.ErrorIs(err, intake.ErrSpoolFull)
+			} else {
+				assert.NoError(err)
+			}
+		}
+		assert.ErrorIs(spool.Enqueue(spoolTestOperationID, strings.Repeat(body, 5)), intake.ErrSpoolFull)
+		assert.ErrorIs(spool.Enqueue("v2.Test.Unknown", body), intake.ErrNoSpoolSender)
+		assert.Equal(3, spool.Stats().Pending)
+		assert.LessOrEqual(spool.Stats().PendingBytes, options.MaxBytes)
+
+		reasons := []intake.SpoolDropReason{}
+		for _, drop := range recorder.drops {
+			assert.Equal(spoolTestOperationID, drop.OperationID)
+			reasons = append(reasons, drop.Reason)
+		}
+		spool, err := intake.OpenSpool(ctx, dir, recorder.options())
+		assert.NoError(err)
+		assert.NoError(spool.Drain(ctx))
+		assert.NoError(spool.Close())
+
+		switch policy {
+		case intake.EvictOldest:
+			assert.Equal([]intake.SpoolDropReason{intake.SpoolDropEvicted, intake.SpoolDropEvicted, intake.SpoolDropFull}, reasons)
+			assert.Equal([]string{body[:98] + " 2", body[:98] + " 3", body[:98] + " 4"}, recorder.sent)
+		case intake.RejectNewest:
+			assert.Equal([]intake.SpoolDropReason{intake.SpoolDropFull, intake.SpoolDropFull, intake.SpoolDropFull}, reasons)
+			assert.Equal([]string{body, body[:98] + " 1", body[:98] + " 2"}, recorder.sent)
+		}
+	}
+}
+
+func TestSpoolExpires(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+	dir := t.TempDir()
+
+	recorder := &spoolRecorder{}
+	spool := openClosedSpool(ctx, t, dir, recorder.options())
+	assert.NoError(spool.Enqueue(spoolTestOperationID, "old"))
+	// The payload is back-dated by rewriting the enqueue time of the header of its file.
+	path := filepath.Join(dir, "00000000000000000001.spool")
+	content, err := os.ReadFile(path)
+	assert.NoError(err)
+	header, payload, _ := strings.Cut(string(content), "\n")
+	operationID, _, _ := strings.Cut(header, " ")
+	enqueuedAt := time.Now().Add(-2 * time.Hour).UnixNano()
+	assert.NoError(os.WriteFile(path, []byte(fmt.Sprintf("%s %d\n%s", operationID, enqueuedAt, payload)), 0o600))
+
+	options := recorder.options()
+	options.MaxAge = time.Hour
+	spool, err = intake.OpenSpool(ctx, dir, options)
+	assert.NoError(err)
+	assert.NoError(spool.Enqueue(spoolTestOperationID, "new"))
+	assert.NoError(spool.Drain(ctx))
+	assert.NoError(spool.Close())
+
+	assert.Equal([]string{"new"}, recorder.sent)
+	assert.Len(recorder.drops, 1)
+	assert.Equal(intake.SpoolDropExpired, recorder.drops[0].Reason)
+	assert.Equal(intake.SpoolStats{Sent: 1, Dropped: 1}, spool.Stats())
+}
+
+func TestSpoolCorruptedWhileSending(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+	dir := t.TempDir()
+
+	recorder := &spoolRecorder{}
+	spool := openClosedSpool(ctx, t, dir, recorder.options())
+	assert.NoError(spool.Enqueue(spoolTestOperationID, "first"))
+	assert.NoError(spool.Enqueue(spoolTestOperationID, "second"))
+
+	options := recorder.options()
+	options.Senders[spoolTestOperationID] = intake.NewSpoolSender(func(ctx context.Context, body string) error {
+		// The file of the second payload is truncated while the first one is sent.
+		if err := os.WriteFile(filepath.Join(dir, "00000000000000000002.spool"), []byte("truncated"), 0o600); err != nil {
+			return err
+		}
+		return recorder.send(ctx, body)
+	})
+	spool, err := intake.OpenSpool(ctx, dir, options)
+	assert.NoError(err)
+	assert.NoError(spool.Drain(ctx))
+	assert.NoError(spool.Close())
+
+	assert.Equal([]string{"first"}, recorder.sent)
+	assert.Len(recorder.drops, 1)
+	assert.Equal(intake.SpoolDropCorrupted, recorder.drops[0].Reason)
+	assert.Equal(spoolTestOperationID, recorder.drops[0].OperationID)
+	assert.Error(recorder.drops[0].Err)
+	assert.Equal(intake.SpoolStats{Sent: 1, Dropped: 1}, spool.Stats())
+}
+
+func TestSpoolConcurrentEnqueue(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+
+	recorder := &spoolRecorder{}
+	spool, err := intake.OpenSpool(ctx, t.TempDir(), recorder.options())
+	assert.NoError(err)
+	var enqueued sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		enqueued.Add(1)
+		go func(i int) {
+			defer enqueued.Done()
+			for j := 0; j < 25; j++ {
+				assert.NoError(spool.Enqueue(spoolTestOperationID, fmt.Sprintf("payload %d-%d", i, j)))
+			}
+		}(i)
+	}
+	enqueued.Wait()
+	assert.NoError(spool.Drain(ctx))
+	assert.NoError(spool.Close())
+
+	assert.Len(recorder.sent, 100)
+	// The payloads enqueued by a goroutine are sent in order.
+	last := map[int]int{}
+	for _, body := range recorder.sent {
+		var i, j int
+		_, err := fmt.Sscanf(body, "payload %d-%d", &i, &j)
+		assert.NoError(err)
+		if previous, ok := last[i]; ok {
+			assert.Less(previous, j)
+		}
+		last[i] = j
+	}
+	assert.Equal(intake.SpoolStats{Sent: 100}, spool.Stats())
+}
+
+func TestSpoolSubmitMetrics(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+	client.GetConfig().RetryConfiguration.EnableRetry = false
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.MetricsApi.SubmitMetrics")
+	assert.NoError(err)
+	received := &tests.CapturePayloads[datadogV2.MetricPayload]{}
+	gock.New(URL).
+		Post("/api/v2/series").
+		Reply(503).
+		JSON(map[string]interface{}{"errors": []string{"Service unavailable"}})
+	gock.New(URL).
+		Post("/api/v2/series").
+		AddMatcher(received.Match).
+		Reply(202).
+		JSON(map[string]interface{}{"errors": []string{}})
+	gock.New(URL).
+		Post("/api/v2/series").
+		Reply(400).
+		JSON(map[string]interface{}{"errors": []string{"Bad request"}})
+	defer gock.Off()
+
+	recorder := &spoolRecorder{}
+	api := datadogV2.NewMetricsApi(client)
+	spool, err := intake.OpenSpool(ctx, t.TempDir(), intake.SpoolOptions{
+		Senders: map[string]intake.SpoolSender{
+			"v2.MetricsApi.SubmitMetrics": intake.SubmitMetricsSender(api),
+		},
+		Retry:  intake.RetryOptions{Backoff: 10 * time.Millisecond},
+		OnDrop: recorder.drop,
+	})
+	assert.NoError(err)
+	defer spool.Close()
+	submitter := intake.NewMetricsSubmitter(ctx, api, intake.MetricsSubmitterOptions{
+		FlushInterval: time.Hour,
+		Spool:         spool,
+	})
+	defer submitter.Close()
+
+	submitter.Gauge("queue.size", 3)
+	assert.NoError(submitter.Flush(ctx))
+	assert.NoError(spool.Drain(ctx))
+	payloads := received.Payloads()
+	assert.Len(payloads, 1)
+	assert.Equal("queue.size", payloads[0].Series[0].Metric)
+
+	submitter.Gauge("queue.size", 4)
+	assert.NoError(submitter.Flush(ctx))
+	assert.NoError(spool.Drain(ctx))
+	assert.Equal(intake.SpoolStats{Sent: 1, Dropped: 1}, spool.Stats())
+	assert.Len(recorder.drops, 1)
+	assert.Equal(intake.SpoolDropRejected, recorder.drops[0].Reason)
+	apiErr, ok := datadog.AsAPIError(recorder.drops[0].Err)
+	assert.True(ok)
+	assert.Equal(400, apiErr.StatusCode)
+	assert.True(gock.IsDone())
+}
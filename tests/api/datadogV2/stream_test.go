@@ -0,0 +1,62 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestListLogsStream(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.LogsApi.ListLogs")
+	assert.NoError(err)
+	body := map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{"id": "first", "type": "log"},
+			map[string]interface{}{"id": "second", "type": "log"},
+			map[string]interface{}{"id": "third", "type": "log"},
+		},
+		"meta": map[string]interface{}{"page": map[string]interface{}{"after": "cursor"}},
+	}
+	gock.New(URL).
+		Post("/api/v2/logs/events/search").
+		Times(2).
+		Reply(200).
+		JSON(body)
+	defer gock.Off()
+
+	api := datadogV2.NewLogsApi(client)
+	var ids []string
+	resp, httpresp, err := api.ListLogsStream(ctx, func(item datadogV2.Log) error {
+		ids = append(ids, item.GetId())
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal(200, httpresp.StatusCode)
+	assert.Equal([]string{"first", "second", "third"}, ids)
+	assert.Empty(resp.GetData())
+	assert.Equal("cursor", resp.Meta.Page.GetAfter())
+
+	// An error returned by the callback stops the decoding.
+	errStop := errors.New("stop")
+	ids = nil
+	_, _, err = api.ListLogsStream(ctx, func(item datadogV2.Log) error {
+		ids = append(ids, item.GetId())
+		if len(ids) == 2 {
+			return errStop
+		}
+		return nil
+	})
+	assert.ErrorIs(err, errStop)
+	assert.Equal([]string{"first", "second"}, ids)
+}
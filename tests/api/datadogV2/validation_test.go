@@ -0,0 +1,136 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+// validationErrors lists the validation errors joined in err.
+func validationErrors(err error) map[string]string {
+	found := make(map[string]string)
+	var walk func(err error)
+	walk = func(err error) {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, err := range joined.Unwrap() {
+				walk(err)
+			}
+			return
+		}
+		var validationError *datadog.ValidationError
+		if errors.As(err, &validationError) {
+			found[validationError.Field] = validationError.Message
+		}
+	}
+	walk(err)
+	return found
+}
+
+func TestValidate(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+
+	team := datadogV2.NewTeamCreateRequest(*datadogV2.NewTeamCreate(
+		*datadogV2.NewTeamCreateAttributes("team-handle", "Team"),
+		datadogV2.TEAMTYPE_TEAM,
+	))
+	assert.NoError(team.Validate())
+
+	team.Data.Attributes.Handle = strings.Repeat("h", 196)
+	team.Data.Type = "teams"
+	err := team.Validate()
+	assert.Error(err)
+	assert.Equal(map[string]string{
+		"data.attributes.handle": "length must be at most 195, got 196",
+		"data.type":              "invalid value 'teams' for TeamType: valid values are [team]",
+	}, validationErrors(err))
+
+	policy := datadogV2.NewMonitorConfigPolicyCreateRequest(*datadogV2.NewMonitorConfigPolicyCreateData(
+		*datadogV2.NewMonitorConfigPolicyAttributeCreateRequest(
+			datadogV2.MonitorConfigPolicyTagPolicyCreateRequestAsMonitorConfigPolicyPolicyCreateRequest(
+				datadogV2.NewMonitorConfigPolicyTagPolicyCreateRequest("env", true, []string{"prod", strings.Repeat("v", 256)}),
+			),
+			datadogV2.MONITORCONFIGPOLICYTYPE_TAG,
+		),
+		datadogV2.MONITORCONFIGPOLICYRESOURCETYPE_MONITOR_CONFIG_POLICY,
+	))
+	assert.Equal(map[string]string{
+		"data.attributes.policy.valid_tag_values[1]": "length must be at most 255, got 256",
+	}, validationErrors(policy.Validate()))
+}
+
+func TestValidateNullable(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	assert := tests.Assert(ctx, t)
+
+	attributes := datadogV2.NewTeamAttributes("team-handle", "Team")
+	assert.NoError(attributes.Validate())
+	attributes.SetSummaryNil()
+	assert.NoError(attributes.Validate())
+	attributes.SetSummary(strings.Repeat("s", 121))
+	assert.Equal(map[string]string{
+		"summary": "length must be at most 120, got 121",
+	}, validationErrors(attributes.Validate()))
+
+	job := datadogV2.NewCIAppPipelineEventJobWithDefaults()
+	job.SetQueueTime(-1)
+	job.SetError(datadogV2.CIAppCIError{Message: *datadog.NewNullableString(datadog.PtrString(strings.Repeat("m", 5001)))})
+	errs := validationErrors(job.Validate())
+	assert.Equal("must be greater than or equal to 0, got -1", errs["queue_time"])
+	assert.Equal("length must be at most 5000, got 5001", errs["error.message"])
+	job.UnsetQueueTime()
+	job.SetErrorNil()
+	errs = validationErrors(job.Validate())
+	assert.NotContains(errs, "queue_time")
+	assert.NotContains(errs, "error.message")
+
+	assert.Equal(map[string]string{
+		"value": "length must be at most 3, got 4",
+	}, validationErrors(datadog.ValidateValue("value", *datadog.NewNullableString(datadog.PtrString("four")), &datadog.Constraints{MaxLength: datadog.PtrInt64(3)})))
+}
+
+func TestValidateRequests(t *testing.T) {
+	ctx, finish := tests.WithTestSpan(context.Background(), t)
+	defer finish()
+	ctx = WithClient(WithFakeAuth(ctx))
+	assert := tests.Assert(ctx, t)
+	client := Client(ctx)
+
+	URL, err := client.GetConfig().ServerURLWithContext(ctx, "v2.TeamsApi.CreateTeam")
+	assert.NoError(err)
+	gock.New(URL).
+		Post("/api/v2/team").
+		Reply(201).
+		JSON(map[string]interface{}{"data": map[string]interface{}{"id": "1", "type": "team"}})
+	defer gock.Off()
+
+	api := datadogV2.NewTeamsApi(client)
+	body := *datadogV2.NewTeamCreateRequest(*datadogV2.NewTeamCreate(
+		*datadogV2.NewTeamCreateAttributes(strings.Repeat("h", 196), "Team"),
+		datadogV2.TEAMTYPE_TEAM,
+	))
+
+	client.GetConfig().ValidateRequests = true
+	_, httpresp, err := api.CreateTeam(ctx, body)
+	assert.Error(err)
+	assert.Nil(httpresp)
+	assert.Contains(err.Error(), "invalid request body: data.attributes.handle: length must be at most 195")
+	var validationError *datadog.ValidationError
+	assert.True(errors.As(err, &validationError))
+	assert.True(gock.IsPending())
+
+	client.GetConfig().ValidateRequests = false
+	_, httpresp, err = api.CreateTeam(ctx, body)
+	assert.NoError(err)
+	assert.Equal(201, httpresp.StatusCode)
+	assert.True(gock.IsDone())
+}
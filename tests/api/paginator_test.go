@@ -0,0 +1,196 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+// pagedItems serves the items 0 to total-1, recording the page requests.
+type pagedItems struct {
+	total    int
+	requests []datadog.PageRequest
+}
+
+func (p *pagedItems) fetch(strategy datadog.PaginationStrategy) func(context.Context, datadog.PageRequest) (datadog.Page[int], error) {
+	return func(ctx context.Context, page datadog.PageRequest) (datadog.Page[int], error) {
+		p.requests = append(p.requests, page)
+		size := int(page.Size)
+		start := 0
+		switch strategy {
+		case datadog.OffsetPagination:
+			start = int(page.Offset)
+		case datadog.PageNumberPagination:
+			start = int(page.Number) * size
+		case datadog.CursorPagination, datadog.NextRecordIDPagination:
+			if page.Cursor != "" {
+				start, _ = strconv.Atoi(page.Cursor)
+			}
+		}
+		var result datadog.Page[int]
+		for i := start; i < start+size && i < p.total; i++ {
+			result.Items = append(result.Items, i)
+		}
+		if end := start + size; end < p.total {
+			result.Cursor = strconv.Itoa(end)
+		}
+		return result, nil
+	}
+}
+
+func TestPaginatorStrategies(t *testing.T) {
+	for _, strategy := range []datadog.PaginationStrategy{
+		datadog.CursorPagination,
+		datadog.OffsetPagination,
+		datadog.PageNumberPagination,
+		datadog.NextRecordIDPagination,
+	} {
+		assert := tests.Assert(context.Background(), t)
+		items := &pagedItems{total: 5}
+		paginator := datadog.Paginator[int]{
+			Strategy: strategy,
+			First:    datadog.PageRequest{Size: 2},
+			Fetch:    items.fetch(strategy),
+		}
+		var got []int
+		for item, err := range paginator.Seq(context.Background()) {
+			assert.NoError(err)
+			got = append(got, item)
+		}
+		assert.Equal([]int{0, 1, 2, 3, 4}, got, "strategy %d", strategy)
+		assert.Len(items.requests, 3, "strategy %d", strategy)
+	}
+}
+
+func TestPaginatorStopsOnFullLastPage(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	items := &pagedItems{total: 4}
+	paginator := datadog.Paginator[int]{
+		Strategy: datadog.PageNumberPagination,
+		First:    datadog.PageRequest{Size: 2},
+		Fetch:    items.fetch(datadog.PageNumberPagination),
+	}
+	count := 0
+	for range paginator.Seq(context.Background()) {
+		count++
+	}
+	assert.Equal(4, count)
+	// The last page is full, so an empty page is needed to know it is the last one.
+	assert.Len(items.requests, 3)
+	assert.Equal(int64(2), items.requests[2].Number)
+}
+
+func TestPaginatorChannel(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	errBoom := errors.New("boom")
+	calls := 0
+	paginator := datadog.Paginator[int]{
+		Strategy: datadog.OffsetPagination,
+		First:    datadog.PageRequest{Size: 1},
+		Fetch: func(ctx context.Context, page datadog.PageRequest) (datadog.Page[int], error) {
+			calls++
+			if page.Offset > 0 {
+				return datadog.Page[int]{}, errBoom
+			}
+			return datadog.Page[int]{Items: []int{42}}, nil
+		},
+	}
+	items, cancel := paginator.Channel(context.Background())
+	defer cancel()
+	var results []datadog.PaginationResult[int]
+	for result := range items {
+		results = append(results, result)
+	}
+	assert.Len(results, 2)
+	assert.Equal(42, results[0].Item)
+	assert.NoError(results[0].Error)
+	assert.ErrorIs(results[1].Error, errBoom)
+	assert.Equal(2, calls)
+}
+
+func TestPaginatorPrefetch(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	var inFlight, maxInFlight, calls int32
+	paginator := datadog.Paginator[int]{
+		Strategy: datadog.PageNumberPagination,
+		First:    datadog.PageRequest{Size: 2},
+		Prefetch: 3,
+		Fetch: func(ctx context.Context, page datadog.PageRequest) (datadog.Page[int], error) {
+			atomic.AddInt32(&calls, 1)
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				m := atomic.LoadInt32(&maxInFlight)
+				if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+					break
+				}
+			}
+			// Later pages answer first, to check that the order is preserved.
+			time.Sleep(time.Duration(10-page.Number) * time.Millisecond)
+			var result datadog.Page[int]
+			for i := page.Number * 2; i < page.Number*2+2 && i < 11; i++ {
+				result.Items = append(result.Items, int(i))
+			}
+			return result, nil
+		},
+	}
+	var got []int
+	for item, err := range paginator.Seq(context.Background()) {
+		assert.NoError(err)
+		got = append(got, item)
+	}
+	assert.Equal([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, got)
+	assert.LessOrEqual(atomic.LoadInt32(&maxInFlight), int32(3))
+	assert.Greater(atomic.LoadInt32(&maxInFlight), int32(1))
+	// All the requests are done when the iteration ends.
+	assert.Equal(int32(0), atomic.LoadInt32(&inFlight))
+	// At most Prefetch-1 pages are requested past the last one.
+	assert.LessOrEqual(atomic.LoadInt32(&calls), int32(8))
+}
+
+func TestPaginatorPrefetchStops(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	errBoom := errors.New("boom")
+	var mu sync.Mutex
+	var canceled int
+	paginator := datadog.Paginator[int]{
+		Strategy: datadog.OffsetPagination,
+		First:    datadog.PageRequest{Size: 1},
+		Prefetch: 4,
+		Fetch: func(ctx context.Context, page datadog.PageRequest) (datadog.Page[int], error) {
+			if page.Offset == 2 {
+				return datadog.Page[int]{}, errBoom
+			}
+			if page.Offset > 2 {
+				<-ctx.Done()
+				mu.Lock()
+				canceled++
+				mu.Unlock()
+				return datadog.Page[int]{}, ctx.Err()
+			}
+			return datadog.Page[int]{Items: []int{int(page.Offset)}}, nil
+		},
+	}
+	var got []int
+	var errs []error
+	for item, err := range paginator.Seq(context.Background()) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, item)
+	}
+	assert.Equal([]int{0, 1}, got)
+	assert.Len(errs, 1)
+	assert.ErrorIs(errs[0], errBoom)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Greater(canceled, 0)
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func rateLimitHeader(name string, limit, remaining, reset string) http.Header {
+	header := http.Header{}
+	header.Set("X-RateLimit-Name", name)
+	header.Set("X-RateLimit-Limit", limit)
+	header.Set("X-RateLimit-Period", "10")
+	header.Set("X-RateLimit-Remaining", remaining)
+	header.Set("X-RateLimit-Reset", reset)
+	return header
+}
+
+func TestRateLimiterTracksBuckets(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	limiter := datadog.NewRateLimiter()
+
+	release, err := limiter.Wait(context.Background(), "v2.LogsApi.ListLogs")
+	assert.NoError(err)
+	release(rateLimitHeader("logs_list", "300", "299", "10"))
+	release, err = limiter.Wait(context.Background(), "v2.MetricsApi.QueryTimeseriesData")
+	assert.NoError(err)
+	release(rateLimitHeader("query", "100", "42", "5"))
+
+	buckets := limiter.Buckets()
+	assert.Len(buckets, 2)
+	assert.Equal("logs_list", buckets[0].Name)
+	assert.Equal(300, buckets[0].Limit)
+	assert.Equal(299, buckets[0].Remaining)
+	assert.Equal(10*time.Second, buckets[0].Period)
+	assert.Equal("query", buckets[1].Name)
+	assert.Equal(42, buckets[1].Remaining)
+
+	name, ok := limiter.BucketName("v2.LogsApi.ListLogs")
+	assert.True(ok)
+	assert.Equal("logs_list", name)
+}
+
+func TestRateLimiterBlocksExhaustedBucket(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	limiter := datadog.NewRateLimiter()
+	limiter.Update("v2.LogsApi.ListLogs", rateLimitHeader("logs_list", "2", "1", "60"))
+
+	release, err := limiter.Wait(context.Background(), "v2.LogsApi.ListLogs")
+	assert.NoError(err)
+	bucket, _ := limiter.Bucket("logs_list")
+	assert.Equal(1, bucket.InFlight)
+
+	// The only remaining request is in flight, so the next caller has to wait for the reset.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Wait(ctx, "v2.LogsApi.ListLogs")
+	assert.ErrorIs(err, context.DeadlineExceeded)
+
+	release(nil)
+	bucket, _ = limiter.Bucket("logs_list")
+	assert.Equal(0, bucket.InFlight)
+	_, err = limiter.Wait(context.Background(), "v2.LogsApi.ListLogs")
+	assert.NoError(err)
+}
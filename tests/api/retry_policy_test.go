@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/tests"
+)
+
+func TestIdempotentOperations(t *testing.T) {
+	assert := tests.Assert(context.Background(), t)
+	configuration := datadog.NewConfiguration()
+
+	assert.True(configuration.IsIdempotentOperation("v1.MonitorsApi.GetMonitor", http.MethodGet))
+	assert.True(configuration.IsIdempotentOperation("v2.LogsApi.ListLogs", http.MethodPost))
+	assert.False(configuration.IsIdempotentOperation("v1.MonitorsApi.CreateMonitor", http.MethodPost))
+	assert.False(configuration.IsIdempotentOperation("v2.IncidentsApi.CreateIncident", http.MethodPost))
+
+	configuration.SetIdempotentOperation("v1.MonitorsApi.DeleteMonitor", false)
+	assert.False(configuration.IsIdempotentOperation("v1.MonitorsApi.DeleteMonitor", http.MethodDelete))
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	policy := &datadog.DefaultRetryPolicy{
+		MaxRetries:           3,
+		BackOffBase:          time.Second,
+		BackOffMultiplier:    2,
+		RetryTransportErrors: true,
+	}
+	req, _ := http.NewRequest(http.MethodPost, "https://api.datadoghq.com/api/v1/monitor", nil)
+	response := func(status int, header map[string]string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		for k, v := range header {
+			resp.Header.Set(k, v)
+		}
+		return resp
+	}
+
+	testCases := []struct {
+		name          string
+		resp          *http.Response
+		err           error
+		info          datadog.InterceptorInfo
+		expectedRetry bool
+		maxDuration   time.Duration
+		minDuration   time.Duration
+	}{
+		{"rate limited with retry-after", response(429, map[string]string{"Retry-After": "7"}), nil, datadog.InterceptorInfo{Attempt: 1}, true, 7 * time.Second, 7 * time.Second},
+		{"rate limited with reset", response(429, map[string]string{"X-RateLimit-Reset": "3"}), nil, datadog.InterceptorInfo{Attempt: 1}, true, 3 * time.Second, 3 * time.Second},
+		{"server error on idempotent operation", response(503, nil), nil, datadog.InterceptorInfo{Attempt: 2, Idempotent: true}, true, 2 * time.Second, 0},
+		{"server error on non-idempotent operation", response(503, nil), nil, datadog.InterceptorInfo{Attempt: 1}, false, 0, 0},
+		{"client error", response(404, nil), nil, datadog.InterceptorInfo{Attempt: 1, Idempotent: true}, false, 0, 0},
+		{"max retries reached", response(429, nil), nil, datadog.InterceptorInfo{Attempt: 4}, false, 0, 0},
+		{"connection refused", nil, syscall.ECONNREFUSED, datadog.InterceptorInfo{Attempt: 1}, true, time.Second, 0},
+		{"connection reset on idempotent operation", nil, syscall.ECONNRESET, datadog.InterceptorInfo{Attempt: 1, Idempotent: true}, true, time.Second, 0},
+		{"connection reset on non-idempotent operation", nil, syscall.ECONNRESET, datadog.InterceptorInfo{Attempt: 1}, false, 0, 0},
+		{"unknown error", nil, errors.New("boom"), datadog.InterceptorInfo{Attempt: 1, Idempotent: true}, false, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		c := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tests.Assert(context.Background(), t)
+			duration, retry := policy.ShouldRetry(req, c.resp, c.err, c.info)
+			assert.Equal(c.expectedRetry, retry)
+			assert.LessOrEqual(duration, c.maxDuration)
+			assert.GreaterOrEqual(duration, c.minDuration)
+		})
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/DataDog/datadog-api-client-go/v2/tests
 
-go 1.22
+go 1.23
 
 require (
 	github.com/DataDog/datadog-api-client-go/v2 v2.14.0
@@ -17,6 +17,7 @@ require (
 )
 
 require (
+	github.com/BurntSushi/toml v1.4.0 // indirect
 	github.com/DataDog/datadog-go v4.8.2+incompatible // indirect
 	github.com/DataDog/sketches-go v1.0.0 // indirect
 	github.com/DataDog/zstd v1.5.2 // indirect
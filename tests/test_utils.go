@@ -8,7 +8,10 @@ package tests
 
 import (
 	"bytes"
+	"compress/gzip"
+	"compress/zlib"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
@@ -20,6 +23,7 @@ import (
 	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -33,6 +37,7 @@ import (
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+	"gopkg.in/h2non/gock.v1"
 )
 
 // RecordingMode defines valid usage of cassette recorder
@@ -73,7 +78,9 @@ var testFiles2EndpointTags = map[string]map[string]string{
 		"api_tags_test":                     "tags",
 		"api_usage_metering_test":           "usage-metering",
 		"api_users_test":                    "users",
+		"distribution_submitter_test":       "distribution-submitter",
 		"telemetry_test":                    "telemetry",
+		"unparsed_test":                     "strict-decoding",
 	},
 	"tests/api/datadogV2": {
 		"api_dashboard_lists_test": "dashboard-lists",
@@ -84,8 +91,25 @@ var testFiles2EndpointTags = map[string]map[string]string{
 		"api_processes_test":       "processes",
 		"api_roles_test":           "roles",
 		"api_users_test":           "users",
+		"batch_test":               "batch",
+		"circuit_breaker_test":     "circuit-breaker",
+		"errors_test":              "errors",
+		"fake_server_test":         "fake-server",
+		"interceptor_test":         "interceptors",
+		"interfaces_test":          "interfaces",
+		"log_handler_test":         "log-handler",
+		"log_shipper_test":         "log-shipper",
+		"logger_test":              "logging",
+		"metrics_submitter_test":   "metrics-submitter",
+		"orgs_test":                "organizations",
+		"pagination_test":          "pagination",
+		"recorder_test":            "recording",
+		"response_meta_test":       "response-metadata",
 		"security_monitoring_test": "security-monitoring",
+		"spool_test":               "spool",
+		"stream_test":              "streaming",
 		"telemetry_test":           "telemetry",
+		"validation_test":          "request-validation",
 	},
 	"tests/api": {
 		"deserialization_test": "deserialization",
@@ -549,3 +573,88 @@ func Assert(ctx context.Context, t *testing.T) *Assertions {
 	t.Helper()
 	return &Assertions{*require.New(&TestingT{t, ctx})}
 }
+
+// CapturedPayload is a request body recorded by CapturePayloads.
+type CapturedPayload[T any] struct {
+	Body     T
+	Encoding string
+	Size     int
+	Query    url.Values
+}
+
+// CapturePayloads records the JSON bodies of the requests matched by a gock mock,
+// decompressed according to their Content-Encoding header.
+type CapturePayloads[T any] struct {
+	mu       sync.Mutex
+	captured []CapturedPayload[T]
+}
+
+// Match is a gock matcher capturing the request body.
+func (c *CapturePayloads[T]) Match(req *http.Request, _ *gock.Request) (bool, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return false, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	encoding := req.Header.Get("Content-Encoding")
+	var reader io.Reader
+	switch encoding {
+	case "gzip":
+		reader, err = gzip.NewReader(bytes.NewReader(body))
+	case "deflate":
+		reader, err = zlib.NewReader(bytes.NewReader(body))
+	}
+	if err != nil {
+		return false, err
+	}
+	if reader != nil {
+		if body, err = io.ReadAll(reader); err != nil {
+			return false, err
+		}
+	}
+	var payload T
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.captured = append(c.captured, CapturedPayload[T]{
+		Body:     payload,
+		Encoding: encoding,
+		Size:     len(body),
+		Query:    req.URL.Query(),
+	})
+	return true, nil
+}
+
+// Captured returns the requests captured so far.
+func (c *CapturePayloads[T]) Captured() []CapturedPayload[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]CapturedPayload[T](nil), c.captured...)
+}
+
+// Payloads returns the bodies captured so far.
+func (c *CapturePayloads[T]) Payloads() []T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	payloads := make([]T, len(c.captured))
+	for i, captured := range c.captured {
+		payloads[i] = captured.Body
+	}
+	return payloads
+}
+
+// Len returns the number of requests captured so far.
+func (c *CapturePayloads[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.captured)
+}
+
+// Reset forgets the requests captured so far.
+func (c *CapturePayloads[T]) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.captured = nil
+}